@@ -3,6 +3,7 @@ package chains
 import (
 	"fmt"
 	"math/big"
+	"sort"
 	"sync"
 
 	"github.com/smartcontractkit/chainlink/core/logger"
@@ -12,12 +13,41 @@ import (
 // 1. Add the global var in the vars list
 // 2. Add the chain ID in the map in the init() function
 // 3. Add a config set in configs.go
+//
+// The chains in this file are the built-in defaults. The node seeds them into
+// the database, where their configs can be changed at runtime with SetConfig.
+
+// ChainType denotes the L2 flavour of a chain, if any
+type ChainType string
+
+const (
+	// ChainTypeNone is a regular L1 chain
+	ChainTypeNone ChainType = ""
+	// ChainArbitrum is an Arbitrum L2 chain
+	ChainArbitrum ChainType = "arbitrum"
+	// ChainOptimism is an Optimism L2 chain
+	ChainOptimism ChainType = "optimism"
+)
+
+// IsValid returns true if t is a known chain type
+func (t ChainType) IsValid() bool {
+	switch t {
+	case ChainTypeNone, ChainArbitrum, ChainOptimism:
+		return true
+	}
+	return false
+}
 
 // Chain represents a blockchain with a unique Chain ID
 type Chain struct {
 	id      *big.Int
 	config  ChainSpecificConfig
 	logOnce sync.Once
+
+	// override is the config set with SetConfig, which takes precedence over
+	// the built-in config
+	override   *ChainSpecificConfig
+	overrideMu sync.RWMutex
 }
 
 func (c *Chain) setChainID(id int64) {
@@ -29,23 +59,45 @@ func (c *Chain) ID() *big.Int {
 }
 
 func (c *Chain) Config() ChainSpecificConfig {
+	c.overrideMu.RLock()
+	override := c.override
+	c.overrideMu.RUnlock()
+	if override != nil {
+		return *override
+	}
 	if !c.config.set {
 		c.logOnce.Do(func() {
 			logger.Warnf("chain with ID %s does not have a chain-specific config, using fallback config instead", c.ID())
 		})
+	}
+	return c.DefaultConfig()
+}
+
+// DefaultConfig returns the built-in config of the chain, or FallbackConfig
+// if there is none
+func (c *Chain) DefaultConfig() ChainSpecificConfig {
+	if !c.config.set {
 		return FallbackConfig
 	}
 	return c.config
 }
 
-// IsArbitrum returns true if the chain is arbitrum mainnet or testnet
+// SetConfig replaces the config of the chain, it takes effect immediately
+func (c *Chain) SetConfig(cfg ChainSpecificConfig) {
+	cfg.set = true
+	c.overrideMu.Lock()
+	defer c.overrideMu.Unlock()
+	c.override = &cfg
+}
+
+// IsArbitrum returns true if the chain is an arbitrum chain
 func (c *Chain) IsArbitrum() bool {
-	return c.ID().Cmp(ArbitrumMainnet.ID()) == 0 || c.ID().Cmp(ArbitrumRinkeby.ID()) == 0
+	return c.Config().ChainType == ChainArbitrum
 }
 
-// IsOptimism returns true if the chain is optimism mainnet or testnet
+// IsOptimism returns true if the chain is an optimism chain
 func (c *Chain) IsOptimism() bool {
-	return c.ID().Cmp(OptimismMainnet.ID()) == 0 || c.ID().Cmp(OptimismKovan.ID()) == 0
+	return c.Config().ChainType == ChainOptimism
 }
 
 // IsL2 returns true if this chain is an L2 chain, notably that the block
@@ -112,6 +164,38 @@ var chainsMu sync.Mutex
 // ChainFromID returns the chain for the given ID
 // If no chain is found, creates a new one and returns that
 func ChainFromID(id *big.Int) *Chain {
+	return chainFromID(id, true)
+}
+
+// DefaultConfig returns the built-in config of the chain with the given ID, or
+// FallbackConfig if it is not one of the built-in chains
+func DefaultConfig(id *big.Int) ChainSpecificConfig {
+	return chainFromID(id, false).DefaultConfig()
+}
+
+// SetConfig replaces the config of the chain with the given ID, which need not
+// be one of the built-in chains
+func SetConfig(id *big.Int, cfg ChainSpecificConfig) {
+	chainFromID(id, false).SetConfig(cfg)
+}
+
+// DefaultChains returns the built-in chains in order of ID
+func DefaultChains() []*Chain {
+	chainsMu.Lock()
+	defer chainsMu.Unlock()
+	var defaults []*Chain
+	for _, chain := range chains {
+		if chain.config.set {
+			defaults = append(defaults, chain)
+		}
+	}
+	sort.Slice(defaults, func(i, j int) bool {
+		return defaults[i].id.Cmp(defaults[j].id) < 0
+	})
+	return defaults
+}
+
+func chainFromID(id *big.Int, warn bool) *Chain {
 	if !id.IsInt64() {
 		panic(fmt.Sprintf("chain IDs larger than the max 64 bit integer are not currently supported, got: %s", id.String()))
 	}
@@ -121,7 +205,9 @@ func ChainFromID(id *big.Int) *Chain {
 	if exists {
 		return chain
 	}
-	logger.Warnf("Chain ID %s is not known, falling back to generic chain", id)
+	if warn {
+		logger.Warnf("Chain ID %s is not known, falling back to generic chain", id)
+	}
 	chain = new(Chain)
 	chain.id = id
 	chains[id.Int64()] = chain
@@ -6,6 +6,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/chains"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func Test_ChainFromID(t *testing.T) {
@@ -30,3 +31,30 @@ func Test_ChainFromID(t *testing.T) {
 		assert.Equal(t, "", c3.Config().LinkContractAddress)
 	})
 }
+
+func Test_SetConfig(t *testing.T) {
+	id := big.NewInt(98766)
+	c := chains.ChainFromID(id)
+	assert.False(t, c.IsL2())
+
+	cfg := chains.DefaultConfig(id)
+	cfg.ChainType = chains.ChainOptimism
+	cfg.EthFinalityDepth = 3
+	chains.SetConfig(id, cfg)
+
+	assert.Equal(t, uint(3), c.Config().EthFinalityDepth)
+	assert.True(t, c.IsOptimism())
+	assert.Equal(t, chains.FallbackConfig.EthFinalityDepth, c.DefaultConfig().EthFinalityDepth)
+}
+
+func Test_DefaultChains(t *testing.T) {
+	defaults := chains.DefaultChains()
+
+	require.NotEmpty(t, defaults)
+	assert.Equal(t, big.NewInt(1), defaults[0].ID())
+	for i := 1; i < len(defaults); i++ {
+		assert.Equal(t, -1, defaults[i-1].ID().Cmp(defaults[i].ID()))
+	}
+	assert.True(t, chains.ArbitrumMainnet.IsArbitrum())
+	assert.True(t, chains.OptimismKovan.IsOptimism())
+}
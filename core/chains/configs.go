@@ -14,13 +14,18 @@ type (
 		BlockHistoryEstimatorBatchSize        uint32
 		BlockHistoryEstimatorBlockDelay       uint16
 		BlockHistoryEstimatorBlockHistorySize uint16
+		ChainType                             ChainType
 		EthBalanceMonitorBlockDelay           uint16
+		EthEIP1559DynamicFees                 bool
 		EthFinalityDepth                      uint
+		EthGasBumpPercent                     uint16
 		EthGasBumpThreshold                   uint64
 		EthGasBumpWei                         big.Int
 		EthGasLimitDefault                    uint64
 		EthGasLimitTransfer                   uint64
 		EthGasPriceDefault                    big.Int
+		EthGasTipCapDefault                   big.Int
+		EthGasTipCapMinimum                   big.Int
 		EthHeadTrackerHistoryDepth            uint
 		EthHeadTrackerSamplingInterval        time.Duration
 		BlockEmissionIdleWarningThreshold     time.Duration
@@ -30,6 +35,7 @@ type (
 		EthMinGasPriceWei                     big.Int
 		EthTxResendAfterThreshold             time.Duration
 		GasEstimatorMode                      string
+		KeeperMulticallAddress                string
 		LinkContractAddress                   string
 		MinIncomingConfirmations              uint32
 		MinRequiredOutgoingConfirmations      uint64
@@ -59,13 +65,18 @@ func setConfigs() {
 		BlockHistoryEstimatorBatchSize:        4, // FIXME: Workaround `websocket: read limit exceeded` until https://app.clubhouse.io/chainlinklabs/story/6717/geth-websockets-can-sometimes-go-bad-under-heavy-load-proposal-for-eth-node-balancer
 		BlockHistoryEstimatorBlockDelay:       1,
 		BlockHistoryEstimatorBlockHistorySize: 24,
+		ChainType:                             ChainTypeNone,
 		EthBalanceMonitorBlockDelay:           1,
+		EthEIP1559DynamicFees:                 false,
 		EthFinalityDepth:                      50,
+		EthGasBumpPercent:                     20,
 		EthGasBumpThreshold:                   3,
 		EthGasBumpWei:                         *assets.GWei(5),
 		EthGasLimitDefault:                    500000,
 		EthGasLimitTransfer:                   21000,
 		EthGasPriceDefault:                    *assets.GWei(20),
+		EthGasTipCapDefault:                   *big.NewInt(1), // Only used for EIP-1559 dynamic fee transactions
+		EthGasTipCapMinimum:                   *big.NewInt(1), // Only used for EIP-1559 dynamic fee transactions
 		EthHeadTrackerHistoryDepth:            100,
 		EthHeadTrackerSamplingInterval:        1 * time.Second,
 		BlockEmissionIdleWarningThreshold:     1 * time.Minute,
@@ -75,6 +86,7 @@ func setConfigs() {
 		EthMinGasPriceWei:                     *assets.GWei(1),
 		EthTxResendAfterThreshold:             1 * time.Minute,
 		GasEstimatorMode:                      "BlockHistory",
+		KeeperMulticallAddress:                "",
 		LinkContractAddress:                   "",
 		MinIncomingConfirmations:              3,
 		MinRequiredOutgoingConfirmations:      12,
@@ -156,6 +168,7 @@ func setConfigs() {
 
 	// Arbitrum is an L2 chain. Pending proper L2 support, for now we rely on their sequencer
 	arbitrumMainnet := FallbackConfig
+	arbitrumMainnet.ChainType = ChainArbitrum
 	arbitrumMainnet.EthGasBumpThreshold = 0 // Disable gas bumping on arbitrum
 	arbitrumMainnet.EthGasLimitDefault = 7000000
 	arbitrumMainnet.EthGasLimitTransfer = 800000            // estimating gas returns 695,344 so 800,000 should be safe with some buffer
@@ -171,6 +184,7 @@ func setConfigs() {
 
 	// Optimism is an L2 chain. Pending proper L2 support, for now we rely on their sequencer
 	optimismMainnet := FallbackConfig
+	optimismMainnet.ChainType = ChainOptimism
 	optimismMainnet.EthBalanceMonitorBlockDelay = 0
 	optimismMainnet.EthFinalityDepth = 1    // Sequencer offers absolute finality as long as no re-org longer than 20 blocks occurs on main chain this event would require special handling (new txm)
 	optimismMainnet.EthGasBumpThreshold = 0 // Never bump gas on optimism
@@ -0,0 +1,212 @@
+package evm
+
+import (
+	"context"
+	"math/big"
+	"net/url"
+
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/service"
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/smartcontractkit/chainlink/core/services/headtracker"
+	httypes "github.com/smartcontractkit/chainlink/core/services/headtracker/types"
+	"github.com/smartcontractkit/chainlink/core/services/log"
+	"github.com/smartcontractkit/chainlink/core/store/config"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"go.uber.org/multierr"
+)
+
+//go:generate mockery --name Chain --output ./mocks/ --case=underscore
+
+// Chain is an EVM chain along with the services that the node runs for it
+type Chain interface {
+	service.Service
+	ID() *big.Int
+	Client() eth.Client
+	Config() *config.Config
+	HeadBroadcaster() httypes.HeadBroadcaster
+	HeadTracker() httypes.Tracker
+	LogBroadcaster() log.Broadcaster
+	TxManager() bulletprooftxmanager.TxManager
+}
+
+type chain struct {
+	utils.StartStopOnce
+	id              *big.Int
+	cfg             *config.Config
+	client          eth.Client
+	headBroadcaster httypes.HeadBroadcaster
+	headTracker     httypes.Tracker
+	logBroadcaster  log.Broadcaster
+	txm             bulletprooftxmanager.TxManager
+}
+
+var _ Chain = (*chain)(nil)
+
+// NewDefaultChain returns the node's default chain, set by ETH_CHAIN_ID and
+// ETH_URL. Its services are started and stopped by the application, so the
+// ChainSet never starts or stops it.
+func NewDefaultChain(cfg *config.Config, client eth.Client, headBroadcaster httypes.HeadBroadcaster, headTracker httypes.Tracker, logBroadcaster log.Broadcaster, txm bulletprooftxmanager.TxManager) Chain {
+	return &chain{
+		id:              cfg.ChainID(),
+		cfg:             cfg,
+		client:          client,
+		headBroadcaster: headBroadcaster,
+		headTracker:     headTracker,
+		logBroadcaster:  logBroadcaster,
+		txm:             txm,
+	}
+}
+
+func newChain(dbchain types.Chain, opts ChainSetOpts) (*chain, error) {
+	id := dbchain.ID.ToInt()
+	cfg := opts.Config.ForEVMChain(id)
+
+	client, err := opts.NewClient(dbchain)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create client for chain %s", id)
+	}
+
+	l := logger.CreateLogger(opts.Logger.With("evmChainID", id.String()))
+	headBroadcaster := headtracker.NewHeadBroadcaster()
+	orm := headtracker.NewChainScopedORM(opts.DB, id)
+	headTracker := headtracker.NewHeadTracker(l, client, cfg, orm, headBroadcaster)
+
+	// Highest seen head height is used as part of the start of LogBroadcaster backfill range
+	highestSeenHead, err := headTracker.HighestSeenHeadFromDB()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load highest seen head for chain %s", id)
+	}
+	logBroadcaster := log.NewBroadcaster(log.NewORM(opts.DB), client, cfg, highestSeenHead)
+	txm := bulletprooftxmanager.NewBulletproofTxManager(opts.DB, client, cfg, opts.KeyStore, opts.AdvisoryLocker, opts.EventBroadcaster)
+
+	headBroadcaster.Subscribe(logBroadcaster)
+	headBroadcaster.Subscribe(txm)
+
+	return &chain{
+		id:              id,
+		cfg:             cfg,
+		client:          client,
+		headBroadcaster: headBroadcaster,
+		headTracker:     headTracker,
+		logBroadcaster:  logBroadcaster,
+		txm:             txm,
+	}, nil
+}
+
+// newClient returns a client with the first primary node as the main node
+// and the other primary and send-only nodes in the same roles as for the
+// default chain
+func newClient(dbchain types.Chain) (eth.Client, error) {
+	var primaries []types.Node
+	var secondaryURLs []url.URL
+	for _, n := range dbchain.Nodes {
+		if n.SendOnly {
+			u, err := url.Parse(n.HTTPURL.String)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid http url for node %s", n.Name)
+			}
+			secondaryURLs = append(secondaryURLs, *u)
+		} else {
+			primaries = append(primaries, n)
+		}
+	}
+	if len(primaries) == 0 {
+		return nil, errors.Errorf("chain %s must have at least one primary node", dbchain.ID.String())
+	}
+
+	var httpURL *url.URL
+	if primaries[0].HTTPURL.Valid {
+		u, err := url.Parse(primaries[0].HTTPURL.String)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid http url for node %s", primaries[0].Name)
+		}
+		httpURL = u
+	}
+	var additionalURLs []url.URL
+	for _, n := range primaries[1:] {
+		u, err := url.Parse(n.WSURL.String)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid websocket url for node %s", n.Name)
+		}
+		additionalURLs = append(additionalURLs, *u)
+	}
+	client, err := eth.NewClientWithPrimaries(primaries[0].WSURL.String, httpURL, additionalURLs, secondaryURLs)
+	if err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+
+func (c *chain) Start() error {
+	return c.StartOnce("Chain", func() (merr error) {
+		logger.Infow("Chain: starting", "evmChainID", c.id.String())
+		if err := c.client.Dial(context.Background()); err != nil {
+			return errors.Wrapf(err, "failed to dial client for chain %s", c.id)
+		}
+		// A chain that failed to start cannot be closed, so anything that
+		// was started is stopped here
+		var started []service.Service
+		defer func() {
+			if merr == nil {
+				return
+			}
+			for i := len(started) - 1; i >= 0; i-- {
+				merr = multierr.Append(merr, started[i].Close())
+			}
+			c.client.Close()
+		}()
+		for _, s := range []service.Service{c.logBroadcaster, c.txm, c.headBroadcaster} {
+			if err := s.Start(); err != nil {
+				return err
+			}
+			started = append(started, s)
+		}
+		return c.headTracker.Start()
+	})
+}
+
+func (c *chain) Close() error {
+	return c.StopOnce("Chain", func() (merr error) {
+		logger.Infow("Chain: stopping", "evmChainID", c.id.String())
+		merr = multierr.Combine(
+			c.headTracker.Stop(),
+			c.headBroadcaster.Close(),
+			c.txm.Close(),
+			c.logBroadcaster.Close(),
+		)
+		c.client.Close()
+		return merr
+	})
+}
+
+func (c *chain) Ready() (merr error) {
+	return multierr.Combine(
+		c.StartStopOnce.Ready(),
+		c.headTracker.Ready(),
+		c.logBroadcaster.Ready(),
+		c.txm.Ready(),
+		c.headBroadcaster.Ready(),
+	)
+}
+
+func (c *chain) Healthy() (merr error) {
+	return multierr.Combine(
+		c.StartStopOnce.Healthy(),
+		c.headTracker.Healthy(),
+		c.logBroadcaster.Healthy(),
+		c.txm.Healthy(),
+		c.headBroadcaster.Healthy(),
+	)
+}
+
+func (c *chain) ID() *big.Int                              { return c.id }
+func (c *chain) Client() eth.Client                        { return c.client }
+func (c *chain) Config() *config.Config                    { return c.cfg }
+func (c *chain) HeadBroadcaster() httypes.HeadBroadcaster  { return c.headBroadcaster }
+func (c *chain) HeadTracker() httypes.Tracker              { return c.headTracker }
+func (c *chain) LogBroadcaster() log.Broadcaster           { return c.logBroadcaster }
+func (c *chain) TxManager() bulletprooftxmanager.TxManager { return c.txm }
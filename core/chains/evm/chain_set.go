@@ -0,0 +1,367 @@
+package evm
+
+import (
+	"math/big"
+	"net/url"
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/chainlink/core/chains"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/service"
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/smartcontractkit/chainlink/core/services/postgres"
+	"github.com/smartcontractkit/chainlink/core/store/config"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"go.uber.org/multierr"
+	"gorm.io/gorm"
+)
+
+// ErrChainNotFound is returned for a chain that the node does not run,
+// either because it does not exist or because it is disabled
+var ErrChainNotFound = errors.New("chain not found")
+
+//go:generate mockery --name ChainSet --output ./mocks/ --case=underscore
+
+// ChainSet is the node's default chain along with the additional chains
+// that are stored in the database. The database also holds the configs of
+// all chains, which are seeded with the built-in defaults.
+type ChainSet interface {
+	service.Service
+	// Get returns the chain with the given ID, a nil ID returns the default
+	// chain
+	Get(id *big.Int) (Chain, error)
+	Default() Chain
+	Chains() []Chain
+	ORM() ORM
+	Add(id *big.Int, cfg types.ChainCfg, nodes []types.NewNode) (types.Chain, error)
+	// Update enables or disables the chain and, unless nodes is nil, replaces
+	// its nodes. The chain is restarted so that the change takes effect
+	// immediately.
+	Update(id *big.Int, enabled bool, nodes []types.NewNode) (types.Chain, error)
+	// Configure replaces the config of the chain, which may be the default
+	// chain. It takes effect immediately, except that a new gas estimator
+	// mode replaces the chain's estimator on its next head.
+	Configure(id *big.Int, cfg types.ChainCfg) (types.Chain, error)
+	Remove(id *big.Int) error
+}
+
+// ChainSetOpts holds the dependencies that are shared by the chains
+type ChainSetOpts struct {
+	Config           *config.Config
+	DB               *gorm.DB
+	KeyStore         bulletprooftxmanager.KeyStore
+	AdvisoryLocker   postgres.AdvisoryLocker
+	EventBroadcaster postgres.EventBroadcaster
+	Logger           *logger.Logger
+	ORM              ORM
+
+	// NewClient is used to create the client of each additional chain,
+	// defaults to a client for the chain's nodes
+	NewClient func(types.Chain) (eth.Client, error)
+}
+
+type chainSet struct {
+	utils.StartStopOnce
+	opts         ChainSetOpts
+	defaultChain Chain
+	chains       map[string]*chain
+	chainsMu     sync.RWMutex
+}
+
+var _ ChainSet = (*chainSet)(nil)
+
+// NewChainSet returns a ChainSet with the given default chain. The additional
+// chains are loaded from the database on Start.
+func NewChainSet(defaultChain Chain, opts ChainSetOpts) ChainSet {
+	if opts.ORM == nil {
+		opts.ORM = NewORM(opts.DB)
+	}
+	if opts.NewClient == nil {
+		opts.NewClient = newClient
+	}
+	if opts.Logger == nil {
+		opts.Logger = logger.Default
+	}
+	return &chainSet{
+		opts:         opts,
+		defaultChain: defaultChain,
+		chains:       make(map[string]*chain),
+	}
+}
+
+func (cs *chainSet) Start() error {
+	return cs.StartOnce("ChainSet", func() error {
+		if cs.opts.Config.EthereumDisabled() {
+			return nil
+		}
+		if err := cs.loadConfigs(); err != nil {
+			return err
+		}
+		dbchains, err := cs.opts.ORM.EnabledChainsWithNodes()
+		if err != nil {
+			return errors.Wrap(err, "failed to load chains")
+		}
+		cs.chainsMu.Lock()
+		defer cs.chainsMu.Unlock()
+		for _, dbchain := range dbchains {
+			if cs.isDefault(dbchain.ID.ToInt()) {
+				logger.Warnw("ChainSet: ignoring chain with the same ID as ETH_CHAIN_ID", "evmChainID", dbchain.ID.String())
+				continue
+			}
+			if err := cs.startChain(dbchain); err != nil {
+				// One misconfigured chain should not prevent the node from
+				// running the others
+				logger.Errorw("ChainSet: failed to start chain", "evmChainID", dbchain.ID.String(), "err", err)
+			}
+		}
+		return nil
+	})
+}
+
+// loadConfigs seeds the database with the built-in chains and the default
+// chain, and applies the configs of all chains in the database
+func (cs *chainSet) loadConfigs() error {
+	seeds := []types.Chain{{
+		ID:  *utils.NewBig(cs.defaultChain.ID()),
+		Cfg: defaultChainCfg(chains.DefaultConfig(cs.defaultChain.ID())),
+	}}
+	for _, c := range chains.DefaultChains() {
+		seeds = append(seeds, types.Chain{ID: *utils.NewBig(c.ID()), Cfg: defaultChainCfg(c.DefaultConfig())})
+	}
+	if err := cs.opts.ORM.EnsureChains(seeds); err != nil {
+		return errors.Wrap(err, "failed to seed chains")
+	}
+	dbchains, err := cs.opts.ORM.AllChains()
+	if err != nil {
+		return errors.Wrap(err, "failed to load chain configs")
+	}
+	for _, dbchain := range dbchains {
+		applyChainCfg(dbchain.ID.ToInt(), dbchain.Cfg)
+	}
+	return nil
+}
+
+func (cs *chainSet) Close() error {
+	return cs.StopOnce("ChainSet", func() (merr error) {
+		cs.chainsMu.Lock()
+		defer cs.chainsMu.Unlock()
+		for id, c := range cs.chains {
+			merr = multierr.Append(merr, c.Close())
+			delete(cs.chains, id)
+		}
+		return merr
+	})
+}
+
+func (cs *chainSet) Ready() (merr error) {
+	merr = cs.StartStopOnce.Ready()
+	cs.chainsMu.RLock()
+	defer cs.chainsMu.RUnlock()
+	for _, c := range cs.chains {
+		merr = multierr.Append(merr, c.Ready())
+	}
+	return
+}
+
+func (cs *chainSet) Healthy() (merr error) {
+	merr = cs.StartStopOnce.Healthy()
+	cs.chainsMu.RLock()
+	defer cs.chainsMu.RUnlock()
+	for _, c := range cs.chains {
+		merr = multierr.Append(merr, c.Healthy())
+	}
+	return
+}
+
+func (cs *chainSet) isDefault(id *big.Int) bool {
+	return id == nil || id.Cmp(cs.defaultChain.ID()) == 0
+}
+
+func (cs *chainSet) Get(id *big.Int) (Chain, error) {
+	if cs.isDefault(id) {
+		return cs.defaultChain, nil
+	}
+	cs.chainsMu.RLock()
+	defer cs.chainsMu.RUnlock()
+	c, exists := cs.chains[id.String()]
+	if !exists {
+		return nil, errors.Wrapf(ErrChainNotFound, "chain %s", id)
+	}
+	return c, nil
+}
+
+func (cs *chainSet) Default() Chain {
+	return cs.defaultChain
+}
+
+// Chains returns the default chain followed by the additional chains in
+// order of ID
+func (cs *chainSet) Chains() []Chain {
+	cs.chainsMu.RLock()
+	defer cs.chainsMu.RUnlock()
+	others := make([]Chain, 0, len(cs.chains))
+	for _, c := range cs.chains {
+		others = append(others, c)
+	}
+	sort.Slice(others, func(i, j int) bool {
+		return others[i].ID().Cmp(others[j].ID()) < 0
+	})
+	return append([]Chain{cs.defaultChain}, others...)
+}
+
+func (cs *chainSet) ORM() ORM {
+	return cs.opts.ORM
+}
+
+func (cs *chainSet) Add(id *big.Int, cfg types.ChainCfg, nodes []types.NewNode) (types.Chain, error) {
+	if err := cs.validate(id, true, nodes); err != nil {
+		return types.Chain{}, err
+	}
+	if err := validateChainCfg(cfg); err != nil {
+		return types.Chain{}, err
+	}
+	cs.chainsMu.Lock()
+	defer cs.chainsMu.Unlock()
+	dbchain, err := cs.opts.ORM.CreateChain(*utils.NewBig(id), cfg, true, nodes)
+	if err != nil {
+		return types.Chain{}, err
+	}
+	applyChainCfg(id, dbchain.Cfg)
+	return dbchain, cs.startChain(dbchain)
+}
+
+func (cs *chainSet) Update(id *big.Int, enabled bool, nodes []types.NewNode) (types.Chain, error) {
+	if cs.isDefault(id) {
+		return types.Chain{}, models.NewValidationError("chain %s is the default chain, it is configured by ETH_CHAIN_ID and ETH_URL", id)
+	}
+	if nodes != nil {
+		if err := cs.validate(id, enabled, nodes); err != nil {
+			return types.Chain{}, err
+		}
+	} else if enabled && cs.opts.Config.EthereumDisabled() {
+		return types.Chain{}, models.NewValidationError("cannot enable chains while ETH_DISABLED is set")
+	}
+	cs.chainsMu.Lock()
+	defer cs.chainsMu.Unlock()
+	dbchain, err := cs.opts.ORM.UpdateChain(*utils.NewBig(id), enabled, nodes)
+	if err != nil {
+		return types.Chain{}, err
+	}
+	if err = cs.stopChain(id); err != nil {
+		return dbchain, err
+	}
+	if enabled {
+		return dbchain, cs.startChain(dbchain)
+	}
+	return dbchain, nil
+}
+
+func (cs *chainSet) Configure(id *big.Int, cfg types.ChainCfg) (types.Chain, error) {
+	if err := validateChainCfg(cfg); err != nil {
+		return types.Chain{}, err
+	}
+	cs.chainsMu.Lock()
+	defer cs.chainsMu.Unlock()
+	dbchain, err := cs.opts.ORM.ConfigureChain(*utils.NewBig(id), cfg)
+	if err != nil {
+		return types.Chain{}, err
+	}
+	applyChainCfg(id, dbchain.Cfg)
+	return dbchain, nil
+}
+
+func (cs *chainSet) Remove(id *big.Int) error {
+	if cs.isDefault(id) {
+		return models.NewValidationError("chain %s is the default chain, it is configured by ETH_CHAIN_ID and ETH_URL", id)
+	}
+	cs.chainsMu.Lock()
+	defer cs.chainsMu.Unlock()
+	if err := cs.opts.ORM.DeleteChain(*utils.NewBig(id)); err != nil {
+		return err
+	}
+	// Built-in chains are seeded again on the next start
+	applyChainCfg(id, types.ChainCfg{})
+	return cs.stopChain(id)
+}
+
+func (cs *chainSet) validate(id *big.Int, enabled bool, nodes []types.NewNode) error {
+	if id == nil || id.Sign() <= 0 || !id.IsInt64() {
+		return models.NewValidationError("chain ID must be a positive 64 bit integer")
+	}
+	if cs.isDefault(id) {
+		return models.NewValidationError("chain %s is the default chain, it is configured by ETH_CHAIN_ID and ETH_URL", id)
+	}
+	if cs.opts.Config.EthereumDisabled() {
+		return models.NewValidationError("cannot add chains while ETH_DISABLED is set")
+	}
+	var primaries int
+	for _, n := range nodes {
+		if n.Name == "" {
+			return models.NewValidationError("node name must not be empty")
+		}
+		if n.SendOnly {
+			if n.WSURL.Valid || !n.HTTPURL.Valid {
+				return models.NewValidationError("send-only node %s must have an httpURL and no wsURL", n.Name)
+			}
+		} else {
+			if !n.WSURL.Valid {
+				return models.NewValidationError("primary node %s must have a wsURL", n.Name)
+			}
+			if err := validateURL(n.WSURL.String, "ws", "wss"); err != nil {
+				return models.NewValidationError("invalid wsURL for node %s: %v", n.Name, err)
+			}
+			primaries++
+		}
+		if n.HTTPURL.Valid {
+			if err := validateURL(n.HTTPURL.String, "http", "https"); err != nil {
+				return models.NewValidationError("invalid httpURL for node %s: %v", n.Name, err)
+			}
+		}
+	}
+	if enabled && primaries == 0 {
+		return models.NewValidationError("chain must have at least one primary node")
+	}
+	return nil
+}
+
+func validateURL(rawURL string, schemes ...string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+	for _, scheme := range schemes {
+		if u.Scheme == scheme {
+			return nil
+		}
+	}
+	return errors.Errorf("scheme must be one of %v", schemes)
+}
+
+// startChain must be called with chainsMu held. A chain that fails to start
+// has already stopped its services and is not added to the set.
+func (cs *chainSet) startChain(dbchain types.Chain) error {
+	c, err := newChain(dbchain, cs.opts)
+	if err != nil {
+		return err
+	}
+	if err = c.Start(); err != nil {
+		return errors.Wrapf(err, "failed to start chain %s", dbchain.ID.String())
+	}
+	cs.chains[dbchain.ID.String()] = c
+	return nil
+}
+
+// stopChain must be called with chainsMu held
+func (cs *chainSet) stopChain(id *big.Int) error {
+	c, exists := cs.chains[id.String()]
+	if !exists {
+		return nil
+	}
+	delete(cs.chains, id.String())
+	return c.Close()
+}
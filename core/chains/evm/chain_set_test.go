@@ -0,0 +1,200 @@
+package evm_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/chainlink/core/chains"
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	evmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/mocks"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/services/gas"
+	"github.com/smartcontractkit/chainlink/core/store/config"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+)
+
+func newTestChainSet(t *testing.T) (evm.ChainSet, *evmmocks.Chain) {
+	cfg := config.NewConfig()
+	defaultChain := new(evmmocks.Chain)
+	defaultChain.On("ID").Return(big.NewInt(1))
+	t.Cleanup(func() { defaultChain.AssertExpectations(t) })
+	return evm.NewChainSet(defaultChain, evm.ChainSetOpts{Config: cfg}), defaultChain
+}
+
+func TestChainSet_Get(t *testing.T) {
+	cs, defaultChain := newTestChainSet(t)
+
+	chain, err := cs.Get(nil)
+	require.NoError(t, err)
+	assert.Equal(t, defaultChain, chain)
+
+	chain, err = cs.Get(big.NewInt(1))
+	require.NoError(t, err)
+	assert.Equal(t, defaultChain, chain)
+
+	_, err = cs.Get(big.NewInt(42))
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, evm.ErrChainNotFound))
+
+	assert.Equal(t, []evm.Chain{defaultChain}, cs.Chains())
+}
+
+func TestChainSet_Add_Validation(t *testing.T) {
+	cs, _ := newTestChainSet(t)
+
+	primary := types.NewNode{Name: "primary", WSURL: null.StringFrom("ws://primary.test")}
+	tests := []struct {
+		name  string
+		id    *big.Int
+		nodes []types.NewNode
+		err   string
+	}{
+		{"default chain", big.NewInt(1), []types.NewNode{primary}, "is the default chain"},
+		{"zero chain ID", big.NewInt(0), []types.NewNode{primary}, "positive 64 bit integer"},
+		{"no nodes", big.NewInt(42), nil, "at least one primary node"},
+		{"only send-only nodes", big.NewInt(42), []types.NewNode{
+			{Name: "sendonly", HTTPURL: null.StringFrom("http://sendonly.test"), SendOnly: true},
+		}, "at least one primary node"},
+		{"unnamed node", big.NewInt(42), []types.NewNode{
+			{WSURL: null.StringFrom("ws://primary.test")},
+		}, "name must not be empty"},
+		{"primary without websocket", big.NewInt(42), []types.NewNode{
+			{Name: "primary", HTTPURL: null.StringFrom("http://primary.test")},
+		}, "must have a wsURL"},
+		{"primary with http websocket URL", big.NewInt(42), []types.NewNode{
+			{Name: "primary", WSURL: null.StringFrom("http://primary.test")},
+		}, "invalid wsURL"},
+		{"send-only with websocket", big.NewInt(42), []types.NewNode{
+			primary,
+			{Name: "sendonly", WSURL: null.StringFrom("ws://sendonly.test"), HTTPURL: null.StringFrom("http://sendonly.test"), SendOnly: true},
+		}, "must have an httpURL and no wsURL"},
+		{"send-only with websocket http URL", big.NewInt(42), []types.NewNode{
+			primary,
+			{Name: "sendonly", HTTPURL: null.StringFrom("wss://sendonly.test"), SendOnly: true},
+		}, "invalid httpURL"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			_, err := cs.Add(test.id, types.ChainCfg{}, test.nodes)
+			require.Error(t, err)
+			assert.IsType(t, &models.ValidationError{}, err)
+			assert.Contains(t, err.Error(), test.err)
+		})
+	}
+}
+
+func TestChainSet_DefaultChainIsReadOnly(t *testing.T) {
+	cs, _ := newTestChainSet(t)
+
+	_, err := cs.Update(big.NewInt(1), false, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "is the default chain")
+
+	err = cs.Remove(big.NewInt(1))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "is the default chain")
+}
+
+func TestChainSet_Configure(t *testing.T) {
+	cfg := config.NewConfig()
+	defaultChain := new(evmmocks.Chain)
+	defaultChain.On("ID").Return(big.NewInt(1)).Maybe()
+	orm := new(evmmocks.ORM)
+	cs := evm.NewChainSet(defaultChain, evm.ChainSetOpts{Config: cfg, ORM: orm})
+
+	id := big.NewInt(4)
+	t.Cleanup(func() { chains.SetConfig(id, chains.DefaultConfig(id)) })
+
+	chainCfg := types.ChainCfg{
+		ChainType:        null.StringFrom(string(chains.ChainArbitrum)),
+		EthFinalityDepth: null.IntFrom(7),
+	}
+	orm.On("ConfigureChain", *utils.NewBig(id), chainCfg).Return(types.Chain{ID: *utils.NewBig(id), Cfg: chainCfg}, nil).Once()
+
+	dbchain, err := cs.Configure(id, chainCfg)
+	require.NoError(t, err)
+	assert.Equal(t, chainCfg, dbchain.Cfg)
+
+	chainConfig := cfg.ForEVMChain(id)
+	assert.Equal(t, uint(7), chainConfig.EthFinalityDepth())
+	assert.True(t, chainConfig.Chain().IsArbitrum())
+	// Unset fields keep their built-in defaults
+	assert.Equal(t, chains.DefaultConfig(id).EthGasLimitDefault, chainConfig.EthGasLimitDefault())
+
+	orm.AssertExpectations(t)
+}
+
+func TestChainSet_Configure_GasEstimatorMode(t *testing.T) {
+	cfg := config.NewConfig()
+	defaultChain := new(evmmocks.Chain)
+	defaultChain.On("ID").Return(big.NewInt(1)).Maybe()
+	orm := new(evmmocks.ORM)
+	cs := evm.NewChainSet(defaultChain, evm.ChainSetOpts{Config: cfg, ORM: orm})
+
+	id := big.NewInt(4)
+	t.Cleanup(func() { chains.SetConfig(id, chains.DefaultConfig(id)) })
+	chainConfig := cfg.ForEVMChain(id)
+	require.Equal(t, "BlockHistory", chainConfig.GasEstimatorMode())
+
+	ethClient := cltest.NewEthClientMock(t)
+	ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(nil, errors.New("no heads yet"))
+	estimator := gas.NewEstimator(ethClient, chainConfig)
+	require.NoError(t, estimator.Start())
+	defer estimator.Close()
+
+	// The block history estimator has no price until it has seen blocks
+	gasPrice, _, err := estimator.EstimateGas(nil, 100000)
+	require.NoError(t, err)
+	assert.Nil(t, gasPrice)
+
+	chainCfg := types.ChainCfg{GasEstimatorMode: null.StringFrom("FixedPrice")}
+	orm.On("ConfigureChain", *utils.NewBig(id), chainCfg).Return(types.Chain{ID: *utils.NewBig(id), Cfg: chainCfg}, nil).Once()
+	_, err = cs.Configure(id, chainCfg)
+	require.NoError(t, err)
+
+	// The running estimator switches on the next head
+	estimator.OnNewLongestChain(context.Background(), *cltest.Head(43))
+	gasPrice, _, err = estimator.EstimateGas(nil, 100000)
+	require.NoError(t, err)
+	assert.Equal(t, chainConfig.EthGasPriceDefault(), gasPrice)
+
+	orm.AssertExpectations(t)
+}
+
+func TestChainSet_Configure_Validation(t *testing.T) {
+	cs := evm.NewChainSet(new(evmmocks.Chain), evm.ChainSetOpts{Config: config.NewConfig(), ORM: new(evmmocks.ORM)})
+
+	tests := []struct {
+		name string
+		cfg  types.ChainCfg
+		err  string
+	}{
+		{"unknown chain type", types.ChainCfg{ChainType: null.StringFrom("zksync")}, "chainType must be one of"},
+		{"zero finality depth", types.ChainCfg{EthFinalityDepth: null.IntFrom(0)}, "ethFinalityDepth must be at least 1"},
+		{"negative bump wei", types.ChainCfg{EthGasBumpWei: utils.NewBigI(-1)}, "ethGasBumpWei must not be negative"},
+		{"bump percent too large", types.ChainCfg{EthGasBumpPercent: null.IntFrom(70000)}, "ethGasBumpPercent must be between"},
+		{"zero gas limit", types.ChainCfg{EthGasLimitDefault: null.IntFrom(0)}, "ethGasLimitDefault must be positive"},
+		{"unknown gas estimator", types.ChainCfg{GasEstimatorMode: null.StringFrom("Magic")}, "gasEstimatorMode must be one of"},
+		{"invalid multicall address", types.ChainCfg{KeeperMulticallAddress: null.StringFrom("0x1234")}, "keeperMulticallAddress must be a hex address"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			_, err := cs.Configure(big.NewInt(42), test.cfg)
+			require.Error(t, err)
+			assert.IsType(t, &models.ValidationError{}, err)
+			assert.Contains(t, err.Error(), test.err)
+		})
+	}
+}
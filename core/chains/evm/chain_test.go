@@ -0,0 +1,40 @@
+package evm_test
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	bptxmmocks "github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager/mocks"
+	htmocks "github.com/smartcontractkit/chainlink/core/services/headtracker/mocks"
+	logmocks "github.com/smartcontractkit/chainlink/core/services/log/mocks"
+	"github.com/smartcontractkit/chainlink/core/store/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChain_Start_StopsStartedServicesOnError(t *testing.T) {
+	cfg := config.NewConfig()
+	client := cltest.NewEthClientMock(t)
+	logBroadcaster := new(logmocks.Broadcaster)
+	txm := new(bptxmmocks.TxManager)
+	headBroadcaster := new(htmocks.HeadBroadcaster)
+
+	client.On("Dial", mock.Anything).Return(nil).Once()
+	logBroadcaster.On("Start").Return(nil).Once()
+	txm.On("Start").Return(errors.New("txm exploded")).Once()
+	logBroadcaster.On("Close").Return(nil).Once()
+	client.On("Close").Return().Once()
+
+	chain := evm.NewDefaultChain(cfg, client, headBroadcaster, nil, logBroadcaster, txm)
+	err := chain.Start()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "txm exploded")
+
+	client.AssertExpectations(t)
+	logBroadcaster.AssertExpectations(t)
+	txm.AssertExpectations(t)
+	headBroadcaster.AssertExpectations(t)
+}
@@ -0,0 +1,101 @@
+package evm
+
+import (
+	"math"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/smartcontractkit/chainlink/core/chains"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"gopkg.in/guregu/null.v4"
+)
+
+var gasEstimatorModes = []string{"Arbitrum", "BlockHistory", "FixedPrice", "GasOracle", "Optimism"}
+
+// defaultChainCfg returns the built-in settings of a chain, which are used to
+// seed the database
+func defaultChainCfg(c chains.ChainSpecificConfig) types.ChainCfg {
+	return types.ChainCfg{
+		ChainType:              null.StringFrom(string(c.ChainType)),
+		EthFinalityDepth:       null.IntFrom(int64(c.EthFinalityDepth)),
+		EthGasBumpPercent:      null.IntFrom(int64(c.EthGasBumpPercent)),
+		EthGasBumpThreshold:    null.IntFrom(int64(c.EthGasBumpThreshold)),
+		EthGasBumpWei:          utils.NewBig(&c.EthGasBumpWei),
+		EthGasLimitDefault:     null.IntFrom(int64(c.EthGasLimitDefault)),
+		EthGasLimitTransfer:    null.IntFrom(int64(c.EthGasLimitTransfer)),
+		GasEstimatorMode:       null.StringFrom(c.GasEstimatorMode),
+		KeeperMulticallAddress: null.NewString(c.KeeperMulticallAddress, c.KeeperMulticallAddress != ""),
+	}
+}
+
+// applyChainCfg replaces the config of the chain with its built-in config
+// overridden by cfg. Config getters pick up the change immediately.
+func applyChainCfg(id *big.Int, cfg types.ChainCfg) {
+	c := chains.DefaultConfig(id)
+	if cfg.ChainType.Valid {
+		c.ChainType = chains.ChainType(cfg.ChainType.String)
+	}
+	if cfg.EthFinalityDepth.Valid {
+		c.EthFinalityDepth = uint(cfg.EthFinalityDepth.Int64)
+	}
+	if cfg.EthGasBumpPercent.Valid {
+		c.EthGasBumpPercent = uint16(cfg.EthGasBumpPercent.Int64)
+	}
+	if cfg.EthGasBumpThreshold.Valid {
+		c.EthGasBumpThreshold = uint64(cfg.EthGasBumpThreshold.Int64)
+	}
+	if cfg.EthGasBumpWei != nil {
+		c.EthGasBumpWei = *cfg.EthGasBumpWei.ToInt()
+	}
+	if cfg.EthGasLimitDefault.Valid {
+		c.EthGasLimitDefault = uint64(cfg.EthGasLimitDefault.Int64)
+	}
+	if cfg.EthGasLimitTransfer.Valid {
+		c.EthGasLimitTransfer = uint64(cfg.EthGasLimitTransfer.Int64)
+	}
+	if cfg.GasEstimatorMode.Valid {
+		c.GasEstimatorMode = cfg.GasEstimatorMode.String
+	}
+	if cfg.KeeperMulticallAddress.Valid {
+		c.KeeperMulticallAddress = cfg.KeeperMulticallAddress.String
+	}
+	chains.SetConfig(id, c)
+}
+
+func validateChainCfg(cfg types.ChainCfg) error {
+	if cfg.ChainType.Valid && !chains.ChainType(cfg.ChainType.String).IsValid() {
+		return models.NewValidationError("chainType must be one of %q, %q or empty", chains.ChainArbitrum, chains.ChainOptimism)
+	}
+	if cfg.EthFinalityDepth.Valid && cfg.EthFinalityDepth.Int64 < 1 {
+		return models.NewValidationError("ethFinalityDepth must be at least 1")
+	}
+	if cfg.EthGasBumpPercent.Valid && (cfg.EthGasBumpPercent.Int64 < 0 || cfg.EthGasBumpPercent.Int64 > math.MaxUint16) {
+		return models.NewValidationError("ethGasBumpPercent must be between 0 and %d", math.MaxUint16)
+	}
+	if cfg.EthGasBumpThreshold.Valid && cfg.EthGasBumpThreshold.Int64 < 0 {
+		return models.NewValidationError("ethGasBumpThreshold must not be negative")
+	}
+	if cfg.EthGasBumpWei != nil && cfg.EthGasBumpWei.ToInt().Sign() < 0 {
+		return models.NewValidationError("ethGasBumpWei must not be negative")
+	}
+	if cfg.EthGasLimitDefault.Valid && cfg.EthGasLimitDefault.Int64 < 1 {
+		return models.NewValidationError("ethGasLimitDefault must be positive")
+	}
+	if cfg.EthGasLimitTransfer.Valid && cfg.EthGasLimitTransfer.Int64 < 1 {
+		return models.NewValidationError("ethGasLimitTransfer must be positive")
+	}
+	if cfg.KeeperMulticallAddress.Valid && cfg.KeeperMulticallAddress.String != "" && !common.IsHexAddress(cfg.KeeperMulticallAddress.String) {
+		return models.NewValidationError("keeperMulticallAddress must be a hex address")
+	}
+	if cfg.GasEstimatorMode.Valid {
+		for _, mode := range gasEstimatorModes {
+			if cfg.GasEstimatorMode.String == mode {
+				return nil
+			}
+		}
+		return models.NewValidationError("gasEstimatorMode must be one of %v", gasEstimatorModes)
+	}
+	return nil
+}
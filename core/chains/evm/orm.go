@@ -0,0 +1,218 @@
+package evm
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/services/postgres"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"gorm.io/gorm"
+)
+
+//go:generate mockery --name ORM --output ./mocks/ --case=underscore
+
+// ORM manages the EVM chains and their nodes in the database
+type ORM interface {
+	Chain(id utils.Big) (types.Chain, error)
+	Chains(offset, limit int) ([]types.Chain, int, error)
+	// AllChains returns every chain without its nodes
+	AllChains() ([]types.Chain, error)
+	EnabledChainsWithNodes() ([]types.Chain, error)
+	CreateChain(id utils.Big, cfg types.ChainCfg, enabled bool, nodes []types.NewNode) (types.Chain, error)
+	// EnsureChains creates the given chains without nodes, unless they
+	// already exist
+	EnsureChains(chains []types.Chain) error
+	// UpdateChain sets enabled and, unless nodes is nil, replaces the nodes of
+	// the chain
+	UpdateChain(id utils.Big, enabled bool, nodes []types.NewNode) (types.Chain, error)
+	ConfigureChain(id utils.Big, cfg types.ChainCfg) (types.Chain, error)
+	DeleteChain(id utils.Big) error
+}
+
+type orm struct {
+	db *gorm.DB
+}
+
+var _ ORM = (*orm)(nil)
+
+// NewORM returns an ORM backed by db
+func NewORM(db *gorm.DB) ORM {
+	return &orm{db}
+}
+
+// Chain returns the chain with its nodes, or gorm.ErrRecordNotFound
+func (o *orm) Chain(id utils.Big) (chain types.Chain, err error) {
+	err = postgres.DBWithDefaultContext(o.db, func(db *gorm.DB) error {
+		if err = db.First(&chain, "id = ?", id).Error; err != nil {
+			return err
+		}
+		return db.Where("evm_chain_id = ?", id).Order("id asc").Find(&chain.Nodes).Error
+	})
+	return chain, err
+}
+
+// Chains returns a page of chains with their nodes along with the total count
+func (o *orm) Chains(offset, limit int) (chains []types.Chain, count int, err error) {
+	err = postgres.DBWithDefaultContext(o.db, func(db *gorm.DB) error {
+		var count64 int64
+		if err = db.Model(&types.Chain{}).Count(&count64).Error; err != nil {
+			return err
+		}
+		count = int(count64)
+		if err = db.Order("id asc").Offset(offset).Limit(limit).Find(&chains).Error; err != nil {
+			return err
+		}
+		return loadNodes(db, chains)
+	})
+	return chains, count, err
+}
+
+func (o *orm) AllChains() (chains []types.Chain, err error) {
+	err = postgres.DBWithDefaultContext(o.db, func(db *gorm.DB) error {
+		return db.Order("id asc").Find(&chains).Error
+	})
+	return chains, err
+}
+
+// EnabledChainsWithNodes returns every enabled chain with its nodes
+func (o *orm) EnabledChainsWithNodes() (chains []types.Chain, err error) {
+	err = postgres.DBWithDefaultContext(o.db, func(db *gorm.DB) error {
+		if err = db.Where("enabled = ?", true).Order("id asc").Find(&chains).Error; err != nil {
+			return err
+		}
+		return loadNodes(db, chains)
+	})
+	return chains, err
+}
+
+func loadNodes(db *gorm.DB, chains []types.Chain) error {
+	if len(chains) == 0 {
+		return nil
+	}
+	ids := make([]utils.Big, len(chains))
+	for i, c := range chains {
+		ids[i] = c.ID
+	}
+	var nodes []types.Node
+	if err := db.Where("evm_chain_id IN ?", ids).Order("id asc").Find(&nodes).Error; err != nil {
+		return errors.Wrap(err, "failed to load nodes")
+	}
+	for i := range chains {
+		for _, n := range nodes {
+			if n.EVMChainID.String() == chains[i].ID.String() {
+				chains[i].Nodes = append(chains[i].Nodes, n)
+			}
+		}
+	}
+	return nil
+}
+
+func (o *orm) CreateChain(id utils.Big, cfg types.ChainCfg, enabled bool, nodes []types.NewNode) (chain types.Chain, err error) {
+	err = postgres.GormTransactionWithDefaultContext(o.db, func(tx *gorm.DB) error {
+		now := time.Now()
+		chain = types.Chain{ID: id, Cfg: cfg, Enabled: enabled, CreatedAt: now, UpdatedAt: now}
+		if err = tx.Create(&chain).Error; err != nil {
+			return err
+		}
+		chain.Nodes, err = createNodes(tx, id, nodes)
+		return err
+	})
+	return chain, err
+}
+
+func (o *orm) EnsureChains(chains []types.Chain) error {
+	return postgres.GormTransactionWithDefaultContext(o.db, func(tx *gorm.DB) error {
+		now := time.Now()
+		for _, c := range chains {
+			err := tx.Exec(`INSERT INTO evm_chains (id, cfg, enabled, created_at, updated_at) VALUES (?, ?, ?, ?, ?) ON CONFLICT (id) DO NOTHING`,
+				c.ID, c.Cfg, c.Enabled, now, now).Error
+			if err != nil {
+				return errors.Wrapf(err, "failed to create chain %s", c.ID.String())
+			}
+		}
+		return nil
+	})
+}
+
+func (o *orm) UpdateChain(id utils.Big, enabled bool, nodes []types.NewNode) (chain types.Chain, err error) {
+	err = postgres.GormTransactionWithDefaultContext(o.db, func(tx *gorm.DB) error {
+		res := tx.Model(&types.Chain{}).Where("id = ?", id).Updates(map[string]interface{}{
+			"enabled":    enabled,
+			"updated_at": time.Now(),
+		})
+		if res.Error != nil {
+			return res.Error
+		} else if res.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		if nodes != nil {
+			if err = tx.Exec(`DELETE FROM evm_nodes WHERE evm_chain_id = ?`, id).Error; err != nil {
+				return err
+			}
+			if _, err = createNodes(tx, id, nodes); err != nil {
+				return err
+			}
+		}
+		if err = tx.First(&chain, "id = ?", id).Error; err != nil {
+			return err
+		}
+		return tx.Where("evm_chain_id = ?", id).Order("id asc").Find(&chain.Nodes).Error
+	})
+	return chain, err
+}
+
+// ConfigureChain replaces the config of the chain, or returns
+// gorm.ErrRecordNotFound
+func (o *orm) ConfigureChain(id utils.Big, cfg types.ChainCfg) (chain types.Chain, err error) {
+	err = postgres.GormTransactionWithDefaultContext(o.db, func(tx *gorm.DB) error {
+		res := tx.Model(&types.Chain{}).Where("id = ?", id).Updates(map[string]interface{}{
+			"cfg":        cfg,
+			"updated_at": time.Now(),
+		})
+		if res.Error != nil {
+			return res.Error
+		} else if res.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		if err = tx.First(&chain, "id = ?", id).Error; err != nil {
+			return err
+		}
+		return tx.Where("evm_chain_id = ?", id).Order("id asc").Find(&chain.Nodes).Error
+	})
+	return chain, err
+}
+
+func createNodes(tx *gorm.DB, chainID utils.Big, newNodes []types.NewNode) (nodes []types.Node, err error) {
+	now := time.Now()
+	for _, n := range newNodes {
+		node := types.Node{
+			Name:       n.Name,
+			EVMChainID: chainID,
+			WSURL:      n.WSURL,
+			HTTPURL:    n.HTTPURL,
+			SendOnly:   n.SendOnly,
+			CreatedAt:  now,
+			UpdatedAt:  now,
+		}
+		if err = tx.Create(&node).Error; err != nil {
+			return nil, errors.Wrapf(err, "failed to create node %s", n.Name)
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, nil
+}
+
+// DeleteChain deletes the chain and its nodes, or returns
+// gorm.ErrRecordNotFound
+func (o *orm) DeleteChain(id utils.Big) error {
+	return postgres.DBWithDefaultContext(o.db, func(db *gorm.DB) error {
+		res := db.Exec(`DELETE FROM evm_chains WHERE id = ?`, id)
+		if res.Error != nil {
+			return res.Error
+		} else if res.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return nil
+	})
+}
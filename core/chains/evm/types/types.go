@@ -0,0 +1,84 @@
+package types
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"gopkg.in/guregu/null.v4"
+)
+
+// Chain is an EVM chain known to the node. The node connects to it if it is
+// enabled, in addition to its default chain set by ETH_CHAIN_ID.
+type Chain struct {
+	ID        utils.Big `gorm:"primary_key"`
+	Cfg       ChainCfg  `gorm:"column:cfg"`
+	Nodes     []Node    `gorm:"-"`
+	Enabled   bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// ChainCfg holds the settings of a chain that are stored in the database.
+// Unset fields fall back to the built-in defaults for the chain ID.
+type ChainCfg struct {
+	ChainType              null.String `json:"chainType"`
+	EthFinalityDepth       null.Int    `json:"ethFinalityDepth"`
+	EthGasBumpPercent      null.Int    `json:"ethGasBumpPercent"`
+	EthGasBumpThreshold    null.Int    `json:"ethGasBumpThreshold"`
+	EthGasBumpWei          *utils.Big  `json:"ethGasBumpWei"`
+	EthGasLimitDefault     null.Int    `json:"ethGasLimitDefault"`
+	EthGasLimitTransfer    null.Int    `json:"ethGasLimitTransfer"`
+	GasEstimatorMode       null.String `json:"gasEstimatorMode"`
+	KeeperMulticallAddress null.String `json:"keeperMulticallAddress"`
+}
+
+// Scan deserializes JSON from the database
+func (c *ChainCfg) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.Errorf("ChainCfg#Scan received a value of type %T", value)
+	}
+	return json.Unmarshal(b, c)
+}
+
+// Value serializes the config to JSON for the database
+func (c ChainCfg) Value() (driver.Value, error) {
+	return json.Marshal(c)
+}
+
+// TableName sets the table name of Chain
+func (Chain) TableName() string {
+	return "evm_chains"
+}
+
+// Node is an RPC node of a Chain. Primary nodes must have a websocket URL
+// and may have an HTTP URL, send-only nodes only have an HTTP URL.
+type Node struct {
+	ID         int32
+	Name       string
+	EVMChainID utils.Big   `gorm:"column:evm_chain_id"`
+	WSURL      null.String `gorm:"column:ws_url"`
+	HTTPURL    null.String `gorm:"column:http_url"`
+	SendOnly   bool
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// TableName sets the table name of Node
+func (Node) TableName() string {
+	return "evm_nodes"
+}
+
+// NewNode is the data needed to create a Node
+type NewNode struct {
+	Name     string      `json:"name"`
+	WSURL    null.String `json:"wsURL"`
+	HTTPURL  null.String `json:"httpURL"`
+	SendOnly bool        `json:"sendOnly"`
+}
@@ -58,6 +58,67 @@ func NewApp(client *Client) *cli.App {
 						},
 					},
 				},
+				{
+					Name:  "users",
+					Usage: "Commands for managing the node's API users, requires the owner role",
+					Subcommands: []cli.Command{
+						{
+							Name:   "list",
+							Usage:  "List all API users and their roles",
+							Action: client.ListAPIUsers,
+						},
+						{
+							Name:   "create",
+							Usage:  format(`Create a new API user with the given role`),
+							Action: client.CreateAPIUser,
+							Flags: []cli.Flag{
+								cli.StringFlag{
+									Name:  "email",
+									Usage: "email of the new user (required)",
+								},
+								cli.StringFlag{
+									Name:  "role",
+									Usage: "role of the new user, one of view-only, job-editor, key-admin or owner (required)",
+								},
+								cli.StringFlag{
+									Name:  "password, p",
+									Usage: "`FILE` containing the password of the new user, prompted for if not given",
+								},
+							},
+						},
+						{
+							Name:   "chrole",
+							Usage:  format(`Change the role of an existing API user`),
+							Action: client.ChangeAPIUserRole,
+							Flags: []cli.Flag{
+								cli.StringFlag{
+									Name:  "email",
+									Usage: "email of the user (required)",
+								},
+								cli.StringFlag{
+									Name:  "role",
+									Usage: "new role of the user, one of view-only, job-editor, key-admin or owner (required)",
+								},
+							},
+						},
+						{
+							Name:   "delete",
+							Usage:  format(`Delete the API user with the given email, ending all of their sessions`),
+							Action: client.DeleteAPIUser,
+						},
+					},
+				},
+				{
+					Name:   "audit",
+					Usage:  "List the audit log of sensitive operator actions, most recent first, requires the owner role",
+					Action: client.IndexAuditLogs,
+					Flags: []cli.Flag{
+						cli.IntFlag{
+							Name:  "page",
+							Usage: "page of results to display",
+						},
+					},
+				},
 			},
 		},
 
@@ -132,6 +193,44 @@ func NewApp(client *Client) *cli.App {
 			},
 		},
 
+		{
+			Name:  "chains",
+			Usage: "Commands for the EVM chains known to the node and their configs",
+			Subcommands: []cli.Command{
+				{
+					Name:   "create",
+					Usage:  format(`Create a chain with its config and nodes from a JSON blob or file, e.g. {"chainID": "1337", "config": {"ethFinalityDepth": 10}, "nodes": [{"name": "primary", "wsURL": "ws://localhost:8546"}]}`),
+					Action: client.CreateChain,
+				},
+				{
+					Name:   "delete",
+					Usage:  "Stop a chain and remove it along with its nodes",
+					Action: client.RemoveChain,
+				},
+				{
+					Name:   "list",
+					Usage:  "List all chains",
+					Action: client.IndexChains,
+					Flags: []cli.Flag{
+						cli.IntFlag{
+							Name:  "page",
+							Usage: "page of results to display",
+						},
+					},
+				},
+				{
+					Name:   "show",
+					Usage:  "Show a chain's config and nodes",
+					Action: client.ShowChain,
+				},
+				{
+					Name:   "update",
+					Usage:  format(`Update the chain with the given ID from a JSON blob or file, e.g. {"config": {"gasEstimatorMode": "FixedPrice"}} or {"enabled": true, "nodes": [...]}. Config changes take effect immediately.`),
+					Action: client.UpdateChain,
+				},
+			},
+		},
+
 		{
 			Name:  "config",
 			Usage: "Commands for the node's configuration",
@@ -216,6 +315,36 @@ func NewApp(client *Client) *cli.App {
 					Usage:  "Create a V2 job",
 					Action: client.CreateJobV2,
 				},
+				{
+					Name:      "update",
+					Usage:     "Save a new version of the spec of a V2 job and restart it",
+					ArgsUsage: "<job ID> <TOML or filepath>",
+					Action:    client.UpdateJob,
+				},
+				{
+					Name:      "versions",
+					Usage:     "List the versions of the spec of a V2 job",
+					ArgsUsage: "<job ID>",
+					Action:    client.ListJobSpecVersions,
+				},
+				{
+					Name:      "rollback",
+					Usage:     "Restore an earlier version of the spec of a V2 job and restart it",
+					ArgsUsage: "<job ID> <version>",
+					Action:    client.RollbackJob,
+				},
+				{
+					Name:      "simulate",
+					Usage:     "Run the pipeline of a V2 job spec without saving the job, sending transactions or calling async bridges",
+					ArgsUsage: "<TOML or filepath>",
+					Action:    client.SimulateJob,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "vars",
+							Usage: `path to a JSON file with the vars of the run, e.g. {"jobRun": {"requestBody": "..."}}`,
+						},
+					},
+				},
 				{
 					Name:   "delete",
 					Usage:  "Delete a V2 job",
@@ -226,6 +355,25 @@ func NewApp(client *Client) *cli.App {
 					Usage:  "Trigger a V2 job run",
 					Action: client.TriggerPipelineRun,
 				},
+				{
+					Name:   "costs",
+					Usage:  "Report the gas spent by jobs per key, chain and day",
+					Action: client.IndexJobCosts,
+					Flags: []cli.Flag{
+						cli.IntFlag{
+							Name:  "job",
+							Usage: "only report the costs of the job with this ID",
+						},
+						cli.StringFlag{
+							Name:  "since",
+							Usage: "start of the report as an RFC3339 timestamp or YYYY-MM-DD date (default: 7 days before until)",
+						},
+						cli.StringFlag{
+							Name:  "until",
+							Usage: "end of the report, exclusive, as an RFC3339 timestamp or YYYY-MM-DD date (default: now)",
+						},
+					},
+				},
 			},
 		},
 		{
@@ -514,6 +662,28 @@ func NewApp(client *Client) *cli.App {
 					Usage:   "Import a key file to use with the node",
 					Action:  client.ImportKey,
 				},
+				{
+					Name:  "keys",
+					Usage: "Commands for managing the keys stored in the *local node's* database",
+					Subcommands: []cli.Command{
+						{
+							Name:        "rotate-password",
+							Usage:       "Re-encrypt all keys with a new keystore password",
+							Description: "The node must be stopped. All keys are decrypted with the current password and re-encrypted with the new one in a single database transaction.",
+							Action:      client.RotateKeystorePassword,
+							Flags: []cli.Flag{
+								cli.StringFlag{
+									Name:  "password, p",
+									Usage: "text file holding the current password for the node's keys",
+								},
+								cli.StringFlag{
+									Name:  "newpassword, n",
+									Usage: "text file holding the new password for the node's keys",
+								},
+							},
+						},
+					},
+				},
 				{
 					Name:   "setnextnonce",
 					Usage:  "Manually set the next nonce for a key. This should NEVER be necessary during normal operation. USE WITH CAUTION: Setting this incorrectly can break your node.",
@@ -549,10 +719,25 @@ func NewApp(client *Client) *cli.App {
 							Name:  "vrfpassword, vp",
 							Usage: "textfile holding the password for the vrf keys; enables chainlink VRF oracle",
 						},
+						cli.StringFlag{
+							Name:  "config, c",
+							Usage: "TOML config file; environment variables override its values, and EthGasPriceDefault, LogLevel and LogSQLStatements are reloaded on SIGHUP",
+						},
 					},
 					Usage:  "Run the chainlink node",
 					Action: client.RunNode,
 				},
+				{
+					Name:   "validate-config",
+					Usage:  "Validate the node's configuration from environment variables and an optional config file, reporting all errors",
+					Action: client.ValidateConfig,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "config, c",
+							Usage: "TOML config file to validate along with the environment",
+						},
+					},
+				},
 				{
 					Name:   "rebroadcast-transactions",
 					Usage:  "Manually rebroadcast txs matching nonce range with the specified gas price. This is useful in emergencies e.g. high gas prices and/or network congestion to forcibly clear out the pending TX queue",
@@ -626,6 +811,39 @@ func NewApp(client *Client) *cli.App {
 							Action: client.MigrateDatabase,
 							Flags:  []cli.Flag{},
 						},
+						{
+							Name:   "backup",
+							Usage:  "Write a backup of the database to a local file.",
+							Action: client.BackupDatabase,
+							Flags: []cli.Flag{
+								cli.StringFlag{
+									Name:  "file, f",
+									Usage: "path of the backup file, defaults to a new file in DATABASE_BACKUP_DIR",
+								},
+								cli.StringFlag{
+									Name:  "mode, m",
+									Usage: "full, or lite to leave out pipeline runs",
+									Value: "full",
+								},
+							},
+						},
+						{
+							Name:        "restore",
+							Usage:       "Replace all data in the database with a backup.",
+							Description: "The node must be stopped. A new database is first migrated to the version the backup was taken at, while a database that is already set up must be at that version and requires --force.",
+							ArgsUsage:   "[backup file]",
+							Action:      client.RestoreDatabase,
+							Flags: []cli.Flag{
+								cli.BoolFlag{
+									Name:  "latest",
+									Usage: "restore the most recent backup from DATABASE_BACKUP_DIR or DATABASE_BACKUP_S3_URL",
+								},
+								cli.BoolFlag{
+									Name:  "force",
+									Usage: "replace the data of a database that is already set up",
+								},
+							},
+						},
 					},
 				},
 			},
@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+	"github.com/urfave/cli"
+)
+
+type AuditLogPresenter struct {
+	JAID
+	presenters.AuditLogResource
+}
+
+// RenderTable implements TableRenderer
+func (p *AuditLogPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable(auditLogHeaders)
+	table.Append(p.ToRow())
+	render("Audit Log Event:", table)
+	return nil
+}
+
+func (p *AuditLogPresenter) ToRow() []string {
+	return []string{
+		p.ID,
+		p.CreatedAt.String(),
+		string(p.Type),
+		p.UserEmail,
+		p.RemoteIP,
+		string(p.Data),
+	}
+}
+
+var auditLogHeaders = []string{"ID", "Time", "Type", "User", "Remote IP", "Data"}
+
+type AuditLogPresenters []AuditLogPresenter
+
+// RenderTable implements TableRenderer
+func (ps *AuditLogPresenters) RenderTable(rt RendererTable) error {
+	table := rt.newTable(auditLogHeaders)
+	for _, p := range *ps {
+		table.Append(p.ToRow())
+	}
+	render("Audit Log:", table)
+	return nil
+}
+
+// IndexAuditLogs lists the audit log of sensitive operator actions
+func (cli *Client) IndexAuditLogs(c *cli.Context) error {
+	return cli.getPage("/v2/audit_logs", c.Int("page"), &AuditLogPresenters{})
+}
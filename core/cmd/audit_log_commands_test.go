@@ -0,0 +1,74 @@
+package cmd_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/cmd"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/services/audit"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditLogPresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		createdAt = time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+		buffer    = bytes.NewBufferString("")
+		r         = cmd.RendererTable{Writer: buffer}
+	)
+
+	p := cmd.AuditLogPresenter{
+		JAID: cmd.JAID{ID: "1"},
+		AuditLogResource: presenters.AuditLogResource{
+			JAID:      presenters.NewJAID("1"),
+			Type:      audit.EventBridgeCreated,
+			UserEmail: cltest.APIEmail,
+			RemoteIP:  "127.0.0.1",
+			Data:      json.RawMessage(`{"name":"adapter"}`),
+			CreatedAt: createdAt,
+		},
+	}
+
+	// Render a single resource
+	require.NoError(t, p.RenderTable(r))
+
+	output := buffer.String()
+	assert.Contains(t, output, "bridge_created")
+	assert.Contains(t, output, cltest.APIEmail)
+	assert.Contains(t, output, "127.0.0.1")
+	assert.Contains(t, output, `{"name":"adapter"}`)
+	assert.Contains(t, output, createdAt.String())
+
+	// Render many resources
+	buffer.Reset()
+	ps := cmd.AuditLogPresenters{p}
+	require.NoError(t, ps.RenderTable(r))
+
+	output = buffer.String()
+	assert.Contains(t, output, "bridge_created")
+	assert.Contains(t, output, cltest.APIEmail)
+}
+
+func TestClient_IndexAuditLogs(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplication(t)
+	client, r := app.NewClientAndRenderer()
+
+	app.GetAuditLogger().Record(audit.Event{Type: audit.EventBridgeCreated, RemoteIP: "127.0.0.1"})
+
+	require.NoError(t, client.IndexAuditLogs(cltest.EmptyCLIContext()))
+	require.Len(t, r.Renders, 1)
+	events := *r.Renders[0].(*cmd.AuditLogPresenters)
+	var types []audit.EventType
+	for _, e := range events {
+		types = append(types, e.Type)
+	}
+	assert.Contains(t, types, audit.EventBridgeCreated)
+}
@@ -0,0 +1,159 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+)
+
+type ChainPresenter struct {
+	JAID
+	presenters.ChainResource
+}
+
+// RenderTable implements TableRenderer
+func (p *ChainPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable(chainHeaders)
+	table.Append(p.ToRow())
+	render("Chain:", table)
+	return nil
+}
+
+func (p *ChainPresenter) ToRow() []string {
+	var nodes []string
+	for _, n := range p.Nodes {
+		nodes = append(nodes, n.Name)
+	}
+	cfg := p.Config
+	row := []string{
+		p.ID,
+		strconv.FormatBool(p.Enabled),
+		cfg.ChainType.String,
+		"",
+		cfg.GasEstimatorMode.String,
+		"",
+		strings.Join(nodes, ", "),
+		p.UpdatedAt.String(),
+	}
+	if cfg.EthFinalityDepth.Valid {
+		row[3] = strconv.FormatInt(cfg.EthFinalityDepth.Int64, 10)
+	}
+	if cfg.EthGasLimitDefault.Valid {
+		row[5] = strconv.FormatInt(cfg.EthGasLimitDefault.Int64, 10)
+	}
+	return row
+}
+
+var chainHeaders = []string{"ID", "Enabled", "Chain Type", "Finality Depth", "Gas Estimator", "Gas Limit", "Nodes", "Last Updated"}
+
+type ChainPresenters []ChainPresenter
+
+// RenderTable implements TableRenderer
+func (ps *ChainPresenters) RenderTable(rt RendererTable) error {
+	table := rt.newTable(chainHeaders)
+	for _, p := range *ps {
+		table.Append(p.ToRow())
+	}
+	render("Chains:", table)
+	return nil
+}
+
+// IndexChains lists the EVM chains known to the node
+func (cli *Client) IndexChains(c *cli.Context) error {
+	return cli.getPage("/v2/chains", c.Int("page"), &ChainPresenters{})
+}
+
+// ShowChain shows the config and nodes of an EVM chain
+func (cli *Client) ShowChain(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the ID of the chain to be shown"))
+	}
+	resp, err := cli.HTTP.Get("/v2/chains/" + c.Args().First())
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &ChainPresenter{})
+}
+
+// CreateChain adds an EVM chain and starts it
+func (cli *Client) CreateChain(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass in the chain's parameters [JSON blob | JSON filepath]"))
+	}
+
+	buf, err := getBufferFromJSON(c.Args().First())
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Post("/v2/chains", buf)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &ChainPresenter{}, "Created chain")
+}
+
+// UpdateChain changes the config of an EVM chain, enables or disables it, or
+// replaces its nodes
+func (cli *Client) UpdateChain(c *cli.Context) (err error) {
+	if c.NArg() != 2 {
+		return cli.errorOut(errors.New("must pass the ID of the chain and the changes [JSON blob | JSON filepath]"))
+	}
+
+	buf, err := getBufferFromJSON(c.Args().Get(1))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Patch("/v2/chains/"+c.Args().First(), buf)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &ChainPresenter{}, "Updated chain")
+}
+
+// RemoveChain stops an EVM chain and removes it along with its nodes
+func (cli *Client) RemoveChain(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the ID of the chain to be removed"))
+	}
+
+	resp, err := cli.HTTP.Delete("/v2/chains/" + c.Args().First())
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	if _, err = cli.parseResponse(resp); err != nil {
+		return cli.errorOut(err)
+	}
+	fmt.Printf("Removed chain %s\n", c.Args().First())
+	return nil
+}
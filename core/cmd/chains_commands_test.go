@@ -0,0 +1,60 @@
+package cmd_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/cmd"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+)
+
+func TestChainPresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		buffer = bytes.NewBufferString("")
+		r      = cmd.RendererTable{Writer: buffer}
+	)
+
+	p := cmd.ChainPresenter{
+		JAID: cmd.JAID{ID: "42161"},
+		ChainResource: presenters.ChainResource{
+			JAID:    presenters.NewJAID("42161"),
+			Enabled: true,
+			Config: types.ChainCfg{
+				ChainType:          null.StringFrom("arbitrum"),
+				EthFinalityDepth:   null.IntFrom(50),
+				EthGasLimitDefault: null.IntFrom(7000000),
+				GasEstimatorMode:   null.StringFrom("FixedPrice"),
+			},
+			Nodes: []presenters.NodeResource{
+				{ID: 1, Name: "arbitrum-primary", WSURL: null.StringFrom("wss://arbitrum.test")},
+			},
+			UpdatedAt: time.Now(),
+		},
+	}
+
+	// Render a single resource
+	require.NoError(t, p.RenderTable(r))
+
+	output := buffer.String()
+	assert.Contains(t, output, "42161")
+	assert.Contains(t, output, "arbitrum")
+	assert.Contains(t, output, "7000000")
+	assert.Contains(t, output, "FixedPrice")
+	assert.Contains(t, output, "arbitrum-primary")
+
+	// Render many resources
+	buffer.Reset()
+	ps := cmd.ChainPresenters{p}
+	require.NoError(t, ps.RenderTable(r))
+
+	output = buffer.String()
+	assert.Contains(t, output, "42161")
+	assert.Contains(t, output, "arbitrum-primary")
+}
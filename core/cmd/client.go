@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"bytes"
+	"context"
 	"crypto/tls"
 	"encoding/json"
 	"fmt"
@@ -19,6 +20,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/services/eth"
 	"github.com/smartcontractkit/chainlink/core/services/postgres"
+	"github.com/smartcontractkit/chainlink/core/services/secrets"
 	"github.com/smartcontractkit/chainlink/core/store"
 	"github.com/smartcontractkit/chainlink/core/store/config"
 	"github.com/smartcontractkit/chainlink/core/store/models"
@@ -72,12 +74,16 @@ type ChainlinkAppFactory struct{}
 
 // NewApplication returns a new instance of the node with the given config.
 func (n ChainlinkAppFactory) NewApplication(config *config.Config) (chainlink.Application, error) {
+	if err := config.ResolveSecrets(context.Background()); err != nil {
+		return nil, err
+	}
+
 	var ethClient eth.Client
 	if config.EthereumDisabled() {
 		ethClient = &eth.NullClient{}
 	} else {
 		var err error
-		ethClient, err = eth.NewClient(config.EthereumURL(), config.EthereumHTTPURL(), config.EthereumSecondaryURLs())
+		ethClient, err = eth.NewClientWithPrimaries(config.EthereumURL(), config.EthereumHTTPURL(), config.EthereumPrimaryURLs(), config.EthereumSecondaryURLs())
 		if err != nil {
 			return nil, err
 		}
@@ -403,15 +409,18 @@ func (p promptingSessionRequestBuilder) Build(string) (models.SessionRequest, er
 	return models.SessionRequest{Email: email, Password: pwd}, nil
 }
 
-type fileSessionRequestBuilder struct{}
+type fileSessionRequestBuilder struct {
+	config *config.Config
+}
 
-// NewFileSessionRequestBuilder pulls credentials from a file to generate a SessionRequest.
-func NewFileSessionRequestBuilder() SessionRequestBuilder {
-	return fileSessionRequestBuilder{}
+// NewFileSessionRequestBuilder pulls credentials from a file to generate a
+// SessionRequest. Secret references to credentials are resolved with config.
+func NewFileSessionRequestBuilder(config *config.Config) SessionRequestBuilder {
+	return fileSessionRequestBuilder{config: config}
 }
 
 func (f fileSessionRequestBuilder) Build(file string) (models.SessionRequest, error) {
-	return credentialsFromFile(file)
+	return credentialsFromFile(f.config, file)
 }
 
 // APIInitializer is the interface used to create the API User credentials
@@ -444,7 +453,7 @@ func (t *promptingAPIInitializer) Initialize(store *store.Store) (models.User, e
 	for {
 		email := t.prompter.Prompt("Enter API Email: ")
 		pwd := t.prompter.PasswordPrompt("Enter API Password: ")
-		user, err := models.NewUser(email, pwd)
+		user, err := models.NewUser(email, pwd, models.UserRoleOwner)
 		if err != nil {
 			fmt.Println("Error creating API user: ", err)
 			continue
@@ -471,12 +480,12 @@ func (f fileAPIInitializer) Initialize(store *store.Store) (models.User, error)
 		return user, err
 	}
 
-	request, err := credentialsFromFile(f.file)
+	request, err := credentialsFromFile(store.Config, f.file)
 	if err != nil {
 		return models.User{}, err
 	}
 
-	user, err := models.NewUser(request.Email, request.Password)
+	user, err := models.NewUser(request.Email, request.Password, models.UserRoleOwner)
 	if err != nil {
 		return user, err
 	}
@@ -485,17 +494,30 @@ func (f fileAPIInitializer) Initialize(store *store.Store) (models.User, error)
 
 var ErrNoCredentialFile = errors.New("no API user credential file was passed")
 
-func credentialsFromFile(file string) (models.SessionRequest, error) {
+// credentialsFromFile reads the API email and password from the first two
+// lines of file, which is either the path of a credentials file or a secret
+// reference to its contents
+func credentialsFromFile(cfg *config.Config, file string) (models.SessionRequest, error) {
 	if len(file) == 0 {
 		return models.SessionRequest{}, ErrNoCredentialFile
 	}
 
 	logger.Debug("Initializing API credentials from ", file)
-	dat, err := ioutil.ReadFile(file)
-	if err != nil {
-		return models.SessionRequest{}, err
+	var dat string
+	if secrets.IsReference(file) {
+		secret, err := cfg.ResolveSecret(context.Background(), file)
+		if err != nil {
+			return models.SessionRequest{}, err
+		}
+		dat = secret
+	} else {
+		b, err := ioutil.ReadFile(file)
+		if err != nil {
+			return models.SessionRequest{}, err
+		}
+		dat = string(b)
 	}
-	lines := strings.Split(string(dat), "\n")
+	lines := strings.Split(dat, "\n")
 	if len(lines) < 2 {
 		return models.SessionRequest{}, fmt.Errorf("malformed API credentials file does not have at least two lines at %s", file)
 	}
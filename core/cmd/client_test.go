@@ -1,6 +1,7 @@
 package cmd_test
 
 import (
+	"os"
 	"testing"
 
 	"github.com/smartcontractkit/chainlink/core/cmd"
@@ -264,7 +265,10 @@ func TestPromptingSessionRequestBuilder(t *testing.T) {
 func TestFileSessionRequestBuilder(t *testing.T) {
 	t.Parallel()
 
-	builder := cmd.NewFileSessionRequestBuilder()
+	require.NoError(t, os.Setenv("FILE_SESSION_REQUEST_BUILDER_TEST_CREDENTIALS", cltest.APIEmail+"\n"+cltest.Password))
+	defer os.Unsetenv("FILE_SESSION_REQUEST_BUILDER_TEST_CREDENTIALS")
+
+	builder := cmd.NewFileSessionRequestBuilder(cltest.NewTestConfig(t).Config)
 	tests := []struct {
 		name, file, wantEmail string
 		wantError             bool
@@ -272,6 +276,8 @@ func TestFileSessionRequestBuilder(t *testing.T) {
 		{"empty", "", "", true},
 		{"correct file", "../internal/fixtures/apicredentials", cltest.APIEmail, false},
 		{"incorrect file", "/tmp/dontexist", "", true},
+		{"secret reference", "env://FILE_SESSION_REQUEST_BUILDER_TEST_CREDENTIALS", cltest.APIEmail, false},
+		{"unset secret reference", "env://FILE_SESSION_REQUEST_BUILDER_TEST_UNSET", "", true},
 	}
 
 	for _, test := range tests {
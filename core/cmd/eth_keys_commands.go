@@ -31,6 +31,7 @@ func (p *EthKeyPresenter) ToRow() []string {
 		p.LinkBalance.String(),
 		nextNonce,
 		fmt.Sprintf("%v", p.IsFunding),
+		fmt.Sprintf("%v", p.IsRemote),
 		p.CreatedAt.String(),
 		p.UpdatedAt.String(),
 		deletedAt,
@@ -39,7 +40,7 @@ func (p *EthKeyPresenter) ToRow() []string {
 
 // RenderTable implements TableRenderer
 func (p *EthKeyPresenter) RenderTable(rt RendererTable) error {
-	headers := []string{"Address", "ETH", "LINK", "Next nonce", "Is funding", "Created", "Updated", "Deleted"}
+	headers := []string{"Address", "ETH", "LINK", "Next nonce", "Is funding", "Is remote", "Created", "Updated", "Deleted"}
 	rows := [][]string{p.ToRow()}
 
 	renderList(headers, rows, rt.Writer)
@@ -51,7 +52,7 @@ type EthKeyPresenters []EthKeyPresenter
 
 // RenderTable implements TableRenderer
 func (ps EthKeyPresenters) RenderTable(rt RendererTable) error {
-	headers := []string{"Address", "ETH", "LINK", "Next nonce", "Is funding", "Created", "Updated", "Deleted"}
+	headers := []string{"Address", "ETH", "LINK", "Next nonce", "Is funding", "Is remote", "Created", "Updated", "Deleted"}
 	rows := [][]string{}
 
 	for _, p := range ps {
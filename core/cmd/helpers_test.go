@@ -1,7 +1,15 @@
 package cmd
 
-import "github.com/smartcontractkit/chainlink/core/services/keystore"
+import (
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/store"
+	"github.com/smartcontractkit/chainlink/core/store/config"
+)
 
 func (auth TerminalKeyStoreAuthenticator) ExportedValidatePasswordStrength(ethKeyStore *keystore.Eth, password string) error {
 	return auth.validatePasswordStrength(ethKeyStore, password)
 }
+
+func ExportedReloadConfigFile(cfg *config.Config, store *store.Store) {
+	reloadConfigFile(cfg, store)
+}
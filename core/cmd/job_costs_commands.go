@@ -0,0 +1,88 @@
+package cmd
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+)
+
+type JobCostPresenter struct {
+	JAID
+	presenters.JobCostResource
+}
+
+// RenderTable implements TableRenderer
+func (p *JobCostPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable(jobCostHeaders)
+	table.Append(p.ToRow())
+	render("Job Cost:", table)
+	return nil
+}
+
+func (p *JobCostPresenter) ToRow() []string {
+	job := "-"
+	if p.JobID != nil {
+		job = strconv.Itoa(int(*p.JobID))
+	}
+	return []string{
+		p.Day.Format("2006-01-02"),
+		job,
+		p.EVMChainID.String(),
+		p.FromAddress,
+		strconv.FormatInt(p.Transactions, 10),
+		strconv.FormatInt(p.GasUsed, 10),
+		p.Fee.String(),
+		p.L1Fee.String(),
+		p.LinkEarned.Link(),
+	}
+}
+
+// jobCostHeaders name the fee columns after what they hold: the gas fee
+// includes the L1 fee on Arbitrum but not on Optimism
+var jobCostHeaders = []string{"Day", "Job", "Chain", "Key", "Txs", "Gas Used", "Gas Fee", "L1 Fee", "LINK Earned"}
+
+type JobCostPresenters []JobCostPresenter
+
+// RenderTable implements TableRenderer
+func (ps *JobCostPresenters) RenderTable(rt RendererTable) error {
+	table := rt.newTable(jobCostHeaders)
+	for _, p := range *ps {
+		table.Append(p.ToRow())
+	}
+	render("Job Costs:", table)
+	return nil
+}
+
+// IndexJobCosts reports the gas spent by jobs per key, chain and day
+func (cli *Client) IndexJobCosts(c *cli.Context) (err error) {
+	query := url.Values{}
+	if c.IsSet("job") {
+		query.Set("jobID", fmt.Sprint(c.Int("job")))
+	}
+	if s := c.String("since"); s != "" {
+		query.Set("since", s)
+	}
+	if s := c.String("until"); s != "" {
+		query.Set("until", s)
+	}
+
+	path := "/v2/job_costs"
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+	resp, err := cli.HTTP.Get(path)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &JobCostPresenters{})
+}
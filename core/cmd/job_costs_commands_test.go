@@ -0,0 +1,59 @@
+package cmd_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/cmd"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobCostPresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		jobID  = int32(7)
+		buffer = bytes.NewBufferString("")
+		r      = cmd.RendererTable{Writer: buffer}
+	)
+
+	p := cmd.JobCostPresenter{
+		JAID: cmd.JAID{ID: "2000-01-01-7-1-0x9D26769a2fF5B5DC4A7e9A1A79F3d2df8ee8f1a8"},
+		JobCostResource: presenters.JobCostResource{
+			JobID:        &jobID,
+			EVMChainID:   utils.NewBigI(1),
+			FromAddress:  "0x9D26769a2fF5B5DC4A7e9A1A79F3d2df8ee8f1a8",
+			Day:          time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+			Transactions: 2,
+			GasUsed:      42000,
+			Fee:          assets.NewEth(42000000000000),
+			L1Fee:        assets.NewEth(0),
+			LinkEarned:   assets.NewLink(1500000000000000000),
+		},
+	}
+
+	// Render a single resource
+	require.NoError(t, p.RenderTable(r))
+
+	output := buffer.String()
+	assert.Contains(t, output, "2000-01-01")
+	assert.Contains(t, output, "0x9D26769a2fF5B5DC4A7e9A1A79F3d2df8ee8f1a8")
+	assert.Contains(t, output, "42000")
+	assert.Contains(t, output, "0.000042000000000000")
+	assert.Contains(t, output, "1.500000000000000000")
+
+	// Render many resources
+	buffer.Reset()
+	p.JobID = nil
+	ps := cmd.JobCostPresenters{p}
+	require.NoError(t, ps.RenderTable(r))
+
+	output = buffer.String()
+	assert.Contains(t, output, "2000-01-01")
+	assert.Contains(t, output, "0x9D26769a2fF5B5DC4A7e9A1A79F3d2df8ee8f1a8")
+}
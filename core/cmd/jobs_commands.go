@@ -194,6 +194,208 @@ func (cli *Client) CreateJobV2(c *cli.Context) (err error) {
 	return err
 }
 
+// UpdateJob saves a new version of the spec of a V2 job and restarts the job
+// with it. Valid input is the job ID followed by a TOML string or a path to a
+// TOML file.
+func (cli *Client) UpdateJob(c *cli.Context) (err error) {
+	if c.NArg() != 2 {
+		return cli.errorOut(errors.New("must pass the job id and the TOML or filepath of its new spec"))
+	}
+
+	tomlString, err := getTOMLString(c.Args().Get(1))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	request, err := json.Marshal(web.UpdateJobRequest{
+		TOML: tomlString,
+	})
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Patch("/v2/jobs/"+c.Args().First(), bytes.NewReader(request))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &JobPresenter{}, "Job updated")
+}
+
+// JobSpecVersionPresenter wraps the JSONAPI job spec version resource and
+// adds rendering functionality
+type JobSpecVersionPresenter struct {
+	JAID
+	presenters.JobSpecVersionResource
+}
+
+// RenderTable implements TableRenderer
+func (p *JobSpecVersionPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable(jobSpecVersionHeaders)
+	table.Append(p.ToRow())
+	render("Job Spec Version", table)
+	return nil
+}
+
+func (p *JobSpecVersionPresenter) ToRow() []string {
+	restorable := "no"
+	if p.TOML.Valid {
+		restorable = "yes"
+	}
+	return []string{
+		fmt.Sprint(p.Version),
+		fmt.Sprint(p.Current),
+		restorable,
+		p.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+var jobSpecVersionHeaders = []string{"Version", "Current", "Restorable", "Created At"}
+
+type JobSpecVersionPresenters []JobSpecVersionPresenter
+
+// RenderTable implements TableRenderer
+func (ps *JobSpecVersionPresenters) RenderTable(rt RendererTable) error {
+	table := rt.newTable(jobSpecVersionHeaders)
+	for _, p := range *ps {
+		table.Append(p.ToRow())
+	}
+	render("Job Spec Versions", table)
+	return nil
+}
+
+// ListJobSpecVersions lists the versions of the spec of a V2 job
+func (cli *Client) ListJobSpecVersions(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the job id"))
+	}
+	resp, err := cli.HTTP.Get("/v2/jobs/" + c.Args().First() + "/versions")
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &JobSpecVersionPresenters{})
+}
+
+// RollbackJob restores an earlier version of the spec of a V2 job and
+// restarts the job with it
+func (cli *Client) RollbackJob(c *cli.Context) (err error) {
+	if c.NArg() != 2 {
+		return cli.errorOut(errors.New("must pass the job id and the version to roll back to"))
+	}
+	resp, err := cli.HTTP.Post(fmt.Sprintf("/v2/jobs/%s/versions/%s/rollback", c.Args().First(), c.Args().Get(1)), nil)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &JobPresenter{}, "Job rolled back")
+}
+
+// JobSimulationPresenter wraps the JSONAPI job simulation resource and adds
+// rendering functionality
+type JobSimulationPresenter struct {
+	JAID
+	presenters.JobSimulationResource
+}
+
+var jobSimulationHeaders = []string{"Task", "Type", "Output", "Error", "Elapsed", "Simulated Action"}
+
+// RenderTable implements TableRenderer
+func (p *JobSimulationPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable(jobSimulationHeaders)
+	for _, tr := range p.TaskRuns {
+		table.Append(p.toRow(tr))
+	}
+	render(fmt.Sprintf("Job Simulation (%s)", p.State), table)
+	return nil
+}
+
+func (p *JobSimulationPresenter) toRow(tr presenters.SimulatedTaskRunResource) []string {
+	var output, taskErr, action string
+	if tr.Output != nil {
+		output = *tr.Output
+	}
+	if tr.Error != nil {
+		taskErr = *tr.Error
+	}
+	if tr.SimulatedAction != nil {
+		b, err := json.Marshal(tr.SimulatedAction)
+		if err != nil {
+			action = err.Error()
+		} else {
+			action = string(b)
+		}
+	}
+	return []string{
+		tr.DotID,
+		string(tr.Type),
+		output,
+		taskErr,
+		tr.Elapsed.String(),
+		action,
+	}
+}
+
+// SimulateJob runs the pipeline of a job spec in-memory with the vars of a
+// JSON file, without saving the job or the run. Transactions and async
+// bridge calls are not made but shown as simulated actions.
+func (cli *Client) SimulateJob(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass in TOML or filepath"))
+	}
+
+	tomlString, err := getTOMLString(c.Args().First())
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	vars := map[string]interface{}{}
+	if varsFile := c.String("vars"); varsFile != "" {
+		b, rerr := ioutil.ReadFile(varsFile)
+		if rerr != nil {
+			return cli.errorOut(errors.Wrap(rerr, "failed to read vars file"))
+		}
+		if rerr = json.Unmarshal(b, &vars); rerr != nil {
+			return cli.errorOut(errors.Wrap(rerr, "vars file must contain a JSON object"))
+		}
+	}
+
+	request, err := json.Marshal(web.SimulateJobRequest{
+		TOML: tomlString,
+		Vars: vars,
+	})
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Post("/v2/jobs/simulate", bytes.NewReader(request))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &JobSimulationPresenter{})
+}
+
 // DeleteJob deletes a V2 job
 func (cli *Client) DeleteJob(c *cli.Context) error {
 	if !c.Args().Present() {
@@ -9,11 +9,13 @@ import (
 	"github.com/smartcontractkit/chainlink/core/cmd"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/urfave/cli"
+	"gopkg.in/guregu/null.v4"
 )
 
 func TestJobPresenter_RenderTable(t *testing.T) {
@@ -347,3 +349,99 @@ func requireJobsCount(t *testing.T, orm job.ORM, expected int) {
 	require.NoError(t, err)
 	require.Len(t, jobs, expected)
 }
+
+func TestJobSpecVersionPresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		createdAt = time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+		buffer    = bytes.NewBufferString("")
+		r         = cmd.RendererTable{Writer: buffer}
+	)
+
+	p := cmd.JobSpecVersionPresenter{
+		JAID: cmd.JAID{ID: "10"},
+		JobSpecVersionResource: presenters.JobSpecVersionResource{
+			JobID:     1,
+			Version:   2,
+			Current:   true,
+			TOML:      null.StringFrom(`type = "cron"`),
+			CreatedAt: createdAt,
+		},
+	}
+
+	// Render a single resource
+	require.NoError(t, p.RenderTable(r))
+
+	output := buffer.String()
+	assert.Contains(t, output, "2")
+	assert.Contains(t, output, "true")
+	assert.Contains(t, output, "yes")
+	assert.Contains(t, output, "2000-01-01T00:00:00Z")
+
+	// Render many resources
+	buffer.Reset()
+	p.Current = false
+	p.TOML = null.String{}
+	ps := cmd.JobSpecVersionPresenters{p}
+	require.NoError(t, ps.RenderTable(r))
+
+	output = buffer.String()
+	assert.Contains(t, output, "false")
+	assert.Contains(t, output, "no")
+}
+
+func TestJobSimulationPresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		createdAt = time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+		buffer    = bytes.NewBufferString("")
+		r         = cmd.RendererTable{Writer: buffer}
+		output    = `"150"`
+		taskErr   = "bridge not found"
+	)
+
+	p := cmd.JobSimulationPresenter{
+		JobSimulationResource: presenters.JobSimulationResource{
+			State: pipeline.RunStatusCompleted,
+			TaskRuns: []presenters.SimulatedTaskRunResource{
+				{
+					PipelineTaskRunResource: presenters.PipelineTaskRunResource{
+						Type:      pipeline.TaskTypeMultiply,
+						CreatedAt: createdAt,
+						Output:    &output,
+						DotID:     "multiply",
+					},
+					Elapsed: models.MustMakeDuration(3 * time.Millisecond),
+				},
+				{
+					PipelineTaskRunResource: presenters.PipelineTaskRunResource{
+						Type:      pipeline.TaskTypeBridge,
+						CreatedAt: createdAt,
+						Error:     &taskErr,
+						DotID:     "fetch",
+					},
+				},
+				{
+					PipelineTaskRunResource: presenters.PipelineTaskRunResource{
+						Type:      pipeline.TaskTypeETHTx,
+						CreatedAt: createdAt,
+						DotID:     "submit",
+					},
+					SimulatedAction: map[string]interface{}{"to": "0x0000000000000000000000000000000000000001"},
+				},
+			},
+		},
+	}
+
+	require.NoError(t, p.RenderTable(r))
+
+	rendered := buffer.String()
+	assert.Contains(t, rendered, "multiply")
+	assert.Contains(t, rendered, `"150"`)
+	assert.Contains(t, rendered, "3ms")
+	assert.Contains(t, rendered, "bridge not found")
+	assert.Contains(t, rendered, "ethtx")
+	assert.Contains(t, rendered, `{"to":"0x0000000000000000000000000000000000000001"}`)
+}
@@ -53,6 +53,10 @@ func (auth TerminalKeyStoreAuthenticator) AuthenticateEthKey(ethKeyStore *keysto
 }
 
 func (auth TerminalKeyStoreAuthenticator) validatePasswordStrength(ethKeyStore *keystore.Eth, password string) error {
+	return checkPasswordStrength(password)
+}
+
+func checkPasswordStrength(password string) error {
 	// Password policy:
 	//
 	// Must be longer than 12 characters
@@ -137,6 +141,9 @@ func (auth TerminalKeyStoreAuthenticator) promptNewPassword(ethKeyStore *keystor
 		if err != nil {
 			return password, errors.Wrap(err, "unexpectedly failed to unlock KeyStore")
 		}
+		if ethKeyStore.HasRemoteSigner() {
+			return password, nil
+		}
 		_, err = ethKeyStore.CreateNewKey()
 		return password, errors.Wrap(err, "failed to create new ETH key")
 	}
@@ -151,6 +158,9 @@ func (auth TerminalKeyStoreAuthenticator) unlockNewWithPassword(ethKeyStore *key
 	if err != nil {
 		return "", errors.Wrap(err, "Error unlocking key store")
 	}
+	if ethKeyStore.HasRemoteSigner() {
+		return password, nil
+	}
 	fmt.Println("There are no accounts, creating a new account with the specified password")
 	_, err = ethKeyStore.CreateNewKey()
 	return password, errors.Wrap(err, "failed to create new ETH key")
@@ -4,14 +4,17 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"math/big"
 	"net/url"
 	"os"
+	"os/signal"
 	"path"
 	"path/filepath"
 	"runtime"
 	"strings"
+	"syscall"
 	"time"
 
 	"github.com/fatih/color"
@@ -33,6 +36,9 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/health"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/services/periodicbackup"
+	"github.com/smartcontractkit/chainlink/core/services/postgres"
+	"github.com/smartcontractkit/chainlink/core/services/secrets"
 	"github.com/smartcontractkit/chainlink/core/static"
 	strpkg "github.com/smartcontractkit/chainlink/core/store"
 	"github.com/smartcontractkit/chainlink/core/store/models"
@@ -53,6 +59,14 @@ const ownerPermsMask = os.FileMode(0700)
 
 // RunNode starts the Chainlink core.
 func (cli *Client) RunNode(c *clipkg.Context) error {
+	if path := c.String("config"); path != "" {
+		if err := cli.Config.LoadConfigFile(path); err != nil {
+			return cli.errorOut(err)
+		}
+	}
+	if err := cli.Config.ResolveSecrets(context.Background()); err != nil {
+		return cli.errorOut(err)
+	}
 	err := cli.Config.Validate()
 	if err != nil {
 		return cli.errorOut(err)
@@ -68,7 +82,7 @@ func (cli *Client) RunNode(c *clipkg.Context) error {
 		logger.Warn("Ethereum is disabled. Chainlink will only run services that can operate without an ethereum connection")
 	}
 
-	pwd, err := passwordFromFile(c.String("password"))
+	pwd, err := passwordFromFile(cli.Config, c.String("password"))
 	if err != nil {
 		return cli.errorOut(fmt.Errorf("error reading password: %+v", err))
 	}
@@ -98,7 +112,7 @@ func (cli *Client) RunNode(c *clipkg.Context) error {
 	}
 
 	if len(c.String("vrfpassword")) != 0 {
-		vrfpwd, fileErr := passwordFromFile(c.String("vrfpassword"))
+		vrfpwd, fileErr := passwordFromFile(cli.Config, c.String("vrfpassword"))
 		if fileErr != nil {
 			return cli.errorOut(errors.Wrapf(fileErr,
 				"error reading VRF password from vrfpassword file \"%s\"",
@@ -144,10 +158,87 @@ func (cli *Client) RunNode(c *clipkg.Context) error {
 		}
 	}
 
+	if cli.Config.ConfigFilePath() != "" {
+		stopReloading := reloadConfigOnSIGHUP(cli.Config, store)
+		defer stopReloading()
+	}
+
 	logger.Infof("Chainlink booted in %s", time.Since(static.InitTime))
 	return cli.errorOut(cli.Runner.Run(app))
 }
 
+// reloadConfigOnSIGHUP reloads the config file whenever the node receives a
+// SIGHUP, until the returned function is called
+func reloadConfigOnSIGHUP(cfg *config.Config, store *strpkg.Store) (stop func()) {
+	sighup := make(chan os.Signal, 1)
+	signal.Notify(sighup, syscall.SIGHUP)
+	chStop := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-chStop:
+				return
+			case <-sighup:
+				logger.Infow("Received SIGHUP, reloading config file", "path", cfg.ConfigFilePath())
+				reloadConfigFile(cfg, store)
+			}
+		}
+	}()
+	return func() {
+		signal.Stop(sighup)
+		close(chStop)
+	}
+}
+
+// reloadConfigFile reloads the config file and applies the reloaded fields
+// that are read once at startup
+func reloadConfigFile(cfg *config.Config, store *strpkg.Store) {
+	reloaded, err := cfg.ReloadConfigFile()
+	if err != nil {
+		logger.Errorw("Failed to reload config file", "path", cfg.ConfigFilePath(), "error", err)
+	}
+	for _, name := range reloaded {
+		switch name {
+		case "LogLevel":
+			logger.SetLogger(cfg.CreateProductionLogger())
+			logger.Default.SetDB(store.DB)
+		case "LogSQLStatements":
+			store.SetLogging(cfg.LogSQLStatements())
+		}
+	}
+	if len(reloaded) > 0 {
+		logger.Infow("Reloaded config file", "path", cfg.ConfigFilePath(), "fields", reloaded)
+	}
+}
+
+// ValidateConfig checks the node's configuration, read from environment
+// variables and the optional config file, and reports all errors at once.
+func (cli *Client) ValidateConfig(c *clipkg.Context) error {
+	var errs []error
+	if path := c.String("config"); path != "" {
+		if err := cli.Config.LoadConfigFile(path); err != nil {
+			// The values of an invalid config file are not loaded, so
+			// validating the rest of the config would be misleading
+			for _, e := range multierr.Errors(errors.Cause(err)) {
+				errs = append(errs, errors.Wrap(e, path))
+			}
+		}
+	}
+	if len(errs) == 0 {
+		errs = append(errs, multierr.Errors(cli.Config.ResolveSecrets(context.Background()))...)
+		errs = append(errs, multierr.Errors(cli.Config.ValidateValues())...)
+		errs = append(errs, multierr.Errors(cli.Config.Validate())...)
+	}
+	if len(errs) > 0 {
+		for _, err := range errs {
+			fmt.Println(err)
+		}
+		return cli.errorOut(errors.Errorf("invalid configuration, found %d error(s)", len(errs)))
+	}
+	fmt.Println("Configuration is valid")
+	return nil
+}
+
 func loggedStop(app chainlink.Application) {
 	logger.WarnIf(app.Stop())
 }
@@ -211,10 +302,15 @@ func checkFilePermissions(rootDir string) error {
 	return nil
 }
 
-func passwordFromFile(pwdFile string) (string, error) {
+// passwordFromFile reads the password from pwdFile, which is either the path
+// of a password file or a secret reference, e.g. vault://secret/data/chainlink#password
+func passwordFromFile(cfg *config.Config, pwdFile string) (string, error) {
 	if len(pwdFile) == 0 {
 		return "", nil
 	}
+	if secrets.IsReference(pwdFile) {
+		return cfg.ResolveSecret(context.Background(), pwdFile)
+	}
 	dat, err := ioutil.ReadFile(pwdFile)
 	return strings.TrimSpace(string(dat)), err
 }
@@ -288,7 +384,7 @@ func (cli *Client) RebroadcastTransactions(c *clipkg.Context) (err error) {
 		return err
 	}
 
-	pwd, err := passwordFromFile(c.String("password"))
+	pwd, err := passwordFromFile(cli.Config, c.String("password"))
 	if err != nil {
 		return cli.errorOut(fmt.Errorf("error reading password: %+v", err))
 	}
@@ -313,6 +409,83 @@ func (cli *Client) RebroadcastTransactions(c *clipkg.Context) (err error) {
 	return cli.errorOut(err)
 }
 
+// RotateKeystorePassword re-encrypts all keys in the database with a new
+// keystore password. The node must be stopped, as it holds the old password.
+func (cli *Client) RotateKeystorePassword(c *clipkg.Context) (err error) {
+	oldPassword, err := passwordFromFile(cli.Config, c.String("password"))
+	if err != nil {
+		return cli.errorOut(fmt.Errorf("error reading password: %+v", err))
+	}
+	newPassword, err := passwordFromFile(cli.Config, c.String("newpassword"))
+	if err != nil {
+		return cli.errorOut(fmt.Errorf("error reading new password: %+v", err))
+	}
+	if oldPassword == "" || newPassword == "" {
+		return cli.errorOut(errors.New("must specify both --password and --newpassword"))
+	}
+	if err = checkPasswordStrength(newPassword); err != nil {
+		return cli.errorOut(err)
+	}
+
+	logger.SetLogger(cli.Config.CreateProductionLogger())
+	cli.Config.Dialect = dialects.PostgresWithoutLock
+	app, err := cli.AppFactory.NewApplication(cli.Config)
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "creating application"))
+	}
+	defer func() {
+		if serr := app.Stop(); serr != nil {
+			err = multierr.Append(err, serr)
+		}
+	}()
+
+	unlock, err := tryAdvisoryLock(app.GetStore().DB, cli.Config.GetAdvisoryLockIDConfiguredOrDefault())
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer unlock()
+
+	err = app.GetKeyStore().RotatePassword(oldPassword, newPassword, utils.GetScryptParams(cli.Config))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	fmt.Println("Keystore password rotated, start the node with the new password")
+	return nil
+}
+
+// tryAdvisoryLock takes the advisory lock held by a running node, failing
+// straight away if it is taken rather than waiting for it
+func tryAdvisoryLock(db *gorm.DB, lockID int64) (unlock func(), err error) {
+	ctx, cancel := postgres.DefaultQueryCtx()
+	defer cancel()
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, err
+	}
+	// Advisory locks are held by the connection, not the pool
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open database connection")
+	}
+	var locked bool
+	if err = conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", lockID).Scan(&locked); err != nil {
+		logger.ErrorIfCalling(conn.Close)
+		return nil, errors.Wrap(err, "failed to take advisory lock")
+	}
+	if !locked {
+		logger.ErrorIfCalling(conn.Close)
+		return nil, errors.New("the database is locked by a running Chainlink node, stop the node and try again")
+	}
+	return func() {
+		ctx, cancel := postgres.DefaultQueryCtx()
+		defer cancel()
+		if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_unlock($1)", lockID); err != nil {
+			logger.Errorw("Failed to release advisory lock", "err", err)
+		}
+		logger.ErrorIfCalling(conn.Close)
+	}, nil
+}
+
 type HealthCheckPresenter struct {
 	webPresenters.Check
 }
@@ -384,7 +557,10 @@ func (cli *Client) makeApp() (chainlink.Application, func(), error) {
 // This is useful to setup the database for testing
 func (cli *Client) ResetDatabase(c *clipkg.Context) error {
 	logger.SetLogger(cli.Config.CreateProductionLogger())
-	cfg := config.NewConfig()
+	cfg, err := newDatabaseConfig()
+	if err != nil {
+		return cli.errorOut(err)
+	}
 	parsed := cfg.DatabaseURL()
 	if parsed.String() == "" {
 		return cli.errorOut(errors.New("You must set DATABASE_URL env variable. HINT: If you are running this to set up your local test database, try DATABASE_URL=postgresql://postgres@localhost:5432/chainlink_test?sslmode=disable"))
@@ -396,7 +572,7 @@ func (cli *Client) ResetDatabase(c *clipkg.Context) error {
 	if !dangerMode && !strings.HasSuffix(dbname, "_test") {
 		return cli.errorOut(fmt.Errorf("cannot reset database named `%s`. This command can only be run against databases with a name that ends in `_test`, to prevent accidental data loss. If you REALLY want to reset this database, pass in the -dangerWillRobinson option", dbname))
 	}
-	logger.Infof("Resetting database: %#v", parsed.String())
+	logger.Infof("Resetting database: %#v", parsed.Redacted())
 	if err := dropAndCreateDB(parsed); err != nil {
 		return cli.errorOut(err)
 	}
@@ -411,7 +587,10 @@ func (cli *Client) PrepareTestDatabase(c *clipkg.Context) error {
 	if err := cli.ResetDatabase(c); err != nil {
 		return cli.errorOut(err)
 	}
-	cfg := config.NewConfig()
+	cfg, err := newDatabaseConfig()
+	if err != nil {
+		return cli.errorOut(err)
+	}
 	if err := insertFixtures(cfg); err != nil {
 		return cli.errorOut(err)
 	}
@@ -421,13 +600,16 @@ func (cli *Client) PrepareTestDatabase(c *clipkg.Context) error {
 // MigrateDatabase migrates the database
 func (cli *Client) MigrateDatabase(c *clipkg.Context) error {
 	logger.SetLogger(cli.Config.CreateProductionLogger())
-	cfg := config.NewConfig()
+	cfg, err := newDatabaseConfig()
+	if err != nil {
+		return cli.errorOut(err)
+	}
 	parsed := cfg.DatabaseURL()
 	if parsed.String() == "" {
 		return cli.errorOut(errors.New("You must set DATABASE_URL env variable. HINT: If you are running this to set up your local test database, try DATABASE_URL=postgresql://postgres@localhost:5432/chainlink_test?sslmode=disable"))
 	}
 
-	logger.Infof("Migrating database: %#v", parsed.String())
+	logger.Infof("Migrating database: %#v", parsed.Redacted())
 	if err := migrateDB(cfg); err != nil {
 		return cli.errorOut(err)
 	}
@@ -437,7 +619,10 @@ func (cli *Client) MigrateDatabase(c *clipkg.Context) error {
 // VersionDatabase displays the current database version.
 func (cli *Client) VersionDatabase(c *clipkg.Context) error {
 	logger.SetLogger(cli.Config.CreateProductionLogger())
-	cfg := config.NewConfig()
+	cfg, err := newDatabaseConfig()
+	if err != nil {
+		return cli.errorOut(err)
+	}
 	parsed := cfg.DatabaseURL()
 	if parsed.String() == "" {
 		return cli.errorOut(errors.New("You must set DATABASE_URL env variable. HINT: If you are running this to set up your local test database, try DATABASE_URL=postgresql://postgres@localhost:5432/chainlink_test?sslmode=disable"))
@@ -457,6 +642,173 @@ func (cli *Client) VersionDatabase(c *clipkg.Context) error {
 	return nil
 }
 
+// newDatabaseConfig returns a new config for the database commands, with its
+// secret references resolved
+func newDatabaseConfig() (*config.Config, error) {
+	cfg := config.NewConfig()
+	return cfg, cfg.ResolveSecrets(context.Background())
+}
+
+// BackupDatabase writes a backup archive of the database to a local file,
+// which can be restored with RestoreDatabase
+func (cli *Client) BackupDatabase(c *clipkg.Context) error {
+	logger.SetLogger(cli.Config.CreateProductionLogger())
+	if err := cli.Config.ResolveSecrets(context.Background()); err != nil {
+		return cli.errorOut(err)
+	}
+	mode, err := config.ParseDatabaseBackupMode(c.String("mode"))
+	if err != nil || mode == config.DatabaseBackupModeNone {
+		return cli.errorOut(errors.Errorf("invalid mode %q, must be %s or %s", c.String("mode"), config.DatabaseBackupModeFull, config.DatabaseBackupModeLite))
+	}
+	path := c.String("file")
+	if path == "" {
+		path = filepath.Join(periodicbackup.OutputDir(cli.Config), fmt.Sprintf("cl_backup_%s_%s.zip", static.Version, time.Now().UTC().Format("20060102T150405Z")))
+	}
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return cli.errorOut(err)
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "failed to create backup file"))
+	}
+	dbURL := cli.Config.DatabaseURL()
+	_, err = periodicbackup.ExportArchive(context.Background(), dbURL.String(), mode, static.Version, f)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		_, err = periodicbackup.VerifyArchive(path)
+	}
+	if err != nil {
+		_ = os.Remove(path)
+		return cli.errorOut(errors.Wrap(err, "backup failed"))
+	}
+	fmt.Printf("Database backed up to %s\n", path)
+	return nil
+}
+
+// RestoreDatabase replaces all data of the database with a backup archive.
+// The node must not be running.
+func (cli *Client) RestoreDatabase(c *clipkg.Context) (err error) {
+	logger.SetLogger(cli.Config.CreateProductionLogger())
+	ctx := context.Background()
+	if err = cli.Config.ResolveSecrets(ctx); err != nil {
+		return cli.errorOut(err)
+	}
+
+	path := c.Args().First()
+	if path == "" && !c.Bool("latest") {
+		return cli.errorOut(errors.New("must pass the path of the backup to restore, or --latest"))
+	}
+	if c.Bool("latest") {
+		if path != "" {
+			return cli.errorOut(errors.New("--latest may not be used with a path"))
+		}
+		var cleanup func()
+		path, cleanup, err = fetchLatestBackup(ctx, cli.Config)
+		if err != nil {
+			return cli.errorOut(err)
+		}
+		defer cleanup()
+	}
+	manifest, err := periodicbackup.VerifyArchive(path)
+	if err != nil {
+		return cli.errorOut(errors.Wrapf(err, "invalid backup %s", path))
+	}
+
+	dbURL := cli.Config.DatabaseURL()
+	orm, err := orm.NewORM(dbURL.String(), cli.Config.DatabaseTimeout(), gracefulpanic.NewSignal(), dialects.PostgresWithoutLock, cli.Config.GetAdvisoryLockIDConfiguredOrDefault(), cli.Config.GlobalLockRetryInterval().Duration(), cli.Config.ORMMaxOpenConns(), cli.Config.ORMMaxIdleConns())
+	if err != nil {
+		return cli.errorOut(fmt.Errorf("failed to initialize orm: %v", err))
+	}
+	defer func() {
+		if cerr := orm.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+	unlock, err := tryAdvisoryLock(orm.DB, cli.Config.GetAdvisoryLockIDConfiguredOrDefault())
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer unlock()
+
+	current, err := migrations.Current(orm.DB)
+	if errors.Cause(err) == migrations.ErrNoRunMigration {
+		// A new database is migrated to the version of the backup
+		if !migrationExists(manifest.Migration) {
+			return cli.errorOut(errors.Errorf("backup was taken at migration %s, which this node does not have", manifest.Migration))
+		}
+		logger.Infof("Migrating database to %v", manifest.Migration)
+		if err = migrations.MigrateUp(orm.DB, manifest.Migration); err != nil {
+			return cli.errorOut(errors.Wrap(err, "failed to migrate database"))
+		}
+	} else if err != nil {
+		return cli.errorOut(err)
+	} else if current.ID != manifest.Migration {
+		return cli.errorOut(errors.Errorf("backup was taken at migration %s by node version %s, but the database is at migration %s. Restore it into a new database, or with node version %s", manifest.Migration, manifest.NodeVersion, current.ID, manifest.NodeVersion))
+	} else if !c.Bool("force") {
+		return cli.errorOut(errors.New("the database has already been set up, pass --force to replace all of its data with the backup"))
+	}
+
+	if _, err = periodicbackup.RestoreArchive(ctx, dbURL.String(), path); err != nil {
+		return cli.errorOut(err)
+	}
+	fmt.Printf("Restored %s backup taken at %s by node version %s\n", manifest.Mode, manifest.CreatedAt.Format(time.RFC3339), manifest.NodeVersion)
+	return nil
+}
+
+// fetchLatestBackup downloads the most recent backup of the configured
+// destination to a temporary file
+func fetchLatestBackup(ctx context.Context, cfg *config.Config) (path string, cleanup func(), err error) {
+	destination, err := periodicbackup.NewDestination(cfg)
+	if err != nil {
+		return "", nil, err
+	}
+	archives, err := destination.List(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	if len(archives) == 0 {
+		return "", nil, errors.New("no backups found")
+	}
+	latest := archives[0]
+	for _, a := range archives[1:] {
+		if a.ModTime.After(latest.ModTime) {
+			latest = a
+		}
+	}
+	logger.Infof("Restoring %s", destination.Location(latest.Name))
+
+	r, err := destination.Open(ctx, latest.Name)
+	if err != nil {
+		return "", nil, err
+	}
+	defer r.Close()
+	f, err := ioutil.TempFile("", "cl_restore_")
+	if err != nil {
+		return "", nil, err
+	}
+	cleanup = func() { _ = os.Remove(f.Name()) }
+	_, err = io.Copy(f, r)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		cleanup()
+		return "", nil, errors.Wrapf(err, "failed to download %s", latest.Name)
+	}
+	return f.Name(), cleanup, nil
+}
+
+func migrationExists(id string) bool {
+	for _, m := range migrations.Migrations {
+		if m.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
 func dropAndCreateDB(parsed url.URL) (err error) {
 	// Cannot drop the database if we are connected to it, so we must connect
 	// to a different one. template1 should be present on all postgres installations
@@ -564,6 +916,9 @@ func (cli *Client) DeleteUser(c *clipkg.Context) (err error) {
 func (cli *Client) SetNextNonce(c *clipkg.Context) error {
 	addressHex := c.String("address")
 	nextNonce := c.Uint64("nextNonce")
+	if err := cli.Config.ResolveSecrets(context.Background()); err != nil {
+		return cli.errorOut(err)
+	}
 	dbURL := cli.Config.DatabaseURL()
 
 	logger.SetLogger(cli.Config.CreateProductionLogger())
@@ -5,15 +5,17 @@ import (
 
 	"github.com/pkg/errors"
 	clipkg "github.com/urfave/cli"
+
+	"github.com/smartcontractkit/chainlink/core/store/config"
 )
 
 // getPassword retrieves the password from the file specified on the CL, or errors
-func getPassword(c *clipkg.Context) ([]byte, error) {
+func getPassword(cfg *config.Config, c *clipkg.Context) ([]byte, error) {
 	if c.String("password") == "" {
 		return nil, fmt.Errorf("must specify password file")
 	}
 
-	rawPassword, err := passwordFromFile(c.String("password"))
+	rawPassword, err := passwordFromFile(cfg, c.String("password"))
 	if err != nil {
 		return nil, errors.Wrapf(err, "could not read password from file %s",
 			c.String("password"))
@@ -2,6 +2,7 @@ package cmd_test
 
 import (
 	"flag"
+	"io/ioutil"
 	"math/big"
 	"os"
 	"path/filepath"
@@ -24,6 +25,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/urfave/cli"
+	"go.uber.org/zap/zapcore"
 )
 
 func TestClient_RunNodeShowsEnv(t *testing.T) {
@@ -527,3 +529,78 @@ func TestClient_SetNextNonce(t *testing.T) {
 	require.NotNil(t, key.NextNonce)
 	require.Equal(t, int64(42), key.NextNonce)
 }
+
+func TestClient_ValidateConfig(t *testing.T) {
+	t.Parallel()
+
+	cfg := cltest.NewTestConfig(t)
+	client := cmd.Client{Config: cfg.Config}
+
+	dir := t.TempDir()
+	valid := filepath.Join(dir, "valid.toml")
+	require.NoError(t, ioutil.WriteFile(valid, []byte(`
+EthGasBumpPercent = 20
+LogLevel = "debug"
+`), 0600))
+
+	set := flag.NewFlagSet("test", 0)
+	set.String("config", valid, "")
+	require.NoError(t, client.ValidateConfig(cli.NewContext(nil, set, nil)))
+	assert.Equal(t, uint16(20), cfg.EthGasBumpPercent())
+
+	invalid := filepath.Join(dir, "invalid.toml")
+	require.NoError(t, ioutil.WriteFile(invalid, []byte(`
+EthGasBumpPercent = "many"
+ETH_URL = "ws://localhost:8546"
+`), 0600))
+
+	set = flag.NewFlagSet("test", 0)
+	set.String("config", invalid, "")
+	client = cmd.Client{Config: cltest.NewTestConfig(t).Config}
+	err := client.ValidateConfig(cli.NewContext(nil, set, nil))
+	assert.EqualError(t, err, "invalid configuration, found 2 error(s)")
+
+	inconsistent := filepath.Join(dir, "inconsistent.toml")
+	require.NoError(t, ioutil.WriteFile(inconsistent, []byte(`
+EthGasBumpPercent = 5
+EthHeadTrackerHistoryDepth = 1
+`), 0600))
+
+	set = flag.NewFlagSet("test", 0)
+	set.String("config", inconsistent, "")
+	client = cmd.Client{Config: cltest.NewTestConfig(t).Config}
+	err = client.ValidateConfig(cli.NewContext(nil, set, nil))
+	assert.EqualError(t, err, "invalid configuration, found 2 error(s)")
+
+	unresolvable := filepath.Join(dir, "unresolvable.toml")
+	require.NoError(t, ioutil.WriteFile(unresolvable, []byte(`
+ExplorerSecret = "env://VALIDATE_CONFIG_TEST_UNSET"
+`), 0600))
+
+	set = flag.NewFlagSet("test", 0)
+	set.String("config", unresolvable, "")
+	client = cmd.Client{Config: cltest.NewTestConfig(t).Config}
+	err = client.ValidateConfig(cli.NewContext(nil, set, nil))
+	assert.EqualError(t, err, "invalid configuration, found 1 error(s)")
+}
+
+func TestClient_ReloadConfigFile_LogLevel(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	path := filepath.Join(t.TempDir(), "config.toml")
+	require.NoError(t, ioutil.WriteFile(path, []byte(`LogLevel = "info"`), 0600))
+	require.NoError(t, store.Config.LoadConfigFile(path))
+
+	defaultLogger := logger.Default
+	defer logger.SetLogger(defaultLogger)
+	logger.SetLogger(store.Config.CreateProductionLogger())
+	require.False(t, logger.Default.Desugar().Core().Enabled(zapcore.DebugLevel))
+
+	require.NoError(t, ioutil.WriteFile(path, []byte(`LogLevel = "debug"`), 0600))
+	cmd.ExportedReloadConfigFile(store.Config, store)
+
+	assert.Equal(t, zapcore.DebugLevel, store.Config.LogLevel().Level)
+	// The default logger is rebuilt with the new level
+	assert.True(t, logger.Default.Desugar().Core().Enabled(zapcore.DebugLevel))
+}
@@ -0,0 +1,158 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/web"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+)
+
+type UserPresenter struct {
+	JAID
+	presenters.UserResource
+}
+
+// RenderTable implements TableRenderer
+func (p *UserPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Email", "Role", "Created"})
+	table.Append(p.ToRow())
+	render("User:", table)
+	return nil
+}
+
+func (p *UserPresenter) ToRow() []string {
+	return []string{
+		p.Email,
+		string(p.Role),
+		p.CreatedAt.String(),
+	}
+}
+
+type UserPresenters []UserPresenter
+
+// RenderTable implements TableRenderer
+func (ps *UserPresenters) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Email", "Role", "Created"})
+	for _, p := range *ps {
+		table.Append(p.ToRow())
+	}
+	render("Users:", table)
+	return nil
+}
+
+// ListAPIUsers lists all API users
+func (cli *Client) ListAPIUsers(c *cli.Context) (err error) {
+	resp, err := cli.HTTP.Get("/v2/users", nil)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &UserPresenters{})
+}
+
+// CreateAPIUser creates a new API user with the given role. The password is
+// read from the --password file, or prompted for if that is not given.
+func (cli *Client) CreateAPIUser(c *cli.Context) (err error) {
+	email := c.String("email")
+	if email == "" {
+		return cli.errorOut(errors.New("Must specify --email flag"))
+	}
+	role, err := models.ParseUserRole(c.String("role"))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	var password string
+	if passwordFile := c.String("password"); passwordFile != "" {
+		b, err := ioutil.ReadFile(passwordFile)
+		if err != nil {
+			return cli.errorOut(errors.Wrap(err, "Could not read password file"))
+		}
+		password = strings.TrimSpace(string(b))
+	} else if cli.PasswordPrompter != nil {
+		password = cli.PasswordPrompter.Prompt()
+	} else {
+		return cli.errorOut(errors.New("Must specify --password flag"))
+	}
+
+	request, err := json.Marshal(web.CreateUserRequest{Email: email, Password: password, Role: role})
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	resp, err := cli.HTTP.Post("/v2/users", bytes.NewReader(request))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &UserPresenter{}, "Created user")
+}
+
+// ChangeAPIUserRole changes the role of an existing API user
+func (cli *Client) ChangeAPIUserRole(c *cli.Context) (err error) {
+	email := c.String("email")
+	if email == "" {
+		return cli.errorOut(errors.New("Must specify --email flag"))
+	}
+	role, err := models.ParseUserRole(c.String("role"))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	request, err := json.Marshal(web.UpdateUserRequest{Role: role})
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	resp, err := cli.HTTP.Patch("/v2/users/"+url.PathEscape(email), bytes.NewReader(request))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &UserPresenter{}, fmt.Sprintf("Changed role of %s", email))
+}
+
+// DeleteAPIUser removes an API user, ending all of their sessions
+func (cli *Client) DeleteAPIUser(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the email of the user to delete"))
+	}
+
+	resp, err := cli.HTTP.Delete("/v2/users/" + url.PathEscape(c.Args().First()))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	if _, err = cli.parseResponse(resp); err != nil {
+		return cli.errorOut(err)
+	}
+	fmt.Printf("Deleted user %s\n", c.Args().First())
+	return nil
+}
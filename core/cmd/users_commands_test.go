@@ -0,0 +1,100 @@
+package cmd_test
+
+import (
+	"bytes"
+	"flag"
+	"testing"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/cmd"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/store/orm"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+)
+
+func TestUserPresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		email     = "editor@chainlink.test"
+		createdAt = time.Now()
+		buffer    = bytes.NewBufferString("")
+		r         = cmd.RendererTable{Writer: buffer}
+	)
+
+	p := cmd.UserPresenter{
+		JAID: cmd.JAID{ID: email},
+		UserResource: presenters.UserResource{
+			JAID:      presenters.NewJAID(email),
+			Email:     email,
+			Role:      models.UserRoleJobEditor,
+			CreatedAt: createdAt,
+		},
+	}
+
+	// Render a single resource
+	require.NoError(t, p.RenderTable(r))
+
+	output := buffer.String()
+	assert.Contains(t, output, email)
+	assert.Contains(t, output, "job_editor")
+	assert.Contains(t, output, createdAt.String())
+
+	// Render many resources
+	buffer.Reset()
+	ps := cmd.UserPresenters{p}
+	require.NoError(t, ps.RenderTable(r))
+
+	output = buffer.String()
+	assert.Contains(t, output, email)
+	assert.Contains(t, output, "job_editor")
+}
+
+func TestClient_APIUsers(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplication(t)
+	client, r := app.NewClientAndRenderer()
+
+	set := flag.NewFlagSet("test", 0)
+	set.String("email", "editor@chainlink.test", "")
+	set.String("role", "job-editor", "")
+	set.String("password", "../internal/fixtures/correct_password.txt", "")
+	require.NoError(t, client.CreateAPIUser(cli.NewContext(nil, set, nil)))
+
+	user, err := app.Store.FindUserByEmail("editor@chainlink.test")
+	require.NoError(t, err)
+	assert.Equal(t, models.UserRoleJobEditor, user.Role)
+
+	r.Renders = nil
+	require.NoError(t, client.ListAPIUsers(cltest.EmptyCLIContext()))
+	require.Len(t, r.Renders, 1)
+	users := *r.Renders[0].(*cmd.UserPresenters)
+	require.Len(t, users, 2)
+	assert.Equal(t, "editor@chainlink.test", users[1].Email)
+
+	set = flag.NewFlagSet("test", 0)
+	set.String("email", "editor@chainlink.test", "")
+	set.String("role", "key-admin", "")
+	require.NoError(t, client.ChangeAPIUserRole(cli.NewContext(nil, set, nil)))
+
+	user, err = app.Store.FindUserByEmail("editor@chainlink.test")
+	require.NoError(t, err)
+	assert.Equal(t, models.UserRoleKeyAdmin, user.Role)
+
+	set = flag.NewFlagSet("test", 0)
+	set.String("email", "editor@chainlink.test", "")
+	set.String("role", "superuser", "")
+	assert.Error(t, client.ChangeAPIUserRole(cli.NewContext(nil, set, nil)))
+
+	set = flag.NewFlagSet("test", 0)
+	require.NoError(t, set.Parse([]string{"editor@chainlink.test"}))
+	require.NoError(t, client.DeleteAPIUser(cli.NewContext(nil, set, nil)))
+
+	_, err = app.Store.FindUserByEmail("editor@chainlink.test")
+	assert.Equal(t, orm.ErrorNotFound, err)
+}
@@ -250,7 +250,7 @@ func (cli *Client) ListVRFKeys(c *cli.Context) error {
 //
 // The key is only stored at the specified file location, not stored in the DB.
 func (cli *Client) CreateAndExportWeakVRFKey(c *cli.Context) error {
-	password, err := getPassword(c)
+	password, err := getPassword(cli.Config, c)
 	if err != nil {
 		return err
 	}
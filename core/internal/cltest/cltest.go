@@ -23,6 +23,7 @@ import (
 
 	uuid "github.com/satori/go.uuid"
 
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
 	"github.com/smartcontractkit/chainlink/core/services/gas"
 	httypes "github.com/smartcontractkit/chainlink/core/services/headtracker/types"
 	"github.com/smartcontractkit/chainlink/core/services/job"
@@ -262,11 +263,11 @@ type JobPipelineV2TestHelper struct {
 	Pr  pipeline.Runner
 }
 
-func NewJobPipelineV2(t testing.TB, tc *TestConfig, db *gorm.DB, ethClient eth.Client, keyStore pipeline.ETHKeyStore, txManager pipeline.TxManager) JobPipelineV2TestHelper {
+func NewJobPipelineV2(t testing.TB, tc *TestConfig, db *gorm.DB, chainSet evm.ChainSet, keyStore pipeline.ETHKeyStore) JobPipelineV2TestHelper {
 	prm, eb, cleanup := NewPipelineORM(t, tc, db)
 	jrm := job.NewORM(db, tc.Config, prm, eb, &postgres.NullAdvisoryLocker{})
 	t.Cleanup(cleanup)
-	pr := pipeline.NewRunner(prm, tc.Config, ethClient, keyStore, nil, txManager)
+	pr := pipeline.NewRunner(prm, tc.Config, chainSet, keyStore, nil)
 	return JobPipelineV2TestHelper{
 		prm,
 		eb,
@@ -590,6 +591,21 @@ func (ta *TestApplication) MustSeedNewSession() string {
 	return session.ID
 }
 
+// NewHTTPClientForUser creates the given user and returns a client with a
+// session for them
+func (ta *TestApplication) NewHTTPClientForUser(user models.User) HTTPClientCleaner {
+	ta.t.Helper()
+
+	require.NoError(ta.t, ta.Store.CreateUser(&user))
+	session := models.NewSession(user.Email)
+	require.NoError(ta.t, ta.Store.DB.Save(&session).Error)
+
+	return HTTPClientCleaner{
+		HTTPClient: NewMockAuthenticatedHTTPClient(ta.Config, session.ID),
+		t:          ta.t,
+	}
+}
+
 // ImportKey adds private key to the application disk keystore, not database.
 func (ta *TestApplication) ImportKey(content string) {
 	_, err := ta.KeyStore.Eth().ImportKey([]byte(content), Password)
@@ -643,7 +659,7 @@ func (ta *TestApplication) NewAuthenticatingClient(prompter cmd.Prompter) *cmd.C
 		Runner:                         EmptyRunner{},
 		HTTP:                           cmd.NewAuthenticatedHTTPClient(ta.Config, cookieAuth, models.SessionRequest{}),
 		CookieAuthenticator:            cookieAuth,
-		FileSessionRequestBuilder:      cmd.NewFileSessionRequestBuilder(),
+		FileSessionRequestBuilder:      cmd.NewFileSessionRequestBuilder(ta.Config.Config),
 		PromptingSessionRequestBuilder: cmd.NewPromptingSessionRequestBuilder(prompter),
 		ChangePasswordPrompter:         &MockChangePasswordPrompter{},
 	}
@@ -1256,8 +1272,9 @@ func MustParseDuration(t testing.TB, durationStr string) time.Duration {
 	return duration
 }
 
+// NewSession returns a new session for the fixture API user
 func NewSession(optionalSessionID ...string) models.Session {
-	session := models.NewSession()
+	session := models.NewSession(APIEmail)
 	if len(optionalSessionID) > 0 {
 		session.ID = optionalSessionID[0]
 	}
@@ -367,7 +367,7 @@ func NewEthTxAttempt(t *testing.T, etxID int64) bulletprooftxmanager.EthTxAttemp
 	gasPrice := utils.NewBig(big.NewInt(1))
 	return bulletprooftxmanager.EthTxAttempt{
 		EthTxID:  etxID,
-		GasPrice: *gasPrice,
+		GasPrice: gasPrice,
 		// Just a random signed raw tx that decodes correctly
 		// Ignore all actual values
 		SignedRawTx: hexutil.MustDecode("0xf889808504a817c8008307a12094000000000000000000000000000000000000000080a400000000000000000000000000000000000000000000000000000000000000000000000025a0838fe165906e2547b9a052c099df08ec891813fea4fcdb3c555362285eb399c5a070db99322490eb8a0f2270be6eca6e3aedbc49ff57ef939cf2774f12d08aa85e"),
@@ -379,7 +379,7 @@ func NewEthTxAttempt(t *testing.T, etxID int64) bulletprooftxmanager.EthTxAttemp
 func MustInsertBroadcastEthTxAttempt(t *testing.T, etxID int64, db *gorm.DB, gasPrice int64) bulletprooftxmanager.EthTxAttempt {
 	attempt := NewEthTxAttempt(t, etxID)
 	attempt.State = bulletprooftxmanager.EthTxAttemptBroadcast
-	attempt.GasPrice = *utils.NewBig(big.NewInt(gasPrice))
+	attempt.GasPrice = utils.NewBig(big.NewInt(gasPrice))
 	require.NoError(t, db.Create(&attempt).Error)
 	return attempt
 }
@@ -588,6 +588,7 @@ func MustInsertKeeperRegistry(t *testing.T, store *strpkg.Store, ethKeyStore *ke
 		JobID:             job.ID,
 		KeeperIndex:       0,
 		NumKeepers:        1,
+		Version:           keeper.RegistryVersion_1_0,
 	}
 	err := store.DB.Create(&registry).Error
 	require.NoError(t, err)
@@ -386,7 +386,7 @@ func (ns NeverSleeper) Duration() time.Duration { return 0 * time.Microsecond }
 
 func MustRandomUser() models.User {
 	email := fmt.Sprintf("user-%v@chainlink.test", NewRandomInt64())
-	r, err := models.NewUser(email, Password)
+	r, err := models.NewUser(email, Password, models.UserRoleOwner)
 	if err != nil {
 		logger.Panic(err)
 	}
@@ -394,7 +394,11 @@ func MustRandomUser() models.User {
 }
 
 func MustNewUser(t *testing.T, email, password string) models.User {
-	r, err := models.NewUser(email, password)
+	return MustNewUserWithRole(t, email, password, models.UserRoleOwner)
+}
+
+func MustNewUserWithRole(t *testing.T, email, password string, role models.UserRole) models.User {
+	r, err := models.NewUser(email, password, role)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -134,15 +134,19 @@ func generateCode(fset *token.FileSet, fileNode *ast.File) []byte {
 }
 
 func getContractName(fileNode *ast.File) string {
-	// Grab the contract name. It's always the first type in the file.
+	// Grab the contract name from the <contractName>ABI constant. Structs used
+	// as contract function arguments are declared before the contract type, so
+	// the first type in the file is not necessarily the contract.
 	var contractName string
 	astutil.Apply(fileNode, func(cursor *astutil.Cursor) bool {
-		x, is := cursor.Node().(*ast.TypeSpec)
+		x, is := cursor.Node().(*ast.ValueSpec)
 		if !is {
 			return true
 		}
-		if contractName == "" {
-			contractName = x.Name.Name
+		for _, name := range x.Names {
+			if contractName == "" && strings.HasSuffix(name.Name, "ABI") {
+				contractName = strings.TrimSuffix(name.Name, "ABI")
+			}
 		}
 		return false
 	}, nil)
@@ -3,12 +3,16 @@
 package mocks
 
 import (
+	audit "github.com/smartcontractkit/chainlink/core/services/audit"
+
 	context "context"
 
 	config "github.com/smartcontractkit/chainlink/core/store/config"
 
 	eth "github.com/smartcontractkit/chainlink/core/services/eth"
 
+	evm "github.com/smartcontractkit/chainlink/core/chains/evm"
+
 	feeds "github.com/smartcontractkit/chainlink/core/services/feeds"
 
 	health "github.com/smartcontractkit/chainlink/core/services/health"
@@ -78,6 +82,38 @@ func (_m *Application) DeleteJob(ctx context.Context, jobID int32) error {
 	return r0
 }
 
+// GetAuditLogger provides a mock function with given fields:
+func (_m *Application) GetAuditLogger() audit.Logger {
+	ret := _m.Called()
+
+	var r0 audit.Logger
+	if rf, ok := ret.Get(0).(func() audit.Logger); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(audit.Logger)
+		}
+	}
+
+	return r0
+}
+
+// GetChainSet provides a mock function with given fields:
+func (_m *Application) GetChainSet() evm.ChainSet {
+	ret := _m.Called()
+
+	var r0 evm.ChainSet
+	if rf, ok := ret.Get(0).(func() evm.ChainSet); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(evm.ChainSet)
+		}
+	}
+
+	return r0
+}
+
 // GetConfig provides a mock function with given fields:
 func (_m *Application) GetConfig() *config.Config {
 	ret := _m.Called()
@@ -375,6 +411,36 @@ func (_m *Application) SetServiceLogger(ctx context.Context, service string, lev
 	return r0
 }
 
+// SimulateJobV2 provides a mock function with given fields: ctx, jb, vars
+func (_m *Application) SimulateJobV2(ctx context.Context, jb job.Job, vars map[string]interface{}) (pipeline.Run, *pipeline.Simulation, error) {
+	ret := _m.Called(ctx, jb, vars)
+
+	var r0 pipeline.Run
+	if rf, ok := ret.Get(0).(func(context.Context, job.Job, map[string]interface{}) pipeline.Run); ok {
+		r0 = rf(ctx, jb, vars)
+	} else {
+		r0 = ret.Get(0).(pipeline.Run)
+	}
+
+	var r1 *pipeline.Simulation
+	if rf, ok := ret.Get(1).(func(context.Context, job.Job, map[string]interface{}) *pipeline.Simulation); ok {
+		r1 = rf(ctx, jb, vars)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*pipeline.Simulation)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, job.Job, map[string]interface{}) error); ok {
+		r2 = rf(ctx, jb, vars)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // Start provides a mock function with given fields:
 func (_m *Application) Start() error {
 	ret := _m.Called()
@@ -403,6 +469,27 @@ func (_m *Application) Stop() error {
 	return r0
 }
 
+// UpdateJob provides a mock function with given fields: ctx, jobID, _a2
+func (_m *Application) UpdateJob(ctx context.Context, jobID int32, _a2 job.Job) (job.Job, error) {
+	ret := _m.Called(ctx, jobID, _a2)
+
+	var r0 job.Job
+	if rf, ok := ret.Get(0).(func(context.Context, int32, job.Job) job.Job); ok {
+		r0 = rf(ctx, jobID, _a2)
+	} else {
+		r0 = ret.Get(0).(job.Job)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int32, job.Job) error); ok {
+		r1 = rf(ctx, jobID, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // WakeSessionReaper provides a mock function with given fields:
 func (_m *Application) WakeSessionReaper() {
 	_m.Called()
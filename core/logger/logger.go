@@ -23,6 +23,8 @@ type Logger struct {
 	dir         string
 	jsonConsole bool
 	toDisk      bool
+	// redact is set by WithRedaction
+	redact func(string) string
 }
 
 // Constants for service names for package specific logging configuration
@@ -161,7 +163,11 @@ func (l *Logger) InitServiceLevelLogger(serviceName string, logLevel string) (*L
 		return nil, err
 	}
 
-	return CreateLoggerWithConfig(zl.Named(serviceName).Sugar(), ll, l.dir, l.jsonConsole, l.toDisk), nil
+	serviceLogger := CreateLoggerWithConfig(zl.Named(serviceName).Sugar(), ll, l.dir, l.jsonConsole, l.toDisk)
+	if l.redact != nil {
+		serviceLogger = serviceLogger.WithRedaction(l.redact)
+	}
+	return serviceLogger, nil
 }
 
 // ServiceLogLevel is the log level set for a specified package
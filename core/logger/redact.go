@@ -0,0 +1,56 @@
+package logger
+
+import (
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// WithRedaction returns a copy of the logger that applies redact to the
+// message and to the string and error fields of every entry, so that secrets
+// are never logged
+func (l *Logger) WithRedaction(redact func(string) string) *Logger {
+	redacted := *l
+	redacted.redact = redact
+	redacted.SugaredLogger = l.Desugar().WithOptions(zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+		return redactCore{core, redact}
+	})).Sugar()
+	return &redacted
+}
+
+// redactCore is a zapcore.Core that redacts entries before writing them
+type redactCore struct {
+	zapcore.Core
+	redact func(string) string
+}
+
+func (c redactCore) With(fields []zapcore.Field) zapcore.Core {
+	return redactCore{c.Core.With(c.redactFields(fields)), c.redact}
+}
+
+func (c redactCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(ent.Level) {
+		return ce.AddCore(ent, c)
+	}
+	return ce
+}
+
+func (c redactCore) Write(ent zapcore.Entry, fields []zapcore.Field) error {
+	ent.Message = c.redact(ent.Message)
+	return c.Core.Write(ent, c.redactFields(fields))
+}
+
+func (c redactCore) redactFields(fields []zapcore.Field) []zapcore.Field {
+	redacted := make([]zapcore.Field, len(fields))
+	for i, field := range fields {
+		switch field.Type {
+		case zapcore.StringType:
+			field.String = c.redact(field.String)
+		case zapcore.ErrorType:
+			if err, ok := field.Interface.(error); ok && err != nil {
+				field = zap.String(field.Key, c.redact(err.Error()))
+			}
+		}
+		redacted[i] = field
+	}
+	return redacted
+}
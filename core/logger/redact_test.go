@@ -0,0 +1,36 @@
+package logger_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+func TestLogger_WithRedaction(t *testing.T) {
+	t.Parallel()
+
+	core, logs := observer.New(zapcore.DebugLevel)
+	lggr := logger.CreateLogger(zap.New(core).Sugar()).WithRedaction(func(s string) string {
+		return strings.ReplaceAll(s, "s3cret", "xxxxx")
+	})
+
+	lggr.With("url", "https://s3cret@example.com").Infow("connecting with s3cret", "token", "s3cret", "err", errors.New("bad token s3cret"), "count", 3)
+
+	entries := logs.All()
+	require.Len(t, entries, 1)
+	assert.Equal(t, "connecting with xxxxx", entries[0].Message)
+	assert.Equal(t, map[string]interface{}{
+		"url":   "https://xxxxx@example.com",
+		"token": "xxxxx",
+		"err":   "bad token xxxxx",
+		"count": int64(3),
+	}, entries[0].ContextMap())
+}
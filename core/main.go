@@ -27,7 +27,7 @@ func NewProductionClient() *cmd.Client {
 	prompter := cmd.NewTerminalPrompter()
 	cookieAuth := cmd.NewSessionCookieAuthenticator(cfg, cmd.DiskCookieStore{Config: cfg})
 	sr := models.SessionRequest{}
-	sessionRequestBuilder := cmd.NewFileSessionRequestBuilder()
+	sessionRequestBuilder := cmd.NewFileSessionRequestBuilder(cfg)
 	if credentialsFile := cfg.AdminCredentialsFile(); credentialsFile != "" {
 		var err error
 		sr, err = sessionRequestBuilder.Build(credentialsFile)
@@ -0,0 +1,189 @@
+package audit
+
+import (
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/service"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"gopkg.in/guregu/null.v4"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// EventType identifies the kind of operator action that was audited
+type EventType string
+
+const (
+	EventSessionLogin       EventType = "session_login"
+	EventSessionLoginFailed EventType = "session_login_failed"
+	EventSessionLogout      EventType = "session_logout"
+
+	EventPasswordUpdated EventType = "password_updated"
+	EventAPITokenCreated EventType = "api_token_created"
+	EventAPITokenDeleted EventType = "api_token_deleted"
+	EventUserCreated     EventType = "user_created"
+	EventUserRoleUpdated EventType = "user_role_updated"
+	EventUserDeleted     EventType = "user_deleted"
+
+	EventKeyCreated  EventType = "key_created"
+	EventKeyImported EventType = "key_imported"
+	EventKeyExported EventType = "key_exported"
+	EventKeyDeleted  EventType = "key_deleted"
+	EventKeyUpdated  EventType = "key_updated"
+
+	EventJobCreated             EventType = "job_created"
+	EventJobUpdated             EventType = "job_updated"
+	EventJobDeleted             EventType = "job_deleted"
+	EventJobProposalApproved    EventType = "job_proposal_approved"
+	EventJobProposalRejected    EventType = "job_proposal_rejected"
+	EventJobProposalSpecUpdated EventType = "job_proposal_spec_updated"
+
+	EventBridgeCreated            EventType = "bridge_created"
+	EventBridgeUpdated            EventType = "bridge_updated"
+	EventBridgeDeleted            EventType = "bridge_deleted"
+	EventExternalInitiatorCreated EventType = "external_initiator_created"
+	EventExternalInitiatorDeleted EventType = "external_initiator_deleted"
+
+	EventChainCreated EventType = "chain_created"
+	EventChainUpdated EventType = "chain_updated"
+	EventChainDeleted EventType = "chain_deleted"
+
+	EventConfigUpdated    EventType = "config_updated"
+	EventLogConfigUpdated EventType = "log_config_updated"
+	EventFundsTransferred EventType = "funds_transferred"
+)
+
+// Event is a single audited action. UserEmail is null if the action was not
+// taken by an authenticated user, e.g. a failed login.
+type Event struct {
+	ID        int64          `json:"id"`
+	Type      EventType      `json:"type"`
+	UserEmail null.String    `json:"userEmail"`
+	RemoteIP  string         `json:"remoteIP"`
+	Data      datatypes.JSON `json:"data"`
+	CreatedAt time.Time      `json:"createdAt"`
+}
+
+// TableName sets the table name of Event
+func (Event) TableName() string {
+	return "audit_logs"
+}
+
+// Logger records sensitive operator actions
+type Logger interface {
+	service.Service
+
+	// Record saves the event. Failing to record an event must not prevent
+	// the action itself, so errors are logged rather than returned.
+	Record(event Event)
+	// Events returns a page of events, most recent first, along with the
+	// total count
+	Events(offset, limit int) ([]Event, int, error)
+}
+
+type auditLogger struct {
+	db       *gorm.DB
+	filePath string
+
+	fileMu sync.Mutex
+	file   *os.File
+
+	utils.StartStopOnce
+}
+
+var _ Logger = (*auditLogger)(nil)
+
+// NewLogger returns a Logger that saves events to the audit_logs table and, if
+// filePath is not empty, appends them to that file as JSON lines
+func NewLogger(db *gorm.DB, filePath string) Logger {
+	return &auditLogger{db: db, filePath: filePath}
+}
+
+// NewData marshals data into the JSON stored with an event. Data is expected
+// to be a map or struct of simple values, so errors are logged and an empty
+// object is returned rather than failing.
+func NewData(data interface{}) datatypes.JSON {
+	b, err := json.Marshal(data)
+	if err != nil {
+		logger.Errorw("AuditLogger: failed to marshal event data", "err", err)
+		return datatypes.JSON("{}")
+	}
+	return datatypes.JSON(b)
+}
+
+func (l *auditLogger) Start() error {
+	return l.StartOnce("AuditLogger", func() error {
+		if l.filePath == "" {
+			return nil
+		}
+		f, err := os.OpenFile(l.filePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+		if err != nil {
+			return errors.Wrapf(err, "failed to open audit log file %s", l.filePath)
+		}
+		l.fileMu.Lock()
+		defer l.fileMu.Unlock()
+		l.file = f
+		return nil
+	})
+}
+
+func (l *auditLogger) Close() error {
+	return l.StopOnce("AuditLogger", func() error {
+		l.fileMu.Lock()
+		defer l.fileMu.Unlock()
+		if l.file == nil {
+			return nil
+		}
+		err := l.file.Close()
+		l.file = nil
+		return err
+	})
+}
+
+func (l *auditLogger) Record(event Event) {
+	if event.CreatedAt.IsZero() {
+		event.CreatedAt = time.Now()
+	}
+	if len(event.Data) == 0 {
+		event.Data = datatypes.JSON("{}")
+	}
+
+	if err := l.db.Create(&event).Error; err != nil {
+		logger.Errorw("AuditLogger: failed to save event", "err", err, "type", event.Type, "userEmail", event.UserEmail)
+	}
+	if err := l.writeFile(event); err != nil {
+		logger.Errorw("AuditLogger: failed to write event to file", "err", err, "type", event.Type, "file", l.filePath)
+	}
+}
+
+func (l *auditLogger) writeFile(event Event) error {
+	l.fileMu.Lock()
+	defer l.fileMu.Unlock()
+	if l.file == nil {
+		return nil
+	}
+	b, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	_, err = l.file.Write(append(b, '\n'))
+	return err
+}
+
+func (l *auditLogger) Events(offset, limit int) (events []Event, count int, err error) {
+	var count64 int64
+	if err = l.db.Model(&Event{}).Count(&count64).Error; err != nil {
+		return nil, 0, err
+	}
+	err = l.db.
+		Order("created_at desc, id desc").
+		Offset(offset).
+		Limit(limit).
+		Find(&events).Error
+	return events, int(count64), err
+}
@@ -0,0 +1,55 @@
+package audit_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/services/audit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+)
+
+func TestLogger_Record(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	t.Cleanup(cleanup)
+
+	filePath := filepath.Join(t.TempDir(), "audit.log")
+	logger := audit.NewLogger(store.DB, filePath)
+	require.NoError(t, logger.Start())
+
+	logger.Record(audit.Event{
+		Type:      audit.EventKeyExported,
+		UserEmail: null.StringFrom(cltest.APIEmail),
+		RemoteIP:  "127.0.0.1",
+		Data:      audit.NewData(map[string]interface{}{"type": "eth", "id": "0x0"}),
+	})
+	logger.Record(audit.Event{Type: audit.EventSessionLoginFailed})
+	require.NoError(t, logger.Close())
+
+	events, count, err := logger.Events(0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	require.Len(t, events, 2)
+	assert.Equal(t, audit.EventSessionLoginFailed, events[0].Type)
+	assert.False(t, events[0].UserEmail.Valid)
+	assert.JSONEq(t, `{}`, string(events[0].Data))
+	assert.Equal(t, audit.EventKeyExported, events[1].Type)
+	assert.Equal(t, cltest.APIEmail, events[1].UserEmail.String)
+	assert.JSONEq(t, `{"type": "eth", "id": "0x0"}`, string(events[1].Data))
+
+	b, err := ioutil.ReadFile(filePath)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(b)), "\n")
+	require.Len(t, lines, 2)
+	var event audit.Event
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &event))
+	assert.Equal(t, audit.EventKeyExported, event.Type)
+	assert.Equal(t, "127.0.0.1", event.RemoteIP)
+}
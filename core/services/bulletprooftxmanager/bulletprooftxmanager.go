@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"net/url"
 	"sync"
 	"time"
 
@@ -23,7 +24,9 @@ import (
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	gethTypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/pkg/errors"
 	"gorm.io/gorm"
@@ -38,6 +41,7 @@ type Config interface {
 	BlockHistoryEstimatorBlockHistorySize() uint16
 	BlockHistoryEstimatorTransactionPercentile() uint16
 	ChainID() *big.Int
+	EthEIP1559DynamicFees() bool
 	EthFinalityDepth() uint
 	EthGasBumpPercent() uint16
 	EthGasBumpThreshold() uint64
@@ -46,6 +50,8 @@ type Config interface {
 	EthGasLimitDefault() uint64
 	EthGasLimitMultiplier() float32
 	EthGasPriceDefault() *big.Int
+	EthGasTipCapDefault() *big.Int
+	EthGasTipCapMinimum() *big.Int
 	EthMaxGasPriceWei() *big.Int
 	EthMaxInFlightTransactions() uint32
 	EthMaxQueuedTransactions() uint64
@@ -55,7 +61,12 @@ type Config interface {
 	EthTxReaperInterval() time.Duration
 	EthTxReaperThreshold() time.Duration
 	EthTxResendAfterThreshold() time.Duration
+	EVMChainIDScope() *big.Int
 	GasEstimatorMode() string
+	GasOracleAggregation() string
+	GasOraclePollPeriod() time.Duration
+	GasOraclePriceUnit() string
+	GasOracleURLs() []url.URL
 	TriggerFallbackDBPollInterval() time.Duration
 }
 
@@ -74,6 +85,10 @@ var (
 		Name: "tx_manager_num_tx_reverted",
 		Help: "Number of times a transaction reverted on-chain",
 	})
+	promSimulationRevertedTxCount = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "tx_manager_num_tx_simulation_reverted",
+		Help: "Number of times a transaction was not sent because its simulation reverted",
+	})
 )
 
 var _ TxManager = &BulletproofTxManager{}
@@ -97,6 +112,7 @@ type BulletproofTxManager struct {
 	advisoryLocker   postgres.AdvisoryLocker
 	eventBroadcaster postgres.EventBroadcaster
 	gasEstimator     gas.Estimator
+	chainScope       chainScope
 
 	chHeads chan models.Head
 	trigger chan common.Address
@@ -117,6 +133,7 @@ func NewBulletproofTxManager(db *gorm.DB, ethClient eth.Client, config Config, k
 		keyStore:         keyStore,
 		advisoryLocker:   advisoryLocker,
 		eventBroadcaster: eventBroadcaster,
+		chainScope:       newChainScope(config),
 		chHeads:          make(chan models.Head),
 		trigger:          make(chan common.Address),
 		chStop:           make(chan struct{}),
@@ -255,7 +272,7 @@ func (b *BulletproofTxManager) Trigger(addr common.Address) {
 
 // CreateEthTransaction inserts a new transaction
 func (b *BulletproofTxManager) CreateEthTransaction(db *gorm.DB, fromAddress, toAddress common.Address, payload []byte, gasLimit uint64, meta interface{}, strategy TxStrategy) (etx EthTx, err error) {
-	err = CheckEthTxQueueCapacity(db, fromAddress, b.config.EthMaxQueuedTransactions())
+	err = checkEthTxQueueCapacity(db, fromAddress, b.config.EthMaxQueuedTransactions(), b.chainScope)
 	if err != nil {
 		return etx, errors.Wrap(err, "BulletproofTxManager#CreateEthTransaction")
 	}
@@ -272,12 +289,12 @@ func (b *BulletproofTxManager) CreateEthTransaction(db *gorm.DB, fromAddress, to
 	value := 0
 	err = postgres.GormTransactionWithDefaultContext(db, func(tx *gorm.DB) error {
 		res := tx.Raw(`
-INSERT INTO eth_txes (from_address, to_address, encoded_payload, value, gas_limit, state, created_at, meta, subject)
+INSERT INTO eth_txes (from_address, to_address, encoded_payload, value, gas_limit, state, created_at, meta, subject, evm_chain_id, simulate, job_id)
 VALUES (
-?,?,?,?,?,'unstarted',NOW(),?,?
+?,?,?,?,?,'unstarted',NOW(),?,?,?,?,?
 )
 RETURNING "eth_txes".*
-`, fromAddress, toAddress, payload, value, gasLimit, metaBytes, strategy.Subject()).Scan(&etx)
+`, fromAddress, toAddress, payload, value, gasLimit, metaBytes, strategy.Subject(), b.chainScope.arg(), strategy.Simulate(), jobIDFromMeta(meta)).Scan(&etx)
 		err = res.Error
 		if err != nil {
 			return errors.Wrap(err, "BulletproofTxManager#CreateEthTransaction failed to insert eth_tx")
@@ -295,6 +312,24 @@ RETURNING "eth_txes".*
 	return
 }
 
+// jobIDFromMeta returns the JobID of a transaction's metadata, or nil if it
+// was not sent by a job
+func jobIDFromMeta(meta interface{}) *int32 {
+	var jobID int32
+	switch m := meta.(type) {
+	case *models.EthTxMetaV2:
+		if m != nil {
+			jobID = m.JobID
+		}
+	case models.EthTxMetaV2:
+		jobID = m.JobID
+	}
+	if jobID == 0 {
+		return nil
+	}
+	return &jobID
+}
+
 // GetGasEstimator returns the gas estimator, mostly useful for tests
 func (b *BulletproofTxManager) GetGasEstimator() gas.Estimator {
 	return b.gasEstimator
@@ -317,7 +352,48 @@ func SendEther(db *gorm.DB, from, to common.Address, value assets.Eth, gasLimit
 	return etx, err
 }
 
-func newAttempt(ethClient eth.Client, ks KeyStore, chainID *big.Int, etx EthTx, gasPrice *big.Int, gasLimit uint64) (EthTxAttempt, error) {
+func newAttempt(ks KeyStore, config Config, etx EthTx, estimator gas.Estimator, opts ...gas.Opt) (attempt EthTxAttempt, err error) {
+	if config.EthEIP1559DynamicFees() {
+		fee, gasLimit, err := estimator.GetDynamicFee(etx.GasLimit)
+		if err != nil {
+			return attempt, errors.Wrap(err, "failed to get dynamic gas fee")
+		}
+		return newDynamicFeeAttempt(ks, config.ChainID(), etx, fee, gasLimit)
+	}
+	var gasPrice *big.Int
+	var gasLimit uint64
+	if ce, is := estimator.(gas.CallEstimator); is {
+		call := ethereum.CallMsg{From: etx.FromAddress, To: &etx.ToAddress, Value: etx.Value.ToInt(), Data: etx.EncodedPayload}
+		gasPrice, gasLimit, err = ce.EstimateCallGas(call, etx.GasLimit, opts...)
+	} else {
+		gasPrice, gasLimit, err = estimator.EstimateGas(etx.EncodedPayload, etx.GasLimit, opts...)
+	}
+	if err != nil {
+		return attempt, errors.Wrap(err, "failed to estimate gas")
+	}
+	return newLegacyAttempt(ks, config.ChainID(), etx, gasPrice, gasLimit)
+}
+
+// newBumpedAttempt returns a new attempt for the given eth_tx with gas bumped
+// from the previous attempt. The type of the new attempt always matches the
+// type of the previous attempt, since a replacement transaction must be of
+// the same type in order to be accepted by the eth node.
+func newBumpedAttempt(ks KeyStore, config Config, etx EthTx, previousAttempt EthTxAttempt, estimator gas.Estimator) (attempt EthTxAttempt, err error) {
+	if previousAttempt.IsDynamicFee() {
+		bumpedFee, bumpedGasLimit, err := estimator.BumpDynamicFee(previousAttempt.DynamicFee(), etx.GasLimit)
+		if err != nil {
+			return attempt, errors.Wrap(err, "failed to bump dynamic fee")
+		}
+		return newDynamicFeeAttempt(ks, config.ChainID(), etx, bumpedFee, bumpedGasLimit)
+	}
+	bumpedGasPrice, bumpedGasLimit, err := estimator.BumpGas(previousAttempt.GasPrice.ToInt(), etx.GasLimit)
+	if err != nil {
+		return attempt, errors.Wrap(err, "failed to bump gas")
+	}
+	return newLegacyAttempt(ks, config.ChainID(), etx, bumpedGasPrice, bumpedGasLimit)
+}
+
+func newLegacyAttempt(ks KeyStore, chainID *big.Int, etx EthTx, gasPrice *big.Int, gasLimit uint64) (EthTxAttempt, error) {
 	attempt := EthTxAttempt{}
 
 	tx := newLegacyTransaction(
@@ -338,8 +414,46 @@ func newAttempt(ethClient eth.Client, ks KeyStore, chainID *big.Int, etx EthTx,
 	attempt.State = EthTxAttemptInProgress
 	attempt.SignedRawTx = signedTxBytes
 	attempt.EthTxID = etx.ID
-	attempt.GasPrice = *utils.NewBig(gasPrice)
+	attempt.GasPrice = utils.NewBig(gasPrice)
+	attempt.ChainSpecificGasLimit = gasLimit
 	attempt.Hash = hash
+	attempt.TxType = int(gas.LegacyTxType)
+
+	return attempt, nil
+}
+
+func newDynamicFeeAttempt(ks KeyStore, chainID *big.Int, etx EthTx, fee gas.DynamicFee, gasLimit uint64) (EthTxAttempt, error) {
+	attempt := EthTxAttempt{}
+
+	if fee.TipCap.Cmp(fee.FeeCap) > 0 {
+		return attempt, errors.Errorf("cannot create dynamic fee attempt for transaction %v: tip cap of %s exceeds fee cap of %s", etx.ID, fee.TipCap.String(), fee.FeeCap.String())
+	}
+
+	tx := newDynamicFeeTransaction(
+		uint64(*etx.Nonce),
+		etx.ToAddress,
+		etx.Value.ToInt(),
+		gasLimit,
+		chainID,
+		fee.TipCap,
+		fee.FeeCap,
+		etx.EncodedPayload,
+	)
+
+	transaction := gethTypes.NewTx(&tx)
+	hash, signedTxBytes, err := signTx(ks, etx.FromAddress, transaction, chainID)
+	if err != nil {
+		return attempt, errors.Wrapf(err, "error using account %s to sign transaction %v", etx.FromAddress.String(), etx.ID)
+	}
+
+	attempt.State = EthTxAttemptInProgress
+	attempt.SignedRawTx = signedTxBytes
+	attempt.EthTxID = etx.ID
+	attempt.GasTipCap = utils.NewBig(fee.TipCap)
+	attempt.GasFeeCap = utils.NewBig(fee.FeeCap)
+	attempt.ChainSpecificGasLimit = gasLimit
+	attempt.Hash = hash
+	attempt.TxType = int(gas.DynamicFeeTxType)
 
 	return attempt, nil
 }
@@ -355,6 +469,19 @@ func newLegacyTransaction(nonce uint64, to common.Address, value *big.Int, gasLi
 	}
 }
 
+func newDynamicFeeTransaction(nonce uint64, to common.Address, value *big.Int, gasLimit uint64, chainID, gasTipCap, gasFeeCap *big.Int, data []byte) gethTypes.DynamicFeeTx {
+	return gethTypes.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     nonce,
+		GasTipCap: gasTipCap,
+		GasFeeCap: gasFeeCap,
+		Gas:       gasLimit,
+		To:        &to,
+		Value:     value,
+		Data:      data,
+	}
+}
+
 func signTx(keyStore KeyStore, address common.Address, tx *gethTypes.Transaction, chainID *big.Int) (common.Hash, []byte, error) {
 	signedTx, err := keyStore.SignTx(address, tx, chainID)
 	if err != nil {
@@ -382,7 +509,7 @@ func sendTransaction(ctx context.Context, ethClient eth.Client, a EthTxAttempt,
 	err = ethClient.SendTransaction(ctx, signedTx)
 	err = errors.WithStack(err)
 
-	logger.Debugw("BulletproofTxManager: Sent transaction", "ethTxAttemptID", a.ID, "txHash", signedTx.Hash(), "gasPriceWei", a.GasPrice.ToInt().Int64(), "err", err, "meta", e.Meta, "gasLimit", e.GasLimit)
+	logger.Debugw("BulletproofTxManager: Sent transaction", "ethTxAttemptID", a.ID, "txHash", signedTx.Hash(), "gasPriceWei", a.GasPriceString(), "txType", a.TxType, "err", err, "meta", e.Meta, "gasLimit", e.GasLimit)
 	sendErr := eth.NewSendError(err)
 	if sendErr.IsTransactionAlreadyInMempool() {
 		logger.Debugw("transaction already in mempool", "txHash", signedTx.Hash(), "nodeErr", sendErr.Error())
@@ -391,6 +518,24 @@ func sendTransaction(ctx context.Context, ethClient eth.Client, a EthTxAttempt,
 	return eth.NewSendError(err)
 }
 
+// simulateTransaction runs the transaction as an eth_call against the pending
+// block, returning the error from the eth node if the call failed
+func simulateTransaction(ctx context.Context, ethClient eth.Client, e EthTx) error {
+	ctx, cancel := eth.DefaultQueryCtx(ctx)
+	defer cancel()
+
+	callArg := map[string]interface{}{
+		"from":  e.FromAddress,
+		"to":    e.ToAddress,
+		"gas":   hexutil.Uint64(e.GasLimit),
+		"value": (*hexutil.Big)(e.Value.ToInt()),
+		"data":  hexutil.Bytes(e.EncodedPayload),
+	}
+	var result hexutil.Bytes
+	err := ethClient.CallContext(ctx, &result, "eth_call", callArg, "pending")
+	return errors.WithStack(err)
+}
+
 // sendEmptyTransaction sends a transaction with 0 Eth and an empty payload to the burn address
 // May be useful for clearing stuck nonces
 func sendEmptyTransaction(
@@ -438,30 +583,36 @@ func saveReplacementInProgressAttempt(db *gorm.DB, oldAttempt EthTxAttempt, repl
 }
 
 // CountUnconfirmedTransactions returns the number of unconfirmed transactions
+// on the node's default chain
 func CountUnconfirmedTransactions(db *gorm.DB, fromAddress common.Address) (count uint32, err error) {
-	return countTransactionsWithState(db, fromAddress, EthTxUnconfirmed)
+	return countTransactionsWithState(db, fromAddress, EthTxUnconfirmed, chainScope{})
 }
 
 // CountUnstartedTransactions returns the number of unconfirmed transactions
+// on the node's default chain
 func CountUnstartedTransactions(db *gorm.DB, fromAddress common.Address) (count uint32, err error) {
-	return countTransactionsWithState(db, fromAddress, EthTxUnstarted)
+	return countTransactionsWithState(db, fromAddress, EthTxUnstarted, chainScope{})
 }
 
-func countTransactionsWithState(db *gorm.DB, fromAddress common.Address, state EthTxState) (count uint32, err error) {
+func countTransactionsWithState(db *gorm.DB, fromAddress common.Address, state EthTxState, scope chainScope) (count uint32, err error) {
 	ctx, cancel := postgres.DefaultQueryCtx()
 	defer cancel()
-	err = db.WithContext(ctx).Raw(`SELECT count(*) FROM eth_txes WHERE from_address = ? AND state = ?`, fromAddress, state).Scan(&count).Error
+	err = db.WithContext(ctx).Raw(`SELECT count(*) FROM eth_txes WHERE from_address = ? AND state = ? AND evm_chain_id IS NOT DISTINCT FROM ?`, fromAddress, state, scope.arg()).Scan(&count).Error
 	return
 }
 
 // CheckEthTxQueueCapacity returns an error if inserting this transaction would
-// exceed the maximum queue size.
+// exceed the maximum queue size of the node's default chain.
 func CheckEthTxQueueCapacity(db *gorm.DB, fromAddress common.Address, maxQueuedTransactions uint64) (err error) {
+	return checkEthTxQueueCapacity(db, fromAddress, maxQueuedTransactions, chainScope{})
+}
+
+func checkEthTxQueueCapacity(db *gorm.DB, fromAddress common.Address, maxQueuedTransactions uint64, scope chainScope) (err error) {
 	if maxQueuedTransactions == 0 {
 		return nil
 	}
 	var count uint64
-	err = db.Raw(`SELECT count(*) FROM eth_txes WHERE from_address = ? AND state = 'unstarted'`, fromAddress).Scan(&count).Error
+	err = db.Raw(`SELECT count(*) FROM eth_txes WHERE from_address = ? AND state = 'unstarted' AND evm_chain_id IS NOT DISTINCT FROM ?`, fromAddress, scope.arg()).Scan(&count).Error
 	if err != nil {
 		err = errors.Wrap(err, "bulletprooftxmanager.CheckEthTxQueueCapacity query failed")
 		return
@@ -2,16 +2,21 @@ package bulletprooftxmanager_test
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"math/big"
 	"testing"
 	"time"
 
+	gethCommon "github.com/ethereum/go-ethereum/common"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
 	uuid "github.com/satori/go.uuid"
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
 	bptxmmocks "github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager/mocks"
+	"github.com/smartcontractkit/chainlink/core/services/gas"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	ksmocks "github.com/smartcontractkit/chainlink/core/services/keystore/mocks"
 	"github.com/smartcontractkit/chainlink/core/services/postgres"
@@ -174,6 +179,7 @@ func TestBulletproofTxManager_CreateEthTransaction(t *testing.T) {
 	config.On("EthTxResendAfterThreshold").Return(time.Duration(0))
 	config.On("EthTxReaperThreshold").Return(time.Duration(0))
 	config.On("GasEstimatorMode").Return("FixedPrice")
+	config.On("EVMChainIDScope").Return(nil)
 
 	bptxm := bulletprooftxmanager.NewBulletproofTxManager(db, nil, config, nil, nil, nil)
 
@@ -182,6 +188,7 @@ func TestBulletproofTxManager_CreateEthTransaction(t *testing.T) {
 		strategy := new(bptxmmocks.TxStrategy)
 		strategy.On("Subject").Return(uuid.NullUUID{UUID: subject, Valid: true})
 		strategy.On("PruneQueue", mock.AnythingOfType("*gorm.DB")).Return(int64(0), nil)
+		strategy.On("Simulate").Return(false)
 		config.On("EthMaxQueuedTransactions").Return(uint64(1))
 		etx, err := bptxm.CreateEthTransaction(db, fromAddress, toAddress, payload, gasLimit, nil, strategy)
 		assert.NoError(t, err)
@@ -231,6 +238,7 @@ func TestBulletproofTxManager_CreateEthTransaction_OutOfEth(t *testing.T) {
 	config.On("EthTxResendAfterThreshold").Return(time.Duration(0))
 	config.On("EthTxReaperThreshold").Return(time.Duration(0))
 	config.On("GasEstimatorMode").Return("FixedPrice")
+	config.On("EVMChainIDScope").Return(nil)
 	bptxm := bulletprooftxmanager.NewBulletproofTxManager(db, nil, config, nil, nil, nil)
 
 	t.Run("if another key has any transactions with insufficient eth errors, transmits as normal", func(t *testing.T) {
@@ -240,6 +248,7 @@ func TestBulletproofTxManager_CreateEthTransaction_OutOfEth(t *testing.T) {
 		strategy := new(bptxmmocks.TxStrategy)
 		strategy.On("Subject").Return(uuid.NullUUID{})
 		strategy.On("PruneQueue", mock.AnythingOfType("*gorm.DB")).Return(int64(0), nil)
+		strategy.On("Simulate").Return(false)
 
 		etx, err := bptxm.CreateEthTransaction(db, fromAddress, toAddress, payload, gasLimit, nil, strategy)
 		assert.NoError(t, err)
@@ -257,6 +266,7 @@ func TestBulletproofTxManager_CreateEthTransaction_OutOfEth(t *testing.T) {
 		strategy := new(bptxmmocks.TxStrategy)
 		strategy.On("Subject").Return(uuid.NullUUID{})
 		strategy.On("PruneQueue", mock.AnythingOfType("*gorm.DB")).Return(int64(0), nil)
+		strategy.On("Simulate").Return(false)
 
 		etx, err := bptxm.CreateEthTransaction(db, fromAddress, toAddress, payload, gasLimit, nil, strategy)
 		assert.NoError(t, err)
@@ -273,6 +283,7 @@ func TestBulletproofTxManager_CreateEthTransaction_OutOfEth(t *testing.T) {
 		strategy := new(bptxmmocks.TxStrategy)
 		strategy.On("Subject").Return(uuid.NullUUID{})
 		strategy.On("PruneQueue", mock.AnythingOfType("*gorm.DB")).Return(int64(0), nil)
+		strategy.On("Simulate").Return(false)
 
 		config.On("EthMaxQueuedTransactions").Return(uint64(1))
 		etx, err := bptxm.CreateEthTransaction(db, fromAddress, toAddress, payload, gasLimit, nil, strategy)
@@ -298,6 +309,7 @@ func TestBulletproofTxManager_Lifecycle(t *testing.T) {
 	config.On("EthMaxInFlightTransactions").Return(uint32(42))
 	config.On("EthFinalityDepth").Maybe().Return(uint(42))
 	config.On("GasEstimatorMode").Return("FixedPrice")
+	config.On("EVMChainIDScope").Return(nil)
 	kst.On("AllKeys").Return([]ethkey.Key{}, nil).Once()
 
 	keyChangeCh := make(chan struct{})
@@ -339,3 +351,92 @@ func TestBulletproofTxManager_Lifecycle(t *testing.T) {
 	eventBroadcaster.AssertExpectations(t)
 	unsub.AwaitOrFail(t, 1*time.Second)
 }
+
+func TestReceipt_UnmarshalJSON_L1Fee(t *testing.T) {
+	t.Parallel()
+
+	base := `"transactionHash":"0x5b0dbf8c5ba3d1a9a1f1b3b5f9f1e0f6b5f9f1e0f6b5f9f1e0f6b5f9f1e0f6b5","status":"0x1","gasUsed":"0x5208"`
+	for name, tc := range map[string]struct {
+		json  string
+		l1Fee *big.Int
+	}{
+		"L1 chain":         {`{` + base + `}`, nil},
+		"optimism":         {`{` + base + `,"l1Fee":"0x3e8"}`, big.NewInt(1000)},
+		"arbitrum":         {`{` + base + `,"gasUsedForL1":"0x64","effectiveGasPrice":"0xa"}`, big.NewInt(1000)},
+		"arbitrum classic": {`{` + base + `,"feeStats":{"paid":{"l1Transaction":"0x258","l1Calldata":"0x190","l2Storage":"0x0","l2Computation":"0x1"}}}`, big.NewInt(1000)},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var r bulletprooftxmanager.Receipt
+			require.NoError(t, json.Unmarshal([]byte(tc.json), &r))
+			assert.Equal(t, uint64(21000), r.GasUsed)
+			assert.Equal(t, tc.l1Fee, r.L1Fee)
+
+			// The L1 fee survives a round trip through the database
+			b, err := json.Marshal(r)
+			require.NoError(t, err)
+			var r2 bulletprooftxmanager.Receipt
+			require.NoError(t, json.Unmarshal(b, &r2))
+			assert.Equal(t, tc.l1Fee, r2.L1Fee)
+		})
+	}
+}
+
+func TestBulletproofTxManager_NewDynamicFeeAttempt(t *testing.T) {
+	t.Parallel()
+
+	chainID := big.NewInt(1337)
+	fromAddress := cltest.NewAddress()
+	nonce := int64(7)
+	etx := bulletprooftxmanager.EthTx{
+		ID:             42,
+		Nonce:          &nonce,
+		FromAddress:    fromAddress,
+		ToAddress:      gethCommon.HexToAddress("0x6C03DDA95a2AEd917EeCc6eddD4b9D16E6380411"),
+		EncodedPayload: []byte{42, 42, 0},
+		Value:          assets.NewEthValue(142),
+	}
+
+	t.Run("signs a dynamic fee transaction with the tip cap and fee cap", func(t *testing.T) {
+		kst := new(ksmocks.EthKeyStoreInterface)
+		kst.On("SignTx", fromAddress, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return tx.Type() == gethTypes.DynamicFeeTxType &&
+				tx.Nonce() == 7 &&
+				tx.Gas() == 1000 &&
+				tx.GasTipCap().Cmp(big.NewInt(10)) == 0 &&
+				tx.GasFeeCap().Cmp(big.NewInt(200)) == 0 &&
+				tx.ChainId().Cmp(chainID) == 0 &&
+				tx.Value().Cmp(big.NewInt(142)) == 0
+		}), chainID).Return(func(_ gethCommon.Address, tx *gethTypes.Transaction, _ *big.Int) *gethTypes.Transaction {
+			return tx
+		}, nil).Once()
+
+		attempt, err := bulletprooftxmanager.NewDynamicFeeAttempt(kst, chainID, etx, gas.DynamicFee{TipCap: big.NewInt(10), FeeCap: big.NewInt(200)}, 1000)
+		require.NoError(t, err)
+
+		assert.True(t, attempt.IsDynamicFee())
+		assert.Equal(t, int(gas.DynamicFeeTxType), attempt.TxType)
+		assert.Equal(t, bulletprooftxmanager.EthTxAttemptInProgress, attempt.State)
+		assert.Equal(t, etx.ID, attempt.EthTxID)
+		assert.Nil(t, attempt.GasPrice)
+		assert.Equal(t, big.NewInt(10), attempt.GasTipCap.ToInt())
+		assert.Equal(t, big.NewInt(200), attempt.GasFeeCap.ToInt())
+		assert.Equal(t, uint64(1000), attempt.ChainSpecificGasLimit)
+
+		signedTx, err := attempt.GetSignedTx()
+		require.NoError(t, err)
+		assert.Equal(t, uint8(gethTypes.DynamicFeeTxType), signedTx.Type())
+		assert.Equal(t, attempt.Hash, signedTx.Hash())
+
+		kst.AssertExpectations(t)
+	})
+
+	t.Run("errors if the tip cap exceeds the fee cap", func(t *testing.T) {
+		kst := new(ksmocks.EthKeyStoreInterface)
+
+		_, err := bulletprooftxmanager.NewDynamicFeeAttempt(kst, chainID, etx, gas.DynamicFee{TipCap: big.NewInt(201), FeeCap: big.NewInt(200)}, 1000)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "tip cap of 201 exceeds fee cap of 200")
+
+		kst.AssertExpectations(t)
+	})
+}
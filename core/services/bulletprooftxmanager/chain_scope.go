@@ -0,0 +1,74 @@
+package bulletprooftxmanager
+
+import (
+	"math/big"
+	"time"
+
+	gethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"gorm.io/gorm"
+)
+
+// chainScope limits the eth_txes and nonces used by a BulletproofTxManager
+// to the chain that its config is for.
+//
+// The node's default chain (ETH_CHAIN_ID) has a nil ID: its eth_txes have a
+// NULL evm_chain_id and its nonces are kept in keys.next_nonce. Any other
+// chain keeps its nonces in evm_key_states.
+type chainScope struct {
+	id *utils.Big
+}
+
+func newChainScope(config interface{ EVMChainIDScope() *big.Int }) chainScope {
+	if id := config.EVMChainIDScope(); id != nil {
+		return chainScope{utils.NewBig(id)}
+	}
+	return chainScope{}
+}
+
+// arg is the value to compare with eth_txes.evm_chain_id, always use
+// IS NOT DISTINCT FROM so that the default chain matches NULL
+func (s chainScope) arg() interface{} {
+	if s.id == nil {
+		return nil
+	}
+	return s.id
+}
+
+func (s chainScope) getNextNonce(db *gorm.DB, address gethCommon.Address) (int64, error) {
+	if s.id == nil {
+		var nonce int64
+		row := db.Raw("SELECT next_nonce FROM keys WHERE address = ?", address).Row()
+		if err := row.Scan(&nonce); err != nil {
+			return 0, errors.Wrap(err, "GetNextNonce failed scanning row")
+		}
+		return nonce, nil
+	}
+
+	// Keys are created without a state for the additional chains, so it is
+	// inserted the first time that it is needed
+	err := db.Exec(`INSERT INTO evm_key_states (address, evm_chain_id, next_nonce, created_at, updated_at)
+VALUES (?, ?, 0, NOW(), NOW()) ON CONFLICT DO NOTHING`, address, s.id).Error
+	if err != nil {
+		return 0, errors.Wrap(err, "GetNextNonce failed to insert key state")
+	}
+	var nonce int64
+	row := db.Raw("SELECT next_nonce FROM evm_key_states WHERE address = ? AND evm_chain_id = ?", address, s.id).Row()
+	if err := row.Scan(&nonce); err != nil {
+		return 0, errors.Wrap(err, "GetNextNonce failed scanning row")
+	}
+	return nonce, nil
+}
+
+// setNextNonce sets the next nonce to newNonce, using currentNonce as an
+// optimistic lock. It returns the number of rows updated.
+func (s chainScope) setNextNonce(db *gorm.DB, address gethCommon.Address, newNonce, currentNonce int64) (int64, error) {
+	var res *gorm.DB
+	if s.id == nil {
+		res = db.Exec(`UPDATE keys SET next_nonce = ?, updated_at = ? WHERE address = ? AND next_nonce = ?`, newNonce, time.Now(), address.Bytes(), currentNonce)
+	} else {
+		res = db.Exec(`UPDATE evm_key_states SET next_nonce = ?, updated_at = ? WHERE address = ? AND evm_chain_id = ? AND next_nonce = ?`, newNonce, time.Now(), address.Bytes(), s.id, currentNonce)
+	}
+	return res.RowsAffected, res.Error
+}
@@ -0,0 +1,116 @@
+package bulletprooftxmanager
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"gorm.io/gorm"
+)
+
+var (
+	// UpkeepPerformedTopic is emitted by keeper registries, with the LINK paid
+	// to the keeper as the first word of its data
+	UpkeepPerformedTopic = crypto.Keccak256Hash([]byte("UpkeepPerformed(uint256,bool,address,uint96,bytes)"))
+	// RandomWordsFulfilledTopic is emitted by VRFCoordinatorV2, with the LINK
+	// paid to the oracle as the second word of its data
+	RandomWordsFulfilledTopic = crypto.Keccak256Hash([]byte("RandomWordsFulfilled(uint256,uint256,uint96,bool)"))
+)
+
+// LinkEarned returns the LINK paid to the node by a transaction with the
+// given logs, or nil if none of its logs reveals a payment. Payments can only
+// be derived for keeper performs and VRF v2 fulfillments; other jobs are paid
+// in ways that are not visible from their own transactions.
+func LinkEarned(logs []*Log) *big.Int {
+	var earned *big.Int
+	for _, l := range logs {
+		if l == nil || len(l.Topics) == 0 {
+			continue
+		}
+		var word int
+		switch l.Topics[0] {
+		case UpkeepPerformedTopic:
+			word = 0
+		case RandomWordsFulfilledTopic:
+			word = 1
+		default:
+			continue
+		}
+		if len(l.Data) < (word+1)*32 {
+			continue
+		}
+		if earned == nil {
+			earned = new(big.Int)
+		}
+		earned.Add(earned, new(big.Int).SetBytes(l.Data[word*32:(word+1)*32]))
+	}
+	return earned
+}
+
+func nullableBig(i *big.Int) *utils.Big {
+	if i == nil {
+		return nil
+	}
+	return utils.NewBig(i)
+}
+
+// JobCost is the gas spent by the confirmed transactions of a job, sent from
+// one key on one chain, on one day
+type JobCost struct {
+	// JobID is nil for transactions not sent by a job
+	JobID *int32
+	// EVMChainID is nil for the node's default chain
+	EVMChainID  *utils.Big
+	FromAddress common.Address
+	Day         time.Time
+	// Transactions is the number of confirmed transactions
+	Transactions int64
+	GasUsed      int64
+	// Fee is the total of gas used times the effective gas price, in wei.
+	// Arbitrum charges for posting to L1 in gas, so its Fee includes L1Fee.
+	// Optimism charges L1Fee on top of the gas, so it is not part of Fee.
+	Fee utils.Big
+	// L1Fee is the part of the fees that paid for posting the transactions
+	// to L1 on L2 chains, in wei
+	L1Fee utils.Big
+	// LinkEarned is the LINK paid to the node by the transactions, in juels,
+	// as far as it can be derived; see LinkEarned
+	LinkEarned utils.Big
+}
+
+// JobCostsParams filters the transactions of a cost report
+type JobCostsParams struct {
+	// JobID limits the report to a single job if non-zero
+	JobID int32
+	// Since and Until limit the report to transactions whose receipts were
+	// saved within [Since, Until)
+	Since time.Time
+	Until time.Time
+}
+
+// JobCosts reports the gas spent by confirmed transactions per job, key,
+// chain and day. The effective gas price of a transaction is taken from its
+// receipt, falling back to the gas price or fee cap of the confirmed attempt
+// for eth nodes that do not return it.
+func JobCosts(db *gorm.DB, params JobCostsParams) (costs []JobCost, err error) {
+	q := db.Table("eth_receipts").
+		Select(`eth_txes.job_id, eth_txes.evm_chain_id, eth_txes.from_address, date_trunc('day', eth_receipts.created_at) AS day,
+	count(*) AS transactions,
+	COALESCE(SUM(eth_receipts.gas_used), 0) AS gas_used,
+	COALESCE(SUM(eth_receipts.gas_used * COALESCE(eth_receipts.effective_gas_price, eth_tx_attempts.gas_price, eth_tx_attempts.gas_fee_cap)), 0) AS fee,
+	COALESCE(SUM(eth_receipts.l1_fee), 0) AS l1_fee,
+	COALESCE(SUM(eth_receipts.link_earned), 0) AS link_earned`).
+		Joins("JOIN eth_tx_attempts ON eth_tx_attempts.hash = eth_receipts.tx_hash").
+		Joins("JOIN eth_txes ON eth_txes.id = eth_tx_attempts.eth_tx_id").
+		Where("eth_txes.state = 'confirmed' AND eth_receipts.created_at >= ? AND eth_receipts.created_at < ?", params.Since, params.Until)
+	if params.JobID != 0 {
+		q = q.Where("eth_txes.job_id = ?", params.JobID)
+	}
+	err = q.Group("eth_txes.job_id, eth_txes.evm_chain_id, eth_txes.from_address, day").
+		Order("day, eth_txes.job_id NULLS LAST, eth_txes.evm_chain_id NULLS FIRST, eth_txes.from_address").
+		Scan(&costs).Error
+	return costs, errors.Wrap(err, "failed to report job costs")
+}
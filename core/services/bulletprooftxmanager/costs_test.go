@@ -0,0 +1,53 @@
+package bulletprooftxmanager_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/keeper_registry_wrapper"
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/vrf_coordinator_v2"
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLinkEarned(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, keeper_registry_wrapper.KeeperRegistryUpkeepPerformed{}.Topic(), bulletprooftxmanager.UpkeepPerformedTopic)
+	assert.Equal(t, vrf_coordinator_v2.VRFCoordinatorV2RandomWordsFulfilled{}.Topic(), bulletprooftxmanager.RandomWordsFulfilledTopic)
+
+	word := func(i int64) []byte { return common.BigToHash(big.NewInt(i)).Bytes() }
+	concat := func(words ...[]byte) (b []byte) {
+		for _, w := range words {
+			b = append(b, w...)
+		}
+		return b
+	}
+
+	t.Run("no payment", func(t *testing.T) {
+		assert.Nil(t, bulletprooftxmanager.LinkEarned(nil))
+		assert.Nil(t, bulletprooftxmanager.LinkEarned([]*bulletprooftxmanager.Log{
+			{Topics: []common.Hash{common.HexToHash("0x01")}, Data: word(1000)},
+			{Data: word(1000)},
+			nil,
+		}))
+	})
+
+	t.Run("keeper perform", func(t *testing.T) {
+		earned := bulletprooftxmanager.LinkEarned([]*bulletprooftxmanager.Log{
+			{Topics: []common.Hash{bulletprooftxmanager.UpkeepPerformedTopic}, Data: concat(word(1000), word(64), word(0))},
+		})
+		assert.Equal(t, big.NewInt(1000), earned)
+	})
+
+	t.Run("VRF v2 fulfillments", func(t *testing.T) {
+		earned := bulletprooftxmanager.LinkEarned([]*bulletprooftxmanager.Log{
+			{Topics: []common.Hash{bulletprooftxmanager.RandomWordsFulfilledTopic}, Data: concat(word(42), word(2000), word(1))},
+			{Topics: []common.Hash{bulletprooftxmanager.RandomWordsFulfilledTopic}, Data: concat(word(43), word(3000), word(1))},
+			// Truncated data is ignored
+			{Topics: []common.Hash{bulletprooftxmanager.RandomWordsFulfilledTopic}, Data: word(44)},
+		})
+		assert.Equal(t, big.NewInt(5000), earned)
+	})
+}
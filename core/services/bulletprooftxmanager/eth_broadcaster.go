@@ -3,7 +3,6 @@ package bulletprooftxmanager
 import (
 	"context"
 	"fmt"
-	"math/big"
 	"sync"
 	"time"
 
@@ -50,6 +49,7 @@ type EthBroadcaster struct {
 	keystore       KeyStore
 	advisoryLocker postgres.AdvisoryLocker
 	estimator      gas.Estimator
+	chainScope     chainScope
 
 	ethTxInsertListener postgres.Subscription
 	eventBroadcaster    postgres.EventBroadcaster
@@ -79,6 +79,7 @@ func NewEthBroadcaster(db *gorm.DB, ethClient eth.Client, config Config, keystor
 		keystore:         keystore,
 		advisoryLocker:   advisoryLocker,
 		estimator:        estimator,
+		chainScope:       newChainScope(config),
 		eventBroadcaster: eventBroadcaster,
 		keys:             allKeys,
 		triggers:         triggers,
@@ -96,7 +97,7 @@ func (eb *EthBroadcaster) Start() error {
 		}
 
 		if eb.config.EthNonceAutoSync() {
-			syncer := NewNonceSyncer(eb.db, eb.ethClient)
+			syncer := NewNonceSyncer(eb.db, eb.ethClient, eb.config)
 			if err := syncer.SyncAll(eb.ctx, eb.keys); err != nil {
 				return errors.Wrap(err, "EthBroadcaster failed to sync with on-chain nonce")
 			}
@@ -221,12 +222,12 @@ func (eb *EthBroadcaster) processUnstartedEthTxs(fromAddress gethCommon.Address)
 	for {
 		maxInFlightTransactions := eb.config.EthMaxInFlightTransactions()
 		if maxInFlightTransactions > 0 {
-			nUnconfirmed, err := CountUnconfirmedTransactions(eb.db, fromAddress)
+			nUnconfirmed, err := countTransactionsWithState(eb.db, fromAddress, EthTxUnconfirmed, eb.chainScope)
 			if err != nil {
 				return errors.Wrap(err, "CountUnconfirmedTransactions failed")
 			}
 			if nUnconfirmed >= maxInFlightTransactions {
-				nUnstarted, err := CountUnstartedTransactions(eb.db, fromAddress)
+				nUnstarted, err := countTransactionsWithState(eb.db, fromAddress, EthTxUnstarted, eb.chainScope)
 				if err != nil {
 					return errors.Wrap(err, "CountUnstartedTransactions failed")
 				}
@@ -243,11 +244,7 @@ func (eb *EthBroadcaster) processUnstartedEthTxs(fromAddress gethCommon.Address)
 			return nil
 		}
 		n++
-		gasPrice, gasLimit, err := eb.estimator.EstimateGas(etx.EncodedPayload, etx.GasLimit)
-		if err != nil {
-			return errors.Wrap(err, "failed to estimate gas")
-		}
-		a, err := newAttempt(eb.ethClient, eb.keystore, eb.config.ChainID(), *etx, gasPrice, gasLimit)
+		a, err := newAttempt(eb.keystore, eb.config, *etx, eb.estimator)
 		if err != nil {
 			return errors.Wrap(err, "processUnstartedEthTxs failed")
 		}
@@ -259,16 +256,48 @@ func (eb *EthBroadcaster) processUnstartedEthTxs(fromAddress gethCommon.Address)
 			return errors.Wrap(err, "processUnstartedEthTxs failed")
 		}
 
+		if etx.Simulate {
+			if reverted, err := eb.simulate(etx); err != nil {
+				return errors.Wrap(err, "processUnstartedEthTxs failed")
+			} else if reverted {
+				continue
+			}
+		}
+
 		if err := eb.handleInProgressEthTx(*etx, a, time.Now()); err != nil {
 			return errors.Wrap(err, "processUnstartedEthTxs failed")
 		}
 	}
 }
 
+// simulate runs the in_progress transaction as an eth_call before it is sent
+// for the first time. If it would revert, it is marked as fatally errored with
+// the revert reason instead of being sent. Simulation only saves gas, so if the
+// eth node fails to simulate it for any other reason it is sent anyway.
+func (eb *EthBroadcaster) simulate(etx *EthTx) (reverted bool, err error) {
+	simErr := simulateTransaction(eb.ctx, eb.ethClient, *etx)
+	if simErr == nil {
+		return false, nil
+	}
+	if !eth.IsExecutionReverted(simErr) {
+		logger.Warnw("EthBroadcaster: could not simulate transaction, sending it anyway", "ethTxID", etx.ID, "err", simErr)
+		return false, nil
+	}
+
+	reason, err := eth.ExtractRevertReasonFromRPCError(simErr)
+	if err != nil || reason == "" {
+		reason = errors.Cause(simErr).Error()
+	}
+	logger.Errorw("EthBroadcaster: transaction simulation reverted, it will not be sent", "ethTxID", etx.ID, "revertReason", reason, "meta", etx.Meta)
+	promSimulationRevertedTxCount.Inc()
+	etx.Error = null.StringFrom(fmt.Sprintf("transaction simulation reverted: %s", reason))
+	return true, saveFatallyErroredTransaction(eb.db, etx)
+}
+
 // handleInProgressEthTx checks if there is any transaction
 // in_progress and if so, finishes the job
 func (eb *EthBroadcaster) handleAnyInProgressEthTx(fromAddress gethCommon.Address) error {
-	etx, err := getInProgressEthTx(eb.db, fromAddress)
+	etx, err := getInProgressEthTx(eb.db, fromAddress, eb.chainScope)
 	if err != nil {
 		return errors.Wrap(err, "handleAnyInProgressEthTx failed")
 	}
@@ -284,9 +313,9 @@ func (eb *EthBroadcaster) handleAnyInProgressEthTx(fromAddress gethCommon.Addres
 // an unfinished state because something went screwy the last time. Most likely
 // the node crashed in the middle of the ProcessUnstartedEthTxs loop.
 // It may or may not have been broadcast to an eth node.
-func getInProgressEthTx(db *gorm.DB, fromAddress gethCommon.Address) (*EthTx, error) {
+func getInProgressEthTx(db *gorm.DB, fromAddress gethCommon.Address, scope chainScope) (*EthTx, error) {
 	etx := &EthTx{}
-	err := db.Preload("EthTxAttempts").First(etx, "from_address = ? AND state = 'in_progress'", fromAddress.Bytes()).Error
+	err := db.Preload("EthTxAttempts").First(etx, "from_address = ? AND state = 'in_progress' AND evm_chain_id IS NOT DISTINCT FROM ?", fromAddress.Bytes(), scope.arg()).Error
 	if errors.Is(err, gorm.ErrRecordNotFound) {
 		return nil, nil
 	}
@@ -310,7 +339,7 @@ func (eb *EthBroadcaster) handleInProgressEthTx(etx EthTx, attempt EthTxAttempt,
 		logger.Errorw("EthBroadcaster: transaction gas price was rejected by the eth node for being too high. Consider increasing your eth node's RPCTxFeeCap (it is suggested to run geth with no cap i.e. --rpc.gascap=0 --rpc.txfeecap=0)",
 			"ethTxID", etx.ID,
 			"err", sendError,
-			"gasPrice", attempt.GasPrice,
+			"gasPrice", attempt.GasPriceString(),
 			"gasLimit", etx.GasLimit,
 			"id", "RPCTxFeeCapExceeded",
 		)
@@ -320,7 +349,7 @@ func (eb *EthBroadcaster) handleInProgressEthTx(etx EthTx, attempt EthTxAttempt,
 	}
 
 	if sendError.Fatal() {
-		logger.Errorw("EthBroadcaster: fatal error sending transaction", "ethTxID", etx.ID, "error", sendError, "gasLimit", etx.GasLimit, "gasPrice", attempt.GasPrice)
+		logger.Errorw("EthBroadcaster: fatal error sending transaction", "ethTxID", etx.ID, "error", sendError, "gasLimit", etx.GasLimit, "gasPrice", attempt.GasPriceString())
 		etx.Error = null.StringFrom(sendError.Error())
 		// Attempt is thrown away in this case; we don't need it since it never got accepted by a node
 		return saveFatallyErroredTransaction(eb.db, &etx)
@@ -379,7 +408,7 @@ func (eb *EthBroadcaster) handleInProgressEthTx(etx EthTx, attempt EthTxAttempt,
 		// success (even though the transaction will never confirm) and hand
 		// off to the ethConfirmer to bump gas periodically until we _can_ get
 		// it in
-		logger.Infow("EthBroadcaster: Transaction temporarily underpriced", "ethTxID", etx.ID, "err", sendError.Error(), "gasPriceWei", attempt.GasPrice.String())
+		logger.Infow("EthBroadcaster: Transaction temporarily underpriced", "ethTxID", etx.ID, "err", sendError.Error(), "gasPriceWei", attempt.GasPriceString())
 		sendError = nil
 	}
 
@@ -387,7 +416,7 @@ func (eb *EthBroadcaster) handleInProgressEthTx(etx EthTx, attempt EthTxAttempt,
 		logger.Errorw(fmt.Sprintf("EthBroadcaster: tx 0x%x at gas price %s Wei was rejected due to insufficient eth. "+
 			"The eth node returned %s. "+
 			"ACTION REQUIRED: Chainlink wallet with address 0x%x is OUT OF FUNDS",
-			attempt.Hash, attempt.GasPrice.String(), sendError.Error(), etx.FromAddress,
+			attempt.Hash, attempt.GasPriceString(), sendError.Error(), etx.FromAddress,
 		), "ethTxID", etx.ID, "err", sendError)
 		// NOTE: This bails out of the entire cycle and essentially "blocks" on
 		// any transaction that gets insufficient_eth. This is OK if a
@@ -413,7 +442,7 @@ func (eb *EthBroadcaster) handleInProgressEthTx(etx EthTx, attempt EthTxAttempt,
 // Returns nil if no transactions are in queue
 func (eb *EthBroadcaster) nextUnstartedTransactionWithNonce(fromAddress gethCommon.Address) (*EthTx, error) {
 	etx := &EthTx{}
-	if err := findNextUnstartedTransactionFromAddress(eb.db, etx, fromAddress); err != nil {
+	if err := findNextUnstartedTransactionFromAddress(eb.db, etx, fromAddress, eb.chainScope); err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			// Finish. No more transactions left to process. Hoorah!
 			return nil, nil
@@ -421,7 +450,7 @@ func (eb *EthBroadcaster) nextUnstartedTransactionWithNonce(fromAddress gethComm
 		return nil, errors.Wrap(err, "findNextUnstartedTransactionFromAddress failed")
 	}
 
-	nonce, err := GetNextNonce(eb.db, etx.FromAddress)
+	nonce, err := eb.chainScope.getNextNonce(eb.db, etx.FromAddress)
 	if err != nil {
 		return nil, err
 	}
@@ -458,9 +487,9 @@ func (eb *EthBroadcaster) saveInProgressTransaction(etx *EthTx, attempt *EthTxAt
 }
 
 // Finds earliest saved transaction that has yet to be broadcast from the given address
-func findNextUnstartedTransactionFromAddress(db *gorm.DB, etx *EthTx, fromAddress gethCommon.Address) error {
+func findNextUnstartedTransactionFromAddress(db *gorm.DB, etx *EthTx, fromAddress gethCommon.Address, scope chainScope) error {
 	return db.
-		Where("from_address = ? AND state = 'unstarted'", fromAddress).
+		Where("from_address = ? AND state = 'unstarted' AND evm_chain_id IS NOT DISTINCT FROM ?", fromAddress, scope.arg()).
 		Order("value ASC, created_at ASC, id ASC").
 		First(etx).
 		Error
@@ -479,7 +508,7 @@ func saveAttempt(db *gorm.DB, etx *EthTx, attempt EthTxAttempt, newAttemptState
 	etx.State = EthTxUnconfirmed
 	attempt.State = newAttemptState
 	return postgres.GormTransactionWithDefaultContext(db, func(tx *gorm.DB) error {
-		if err := IncrementNextNonce(tx, etx.FromAddress, *etx.Nonce); err != nil {
+		if err := incrementNextNonce(tx, etx.FromAddress, *etx.Nonce, chainScope{etx.EVMChainID}); err != nil {
 			return errors.Wrap(err, "saveUnconfirmed failed")
 		}
 		if err := tx.Save(etx).Error; err != nil {
@@ -498,38 +527,33 @@ func saveAttempt(db *gorm.DB, etx *EthTx, attempt EthTxAttempt, newAttemptState
 }
 
 func (eb *EthBroadcaster) tryAgainBumpingGas(sendError *eth.SendError, etx EthTx, attempt EthTxAttempt, initialBroadcastAt time.Time) error {
-	bumpedGasPrice, bumpedGasLimit, err := eb.estimator.BumpGas(attempt.GasPrice.ToInt(), etx.GasLimit)
+	replacementAttempt, err := newBumpedAttempt(eb.keystore, eb.config, etx, attempt, eb.estimator)
 	if err != nil {
-		return errors.Wrap(err, "tryAgainWithHigherGasPrice failed")
+		return errors.Wrap(err, "tryAgainBumpingGas failed")
 	}
 	logger.Errorw(fmt.Sprintf("default gas price %v wei was rejected by the eth node for being too low. "+
 		"Eth node returned: '%s'. "+
 		"Bumping to %v wei and retrying. ACTION REQUIRED: This is a configuration error. "+
-		"Consider increasing ETH_GAS_PRICE_DEFAULT", eb.config.EthGasPriceDefault(), sendError.Error(), bumpedGasPrice), "err", err)
-	if bumpedGasPrice.Cmp(attempt.GasPrice.ToInt()) == 0 && bumpedGasPrice.Cmp(eb.config.EthMaxGasPriceWei()) == 0 {
+		"Consider increasing ETH_GAS_PRICE_DEFAULT", attempt.GasPriceString(), sendError.Error(), replacementAttempt.GasPriceString()), "err", err)
+	if !attempt.IsDynamicFee() && replacementAttempt.GasPrice.ToInt().Cmp(attempt.GasPrice.ToInt()) == 0 && replacementAttempt.GasPrice.ToInt().Cmp(eb.config.EthMaxGasPriceWei()) == 0 {
 		return errors.Errorf("Hit gas price bump ceiling, will not bump further. This is a terminal error")
 	}
-	return eb.tryAgainWithNewGas(etx, attempt, initialBroadcastAt, bumpedGasPrice, bumpedGasLimit)
+	return eb.tryAgainWithReplacementAttempt(etx, attempt, replacementAttempt, initialBroadcastAt)
 }
 
 func (eb *EthBroadcaster) tryAgainWithNewEstimation(sendError *eth.SendError, etx EthTx, attempt EthTxAttempt, initialBroadcastAt time.Time) error {
-	gasPrice, gasLimit, err := eb.estimator.EstimateGas(etx.EncodedPayload, etx.GasLimit, gas.OptForceRefetch)
+	replacementAttempt, err := newAttempt(eb.keystore, eb.config, etx, eb.estimator, gas.OptForceRefetch)
 	if err != nil {
 		return errors.Wrap(err, "tryAgainWithNewEstimation failed to estimate gas")
 	}
 	logger.Debugw("Optimism rejected transaction due to incorrect fee, re-estimated and will try again",
-		"etxID", etx.ID, "err", err, "newGasPrice", gasPrice, "newGasLimit", gasLimit)
-	return eb.tryAgainWithNewGas(etx, attempt, initialBroadcastAt, gasPrice, gasLimit)
+		"etxID", etx.ID, "err", err, "newGasPrice", replacementAttempt.GasPriceString(), "newGasLimit", replacementAttempt.ChainSpecificGasLimit)
+	return eb.tryAgainWithReplacementAttempt(etx, attempt, replacementAttempt, initialBroadcastAt)
 }
 
-func (eb *EthBroadcaster) tryAgainWithNewGas(etx EthTx, attempt EthTxAttempt, initialBroadcastAt time.Time, newGasPrice *big.Int, newGasLimit uint64) error {
-	replacementAttempt, err := newAttempt(eb.ethClient, eb.keystore, eb.config.ChainID(), etx, newGasPrice, newGasLimit)
-	if err != nil {
-		return errors.Wrap(err, "tryAgainWithHigherGasPrice failed")
-	}
-
-	if err = saveReplacementInProgressAttempt(eb.db, attempt, &replacementAttempt); err != nil {
-		return errors.Wrap(err, "tryAgainWithHigherGasPrice failed")
+func (eb *EthBroadcaster) tryAgainWithReplacementAttempt(etx EthTx, attempt EthTxAttempt, replacementAttempt EthTxAttempt, initialBroadcastAt time.Time) error {
+	if err := saveReplacementInProgressAttempt(eb.db, attempt, &replacementAttempt); err != nil {
+		return errors.Wrap(err, "tryAgainWithReplacementAttempt failed")
 	}
 	return eb.handleInProgressEthTx(etx, replacementAttempt, initialBroadcastAt)
 }
@@ -553,21 +577,20 @@ func saveFatallyErroredTransaction(db *gorm.DB, etx *EthTx) error {
 
 // GetNextNonce returns keys.next_nonce for the given address
 func GetNextNonce(db *gorm.DB, address gethCommon.Address) (int64, error) {
-	var nonce int64
-	row := db.Raw("SELECT next_nonce FROM keys WHERE address = ?", address).Row()
-	if err := row.Scan(&nonce); err != nil {
-		return 0, errors.Wrap(err, "GetNextNonce failed scanning row")
-	}
-	return nonce, nil
+	return chainScope{}.getNextNonce(db, address)
 }
 
 // IncrementNextNonce increments keys.next_nonce by 1
 func IncrementNextNonce(db *gorm.DB, address gethCommon.Address, currentNonce int64) error {
-	res := db.Exec("UPDATE keys SET next_nonce = next_nonce + 1, updated_at = NOW() WHERE address = ? AND next_nonce = ?", address.Bytes(), currentNonce)
-	if res.Error != nil {
-		return errors.Wrap(res.Error, "IncrementNextNonce failed to update keys")
+	return incrementNextNonce(db, address, currentNonce, chainScope{})
+}
+
+func incrementNextNonce(db *gorm.DB, address gethCommon.Address, currentNonce int64, scope chainScope) error {
+	rowsAffected, err := scope.setNextNonce(db, address, currentNonce+1, currentNonce)
+	if err != nil {
+		return errors.Wrap(err, "IncrementNextNonce failed to update keys")
 	}
-	if res.RowsAffected == 0 {
+	if rowsAffected == 0 {
 		var key ethkey.Key
 		db.Where("address = ?", address.Bytes()).First(&key)
 		return errors.New("invariant violation: could not increment nonce because no rows matched query. " +
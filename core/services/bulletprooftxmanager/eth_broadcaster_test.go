@@ -1,6 +1,7 @@
 package bulletprooftxmanager_test
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -10,6 +11,7 @@ import (
 	"time"
 
 	gethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	gethTypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/onsi/gomega"
 	uuid "github.com/satori/go.uuid"
@@ -19,6 +21,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/mocks"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
 	gasmocks "github.com/smartcontractkit/chainlink/core/services/gas/mocks"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	ksmocks "github.com/smartcontractkit/chainlink/core/services/keystore/mocks"
@@ -26,6 +29,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/store"
 	"github.com/smartcontractkit/chainlink/core/store/dialects"
 	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -328,6 +332,142 @@ func TestEthBroadcaster_ProcessUnstartedEthTxs_Success_WithMultiplier(t *testing
 	ethClient.AssertExpectations(t)
 }
 
+func TestEthBroadcaster_ProcessUnstartedEthTxs_DynamicFee(t *testing.T) {
+	db := pgtest.NewGormDB(t)
+
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	key, fromAddress := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore, 0)
+	ethKeyStore.Unlock(cltest.Password)
+
+	config, cleanup := cltest.NewConfig(t)
+	defer cleanup()
+	config.Set("ETH_EIP1559_DYNAMIC_FEES", "true")
+	config.Set("ETH_GAS_TIP_CAP_DEFAULT", "10")
+	config.Set("ETH_GAS_PRICE_DEFAULT", "200")
+
+	ethClient := cltest.NewEthClientMock(t)
+
+	eb, cleanup := cltest.NewEthBroadcaster(t, db, ethClient, ethKeyStore, config, key)
+	defer cleanup()
+
+	ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+		return tx.Type() == gethTypes.DynamicFeeTxType &&
+			tx.GasTipCap().Cmp(big.NewInt(10)) == 0 &&
+			tx.GasFeeCap().Cmp(big.NewInt(200)) == 0 &&
+			tx.ChainId().Cmp(config.ChainID()) == 0
+	})).Return(nil).Once()
+
+	etx := bulletprooftxmanager.EthTx{
+		FromAddress:    fromAddress,
+		ToAddress:      gethCommon.HexToAddress("0x6C03DDA95a2AEd917EeCc6eddD4b9D16E6380411"),
+		EncodedPayload: []byte{42, 42, 0},
+		Value:          assets.NewEthValue(242),
+		GasLimit:       1231,
+		CreatedAt:      time.Unix(0, 0),
+		State:          bulletprooftxmanager.EthTxUnstarted,
+	}
+	require.NoError(t, db.Save(&etx).Error)
+
+	// Do the thing
+	require.NoError(t, eb.ProcessUnstartedEthTxs(key))
+
+	etx, err := cltest.FindEthTxWithAttempts(db, etx.ID)
+	require.NoError(t, err)
+	assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, etx.State)
+	require.Len(t, etx.EthTxAttempts, 1)
+	attempt := etx.EthTxAttempts[0]
+	assert.True(t, attempt.IsDynamicFee())
+	assert.Nil(t, attempt.GasPrice)
+	assert.Equal(t, big.NewInt(10), attempt.GasTipCap.ToInt())
+	assert.Equal(t, big.NewInt(200), attempt.GasFeeCap.ToInt())
+	assert.Equal(t, bulletprooftxmanager.EthTxAttemptBroadcast, attempt.State)
+
+	ethClient.AssertExpectations(t)
+}
+
+func TestEthBroadcaster_ProcessUnstartedEthTxs_SameKeyOnTwoChains(t *testing.T) {
+	db := pgtest.NewGormDB(t)
+
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	key, fromAddress := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore, 0)
+	ethKeyStore.Unlock(cltest.Password)
+
+	config, cleanup := cltest.NewConfig(t)
+	defer cleanup()
+	otherChainID := big.NewInt(10)
+	otherConfig := &cltest.TestConfig{Config: config.ForEVMChain(otherChainID)}
+	require.NoError(t, db.Exec(`INSERT INTO evm_chains (id, created_at, updated_at) VALUES (?, NOW(), NOW())`, utils.NewBig(otherChainID)).Error)
+
+	ethClient := cltest.NewEthClientMock(t)
+	otherEthClient := cltest.NewEthClientMock(t)
+
+	eb, cleanup := cltest.NewEthBroadcaster(t, db, ethClient, ethKeyStore, config, key)
+	defer cleanup()
+	otherEb, cleanup := cltest.NewEthBroadcaster(t, db, otherEthClient, ethKeyStore, otherConfig, key)
+	defer cleanup()
+
+	toAddress := gethCommon.HexToAddress("0x6C03DDA95a2AEd917EeCc6eddD4b9D16E6380411")
+	newEthTx := func(chainID *utils.Big) bulletprooftxmanager.EthTx {
+		return bulletprooftxmanager.EthTx{
+			FromAddress:    fromAddress,
+			ToAddress:      toAddress,
+			EncodedPayload: []byte{42, 42, 0},
+			Value:          assets.NewEthValue(242),
+			GasLimit:       1231,
+			State:          bulletprooftxmanager.EthTxUnstarted,
+			EVMChainID:     chainID,
+		}
+	}
+
+	t.Run("sends with the same nonce on each chain", func(t *testing.T) {
+		etx := newEthTx(nil)
+		require.NoError(t, db.Save(&etx).Error)
+		otherEtx := newEthTx(utils.NewBig(otherChainID))
+		require.NoError(t, db.Save(&otherEtx).Error)
+
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return tx.Nonce() == 0 && tx.ChainId().Cmp(config.ChainID()) == 0
+		})).Return(nil).Once()
+		otherEthClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return tx.Nonce() == 0 && tx.ChainId().Cmp(otherChainID) == 0
+		})).Return(nil).Once()
+
+		require.NoError(t, eb.ProcessUnstartedEthTxs(key))
+		require.NoError(t, otherEb.ProcessUnstartedEthTxs(key))
+
+		for _, id := range []int64{etx.ID, otherEtx.ID} {
+			etx, err := cltest.FindEthTxWithAttempts(db, id)
+			require.NoError(t, err)
+			assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, etx.State)
+			require.NotNil(t, etx.Nonce)
+			assert.Equal(t, int64(0), *etx.Nonce)
+		}
+
+		ethClient.AssertExpectations(t)
+		otherEthClient.AssertExpectations(t)
+	})
+
+	t.Run("allows one in progress transaction per key on each chain", func(t *testing.T) {
+		nonce := int64(1)
+		etx := newEthTx(nil)
+		etx.Nonce = &nonce
+		etx.State = bulletprooftxmanager.EthTxInProgress
+		require.NoError(t, db.Save(&etx).Error)
+		otherEtx := newEthTx(utils.NewBig(otherChainID))
+		otherEtx.Nonce = &nonce
+		otherEtx.State = bulletprooftxmanager.EthTxInProgress
+		require.NoError(t, db.Save(&otherEtx).Error)
+
+		nonce2 := int64(2)
+		etx2 := newEthTx(nil)
+		etx2.Nonce = &nonce2
+		etx2.State = bulletprooftxmanager.EthTxInProgress
+		err := db.Save(&etx2).Error
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "idx_only_one_in_progress_tx_per_account")
+	})
+}
+
 func TestEthBroadcaster_AssignsNonceOnStart(t *testing.T) {
 	var err error
 	db := pgtest.NewGormDB(t)
@@ -1108,6 +1248,112 @@ func TestEthBroadcaster_ProcessUnstartedEthTxs_Errors(t *testing.T) {
 	})
 }
 
+func TestEthBroadcaster_ProcessUnstartedEthTxs_Simulate(t *testing.T) {
+	toAddress := gethCommon.HexToAddress("0x6C03DDA95a2AEd917EeCc6eddD4b9D16E6380411")
+	value := assets.NewEthValue(142)
+	gasLimit := uint64(242)
+	encodedPayload := []byte{0, 1}
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	db := store.DB
+
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	key, fromAddress := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore, 0)
+	ethKeyStore.Unlock(cltest.Password)
+
+	config, cleanup := cltest.NewConfig(t)
+	defer cleanup()
+
+	ethClient := cltest.NewEthClientMock(t)
+
+	eb, cleanup := cltest.NewEthBroadcaster(t, db, ethClient, ethKeyStore, config, key)
+	defer cleanup()
+
+	mustInsertSimulatedEthTx := func(t *testing.T) bulletprooftxmanager.EthTx {
+		etx := bulletprooftxmanager.EthTx{
+			FromAddress:    fromAddress,
+			ToAddress:      toAddress,
+			EncodedPayload: encodedPayload,
+			Value:          value,
+			GasLimit:       gasLimit,
+			State:          bulletprooftxmanager.EthTxUnstarted,
+			Simulate:       true,
+		}
+		require.NoError(t, db.Save(&etx).Error)
+		return etx
+	}
+	isSimulation := func(callArg interface{}) bool {
+		arg := callArg.(map[string]interface{})
+		return arg["from"] == fromAddress && arg["to"] == toAddress && arg["gas"] == hexutil.Uint64(gasLimit) &&
+			bytes.Equal(arg["data"].(hexutil.Bytes), encodedPayload)
+	}
+
+	t.Run("transaction that would revert is marked as fatally errored and not sent", func(t *testing.T) {
+		localNextNonce := getLocalNextNonce(t, store, fromAddress)
+		etx := mustInsertSimulatedEthTx(t)
+
+		ethClient.On("CallContext", mock.Anything, mock.Anything, "eth_call", mock.MatchedBy(isSimulation), "pending").
+			Return(&eth.JsonError{Code: 3, Message: "execution reverted: round not accepting submissions"}).Once()
+
+		require.NoError(t, eb.ProcessUnstartedEthTxs(key))
+
+		etx, err := cltest.FindEthTxWithAttempts(db, etx.ID)
+		require.NoError(t, err)
+
+		assert.Equal(t, bulletprooftxmanager.EthTxFatalError, etx.State)
+		assert.Nil(t, etx.BroadcastAt)
+		assert.Nil(t, etx.Nonce)
+		require.True(t, etx.Error.Valid)
+		assert.Equal(t, "transaction simulation reverted: execution reverted: round not accepting submissions", etx.Error.String)
+		assert.Len(t, etx.EthTxAttempts, 0)
+
+		// The nonce was not used
+		assert.Equal(t, localNextNonce, getLocalNextNonce(t, store, fromAddress))
+
+		ethClient.AssertExpectations(t)
+	})
+
+	t.Run("transaction that would succeed is sent", func(t *testing.T) {
+		localNextNonce := getLocalNextNonce(t, store, fromAddress)
+		etx := mustInsertSimulatedEthTx(t)
+
+		ethClient.On("CallContext", mock.Anything, mock.Anything, "eth_call", mock.MatchedBy(isSimulation), "pending").
+			Return(nil).Once()
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return tx.Nonce() == localNextNonce
+		})).Return(nil).Once()
+
+		require.NoError(t, eb.ProcessUnstartedEthTxs(key))
+
+		etx, err := cltest.FindEthTxWithAttempts(db, etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, etx.State)
+		assert.False(t, etx.Error.Valid)
+
+		ethClient.AssertExpectations(t)
+	})
+
+	t.Run("transaction is sent anyway if the simulation fails for another reason", func(t *testing.T) {
+		localNextNonce := getLocalNextNonce(t, store, fromAddress)
+		etx := mustInsertSimulatedEthTx(t)
+
+		ethClient.On("CallContext", mock.Anything, mock.Anything, "eth_call", mock.MatchedBy(isSimulation), "pending").
+			Return(errors.New("429 Too Many Requests")).Once()
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return tx.Nonce() == localNextNonce
+		})).Return(nil).Once()
+
+		require.NoError(t, eb.ProcessUnstartedEthTxs(key))
+
+		etx, err := cltest.FindEthTxWithAttempts(db, etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, etx.State)
+
+		ethClient.AssertExpectations(t)
+	})
+}
+
 func TestEthBroadcaster_ProcessUnstartedEthTxs_KeystoreErrors(t *testing.T) {
 	toAddress := gethCommon.HexToAddress("0x6C03DDA95a2AEd917EeCc6eddD4b9D16E6380411")
 	value := assets.NewEthValue(142)
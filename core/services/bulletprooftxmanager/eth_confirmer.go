@@ -10,6 +10,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/null"
 	"github.com/smartcontractkit/chainlink/core/services/eth"
@@ -20,6 +21,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils"
 
+	"github.com/ethereum/go-ethereum"
 	gethCommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/rpc"
@@ -32,6 +34,10 @@ const (
 	// processHeadTimeout represents a sanity limit on how long ProcessHead
 	// should take to complete
 	processHeadTimeout = 10 * time.Minute
+
+	// maxRevertReasonsPerHead limits how many reverted transactions are
+	// replayed on each head
+	maxRevertReasonsPerHead = 100
 )
 
 var (
@@ -55,6 +61,7 @@ type EthConfirmer struct {
 	keystore       KeyStore
 	advisoryLocker postgres.AdvisoryLocker
 	estimator      gas.Estimator
+	chainScope     chainScope
 
 	keys []ethkey.Key
 
@@ -75,6 +82,7 @@ func NewEthConfirmer(db *gorm.DB, ethClient eth.Client, config Config, keystore
 		keystore,
 		advisoryLocker,
 		estimator,
+		newChainScope(config),
 		keys,
 		utils.NewMailbox(1),
 		context,
@@ -161,6 +169,10 @@ func (ec *EthConfirmer) processHead(ctx context.Context, head models.Head) error
 		return errors.Wrap(err, "CheckForReceipts failed")
 	}
 
+	if err := ec.FetchRevertReasons(ctx, head.Number); err != nil {
+		return errors.Wrap(err, "FetchRevertReasons failed")
+	}
+
 	logger.Debugw("EthConfirmer: finished CheckForReceipts", "headNum", head.Number, "time", time.Since(mark), "id", "eth_confirmer")
 	mark = time.Now()
 
@@ -183,8 +195,12 @@ func (ec *EthConfirmer) processHead(ctx context.Context, head models.Head) error
 // the attempt is already broadcast it _must_ have been before this head.
 func (ec *EthConfirmer) SetBroadcastBeforeBlockNum(blockNum int64) error {
 	return ec.db.Exec(
-		`UPDATE eth_tx_attempts SET broadcast_before_block_num = ? WHERE broadcast_before_block_num IS NULL AND state = 'broadcast'`,
-		blockNum,
+		`UPDATE eth_tx_attempts SET broadcast_before_block_num = ?
+FROM eth_txes
+WHERE eth_txes.id = eth_tx_attempts.eth_tx_id
+AND eth_tx_attempts.broadcast_before_block_num IS NULL AND eth_tx_attempts.state = 'broadcast'
+AND eth_txes.evm_chain_id IS NOT DISTINCT FROM ?`,
+		blockNum, ec.chainScope.arg(),
 	).Error
 }
 
@@ -300,8 +316,8 @@ func (ec *EthConfirmer) findEthTxAttemptsRequiringReceiptFetch() (attempts []Eth
 	err = ec.db.
 		Joins("EthTx"). // Joins("EthTx") is needed for the query to actually return data from eth_txes table as well.
 		Joins("JOIN eth_txes ON eth_txes.id = eth_tx_attempts.eth_tx_id AND eth_txes.state IN ('unconfirmed', 'confirmed_missing_receipt')").
-		Order("eth_txes.nonce ASC, eth_tx_attempts.gas_price DESC").
-		Where("eth_tx_attempts.state != 'insufficient_eth'").
+		Order("eth_txes.nonce ASC, eth_tx_attempts.gas_price DESC, eth_tx_attempts.gas_tip_cap DESC").
+		Where("eth_tx_attempts.state != 'insufficient_eth' AND eth_txes.evm_chain_id IS NOT DISTINCT FROM ?", ec.chainScope.arg()).
 		Find(&attempts).Error
 
 	return
@@ -433,19 +449,24 @@ func (ec *EthConfirmer) saveFetchedReceipts(receipts []Receipt) (err error) {
 		if err != nil {
 			return errors.Wrap(err, "saveFetchedReceipts failed to marshal JSON")
 		}
-		valueStrs = append(valueStrs, "(?,?,?,?,?,NOW())")
-		valueArgs = append(valueArgs, r.TxHash, r.BlockHash, r.BlockNumber.Int64(), r.TransactionIndex, receiptJSON)
+		valueStrs = append(valueStrs, "(?,?,?,?,?,?,?,?,?,NOW())")
+		valueArgs = append(valueArgs, r.TxHash, r.BlockHash, r.BlockNumber.Int64(), r.TransactionIndex, receiptJSON,
+			nullableBig(r.L1Fee), int64(r.GasUsed), nullableBig(r.EffectiveGasPrice), nullableBig(LinkEarned(r.Logs)))
 	}
 
 	/* #nosec G201 */
 	sql := `
 	WITH inserted_receipts AS (
-		INSERT INTO eth_receipts (tx_hash, block_hash, block_number, transaction_index, receipt, created_at)
+		INSERT INTO eth_receipts (tx_hash, block_hash, block_number, transaction_index, receipt, l1_fee, gas_used, effective_gas_price, link_earned, created_at)
 		VALUES %s
 		ON CONFLICT (tx_hash, block_hash) DO UPDATE SET
 			block_number = EXCLUDED.block_number,
 			transaction_index = EXCLUDED.transaction_index,
-			receipt = EXCLUDED.receipt
+			receipt = EXCLUDED.receipt,
+			l1_fee = EXCLUDED.l1_fee,
+			gas_used = EXCLUDED.gas_used,
+			effective_gas_price = EXCLUDED.effective_gas_price,
+			link_earned = EXCLUDED.link_earned
 		RETURNING eth_receipts.tx_hash, eth_receipts.block_number
 	),
 	updated_eth_tx_attempts AS (
@@ -472,6 +493,79 @@ func (ec *EthConfirmer) saveFetchedReceipts(receipts []Receipt) (err error) {
 	return errors.Wrap(err, "saveFetchedReceipts failed to save receipts")
 }
 
+// FetchRevertReasons replays the transactions that reverted on-chain as an
+// eth_call at the block they were mined in, and saves the revert reason on
+// their receipts. The replay runs against the state at the end of that block,
+// so in rare cases it does not revert the same way, or at all. The reason is
+// saved as empty if it cannot be determined, so each receipt is only replayed
+// once.
+//
+// If the replay fails for another reason, e.g. the eth node is unreachable,
+// the receipt is replayed again on a later head. Receipts older than
+// ETH_FINALITY_DEPTH are given up on, since a node that is not an archive node
+// may never be able to replay them.
+func (ec *EthConfirmer) FetchRevertReasons(ctx context.Context, blockNum int64) error {
+	var reverted []struct {
+		ReceiptID      int64
+		TxHash         gethCommon.Hash
+		BlockNumber    int64
+		FromAddress    gethCommon.Address
+		ToAddress      gethCommon.Address
+		EncodedPayload []byte
+		Value          assets.Eth
+		GasLimit       uint64
+	}
+	err := ec.db.Raw(`
+SELECT eth_receipts.id AS receipt_id, eth_receipts.tx_hash, eth_receipts.block_number,
+	eth_txes.from_address, eth_txes.to_address, eth_txes.encoded_payload, eth_txes.value, eth_txes.gas_limit
+FROM eth_receipts
+JOIN eth_tx_attempts ON eth_tx_attempts.hash = eth_receipts.tx_hash
+JOIN eth_txes ON eth_txes.id = eth_tx_attempts.eth_tx_id
+WHERE eth_receipts.revert_reason IS NULL AND eth_receipts.receipt->>'status' = '0x0'
+AND eth_txes.evm_chain_id IS NOT DISTINCT FROM ?
+ORDER BY eth_receipts.block_number DESC
+LIMIT ?`, ec.chainScope.arg(), maxRevertReasonsPerHead).Scan(&reverted).Error
+	if err != nil {
+		return errors.Wrap(err, "FetchRevertReasons failed to load reverted transactions")
+	}
+
+	for _, tx := range reverted {
+		to := tx.ToAddress
+		msg := ethereum.CallMsg{
+			From:  tx.FromAddress,
+			To:    &to,
+			Gas:   tx.GasLimit,
+			Value: tx.Value.ToInt(),
+			Data:  tx.EncodedPayload,
+		}
+		callCtx, cancel := eth.DefaultQueryCtx(ctx)
+		_, callErr := ec.ethClient.CallContract(callCtx, msg, big.NewInt(tx.BlockNumber))
+		cancel()
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		var revertReason string
+		if callErr == nil {
+			logger.Warnw("EthConfirmer: transaction reverted on-chain, but did not revert when replayed", "txHash", tx.TxHash, "blockNumber", tx.BlockNumber)
+		} else if !eth.IsExecutionReverted(callErr) {
+			if blockNum-tx.BlockNumber < int64(ec.config.EthFinalityDepth()) {
+				logger.Warnw("EthConfirmer: failed to replay reverted transaction, will retry", "txHash", tx.TxHash, "blockNumber", tx.BlockNumber, "err", callErr)
+				continue
+			}
+			logger.Warnw("EthConfirmer: failed to replay reverted transaction, giving up", "txHash", tx.TxHash, "blockNumber", tx.BlockNumber, "err", callErr)
+		} else if revertReason, err = eth.ExtractRevertReasonFromRPCError(callErr); err != nil || revertReason == "" {
+			revertReason = errors.Cause(callErr).Error()
+		}
+		logger.Infow(fmt.Sprintf("EthConfirmer: transaction %s reverted on-chain", tx.TxHash.Hex()), "txHash", tx.TxHash, "blockNumber", tx.BlockNumber, "revertReason", revertReason)
+
+		if err = ec.db.Exec(`UPDATE eth_receipts SET revert_reason = ? WHERE id = ?`, revertReason, tx.ReceiptID).Error; err != nil {
+			return errors.Wrap(err, "FetchRevertReasons failed to save revert reason")
+		}
+	}
+	return nil
+}
+
 // markConfirmedMissingReceipt
 // It is possible that we can fail to get a receipt for all eth_tx_attempts
 // even though a transaction with this nonce has long since been confirmed (we
@@ -500,11 +594,13 @@ func (ec *EthConfirmer) markConfirmedMissingReceipt() (err error) {
 UPDATE eth_txes
 SET state = 'confirmed_missing_receipt'
 WHERE state = 'unconfirmed'
+AND evm_chain_id IS NOT DISTINCT FROM ?
 AND nonce < (
 	SELECT MAX(nonce) FROM eth_txes
 	WHERE state = 'confirmed'
+	AND evm_chain_id IS NOT DISTINCT FROM ?
 )
-	`)
+	`, ec.chainScope.arg(), ec.chainScope.arg())
 	if res.Error != nil {
 		return res.Error
 	}
@@ -545,13 +641,14 @@ FROM (
 		SELECT e2.id FROM eth_txes AS e2
 		INNER JOIN eth_tx_attempts ON e2.id = eth_tx_attempts.eth_tx_id
 		WHERE e2.state = 'confirmed_missing_receipt'
+		AND e2.evm_chain_id IS NOT DISTINCT FROM $3
 		GROUP BY e2.id
 		HAVING max(eth_tx_attempts.broadcast_before_block_num) < $2
 	)
 	FOR UPDATE OF e1
 ) e0
 WHERE e0.id = eth_txes.id
-RETURNING e0.id, e0.nonce, e0.from_address`, ErrCouldNotGetReceipt, cutoff)
+RETURNING e0.id, e0.nonce, e0.from_address`, ErrCouldNotGetReceipt, cutoff, ec.chainScope.arg())
 
 	if err != nil {
 		return errors.Wrap(err, "markOldTxesMissingReceiptAsErrored failed to query")
@@ -612,7 +709,7 @@ func (ec *EthConfirmer) rebroadcastWhereNecessary(ctx context.Context, address g
 	threshold := int64(ec.config.EthGasBumpThreshold())
 	bumpDepth := int64(ec.config.EthGasBumpTxDepth())
 	maxInFlightTransactions := ec.config.EthMaxInFlightTransactions()
-	etxs, err := FindEthTxsRequiringRebroadcast(ec.db, address, blockHeight, threshold, bumpDepth, maxInFlightTransactions)
+	etxs, err := findEthTxsRequiringRebroadcast(ec.db, address, blockHeight, threshold, bumpDepth, maxInFlightTransactions, ec.chainScope)
 	if err != nil {
 		return errors.Wrap(err, "FindEthTxsRequiringRebroadcast failed")
 	}
@@ -622,7 +719,7 @@ func (ec *EthConfirmer) rebroadcastWhereNecessary(ctx context.Context, address g
 			return errors.Wrap(err, "attemptForRebroadcast failed")
 		}
 
-		logger.Debugw("EthConfirmer: Rebroadcasting transaction", "ethTxID", etx.ID, "nonce", etx.Nonce, "nPreviousAttempts", len(etx.EthTxAttempts), "gasPrice", attempt.GasPrice)
+		logger.Debugw("EthConfirmer: Rebroadcasting transaction", "ethTxID", etx.ID, "nonce", etx.Nonce, "nPreviousAttempts", len(etx.EthTxAttempts), "gasPrice", attempt.GasPriceString())
 
 		if err := ec.saveInProgressAttempt(&attempt); err != nil {
 			return errors.Wrap(err, "saveInProgressAttempt failed")
@@ -641,7 +738,7 @@ func (ec *EthConfirmer) rebroadcastWhereNecessary(ctx context.Context, address g
 // re-org, so multiple attempts are allowed to be in in_progress state (but
 // only one per eth_tx).
 func (ec *EthConfirmer) handleAnyInProgressAttempts(ctx context.Context, address gethCommon.Address, blockHeight int64) error {
-	attempts, err := getInProgressEthTxAttempts(ec.db, address)
+	attempts, err := getInProgressEthTxAttempts(ec.db, address, ec.chainScope)
 	if err != nil {
 		return errors.Wrap(err, "getInProgressEthTxAttempts failed")
 	}
@@ -656,7 +753,7 @@ func (ec *EthConfirmer) handleAnyInProgressAttempts(ctx context.Context, address
 	return nil
 }
 
-func getInProgressEthTxAttempts(db *gorm.DB, address gethCommon.Address) ([]EthTxAttempt, error) {
+func getInProgressEthTxAttempts(db *gorm.DB, address gethCommon.Address, scope chainScope) ([]EthTxAttempt, error) {
 	ctx, cancel := postgres.DefaultQueryCtx()
 	defer cancel()
 
@@ -666,17 +763,22 @@ func getInProgressEthTxAttempts(db *gorm.DB, address gethCommon.Address) ([]EthT
 		Preload("EthTx").
 		Joins("INNER JOIN eth_txes ON eth_txes.id = eth_tx_attempts.eth_tx_id AND eth_txes.state in ('confirmed', 'confirmed_missing_receipt', 'unconfirmed')").
 		Where("eth_tx_attempts.state = 'in_progress'").
-		Where("eth_txes.from_address = ?", address).
+		Where("eth_txes.from_address = ? AND eth_txes.evm_chain_id IS NOT DISTINCT FROM ?", address, scope.arg()).
 		Find(&attempts).Error
 	return attempts, errors.Wrap(err, "getInProgressEthTxAttempts failed")
 }
 
 // FindEthTxsRequiringRebroadcast returns attempts that hit insufficient eth,
-// and attempts that need bumping, in nonce ASC order
+// and attempts that need bumping, in nonce ASC order, on the node's default
+// chain
 func FindEthTxsRequiringRebroadcast(db *gorm.DB, address gethCommon.Address, blockNum, gasBumpThreshold, bumpDepth int64, maxInFlightTransactions uint32) (etxs []EthTx, err error) {
+	return findEthTxsRequiringRebroadcast(db, address, blockNum, gasBumpThreshold, bumpDepth, maxInFlightTransactions, chainScope{})
+}
+
+func findEthTxsRequiringRebroadcast(db *gorm.DB, address gethCommon.Address, blockNum, gasBumpThreshold, bumpDepth int64, maxInFlightTransactions uint32, scope chainScope) (etxs []EthTx, err error) {
 	// NOTE: These two queries could be combined into one using union but it
 	// becomes harder to read and difficult to test in isolation. KISS principle
-	etxInsufficientEths, err := FindEthTxsRequiringResubmissionDueToInsufficientEth(db, address)
+	etxInsufficientEths, err := findEthTxsRequiringResubmissionDueToInsufficientEth(db, address, scope)
 	if err != nil {
 		return nil, err
 	}
@@ -685,7 +787,7 @@ func FindEthTxsRequiringRebroadcast(db *gorm.DB, address gethCommon.Address, blo
 		logger.Infow(fmt.Sprintf("EthConfirmer: Found %d transactions to be re-sent that were previously rejected due to insufficient eth balance", len(etxInsufficientEths)), "blockNum", blockNum, "address", address)
 	}
 
-	etxBumps, err := FindEthTxsRequiringGasBump(db, address, blockNum, gasBumpThreshold, bumpDepth)
+	etxBumps, err := findEthTxsRequiringGasBump(db, address, blockNum, gasBumpThreshold, bumpDepth, scope)
 	if err != nil {
 		return nil, err
 	}
@@ -732,14 +834,18 @@ func FindEthTxsRequiringRebroadcast(db *gorm.DB, address gethCommon.Address, blo
 
 // FindEthTxsRequiringResubmissionDueToInsufficientEth returns transactions
 // that need to be re-sent because they hit an out-of-eth error on a previous
-// block
+// block, on the node's default chain
 func FindEthTxsRequiringResubmissionDueToInsufficientEth(db *gorm.DB, address gethCommon.Address) (etxs []EthTx, err error) {
+	return findEthTxsRequiringResubmissionDueToInsufficientEth(db, address, chainScope{})
+}
+
+func findEthTxsRequiringResubmissionDueToInsufficientEth(db *gorm.DB, address gethCommon.Address, scope chainScope) (etxs []EthTx, err error) {
 	err = db.
 		Preload("EthTxAttempts", func(db *gorm.DB) *gorm.DB {
-			return db.Order("eth_tx_attempts.gas_price DESC")
+			return db.Order("eth_tx_attempts.gas_price DESC, eth_tx_attempts.gas_tip_cap DESC")
 		}).
 		Joins("INNER JOIN eth_tx_attempts ON eth_txes.id = eth_tx_attempts.eth_tx_id AND eth_tx_attempts.state = 'insufficient_eth'").
-		Where("eth_txes.from_address = ? AND eth_txes.state = 'unconfirmed'", address).
+		Where("eth_txes.from_address = ? AND eth_txes.state = 'unconfirmed' AND eth_txes.evm_chain_id IS NOT DISTINCT FROM ?", address, scope.arg()).
 		Order("nonce ASC").
 		Find(&etxs).Error
 
@@ -754,20 +860,26 @@ func FindEthTxsRequiringResubmissionDueToInsufficientEth(db *gorm.DB, address ge
 // limited by limit pending transactions
 //
 // It also returns eth_txes that are unconfirmed with no eth_tx_attempts
+//
+// Only transactions on the node's default chain are returned
 func FindEthTxsRequiringGasBump(db *gorm.DB, address gethCommon.Address, blockNum, gasBumpThreshold, depth int64) (etxs []EthTx, err error) {
+	return findEthTxsRequiringGasBump(db, address, blockNum, gasBumpThreshold, depth, chainScope{})
+}
+
+func findEthTxsRequiringGasBump(db *gorm.DB, address gethCommon.Address, blockNum, gasBumpThreshold, depth int64, scope chainScope) (etxs []EthTx, err error) {
 	if gasBumpThreshold == 0 {
 		return
 	}
 	q := db.
 		Preload("EthTxAttempts", func(db *gorm.DB) *gorm.DB {
-			return db.Order("eth_tx_attempts.gas_price DESC")
+			return db.Order("eth_tx_attempts.gas_price DESC, eth_tx_attempts.gas_tip_cap DESC")
 		}).
 		Joins("LEFT JOIN eth_tx_attempts ON eth_txes.id = eth_tx_attempts.eth_tx_id "+
 			"AND (broadcast_before_block_num > ? OR broadcast_before_block_num IS NULL OR eth_tx_attempts.state != 'broadcast')", blockNum-gasBumpThreshold).
-		Where("eth_txes.state = 'unconfirmed' AND eth_tx_attempts.id IS NULL AND eth_txes.from_address = ?", address)
+		Where("eth_txes.state = 'unconfirmed' AND eth_tx_attempts.id IS NULL AND eth_txes.from_address = ? AND eth_txes.evm_chain_id IS NOT DISTINCT FROM ?", address, scope.arg())
 
 	if depth > 0 {
-		q = q.Where("eth_txes.id IN (SELECT id FROM eth_txes WHERE state = 'unconfirmed' AND from_address = ? ORDER BY nonce ASC LIMIT ?)", address, depth)
+		q = q.Where("eth_txes.id IN (SELECT id FROM eth_txes WHERE state = 'unconfirmed' AND from_address = ? AND evm_chain_id IS NOT DISTINCT FROM ? ORDER BY nonce ASC LIMIT ?)", address, scope.arg(), depth)
 	}
 
 	err = q.Order("nonce ASC").Find(&etxs).Error
@@ -777,8 +889,6 @@ func FindEthTxsRequiringGasBump(db *gorm.DB, address gethCommon.Address, blockNu
 }
 
 func (ec *EthConfirmer) attemptForRebroadcast(ctx context.Context, etx EthTx) (attempt EthTxAttempt, err error) {
-	var bumpedGasPrice *big.Int
-	var bumpedGasLimit uint64
 	if len(etx.EthTxAttempts) > 0 {
 		previousAttempt := etx.EthTxAttempts[0]
 		if previousAttempt.State == EthTxAttemptInsufficientEth {
@@ -789,11 +899,12 @@ func (ec *EthConfirmer) attemptForRebroadcast(ctx context.Context, etx EthTx) (a
 			// TODO: Handle optimism case here
 			return previousAttempt, nil
 		}
-		bumpedGasPrice, bumpedGasLimit, err = ec.estimator.BumpGas(previousAttempt.GasPrice.ToInt(), etx.GasLimit)
+		attempt, err = newBumpedAttempt(ec.keystore, ec.config, etx, previousAttempt, ec.estimator)
 		logFields := []interface{}{
 			"etxID", etx.ID,
 			"txHash", attempt.Hash,
-			"originalGasPrice", previousAttempt.GasPrice.String(),
+			"originalGasPrice", previousAttempt.GasPriceString(),
+			"txType", previousAttempt.TxType,
 			"gasLimit", etx.GasLimit,
 			"originalChainSpecificGasLimit", previousAttempt.ChainSpecificGasLimit,
 			"maxGasPrice", ec.config.EthMaxGasPriceWei(),
@@ -809,15 +920,13 @@ func (ec *EthConfirmer) attemptForRebroadcast(ctx context.Context, etx EthTx) (a
 			previousAttempt.State = EthTxAttemptInProgress
 			return previousAttempt, nil
 		}
-		logger.Debugw("EthConfirmer: rebroadcast bumping gas", append(logFields, "bumpedGasPrice", bumpedGasPrice.String())...)
-	} else {
-		logger.Errorf("invariant violation: EthTx %v was unconfirmed but didn't have any attempts. "+
-			"Falling back to default gas price instead."+
-			"This is a bug! Please report to https://github.com/smartcontractkit/chainlink/issues", etx.ID)
-		bumpedGasPrice = ec.config.EthGasPriceDefault()
-		bumpedGasLimit = etx.GasLimit
-	}
-	return newAttempt(ec.ethClient, ec.keystore, ec.config.ChainID(), etx, bumpedGasPrice, bumpedGasLimit)
+		logger.Debugw("EthConfirmer: rebroadcast bumping gas", append(logFields, "bumpedGasPrice", attempt.GasPriceString())...)
+		return attempt, nil
+	}
+	logger.Errorf("invariant violation: EthTx %v was unconfirmed but didn't have any attempts. "+
+		"Creating a new attempt at the gas price from the estimator instead. "+
+		"This is a bug! Please report to https://github.com/smartcontractkit/chainlink/issues", etx.ID)
+	return newAttempt(ec.keystore, ec.config, etx, ec.estimator)
 }
 
 func (ec *EthConfirmer) saveInProgressAttempt(attempt *EthTxAttempt) error {
@@ -840,18 +949,14 @@ func (ec *EthConfirmer) handleInProgressAttempt(ctx context.Context, etx EthTx,
 		// already bumped above the required minimum in ethBroadcaster.
 		//
 		// It could conceivably happen if the remote eth node changed its configuration.
-		bumpedGasPrice, bumpedGasLimit, err := ec.estimator.BumpGas(attempt.GasPrice.ToInt(), etx.GasLimit)
+		replacementAttempt, err := newBumpedAttempt(ec.keystore, ec.config, etx, attempt, ec.estimator)
 		if err != nil {
 			return errors.Wrap(err, "could not bump gas for terminally underpriced transaction")
 		}
 		logger.Errorf("gas price %v wei was rejected by the eth node for being too low. "+
 			"Eth node returned: '%s'. "+
 			"Bumping to %v wei and retrying. "+
-			"ACTION REQUIRED: You should consider increasing ETH_GAS_PRICE_DEFAULT", attempt.GasPrice.String(), sendError.Error(), bumpedGasPrice)
-		replacementAttempt, err := newAttempt(ec.ethClient, ec.keystore, ec.config.ChainID(), etx, bumpedGasPrice, bumpedGasLimit)
-		if err != nil {
-			return errors.Wrap(err, "newAttempt failed")
-		}
+			"ACTION REQUIRED: You should consider increasing ETH_GAS_PRICE_DEFAULT", attempt.GasPriceString(), sendError.Error(), replacementAttempt.GasPriceString())
 
 		if err := saveReplacementInProgressAttempt(ec.db, attempt, &replacementAttempt); err != nil {
 			return errors.Wrap(err, "saveReplacementInProgressAttempt failed")
@@ -866,7 +971,7 @@ func (ec *EthConfirmer) handleInProgressAttempt(ctx context.Context, etx EthTx,
 		// In that case, the safest thing to do is to pretend the transaction
 		// was accepted and continue the normal gas bumping cycle until we can
 		// get it into the mempool
-		logger.Infow("EthConfirmer: Transaction temporarily underpriced", "ethTxID", etx.ID, "attemptID", attempt.ID, "err", sendError.Error(), "gasPriceWei", attempt.GasPrice.String())
+		logger.Infow("EthConfirmer: Transaction temporarily underpriced", "ethTxID", etx.ID, "attemptID", attempt.ID, "err", sendError.Error(), "gasPriceWei", attempt.GasPriceString())
 		sendError = nil
 	}
 
@@ -878,7 +983,7 @@ func (ec *EthConfirmer) handleInProgressAttempt(ctx context.Context, etx EthTx,
 		logger.Errorw("EthConfirmer: bumped transaction gas price was rejected by the eth node for being too high. Consider increasing your eth node's RPCTxFeeCap (it is suggested to run geth with no cap i.e. --rpc.gascap=0 --rpc.txfeecap=0)",
 			"ethTxID", etx.ID,
 			"err", sendError,
-			"gasPrice", attempt.GasPrice,
+			"gasPrice", attempt.GasPriceString(),
 			"gasLimit", etx.GasLimit,
 			"signedRawTx", hexutil.Encode(attempt.SignedRawTx),
 			"blockHeight", blockHeight,
@@ -924,11 +1029,11 @@ func (ec *EthConfirmer) handleInProgressAttempt(ctx context.Context, etx EthTx,
 		// In this case the simplest and most robust way to recover is to ignore
 		// this attempt and wait until the next bump threshold is reached in
 		// order to bump again.
-		logger.Errorw(fmt.Sprintf("EthConfirmer: replacement transaction underpriced at %v wei for eth_tx %v. "+
+		logger.Errorw(fmt.Sprintf("EthConfirmer: replacement transaction underpriced at %s wei for eth_tx %v. "+
 			"Eth node returned error: '%s'. "+
 			"Either you have set ETH_GAS_BUMP_PERCENT (currently %v%%) too low or an external wallet used this account. "+
 			"Please note that using your node's private keys outside of the chainlink node is NOT SUPPORTED and can lead to missed transactions.",
-			attempt.GasPrice.ToInt().Int64(), etx.ID, sendError.Error(), ec.config.EthGasBumpPercent()), "err", sendError)
+			attempt.GasPriceString(), etx.ID, sendError.Error(), ec.config.EthGasBumpPercent()), "err", sendError)
 
 		// Assume success and hand off to the next cycle.
 		sendError = nil
@@ -938,7 +1043,7 @@ func (ec *EthConfirmer) handleInProgressAttempt(ctx context.Context, etx EthTx,
 		logger.Errorw(fmt.Sprintf("EthConfirmer: EthTxAttempt %v (hash 0x%x) at gas price (%s Wei) was rejected due to insufficient eth. "+
 			"The eth node returned %s. "+
 			"ACTION REQUIRED: Chainlink wallet with address 0x%x is OUT OF FUNDS",
-			attempt.ID, attempt.Hash, attempt.GasPrice.String(), sendError.Error(), etx.FromAddress,
+			attempt.ID, attempt.Hash, attempt.GasPriceString(), sendError.Error(), etx.FromAddress,
 		), "err", sendError)
 		return saveInsufficientEthAttempt(ec.db, &attempt, now)
 	}
@@ -1009,7 +1114,7 @@ func saveInsufficientEthAttempt(db *gorm.DB, attempt *EthTxAttempt, broadcastAt
 // If any of the confirmed transactions does not have a receipt in the chain, it has been
 // re-org'd out and will be rebroadcast.
 func (ec *EthConfirmer) EnsureConfirmedTransactionsInLongestChain(ctx context.Context, head models.Head) error {
-	etxs, err := findTransactionsConfirmedInBlockRange(ec.db, head.Number, head.EarliestInChain().Number)
+	etxs, err := findTransactionsConfirmedInBlockRange(ec.db, head.Number, head.EarliestInChain().Number, ec.chainScope)
 	if err != nil {
 		return errors.Wrap(err, "findTransactionsConfirmedInBlockRange failed")
 	}
@@ -1046,17 +1151,17 @@ func (ec *EthConfirmer) EnsureConfirmedTransactionsInLongestChain(ctx context.Co
 	return multierr.Combine(errors...)
 }
 
-func findTransactionsConfirmedInBlockRange(db *gorm.DB, highBlockNumber, lowBlockNumber int64) ([]EthTx, error) {
+func findTransactionsConfirmedInBlockRange(db *gorm.DB, highBlockNumber, lowBlockNumber int64, scope chainScope) ([]EthTx, error) {
 	var etxs []EthTx
 	err := db.
 		Preload("EthTxAttempts", func(db *gorm.DB) *gorm.DB {
-			return db.Order("eth_tx_attempts.gas_price DESC")
+			return db.Order("eth_tx_attempts.gas_price DESC, eth_tx_attempts.gas_tip_cap DESC")
 		}).
 		Preload("EthTxAttempts.EthReceipts").
 		Joins("INNER JOIN eth_tx_attempts ON eth_txes.id = eth_tx_attempts.eth_tx_id AND eth_tx_attempts.state = 'broadcast'").
 		Joins("INNER JOIN eth_receipts ON eth_receipts.tx_hash = eth_tx_attempts.hash").
 		Order("nonce ASC").
-		Where("eth_txes.state IN ('confirmed', 'confirmed_missing_receipt') AND block_number BETWEEN ? AND ?", lowBlockNumber, highBlockNumber).
+		Where("eth_txes.state IN ('confirmed', 'confirmed_missing_receipt') AND block_number BETWEEN ? AND ? AND eth_txes.evm_chain_id IS NOT DISTINCT FROM ?", lowBlockNumber, highBlockNumber, scope.arg()).
 		Find(&etxs).Error
 	return etxs, errors.Wrap(err, "findTransactionsConfirmedInBlockRange failed")
 }
@@ -1150,7 +1255,7 @@ func (ec *EthConfirmer) ForceRebroadcast(beginningNonce uint, endingNonce uint,
 	logger.Infof("ForceRebroadcast: will rebroadcast transactions for all nonces between %v and %v", beginningNonce, endingNonce)
 
 	for n := beginningNonce; n <= endingNonce; n++ {
-		etx, err := findEthTxWithNonce(ec.db, address, n)
+		etx, err := findEthTxWithNonce(ec.db, address, n, ec.chainScope)
 		if err != nil {
 			return errors.Wrap(err, "ForceRebroadcast failed")
 		}
@@ -1167,7 +1272,7 @@ func (ec *EthConfirmer) ForceRebroadcast(beginningNonce uint, endingNonce uint,
 			if overrideGasLimit != 0 {
 				etx.GasLimit = overrideGasLimit
 			}
-			attempt, err := newAttempt(ec.ethClient, ec.keystore, ec.config.ChainID(), *etx, big.NewInt(int64(gasPriceWei)), etx.GasLimit)
+			attempt, err := newLegacyAttempt(ec.keystore, ec.config.ChainID(), *etx, big.NewInt(int64(gasPriceWei)), etx.GasLimit)
 			if err != nil {
 				logger.Errorw("ForceRebroadcast: failed to create new attempt", "ethTxID", etx.ID, "err", err)
 				continue
@@ -1195,13 +1300,13 @@ func (ec *EthConfirmer) sendEmptyTransaction(ctx context.Context, fromAddress ge
 }
 
 // findEthTxWithNonce returns any broadcast ethtx with the given nonce
-func findEthTxWithNonce(db *gorm.DB, fromAddress gethCommon.Address, nonce uint) (*EthTx, error) {
+func findEthTxWithNonce(db *gorm.DB, fromAddress gethCommon.Address, nonce uint, scope chainScope) (*EthTx, error) {
 	etx := EthTx{}
 	err := db.
 		Preload("EthTxAttempts", func(db *gorm.DB) *gorm.DB {
-			return db.Order("eth_tx_attempts.gas_price DESC")
+			return db.Order("eth_tx_attempts.gas_price DESC, eth_tx_attempts.gas_tip_cap DESC")
 		}).
-		First(&etx, "from_address = ? AND nonce = ? AND state IN ('confirmed', 'confirmed_missing_receipt', 'unconfirmed')", fromAddress, nonce).
+		First(&etx, "from_address = ? AND nonce = ? AND state IN ('confirmed', 'confirmed_missing_receipt', 'unconfirmed') AND evm_chain_id IS NOT DISTINCT FROM ?", fromAddress, nonce, scope.arg()).
 		Error
 	if errors.Is(err, gorm.ErrRecordNotFound) {
 		return nil, nil
@@ -17,6 +17,7 @@ import (
 	ksmocks "github.com/smartcontractkit/chainlink/core/services/keystore/mocks"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils"
+	"gopkg.in/guregu/null.v4"
 	"gorm.io/gorm"
 
 	gethCommon "github.com/ethereum/go-ethereum/common"
@@ -39,7 +40,7 @@ func newBroadcastEthTxAttempt(t *testing.T, etxID int64, gasPrice ...int64) bull
 	attempt.State = bulletprooftxmanager.EthTxAttemptBroadcast
 	if len(gasPrice) > 0 {
 		gp := gasPrice[0]
-		attempt.GasPrice = *utils.NewBig(big.NewInt(gp))
+		attempt.GasPrice = utils.NewBig(big.NewInt(gp))
 	}
 	return attempt
 }
@@ -49,7 +50,7 @@ func newInProgressEthTxAttempt(t *testing.T, etxID int64, gasPrice ...int64) bul
 	attempt.State = bulletprooftxmanager.EthTxAttemptInProgress
 	if len(gasPrice) > 0 {
 		gp := gasPrice[0]
-		attempt.GasPrice = *utils.NewBig(big.NewInt(gp))
+		attempt.GasPrice = utils.NewBig(big.NewInt(gp))
 	}
 	return attempt
 }
@@ -300,10 +301,10 @@ func TestEthConfirmer_CheckForReceipts(t *testing.T) {
 
 	t.Run("fetches and saves receipts for several attempts in gas price order", func(t *testing.T) {
 		attempt2_2 := newBroadcastEthTxAttempt(t, etx2.ID)
-		attempt2_2.GasPrice = *utils.NewBig(big.NewInt(10))
+		attempt2_2.GasPrice = utils.NewBig(big.NewInt(10))
 
 		attempt2_3 := newBroadcastEthTxAttempt(t, etx2.ID)
-		attempt2_3.GasPrice = *utils.NewBig(big.NewInt(20))
+		attempt2_3.GasPrice = utils.NewBig(big.NewInt(20))
 
 		// Insert order deliberately reversed to test sorting by gas price
 		require.NoError(t, store.DB.Create(&attempt2_3).Error)
@@ -446,7 +447,7 @@ func TestEthConfirmer_CheckForReceipts(t *testing.T) {
 
 	t.Run("on receipt fetch marks in_progress eth_tx_attempt as broadcast", func(t *testing.T) {
 		attempt4_2 := newInProgressEthTxAttempt(t, etx4.ID)
-		attempt4_2.GasPrice = *utils.NewBig(big.NewInt(10))
+		attempt4_2.GasPrice = utils.NewBig(big.NewInt(10))
 
 		require.NoError(t, store.DB.Create(&attempt4_2).Error)
 
@@ -913,6 +914,66 @@ func TestEthConfirmer_CheckForReceipts_confirmed_missing_receipt(t *testing.T) {
 	})
 }
 
+func TestEthConfirmer_FetchRevertReasons(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	db := store.DB
+	ethKeyStore := cltest.NewKeyStore(t, store.DB).Eth()
+
+	key, fromAddress := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore, 0)
+
+	ethClient := cltest.NewEthClientMock(t)
+
+	config, cleanup := cltest.NewConfig(t)
+	defer cleanup()
+
+	ec := cltest.NewEthConfirmer(t, store.DB, ethClient, config, ethKeyStore, []ethkey.Key{key})
+
+	ctx := context.Background()
+
+	etx0 := cltest.MustInsertConfirmedEthTxWithReceipt(t, db, fromAddress, 0, 40)
+	etx1 := cltest.MustInsertConfirmedEthTxWithReceipt(t, db, fromAddress, 1, 41)
+	etx2 := cltest.MustInsertConfirmedEthTxWithReceipt(t, db, fromAddress, 2, 42)
+	etx3 := cltest.MustInsertConfirmedEthTxWithReceipt(t, db, fromAddress, 3, 43)
+	// etx0, etx1 and etx3 reverted, etx2 succeeded
+	require.NoError(t, db.Exec(`UPDATE eth_receipts SET receipt = '{"status": "0x0"}' WHERE tx_hash IN (?, ?, ?)`, etx0.EthTxAttempts[0].Hash, etx1.EthTxAttempts[0].Hash, etx3.EthTxAttempts[0].Hash).Error)
+	require.NoError(t, db.Exec(`UPDATE eth_receipts SET receipt = '{"status": "0x1"}' WHERE tx_hash = ?`, etx2.EthTxAttempts[0].Hash).Error)
+
+	ethClient.On("CallContract", mock.Anything, mock.Anything, big.NewInt(40)).Return(nil, errors.New("execution reverted: round not accepting submissions")).Once()
+	ethClient.On("CallContract", mock.Anything, mock.Anything, big.NewInt(41)).Return(nil, nil).Once()
+	ethClient.On("CallContract", mock.Anything, mock.Anything, big.NewInt(43)).Return(nil, errors.New("connection refused")).Twice()
+
+	findRevertReasons := func() []null.String {
+		var receipts []bulletprooftxmanager.EthReceipt
+		require.NoError(t, db.Order("block_number ASC").Find(&receipts).Error)
+		require.Len(t, receipts, 4)
+		reasons := make([]null.String, len(receipts))
+		for i, r := range receipts {
+			reasons[i] = r.RevertReason
+		}
+		return reasons
+	}
+
+	require.NoError(t, ec.FetchRevertReasons(ctx, 44))
+	reasons := findRevertReasons()
+	assert.Equal(t, null.StringFrom("execution reverted: round not accepting submissions"), reasons[0])
+	assert.Equal(t, null.StringFrom(""), reasons[1])
+	assert.False(t, reasons[2].Valid)
+	// The replay failed, so it is retried
+	assert.False(t, reasons[3].Valid)
+
+	// Reasons are only looked up once, and failed replays are given up on
+	// once the receipt is older than the finality depth
+	require.NoError(t, ec.FetchRevertReasons(ctx, 43+int64(config.EthFinalityDepth())))
+	reasons = findRevertReasons()
+	assert.Equal(t, null.StringFrom(""), reasons[3])
+
+	require.NoError(t, ec.FetchRevertReasons(ctx, 44+int64(config.EthFinalityDepth())))
+	ethClient.AssertExpectations(t)
+}
+
 func TestEthConfirmer_FindEthTxsRequiringResubmissionDueToInsufficientEth(t *testing.T) {
 	t.Parallel()
 
@@ -929,7 +990,7 @@ func TestEthConfirmer_FindEthTxsRequiringResubmissionDueToInsufficientEth(t *tes
 	etx3 := cltest.MustInsertUnconfirmedEthTxWithBroadcastAttempt(t, db, 2, fromAddress)
 	attempt3_2 := cltest.NewEthTxAttempt(t, etx3.ID)
 	attempt3_2.State = bulletprooftxmanager.EthTxAttemptInsufficientEth
-	attempt3_2.GasPrice = *utils.NewBig(big.NewInt(100))
+	attempt3_2.GasPrice = utils.NewBig(big.NewInt(100))
 	require.NoError(t, store.DB.Save(&attempt3_2).Error)
 	etx1 := cltest.MustInsertUnconfirmedEthTxWithInsufficientEthAttempt(t, db, 0, fromAddress)
 
@@ -1023,7 +1084,7 @@ func TestEthConfirmer_FindEthTxsRequiringRebroadcast(t *testing.T) {
 	require.NoError(t, store.DB.Save(&attempt1_1).Error)
 	attempt1_2 := newBroadcastEthTxAttempt(t, etx1.ID)
 	attempt1_2.BroadcastBeforeBlockNum = &onTheMoney
-	attempt1_2.GasPrice = *utils.NewBigI(30000)
+	attempt1_2.GasPrice = utils.NewBigI(30000)
 	require.NoError(t, store.DB.Save(&attempt1_2).Error)
 
 	t.Run("returns nothing when the transaction is unconfirmed with an attempt that is recent", func(t *testing.T) {
@@ -1152,7 +1213,7 @@ func TestEthConfirmer_FindEthTxsRequiringRebroadcast(t *testing.T) {
 
 	attempt3_2 := newBroadcastEthTxAttempt(t, etx3.ID)
 	attempt3_2.BroadcastBeforeBlockNum = &oldEnough
-	attempt3_2.GasPrice = *utils.NewBigI(30000)
+	attempt3_2.GasPrice = utils.NewBigI(30000)
 	require.NoError(t, store.DB.Save(&attempt3_2).Error)
 
 	t.Run("returns the transaction if it is unconfirmed with two attempts that are older than gasBumpThreshold blocks", func(t *testing.T) {
@@ -1167,7 +1228,7 @@ func TestEthConfirmer_FindEthTxsRequiringRebroadcast(t *testing.T) {
 
 	attempt3_3 := newBroadcastEthTxAttempt(t, etx3.ID)
 	attempt3_3.BroadcastBeforeBlockNum = &tooNew
-	attempt3_3.GasPrice = *utils.NewBigI(40000)
+	attempt3_3.GasPrice = utils.NewBigI(40000)
 	require.NoError(t, store.DB.Save(&attempt3_3).Error)
 
 	t.Run("does not return the transaction if it has some older but one newer attempt", func(t *testing.T) {
@@ -1192,7 +1253,7 @@ func TestEthConfirmer_FindEthTxsRequiringRebroadcast(t *testing.T) {
 	// not be duplicated
 	attempt4_2 := cltest.NewEthTxAttempt(t, etx4.ID)
 	attempt4_2.State = bulletprooftxmanager.EthTxAttemptInsufficientEth
-	attempt4_2.GasPrice = *utils.NewBigI(40000)
+	attempt4_2.GasPrice = utils.NewBigI(40000)
 	require.NoError(t, store.DB.Save(&attempt4_2).Error)
 
 	etx5 := cltest.MustInsertUnconfirmedEthTxWithInsufficientEthAttempt(t, db, nonce, fromAddress)
@@ -1204,7 +1265,7 @@ func TestEthConfirmer_FindEthTxsRequiringRebroadcast(t *testing.T) {
 	etx6 := cltest.MustInsertUnconfirmedEthTxWithInsufficientEthAttempt(t, db, nonce, fromAddress)
 	attempt6_2 := newBroadcastEthTxAttempt(t, etx3.ID)
 	attempt6_2.BroadcastBeforeBlockNum = &tooNew
-	attempt6_2.GasPrice = *utils.NewBigI(30001)
+	attempt6_2.GasPrice = utils.NewBigI(30001)
 	require.NoError(t, store.DB.Save(&attempt6_2).Error)
 	nonce++
 
@@ -1629,7 +1690,7 @@ func TestEthConfirmer_RebroadcastWhereNecessary(t *testing.T) {
 	nonce++
 	attempt3_1 := etx3.EthTxAttempts[0]
 	attempt3_1.BroadcastBeforeBlockNum = &oldEnough
-	attempt3_1.GasPrice = *utils.NewBig(big.NewInt(35000000000))
+	attempt3_1.GasPrice = utils.NewBig(big.NewInt(35000000000))
 	require.NoError(t, store.DB.Save(&attempt3_1).Error)
 
 	var attempt3_2 bulletprooftxmanager.EthTxAttempt
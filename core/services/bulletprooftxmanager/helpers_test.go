@@ -1,9 +1,16 @@
 package bulletprooftxmanager
 
 import (
+	"math/big"
+
 	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/smartcontractkit/chainlink/core/services/gas"
 )
 
 func SetEthClientOnEthConfirmer(ethClient eth.Client, ethConfirmer *EthConfirmer) {
 	ethConfirmer.ethClient = ethClient
 }
+
+func NewDynamicFeeAttempt(ks KeyStore, chainID *big.Int, etx EthTx, fee gas.DynamicFee, gasLimit uint64) (EthTxAttempt, error) {
+	return newDynamicFeeAttempt(ks, chainID, etx, fee, gasLimit)
+}
@@ -8,6 +8,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	time "time"
+
+	url "net/url"
 )
 
 // Config is an autogenerated mock type for the Config type
@@ -87,6 +89,36 @@ func (_m *Config) ChainID() *big.Int {
 	return r0
 }
 
+// EVMChainIDScope provides a mock function with given fields:
+func (_m *Config) EVMChainIDScope() *big.Int {
+	ret := _m.Called()
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	return r0
+}
+
+// EthEIP1559DynamicFees provides a mock function with given fields:
+func (_m *Config) EthEIP1559DynamicFees() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // EthFinalityDepth provides a mock function with given fields:
 func (_m *Config) EthFinalityDepth() uint {
 	ret := _m.Called()
@@ -203,6 +235,38 @@ func (_m *Config) EthGasPriceDefault() *big.Int {
 	return r0
 }
 
+// EthGasTipCapDefault provides a mock function with given fields:
+func (_m *Config) EthGasTipCapDefault() *big.Int {
+	ret := _m.Called()
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	return r0
+}
+
+// EthGasTipCapMinimum provides a mock function with given fields:
+func (_m *Config) EthGasTipCapMinimum() *big.Int {
+	ret := _m.Called()
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	return r0
+}
+
 // EthMaxGasPriceWei provides a mock function with given fields:
 func (_m *Config) EthMaxGasPriceWei() *big.Int {
 	ret := _m.Called()
@@ -347,6 +411,64 @@ func (_m *Config) GasEstimatorMode() string {
 	return r0
 }
 
+// GasOracleAggregation provides a mock function with given fields:
+func (_m *Config) GasOracleAggregation() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// GasOraclePollPeriod provides a mock function with given fields:
+func (_m *Config) GasOraclePollPeriod() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// GasOraclePriceUnit provides a mock function with given fields:
+func (_m *Config) GasOraclePriceUnit() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// GasOracleURLs provides a mock function with given fields:
+func (_m *Config) GasOracleURLs() []url.URL {
+	ret := _m.Called()
+
+	var r0 []url.URL
+	if rf, ok := ret.Get(0).(func() []url.URL); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]url.URL)
+		}
+	}
+
+	return r0
+}
+
 // TriggerFallbackDBPollInterval provides a mock function with given fields:
 func (_m *Config) TriggerFallbackDBPollInterval() time.Duration {
 	ret := _m.Called()
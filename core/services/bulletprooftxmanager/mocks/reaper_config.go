@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	big "math/big"
+
 	time "time"
 
 	mock "github.com/stretchr/testify/mock"
@@ -13,6 +15,22 @@ type ReaperConfig struct {
 	mock.Mock
 }
 
+// EVMChainIDScope provides a mock function with given fields:
+func (_m *ReaperConfig) EVMChainIDScope() *big.Int {
+	ret := _m.Called()
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	return r0
+}
+
 // EthFinalityDepth provides a mock function with given fields:
 func (_m *ReaperConfig) EthFinalityDepth() uint {
 	ret := _m.Called()
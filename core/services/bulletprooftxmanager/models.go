@@ -12,6 +12,7 @@ import (
 	uuid "github.com/satori/go.uuid"
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/gas"
 	"github.com/smartcontractkit/chainlink/core/utils"
 	"gopkg.in/guregu/null.v4"
 	"gorm.io/datatypes"
@@ -55,6 +56,14 @@ type EthTx struct {
 	// at send time.
 	Meta    datatypes.JSON
 	Subject uuid.NullUUID
+	// Simulate is true if the transaction should be simulated with eth_call
+	// before it is broadcast, see TxStrategy
+	Simulate bool
+	// EVMChainID is nil for transactions on the node's default chain
+	EVMChainID *utils.Big `gorm:"column:evm_chain_id"`
+	// JobID is the job that created the transaction, taken from the JobID of
+	// its EthTxMetaV2. It is nil for transactions not sent by a job.
+	JobID *int32
 }
 
 func (e EthTx) GetError() error {
@@ -70,10 +79,14 @@ func (e EthTx) GetID() string {
 }
 
 type EthTxAttempt struct {
-	ID       int64
-	EthTxID  int64
-	EthTx    EthTx `gorm:"foreignkey:EthTxID;->"`
-	GasPrice utils.Big
+	ID      int64
+	EthTxID int64
+	EthTx   EthTx `gorm:"foreignkey:EthTxID;->"`
+	// GasPrice applies to LegacyTx
+	GasPrice *utils.Big
+	// GasTipCap and GasFeeCap are used instead for DynamicFeeTx
+	GasTipCap *utils.Big
+	GasFeeCap *utils.Big
 	// ChainSpecificGasLimit on the EthTxAttempt is always the same as the on-chain encoded value for gas limit
 	ChainSpecificGasLimit   uint64
 	SignedRawTx             []byte
@@ -82,6 +95,30 @@ type EthTxAttempt struct {
 	BroadcastBeforeBlockNum *int64
 	State                   EthTxAttemptState
 	EthReceipts             []EthReceipt `gorm:"foreignKey:TxHash;references:Hash;association_foreignkey:Hash;->"`
+	TxType                  int
+}
+
+// IsDynamicFee returns true if this attempt is an EIP-1559 (type 2)
+// transaction priced with a tip cap and fee cap
+func (a EthTxAttempt) IsDynamicFee() bool {
+	return a.TxType == int(gas.DynamicFeeTxType)
+}
+
+// DynamicFee returns the tip cap and fee cap of a dynamic fee attempt
+func (a EthTxAttempt) DynamicFee() gas.DynamicFee {
+	return gas.DynamicFee{
+		FeeCap: a.GasFeeCap.ToInt(),
+		TipCap: a.GasTipCap.ToInt(),
+	}
+}
+
+// GasPriceString returns a human-readable description of the price of the
+// attempt, suitable for logging
+func (a EthTxAttempt) GasPriceString() string {
+	if a.IsDynamicFee() {
+		return fmt.Sprintf("tipCap: %s, feeCap: %s", a.GasTipCap.String(), a.GasFeeCap.String())
+	}
+	return a.GasPrice.String()
 }
 
 // GetSignedTx decodes the SignedRawTx into a types.Transaction struct
@@ -103,4 +140,17 @@ type EthReceipt struct {
 	TransactionIndex uint
 	Receipt          []byte
 	CreatedAt        time.Time
+	// RevertReason is set by the EthConfirmer for reverted transactions, it
+	// is empty if the reason could not be determined
+	RevertReason null.String
+	// L1Fee is the part of the fee that paid for posting the transaction to
+	// L1 on L2 chains
+	L1Fee *utils.Big
+	// GasUsed and EffectiveGasPrice are copied from the receipt, the latter
+	// is nil if the eth node did not return it
+	GasUsed           *int64
+	EffectiveGasPrice *utils.Big
+	// LinkEarned is the LINK paid to the node by the transaction, if it
+	// could be derived from its logs
+	LinkEarned *utils.Big
 }
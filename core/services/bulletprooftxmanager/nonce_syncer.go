@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"sync"
-	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
@@ -50,8 +49,9 @@ type (
 	// This gives us re-org protection up to ETH_FINALITY_DEPTH deep in the
 	// worst case, which is in line with our other guarantees.
 	NonceSyncer struct {
-		db        *gorm.DB
-		ethClient eth.Client
+		db         *gorm.DB
+		ethClient  eth.Client
+		chainScope chainScope
 	}
 	// NSinserttx represents an EthTx and Attempt to be inserted together
 	NSinserttx struct {
@@ -61,10 +61,11 @@ type (
 )
 
 // NewNonceSyncer returns a new syncer
-func NewNonceSyncer(db *gorm.DB, ethClient eth.Client) *NonceSyncer {
+func NewNonceSyncer(db *gorm.DB, ethClient eth.Client, config Config) *NonceSyncer {
 	return &NonceSyncer{
 		db,
 		ethClient,
+		newChainScope(config),
 	}
 }
 
@@ -104,7 +105,7 @@ func (s NonceSyncer) fastForwardNonceIfNecessary(ctx context.Context, address co
 
 	selectCtx, cancel := postgres.DefaultQueryCtx()
 	defer cancel()
-	keyNextNonce, err := GetNextNonce(s.db.WithContext(selectCtx), address)
+	keyNextNonce, err := s.chainScope.getNextNonce(s.db.WithContext(selectCtx), address)
 	if err != nil {
 		return err
 	}
@@ -138,11 +139,11 @@ func (s NonceSyncer) fastForwardNonceIfNecessary(ctx context.Context, address co
 	//  We pass in next_nonce here as an optimistic lock to make sure it
 	//  didn't get changed out from under us. Shouldn't happen but can't hurt.
 	return postgres.DBWithDefaultContext(s.db, func(db *gorm.DB) error {
-		res := db.Exec(`UPDATE keys SET next_nonce = ?, updated_at = ? WHERE address = ? AND next_nonce = ?`, newNextNonce, time.Now(), address, keyNextNonce)
-		if res.Error != nil {
-			return errors.Wrap(res.Error, "NonceSyncer#fastForwardNonceIfNecessary failed to update keys.next_nonce")
+		rowsAffected, err := s.chainScope.setNextNonce(db, address, int64(newNextNonce), keyNextNonce)
+		if err != nil {
+			return errors.Wrap(err, "NonceSyncer#fastForwardNonceIfNecessary failed to update keys.next_nonce")
 		}
-		if res.RowsAffected == 0 {
+		if rowsAffected == 0 {
 			return errors.Errorf("NonceSyncer#fastForwardNonceIfNecessary optimistic lock failure fastforwarding nonce %v to %v for key %s", localNonce, chainNonce, address.Hex())
 		}
 		return nil
@@ -158,7 +159,7 @@ func (s NonceSyncer) pendingNonceFromEthClient(ctx context.Context, account comm
 
 func (s NonceSyncer) hasInProgressTransaction(account common.Address) (exists bool, err error) {
 	err = postgres.DBWithDefaultContext(s.db, func(db *gorm.DB) error {
-		return db.Raw(`SELECT EXISTS(SELECT 1 FROM eth_txes WHERE state = 'in_progress' AND from_address = ?)`, account).Scan(&exists).Error
+		return db.Raw(`SELECT EXISTS(SELECT 1 FROM eth_txes WHERE state = 'in_progress' AND from_address = ? AND evm_chain_id IS NOT DISTINCT FROM ?)`, account, s.chainScope.arg()).Scan(&exists).Error
 	})
 	return
 }
@@ -30,7 +30,7 @@ func Test_NonceSyncer_SyncAll(t *testing.T) {
 			return from == addr
 		})).Return(uint64(0), errors.New("something exploded"))
 
-		ns := bulletprooftxmanager.NewNonceSyncer(store.DB, ethClient)
+		ns := bulletprooftxmanager.NewNonceSyncer(store.DB, ethClient, store.Config)
 
 		sendingKeys := cltest.MustSendingKeys(t, ethKeyStore)
 		err := ns.SyncAll(context.Background(), sendingKeys)
@@ -58,7 +58,7 @@ func Test_NonceSyncer_SyncAll(t *testing.T) {
 			return from == addr
 		})).Return(uint64(0), nil)
 
-		ns := bulletprooftxmanager.NewNonceSyncer(store.DB, ethClient)
+		ns := bulletprooftxmanager.NewNonceSyncer(store.DB, ethClient, store.Config)
 
 		sendingKeys := cltest.MustSendingKeys(t, ethKeyStore)
 		require.NoError(t, ns.SyncAll(context.Background(), sendingKeys))
@@ -85,7 +85,7 @@ func Test_NonceSyncer_SyncAll(t *testing.T) {
 			return k1.Address.Address() == addr
 		})).Return(uint64(31), nil)
 
-		ns := bulletprooftxmanager.NewNonceSyncer(store.DB, ethClient)
+		ns := bulletprooftxmanager.NewNonceSyncer(store.DB, ethClient, store.Config)
 
 		sendingKeys := cltest.MustSendingKeys(t, ethKeyStore)
 		require.NoError(t, ns.SyncAll(context.Background(), sendingKeys))
@@ -118,7 +118,7 @@ func Test_NonceSyncer_SyncAll(t *testing.T) {
 			return key1 == addr
 		})).Return(uint64(5), nil)
 
-		ns := bulletprooftxmanager.NewNonceSyncer(store.DB, ethClient)
+		ns := bulletprooftxmanager.NewNonceSyncer(store.DB, ethClient, store.Config)
 
 		sendingKeys := cltest.MustSendingKeys(t, ethKeyStore)
 		require.NoError(t, ns.SyncAll(context.Background(), sendingKeys))
@@ -145,7 +145,7 @@ func Test_NonceSyncer_SyncAll(t *testing.T) {
 			// by 1, but does not need to change when taking into account the in_progress tx
 			return key1 == addr
 		})).Return(uint64(1), nil)
-		ns := bulletprooftxmanager.NewNonceSyncer(store.DB, ethClient)
+		ns := bulletprooftxmanager.NewNonceSyncer(store.DB, ethClient, store.Config)
 
 		sendingKeys := cltest.MustSendingKeys(t, ethKeyStore)
 		require.NoError(t, ns.SyncAll(context.Background(), sendingKeys))
@@ -159,7 +159,7 @@ func Test_NonceSyncer_SyncAll(t *testing.T) {
 			// by 2, but only ahead by 1 if we count the in_progress tx as +1
 			return key1 == addr
 		})).Return(uint64(2), nil)
-		ns = bulletprooftxmanager.NewNonceSyncer(store.DB, ethClient)
+		ns = bulletprooftxmanager.NewNonceSyncer(store.DB, ethClient, store.Config)
 
 		require.NoError(t, ns.SyncAll(context.Background(), sendingKeys))
 		assertDatabaseNonce(t, db, key1, 1)
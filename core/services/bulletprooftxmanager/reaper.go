@@ -2,6 +2,7 @@ package bulletprooftxmanager
 
 import (
 	"fmt"
+	"math/big"
 	"sync/atomic"
 	"time"
 
@@ -18,6 +19,7 @@ type ReaperConfig interface {
 	EthTxReaperInterval() time.Duration
 	EthTxReaperThreshold() time.Duration
 	EthFinalityDepth() uint
+	EVMChainIDScope() *big.Int
 }
 
 // Reaper handles periodic database cleanup for BPTXM
@@ -104,6 +106,7 @@ func (r *Reaper) ReapEthTxes(headNum int64) error {
 		return nil
 	}
 	minBlockNumberToKeep := headNum - int64(r.config.EthFinalityDepth())
+	scope := newChainScope(r.config)
 	mark := time.Now()
 	timeThreshold := mark.Add(-threshold)
 
@@ -116,8 +119,11 @@ func (r *Reaper) ReapEthTxes(headNum int64) error {
 		res := r.db.Exec(`
 WITH old_enough_receipts AS (
 	SELECT tx_hash FROM eth_receipts
+	JOIN eth_tx_attempts ON eth_tx_attempts.hash = eth_receipts.tx_hash
+	JOIN eth_txes ON eth_txes.id = eth_tx_attempts.eth_tx_id
 	WHERE block_number < ?
-	ORDER BY block_number ASC, id ASC
+	AND eth_txes.evm_chain_id IS NOT DISTINCT FROM ?
+	ORDER BY block_number ASC, eth_receipts.id ASC
 	LIMIT ?
 )
 DELETE FROM eth_txes
@@ -125,7 +131,7 @@ USING old_enough_receipts, eth_tx_attempts
 WHERE eth_tx_attempts.eth_tx_id = eth_txes.id
 AND eth_tx_attempts.hash = old_enough_receipts.tx_hash
 AND eth_txes.created_at < ?
-AND eth_txes.state = 'confirmed'`, minBlockNumberToKeep, limit, timeThreshold)
+AND eth_txes.state = 'confirmed'`, minBlockNumberToKeep, scope.arg(), limit, timeThreshold)
 		if res.Error != nil {
 			return count, res.Error
 		}
@@ -139,7 +145,8 @@ AND eth_txes.state = 'confirmed'`, minBlockNumberToKeep, limit, timeThreshold)
 		res := r.db.Exec(`
 DELETE FROM eth_txes
 WHERE created_at < ?
-AND state = 'fatal_error'`, timeThreshold)
+AND state = 'fatal_error'
+AND evm_chain_id IS NOT DISTINCT FROM ?`, timeThreshold, scope.arg())
 		if res.Error != nil {
 			return count, res.Error
 		}
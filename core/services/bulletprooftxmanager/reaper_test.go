@@ -27,6 +27,7 @@ func TestReaper_ReapEthTxes(t *testing.T) {
 		config.On("EthFinalityDepth").Return(uint(10))
 		config.On("EthTxReaperThreshold").Return(1 * time.Hour)
 		config.On("EthTxReaperInterval").Return(1 * time.Hour)
+		config.On("EVMChainIDScope").Return(nil)
 
 		r := bulletprooftxmanager.NewReaper(store.DB, config)
 
@@ -43,6 +44,7 @@ func TestReaper_ReapEthTxes(t *testing.T) {
 		config.On("EthFinalityDepth").Return(uint(10))
 		config.On("EthTxReaperThreshold").Return(0 * time.Second)
 		config.On("EthTxReaperInterval").Return(1 * time.Hour)
+		config.On("EVMChainIDScope").Return(nil)
 
 		r := bulletprooftxmanager.NewReaper(store.DB, config)
 
@@ -57,6 +59,7 @@ func TestReaper_ReapEthTxes(t *testing.T) {
 		config.On("EthFinalityDepth").Return(uint(10))
 		config.On("EthTxReaperThreshold").Return(1 * time.Hour)
 		config.On("EthTxReaperInterval").Return(1 * time.Hour)
+		config.On("EVMChainIDScope").Return(nil)
 
 		r := bulletprooftxmanager.NewReaper(store.DB, config)
 
@@ -85,6 +88,7 @@ func TestReaper_ReapEthTxes(t *testing.T) {
 		config.On("EthFinalityDepth").Return(uint(10))
 		config.On("EthTxReaperThreshold").Return(1 * time.Hour)
 		config.On("EthTxReaperInterval").Return(1 * time.Hour)
+		config.On("EVMChainIDScope").Return(nil)
 
 		r := bulletprooftxmanager.NewReaper(store.DB, config)
 
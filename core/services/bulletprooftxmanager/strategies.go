@@ -12,24 +12,35 @@ type TxStrategy interface {
 	Subject() uuid.NullUUID
 	// PruneQueue is called after eth_tx insertion
 	PruneQueue(tx *gorm.DB) (n int64, err error)
+	// Simulate will be saved to eth_txes.simulate, if true the EthBroadcaster
+	// runs the transaction as an eth_call before sending it and marks it as
+	// fatally errored instead if it would revert
+	Simulate() bool
 }
 
 var _ TxStrategy = SendEveryStrategy{}
 
-func NewQueueingTxStrategy(subject uuid.UUID, queueSize uint32) (strategy TxStrategy) {
+func NewQueueingTxStrategy(subject uuid.UUID, queueSize uint32, simulate bool) (strategy TxStrategy) {
 	if queueSize > 0 {
-		strategy = NewDropOldestStrategy(subject, queueSize)
+		strategy = NewDropOldestStrategy(subject, queueSize, simulate)
 	} else {
-		strategy = SendEveryStrategy{}
+		strategy = NewSendEveryStrategy(simulate)
 	}
 	return
 }
 
 // SendEveryStrategy will always send the tx
-type SendEveryStrategy struct{}
+type SendEveryStrategy struct {
+	simulate bool
+}
+
+func NewSendEveryStrategy(simulate bool) SendEveryStrategy {
+	return SendEveryStrategy{simulate}
+}
 
 func (SendEveryStrategy) Subject() uuid.NullUUID             { return uuid.NullUUID{} }
 func (SendEveryStrategy) PruneQueue(*gorm.DB) (int64, error) { return 0, nil }
+func (s SendEveryStrategy) Simulate() bool                   { return s.simulate }
 
 var _ TxStrategy = DropOldestStrategy{}
 
@@ -38,16 +49,21 @@ var _ TxStrategy = DropOldestStrategy{}
 type DropOldestStrategy struct {
 	subject   uuid.UUID
 	queueSize uint32
+	simulate  bool
 }
 
-func NewDropOldestStrategy(subject uuid.UUID, queueSize uint32) DropOldestStrategy {
-	return DropOldestStrategy{subject, queueSize}
+func NewDropOldestStrategy(subject uuid.UUID, queueSize uint32, simulate bool) DropOldestStrategy {
+	return DropOldestStrategy{subject, queueSize, simulate}
 }
 
 func (s DropOldestStrategy) Subject() uuid.NullUUID {
 	return uuid.NullUUID{UUID: s.subject, Valid: true}
 }
 
+func (s DropOldestStrategy) Simulate() bool {
+	return s.simulate
+}
+
 func (s DropOldestStrategy) PruneQueue(tx *gorm.DB) (n int64, err error) {
 	res := tx.Exec(`
 DELETE FROM eth_txes
@@ -20,18 +20,39 @@ func Test_SendEveryStrategy(t *testing.T) {
 	n, err := s.PruneQueue(nil)
 	assert.NoError(t, err)
 	assert.Equal(t, int64(0), n)
+
+	assert.False(t, s.Simulate())
+	assert.True(t, bulletprooftxmanager.NewSendEveryStrategy(true).Simulate())
 }
 
 func Test_DropOldestStrategy_Subject(t *testing.T) {
 	t.Parallel()
 
 	subject := uuid.NewV4()
-	s := bulletprooftxmanager.NewDropOldestStrategy(subject, 1)
+	s := bulletprooftxmanager.NewDropOldestStrategy(subject, 1, false)
 
 	assert.True(t, s.Subject().Valid)
 	assert.Equal(t, subject, s.Subject().UUID)
 }
 
+func Test_NewQueueingTxStrategy(t *testing.T) {
+	t.Parallel()
+
+	subject := uuid.NewV4()
+
+	s := bulletprooftxmanager.NewQueueingTxStrategy(subject, 0, true)
+	assert.IsType(t, bulletprooftxmanager.SendEveryStrategy{}, s)
+	assert.True(t, s.Simulate())
+
+	s = bulletprooftxmanager.NewQueueingTxStrategy(subject, 1, true)
+	assert.IsType(t, bulletprooftxmanager.DropOldestStrategy{}, s)
+	assert.True(t, s.Simulate())
+	assert.Equal(t, subject, s.Subject().UUID)
+
+	s = bulletprooftxmanager.NewQueueingTxStrategy(subject, 1, false)
+	assert.False(t, s.Simulate())
+}
+
 func Test_DropOldestStrategy_PruneQueue(t *testing.T) {
 	t.Parallel()
 
@@ -64,7 +85,7 @@ func Test_DropOldestStrategy_PruneQueue(t *testing.T) {
 	}
 
 	t.Run("with queue size of 2, removes everything except the newest two transactions for the given subject, ignoring fromAddress", func(t *testing.T) {
-		s := bulletprooftxmanager.NewDropOldestStrategy(subj1, 2)
+		s := bulletprooftxmanager.NewDropOldestStrategy(subj1, 2, false)
 
 		n, err := s.PruneQueue(db)
 		require.NoError(t, err)
@@ -0,0 +1,271 @@
+package bulletprooftxmanager
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	clnull "github.com/smartcontractkit/chainlink/core/null"
+	"github.com/smartcontractkit/chainlink/core/services/gas"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"gorm.io/gorm"
+)
+
+// ErrTxLimitExceeded is returned when sending a transaction would exceed one
+// of the configured ETH_TX_LIMIT_* limits
+var ErrTxLimitExceeded = errors.New("transaction limit exceeded")
+
+// The limits that a transaction can be rejected by, used to label
+// promTxLimitRejections
+const (
+	txLimitTxsPerJob     = "txs_per_job"
+	txLimitTxsPerKey     = "txs_per_key"
+	txLimitSpendPerJob   = "daily_spend_per_job"
+	txLimitSpendPerKey   = "daily_spend_per_key"
+	txLimitCostPerTx     = "cost_per_tx"
+	txLimitValuePerTxJob = "value_per_tx_per_job"
+	txLimitValuePerTxKey = "value_per_tx_per_key"
+)
+
+var promTxLimitRejections = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "tx_manager_tx_limit_rejections",
+	Help: "Number of transactions that were not sent because they would exceed a configured limit",
+}, []string{"limit", "evmChainID"})
+
+// TxLimitsConfig is the config used to limit the transactions of jobs and keys
+type TxLimitsConfig interface {
+	ChainID() *big.Int
+	EthEIP1559DynamicFees() bool
+	EthGasPriceDefault() *big.Int
+	EthTxLimitMaxCostPerTxWei() *big.Int
+	EthTxLimitMaxDailySpendPerJobWei() *big.Int
+	EthTxLimitMaxDailySpendPerKeyWei() *big.Int
+	EthTxLimitMaxTxsPerJob() uint32
+	EthTxLimitMaxTxsPerKey() uint32
+	EthTxLimitMaxValuePerTxWei() *big.Int
+	EthTxLimitWindow() time.Duration
+	EVMChainIDScope() *big.Int
+}
+
+// CheckTxLimits returns an error wrapping ErrTxLimitExceeded if sending a
+// transaction with the given value, calldata and gas limit from fromAddress on
+// behalf of the job would exceed any of its limits. A jobID of 0 only checks
+// the limits of the key.
+//
+// The limits of a key or job are the ETH_TX_LIMIT_* config, unless the key or
+// the job overrides them.
+//
+// The cost of a transaction is its value plus its gas limit times its gas
+// price. A transaction that was sent is charged at the gas price of its mined
+// attempt, or of its latest attempt until it is mined. Transactions that were
+// not sent yet, including the new one, are charged at the gas price from the
+// estimator, or ETH_GAS_PRICE_DEFAULT if it has no estimate.
+//
+// db must be the database transaction that then inserts the eth_tx. The rows
+// of the key and the job are locked until it ends, so that concurrent checks
+// for the same key or job see each other's transactions.
+func CheckTxLimits(db *gorm.DB, config TxLimitsConfig, estimator gas.Estimator, jobID int32, fromAddress common.Address, value *big.Int, calldata []byte, gasLimit uint64) error {
+	l := txLimiter{db, config, newChainScope(config), estimatedGasPrice(config, estimator, calldata, gasLimit)}
+
+	keyLimits, err := l.keyLimits(fromAddress)
+	if err != nil {
+		return err
+	}
+	var jobLimits txLimits
+	if jobID != 0 {
+		if jobLimits, err = l.jobLimits(jobID); err != nil {
+			return err
+		}
+	}
+
+	if max := keyLimits.maxValuePerTx; max.enabled() && value.Cmp(max.max) > 0 {
+		return l.reject(txLimitValuePerTxKey, "transaction transfers %s wei from key %s, which exceeds %s of %s wei", value, fromAddress.Hex(), max.source, max.max)
+	}
+	if max := jobLimits.maxValuePerTx; max.enabled() && value.Cmp(max.max) > 0 {
+		return l.reject(txLimitValuePerTxJob, "transaction transfers %s wei for job %d, which exceeds %s of %s wei", value, jobID, max.source, max.max)
+	}
+	cost := new(big.Int).Mul(new(big.Int).SetUint64(gasLimit), l.gasPrice)
+	cost.Add(cost, value)
+	if max := config.EthTxLimitMaxCostPerTxWei(); max.Sign() > 0 && cost.Cmp(max) > 0 {
+		return l.reject(txLimitCostPerTx, "transaction is estimated to cost %s wei, which exceeds ETH_TX_LIMIT_MAX_COST_PER_TX_WEI of %s wei", cost, max)
+	}
+
+	if keyLimits.maxTxs.enabled() || keyLimits.maxDailySpend.enabled() {
+		if err := l.db.Exec(`SELECT 1 FROM keys WHERE address = ? FOR UPDATE`, fromAddress).Error; err != nil {
+			return errors.Wrap(err, "failed to lock key")
+		}
+	}
+	// The job is always locked after the key, so that checks cannot deadlock
+	if jobLimits.maxTxs.enabled() || jobLimits.maxDailySpend.enabled() {
+		if err := l.db.Exec(`SELECT 1 FROM jobs WHERE id = ? FOR UPDATE`, jobID).Error; err != nil {
+			return errors.Wrap(err, "failed to lock job")
+		}
+	}
+
+	window := config.EthTxLimitWindow()
+	if max := keyLimits.maxTxs; max.enabled() {
+		count, err := l.countTxs(window, `from_address = ?`, fromAddress)
+		if err != nil {
+			return err
+		}
+		if big.NewInt(count).Cmp(max.max) >= 0 {
+			return l.reject(txLimitTxsPerKey, "key %s has sent %d transactions in the last %s, which reaches %s of %s", fromAddress.Hex(), count, window, max.source, max.max)
+		}
+	}
+	if max := keyLimits.maxDailySpend; max.enabled() {
+		spent, err := l.spend(`from_address = ?`, fromAddress)
+		if err != nil {
+			return err
+		}
+		if total := new(big.Int).Add(spent, cost); total.Cmp(max.max) > 0 {
+			return l.reject(txLimitSpendPerKey, "key %s has spent %s wei in the last 24 hours, another transaction estimated to cost %s wei would exceed %s of %s wei", fromAddress.Hex(), spent, cost, max.source, max.max)
+		}
+	}
+	if max := jobLimits.maxTxs; max.enabled() {
+		count, err := l.countTxs(window, `job_id = ?`, jobID)
+		if err != nil {
+			return err
+		}
+		if big.NewInt(count).Cmp(max.max) >= 0 {
+			return l.reject(txLimitTxsPerJob, "job %d has sent %d transactions in the last %s, which reaches %s of %s", jobID, count, window, max.source, max.max)
+		}
+	}
+	if max := jobLimits.maxDailySpend; max.enabled() {
+		spent, err := l.spend(`job_id = ?`, jobID)
+		if err != nil {
+			return err
+		}
+		if total := new(big.Int).Add(spent, cost); total.Cmp(max.max) > 0 {
+			return l.reject(txLimitSpendPerJob, "job %d has spent %s wei in the last 24 hours, another transaction estimated to cost %s wei would exceed %s of %s wei", jobID, spent, cost, max.source, max.max)
+		}
+	}
+	return nil
+}
+
+// estimatedGasPrice is the gas price that a new transaction is expected to be
+// sent at
+func estimatedGasPrice(config TxLimitsConfig, estimator gas.Estimator, calldata []byte, gasLimit uint64) *big.Int {
+	if estimator == nil {
+		return config.EthGasPriceDefault()
+	}
+	if config.EthEIP1559DynamicFees() {
+		if fee, _, err := estimator.GetDynamicFee(gasLimit); err == nil && fee.FeeCap != nil {
+			return fee.FeeCap
+		}
+	} else if gasPrice, _, err := estimator.EstimateGas(calldata, gasLimit); err == nil && gasPrice != nil {
+		return gasPrice
+	}
+	return config.EthGasPriceDefault()
+}
+
+// txLimit is a limit of a key or job along with the setting it comes from,
+// which is named in the rejection
+type txLimit struct {
+	max    *big.Int
+	source string
+}
+
+func (t txLimit) enabled() bool {
+	return t.max != nil && t.max.Sign() > 0
+}
+
+func newTxLimit(override *utils.Big, overrideName string, max *big.Int, configName string) txLimit {
+	if override != nil {
+		return txLimit{override.ToInt(), overrideName}
+	}
+	return txLimit{max, configName}
+}
+
+type txLimits struct {
+	maxTxs        txLimit
+	maxDailySpend txLimit
+	maxValuePerTx txLimit
+}
+
+type txLimiter struct {
+	db       *gorm.DB
+	config   TxLimitsConfig
+	scope    chainScope
+	gasPrice *big.Int
+}
+
+// overrides returns the limits that are set on the row of the key or job
+// matching cond
+func (l txLimiter) overrides(table string, cond string, arg interface{}) (o ethkey.TxLimits, err error) {
+	err = l.db.Raw(`SELECT eth_tx_limit_max_txs AS max_txs, eth_tx_limit_max_daily_spend_wei AS max_daily_spend_wei, eth_tx_limit_max_value_per_tx_wei AS max_value_per_tx_wei
+FROM `+table+` WHERE `+cond, arg).Scan(&o).Error
+	return o, errors.Wrapf(err, "failed to load transaction limits from %s", table)
+}
+
+func (l txLimiter) keyLimits(address common.Address) (txLimits, error) {
+	o, err := l.overrides("keys", `address = ?`, address)
+	if err != nil {
+		return txLimits{}, err
+	}
+	return txLimits{
+		maxTxs:        newTxLimit(uint32ToBig(o.MaxTxs), "the key's maxTxs", big.NewInt(int64(l.config.EthTxLimitMaxTxsPerKey())), "ETH_TX_LIMIT_MAX_TXS_PER_KEY"),
+		maxDailySpend: newTxLimit(o.MaxDailySpendWei, "the key's maxDailySpendWei", l.config.EthTxLimitMaxDailySpendPerKeyWei(), "ETH_TX_LIMIT_MAX_DAILY_SPEND_PER_KEY_WEI"),
+		maxValuePerTx: newTxLimit(o.MaxValuePerTxWei, "the key's maxValuePerTxWei", l.config.EthTxLimitMaxValuePerTxWei(), "ETH_TX_LIMIT_MAX_VALUE_PER_TX_WEI"),
+	}, nil
+}
+
+func (l txLimiter) jobLimits(jobID int32) (txLimits, error) {
+	o, err := l.overrides("jobs", `id = ?`, jobID)
+	if err != nil {
+		return txLimits{}, err
+	}
+	return txLimits{
+		maxTxs:        newTxLimit(uint32ToBig(o.MaxTxs), "the job's ethTxLimitMaxTxs", big.NewInt(int64(l.config.EthTxLimitMaxTxsPerJob())), "ETH_TX_LIMIT_MAX_TXS_PER_JOB"),
+		maxDailySpend: newTxLimit(o.MaxDailySpendWei, "the job's ethTxLimitMaxDailySpendWei", l.config.EthTxLimitMaxDailySpendPerJobWei(), "ETH_TX_LIMIT_MAX_DAILY_SPEND_PER_JOB_WEI"),
+		maxValuePerTx: newTxLimit(o.MaxValuePerTxWei, "the job's ethTxLimitMaxValuePerTxWei", l.config.EthTxLimitMaxValuePerTxWei(), "ETH_TX_LIMIT_MAX_VALUE_PER_TX_WEI"),
+	}, nil
+}
+
+func uint32ToBig(i clnull.Uint32) *utils.Big {
+	if !i.Valid {
+		return nil
+	}
+	return utils.NewBigI(int64(i.Uint32))
+}
+
+func (l txLimiter) reject(limit string, format string, args ...interface{}) error {
+	promTxLimitRejections.WithLabelValues(limit, l.config.ChainID().String()).Inc()
+	err := errors.Wrap(ErrTxLimitExceeded, fmt.Sprintf(format, args...))
+	logger.Warnw("BulletproofTxManager: rejected transaction", "limit", limit, "err", err)
+	return err
+}
+
+// countTxs counts the transactions matching cond that were created within the
+// window, fatally errored transactions were never sent so they do not count
+func (l txLimiter) countTxs(window time.Duration, cond string, arg interface{}) (count int64, err error) {
+	err = l.db.Raw(`SELECT count(*) FROM eth_txes
+WHERE `+cond+` AND state <> 'fatal_error' AND created_at > ? AND evm_chain_id IS NOT DISTINCT FROM ?`,
+		arg, time.Now().Add(-window), l.scope.arg()).Scan(&count).Error
+	return count, errors.Wrap(err, "failed to count transactions")
+}
+
+// spend sums the cost of the transactions matching cond that were created in
+// the last 24 hours
+func (l txLimiter) spend(cond string, arg interface{}) (*big.Int, error) {
+	var spent utils.Big
+	err := l.db.Raw(`SELECT COALESCE(SUM(eth_txes.value + eth_txes.gas_limit * COALESCE(sent.gas_price, sent.gas_fee_cap, ?)), 0)
+FROM eth_txes
+LEFT JOIN LATERAL (
+	SELECT gas_price, gas_fee_cap FROM eth_tx_attempts
+	WHERE eth_tx_attempts.eth_tx_id = eth_txes.id
+	ORDER BY EXISTS (SELECT 1 FROM eth_receipts WHERE eth_receipts.tx_hash = eth_tx_attempts.hash) DESC, eth_tx_attempts.id DESC
+	LIMIT 1
+) sent ON true
+WHERE `+cond+` AND eth_txes.state <> 'fatal_error' AND eth_txes.created_at > ? AND eth_txes.evm_chain_id IS NOT DISTINCT FROM ?`,
+		utils.NewBig(l.gasPrice), arg, time.Now().Add(-24*time.Hour), l.scope.arg()).Row().Scan(&spent)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sum transaction costs")
+	}
+	return spent.ToInt(), nil
+}
@@ -0,0 +1,238 @@
+package bulletprooftxmanager_test
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+	"github.com/smartcontractkit/chainlink/core/services/gas"
+	gasmocks "github.com/smartcontractkit/chainlink/core/services/gas/mocks"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/datatypes"
+)
+
+func TestCheckTxLimits(t *testing.T) {
+	db := pgtest.NewGormDB(t)
+	fromAddress := cltest.MustInsertRandomKey(t, db, 0).Address.Address()
+	otherAddress := cltest.MustInsertRandomKey(t, db, 0).Address.Address()
+
+	config, cleanup := cltest.NewConfig(t)
+	defer cleanup()
+	config.Set("ETH_MAX_GAS_PRICE_WEI", 10)
+	config.Set("ETH_GAS_PRICE_DEFAULT", 5)
+
+	// Without an estimator, transactions that were not sent are charged at
+	// ETH_GAS_PRICE_DEFAULT
+	check := func(jobID int32, fromAddress common.Address, value int64, gasLimit uint64) error {
+		return bulletprooftxmanager.CheckTxLimits(db, config, nil, jobID, fromAddress, big.NewInt(value), nil, gasLimit)
+	}
+
+	jobID := int32(42)
+	mustInsertJobEthTx := func(t *testing.T, jobID int32) bulletprooftxmanager.EthTx {
+		etx := cltest.NewEthTx(t, fromAddress)
+		etx.Meta = datatypes.JSON(fmt.Sprintf(`{"JobID": %d}`, jobID))
+		etx.JobID = &jobID
+		require.NoError(t, db.Save(&etx).Error)
+		return etx
+	}
+
+	t.Run("with no limits configured", func(t *testing.T) {
+		cltest.MustInsertUnstartedEthTx(t, db, fromAddress)
+		require.NoError(t, check(jobID, fromAddress, 1e18, 1e9))
+	})
+
+	t.Run("ETH_TX_LIMIT_MAX_COST_PER_TX_WEI", func(t *testing.T) {
+		config.Set("ETH_TX_LIMIT_MAX_COST_PER_TX_WEI", 1000)
+		defer config.Set("ETH_TX_LIMIT_MAX_COST_PER_TX_WEI", 0)
+
+		require.NoError(t, check(jobID, fromAddress, 10, 198))
+		err := check(jobID, fromAddress, 1, 200)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, bulletprooftxmanager.ErrTxLimitExceeded))
+		assert.Contains(t, err.Error(), "transaction is estimated to cost 1001 wei")
+	})
+
+	t.Run("ETH_TX_LIMIT_MAX_COST_PER_TX_WEI with an estimator", func(t *testing.T) {
+		config.Set("ETH_TX_LIMIT_MAX_COST_PER_TX_WEI", 700)
+		defer config.Set("ETH_TX_LIMIT_MAX_COST_PER_TX_WEI", 0)
+
+		estimator := new(gasmocks.Estimator)
+		estimator.On("EstimateGas", []byte{1}, uint64(100)).Return(big.NewInt(7), uint64(100), nil)
+		err := bulletprooftxmanager.CheckTxLimits(db, config, estimator, jobID, fromAddress, big.NewInt(1), []byte{1}, 100)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "transaction is estimated to cost 701 wei")
+		estimator.AssertExpectations(t)
+
+		// An estimator without an estimate falls back to ETH_GAS_PRICE_DEFAULT
+		estimator = new(gasmocks.Estimator)
+		estimator.On("EstimateGas", []byte{1}, uint64(100)).Return(nil, uint64(0), errors.New("not started"))
+		require.NoError(t, bulletprooftxmanager.CheckTxLimits(db, config, estimator, jobID, fromAddress, big.NewInt(1), []byte{1}, 100))
+		estimator.AssertExpectations(t)
+	})
+
+	t.Run("ETH_TX_LIMIT_MAX_COST_PER_TX_WEI with EIP-1559 transactions", func(t *testing.T) {
+		config.Set("ETH_TX_LIMIT_MAX_COST_PER_TX_WEI", 800)
+		defer config.Set("ETH_TX_LIMIT_MAX_COST_PER_TX_WEI", 0)
+		config.Set("ETH_EIP1559_DYNAMIC_FEES", true)
+		defer config.Set("ETH_EIP1559_DYNAMIC_FEES", false)
+
+		estimator := new(gasmocks.Estimator)
+		estimator.On("GetDynamicFee", uint64(100)).Return(gas.DynamicFee{FeeCap: big.NewInt(8), TipCap: big.NewInt(1)}, uint64(100), nil)
+		err := bulletprooftxmanager.CheckTxLimits(db, config, estimator, jobID, fromAddress, big.NewInt(1), nil, 100)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "transaction is estimated to cost 801 wei")
+		estimator.AssertExpectations(t)
+	})
+
+	t.Run("ETH_TX_LIMIT_MAX_VALUE_PER_TX_WEI", func(t *testing.T) {
+		config.Set("ETH_TX_LIMIT_MAX_VALUE_PER_TX_WEI", 100)
+		defer config.Set("ETH_TX_LIMIT_MAX_VALUE_PER_TX_WEI", 0)
+
+		// Gas does not count towards the value
+		require.NoError(t, check(jobID, fromAddress, 100, 1e9))
+		err := check(0, fromAddress, 101, 1)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, bulletprooftxmanager.ErrTxLimitExceeded))
+		assert.Contains(t, err.Error(), "exceeds ETH_TX_LIMIT_MAX_VALUE_PER_TX_WEI of 100 wei")
+	})
+
+	require.NoError(t, db.Exec(`DELETE FROM eth_txes`).Error)
+
+	t.Run("ETH_TX_LIMIT_MAX_TXS_PER_KEY", func(t *testing.T) {
+		config.Set("ETH_TX_LIMIT_MAX_TXS_PER_KEY", 2)
+		defer config.Set("ETH_TX_LIMIT_MAX_TXS_PER_KEY", 0)
+		defer db.Exec(`DELETE FROM eth_txes`)
+
+		cltest.MustInsertUnstartedEthTx(t, db, fromAddress)
+		// Fatally errored transactions were never sent
+		cltest.MustInsertFatalErrorEthTx(t, db, fromAddress)
+		require.NoError(t, check(0, fromAddress, 0, 1))
+
+		cltest.MustInsertUnconfirmedEthTxWithBroadcastAttempt(t, db, 0, fromAddress)
+		err := check(0, fromAddress, 0, 1)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, bulletprooftxmanager.ErrTxLimitExceeded))
+
+		require.NoError(t, check(0, otherAddress, 0, 1))
+	})
+
+	t.Run("ETH_TX_LIMIT_MAX_TXS_PER_JOB", func(t *testing.T) {
+		config.Set("ETH_TX_LIMIT_MAX_TXS_PER_JOB", 1)
+		defer config.Set("ETH_TX_LIMIT_MAX_TXS_PER_JOB", 0)
+		defer db.Exec(`DELETE FROM eth_txes`)
+
+		cltest.MustInsertUnstartedEthTx(t, db, fromAddress)
+		require.NoError(t, check(jobID, fromAddress, 0, 1))
+
+		mustInsertJobEthTx(t, jobID)
+		err := check(jobID, otherAddress, 0, 1)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, bulletprooftxmanager.ErrTxLimitExceeded))
+
+		require.NoError(t, check(jobID+1, fromAddress, 0, 1))
+	})
+
+	t.Run("ETH_TX_LIMIT_MAX_DAILY_SPEND_PER_KEY_WEI", func(t *testing.T) {
+		defer db.Exec(`DELETE FROM eth_txes`)
+
+		// Costs 142 + 1e9 * 1 (gas price of the attempt)
+		etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastAttempt(t, db, 0, fromAddress)
+		require.Equal(t, int64(1), etx.EthTxAttempts[0].GasPrice.ToInt().Int64())
+		// Costs 142 + 1e9 * 5 (ETH_GAS_PRICE_DEFAULT) as it was not broadcast yet
+		cltest.MustInsertUnstartedEthTx(t, db, fromAddress)
+
+		config.Set("ETH_TX_LIMIT_MAX_DAILY_SPEND_PER_KEY_WEI", 6000000284+50)
+		defer config.Set("ETH_TX_LIMIT_MAX_DAILY_SPEND_PER_KEY_WEI", 0)
+
+		require.NoError(t, check(0, fromAddress, 0, 10))
+		err := check(0, fromAddress, 1, 10)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, bulletprooftxmanager.ErrTxLimitExceeded))
+		assert.Contains(t, err.Error(), "has spent 6000000284 wei")
+
+		require.NoError(t, check(0, otherAddress, 1, 10))
+	})
+
+	t.Run("ETH_TX_LIMIT_MAX_DAILY_SPEND_PER_KEY_WEI charges mined transactions at the price of the mined attempt", func(t *testing.T) {
+		defer db.Exec(`DELETE FROM eth_txes`)
+
+		// Costs 142 + 1e9 * 2, the later attempt at 3 was not mined
+		etx := cltest.MustInsertUnconfirmedEthTx(t, db, 0, fromAddress)
+		mined := cltest.MustInsertBroadcastEthTxAttempt(t, etx.ID, db, 2)
+		cltest.MustInsertBroadcastEthTxAttempt(t, etx.ID, db, 3)
+		cltest.MustInsertEthReceipt(t, db, 42, utils.NewHash(), mined.Hash)
+
+		config.Set("ETH_TX_LIMIT_MAX_DAILY_SPEND_PER_KEY_WEI", 2000000142)
+		defer config.Set("ETH_TX_LIMIT_MAX_DAILY_SPEND_PER_KEY_WEI", 0)
+
+		require.NoError(t, check(0, fromAddress, 0, 0))
+		err := check(0, fromAddress, 1, 0)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "has spent 2000000142 wei")
+	})
+
+	t.Run("ETH_TX_LIMIT_MAX_DAILY_SPEND_PER_JOB_WEI", func(t *testing.T) {
+		defer db.Exec(`DELETE FROM eth_txes`)
+
+		// Costs 142 + 1e9 * 5 (ETH_GAS_PRICE_DEFAULT)
+		mustInsertJobEthTx(t, jobID)
+		cltest.MustInsertUnstartedEthTx(t, db, fromAddress)
+
+		config.Set("ETH_TX_LIMIT_MAX_DAILY_SPEND_PER_JOB_WEI", 5000000142+50)
+		defer config.Set("ETH_TX_LIMIT_MAX_DAILY_SPEND_PER_JOB_WEI", 0)
+
+		require.NoError(t, check(jobID, fromAddress, 0, 10))
+		err := check(jobID, fromAddress, 1, 10)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, bulletprooftxmanager.ErrTxLimitExceeded))
+
+		require.NoError(t, check(jobID+1, fromAddress, 1, 10))
+	})
+
+	t.Run("keys override the config", func(t *testing.T) {
+		defer db.Exec(`DELETE FROM eth_txes`)
+		defer db.Exec(`UPDATE keys SET eth_tx_limit_max_txs = NULL, eth_tx_limit_max_daily_spend_wei = NULL, eth_tx_limit_max_value_per_tx_wei = NULL`)
+		config.Set("ETH_TX_LIMIT_MAX_TXS_PER_KEY", 1)
+		defer config.Set("ETH_TX_LIMIT_MAX_TXS_PER_KEY", 0)
+
+		require.NoError(t, db.Exec(`UPDATE keys SET eth_tx_limit_max_txs = 2, eth_tx_limit_max_value_per_tx_wei = 10 WHERE address = ?`, fromAddress).Error)
+		// A limit of 0 disables the limit of the config
+		require.NoError(t, db.Exec(`UPDATE keys SET eth_tx_limit_max_txs = 0 WHERE address = ?`, otherAddress).Error)
+
+		cltest.MustInsertUnstartedEthTx(t, db, fromAddress)
+		cltest.MustInsertUnstartedEthTx(t, db, otherAddress)
+		require.NoError(t, check(0, fromAddress, 10, 1))
+		require.NoError(t, check(0, otherAddress, 11, 1))
+
+		err := check(0, fromAddress, 11, 1)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "exceeds the key's maxValuePerTxWei of 10 wei")
+
+		cltest.MustInsertUnstartedEthTx(t, db, fromAddress)
+		err = check(0, fromAddress, 0, 1)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "which reaches the key's maxTxs of 2")
+	})
+
+	t.Run("jobs override the config", func(t *testing.T) {
+		defer db.Exec(`DELETE FROM eth_txes`)
+		jb, _ := cltest.MustInsertWebhookSpec(t, db)
+		require.NoError(t, db.Exec(`UPDATE jobs SET eth_tx_limit_max_daily_spend_wei = ? WHERE id = ?`, 5000000142+50, jb.ID).Error)
+
+		// Costs 142 + 1e9 * 5 (ETH_GAS_PRICE_DEFAULT)
+		mustInsertJobEthTx(t, jb.ID)
+
+		require.NoError(t, check(jb.ID, fromAddress, 0, 10))
+		err := check(jb.ID, fromAddress, 1, 10)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, bulletprooftxmanager.ErrTxLimitExceeded))
+		assert.Contains(t, err.Error(), "would exceed the job's ethTxLimitMaxDailySpendWei of 5000000192 wei")
+	})
+}
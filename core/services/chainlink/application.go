@@ -17,10 +17,12 @@ import (
 	"github.com/gobuffalo/packr"
 	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
 	"github.com/smartcontractkit/chainlink/core/gracefulpanic"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/service"
 	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/services/audit"
 	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
 	"github.com/smartcontractkit/chainlink/core/services/cron"
 	"github.com/smartcontractkit/chainlink/core/services/directrequest"
@@ -63,9 +65,11 @@ type Application interface {
 	GetHealthChecker() health.Checker
 	GetStore() *strpkg.Store
 	GetEthClient() eth.Client
+	GetChainSet() evm.ChainSet
 	GetConfig() *config.Config
 	GetKeyStore() *keystore.Master
 	GetHeadBroadcaster() httypes.HeadBroadcasterRegistry
+	GetAuditLogger() audit.Logger
 	WakeSessionReaper()
 	NewBox() packr.Box
 
@@ -76,9 +80,11 @@ type Application interface {
 	JobORM() job.ORM
 	PipelineORM() pipeline.ORM
 	AddJobV2(ctx context.Context, job job.Job, name null.String) (job.Job, error)
+	UpdateJob(ctx context.Context, jobID int32, job job.Job) (job.Job, error)
 	DeleteJob(ctx context.Context, jobID int32) error
 	RunWebhookJobV2(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta pipeline.JSONSerializable) (int64, error)
 	ResumeJobV2(ctx context.Context, run *pipeline.Run) (bool, error)
+	SimulateJobV2(ctx context.Context, jb job.Job, vars map[string]interface{}) (pipeline.Run, *pipeline.Simulation, error)
 	// Testing only
 	RunJobV2(ctx context.Context, jobID int32, meta map[string]interface{}) (int64, error)
 	SetServiceLogger(ctx context.Context, service string, level zapcore.Level) error
@@ -98,6 +104,7 @@ type ChainlinkApplication struct {
 	HeadTracker              httypes.Tracker
 	HeadBroadcaster          httypes.HeadBroadcaster
 	TxManager                bulletprooftxmanager.TxManager
+	ChainSet                 evm.ChainSet
 	LogBroadcaster           log.Broadcaster
 	EventBroadcaster         postgres.EventBroadcaster
 	jobORM                   job.ORM
@@ -111,6 +118,7 @@ type ChainlinkApplication struct {
 	Config                   *config.Config
 	KeyStore                 *keystore.Master
 	ExternalInitiatorManager webhook.ExternalInitiatorManager
+	AuditLogger              audit.Logger
 	SessionReaper            utils.SleeperTask
 	shutdownOnce             sync.Once
 	shutdownSignal           gracefulpanic.Signal
@@ -145,6 +153,13 @@ func NewApplication(cfg *config.Config, ethClient eth.Client, advisoryLocker pos
 
 	scryptParams := utils.GetScryptParams(cfg)
 	keyStore := keystore.New(store.DB, scryptParams)
+	if cfg.EthRemoteSignerURL() != nil {
+		signer, err := keystore.NewRemoteEthSigner(cfg.EthRemoteSignerURL().String())
+		if err != nil {
+			return nil, err
+		}
+		keyStore.Eth().SetRemoteSigner(signer)
+	}
 
 	explorerClient := synchronization.ExplorerClient(&synchronization.NoopExplorerClient{})
 	monitoringEndpoint := ocrtypes.MonitoringEndpoint(&telemetry.NoopAgent{})
@@ -158,7 +173,10 @@ func NewApplication(cfg *config.Config, ethClient eth.Client, advisoryLocker pos
 	if cfg.DatabaseBackupMode() != config.DatabaseBackupModeNone && cfg.DatabaseBackupFrequency() > 0 {
 		logger.Infow("DatabaseBackup: periodic database backups are enabled", "frequency", cfg.DatabaseBackupFrequency())
 
-		databaseBackup := periodicbackup.NewDatabaseBackup(cfg, logger.Default)
+		databaseBackup, err := periodicbackup.NewDatabaseBackup(cfg, logger.Default)
+		if err != nil {
+			return nil, err
+		}
 		subservices = append(subservices, databaseBackup)
 	} else {
 		logger.Info("DatabaseBackup: periodic database backups are disabled. To enable automatic backups, set DATABASE_BACKUP_MODE=lite or DATABASE_BACKUP_MODE=full")
@@ -218,33 +236,64 @@ func NewApplication(cfg *config.Config, ethClient eth.Client, advisoryLocker pos
 	promReporter := services.NewPromReporter(store.MustSQLDB())
 	subservices = append(subservices, promReporter)
 
+	auditLogger := audit.NewLogger(store.DB, cfg.AuditLogFile())
+	subservices = append(subservices, auditLogger)
+
+	defaultChain := evm.NewDefaultChain(cfg, ethClient, headBroadcaster, headTracker, logBroadcaster, txManager)
+	chainSet := evm.NewChainSet(defaultChain, evm.ChainSetOpts{
+		Config:           cfg,
+		DB:               store.DB,
+		KeyStore:         keyStore.Eth(),
+		AdvisoryLocker:   advisoryLocker,
+		EventBroadcaster: eventBroadcaster,
+		Logger:           headTrackerLogger,
+	})
+
 	var (
 		pipelineORM    = pipeline.NewORM(store.DB)
-		pipelineRunner = pipeline.NewRunner(pipelineORM, cfg, ethClient, keyStore.Eth(), keyStore.VRF(), txManager)
+		pipelineRunner = pipeline.NewRunner(pipelineORM, cfg, chainSet, keyStore.Eth(), keyStore.VRF())
 		jobORM         = job.NewORM(store.ORM.DB, cfg, pipelineORM, eventBroadcaster, advisoryLocker)
 	)
 
 	var (
 		delegates = map[job.Type]job.Delegate{
-			job.DirectRequest: directrequest.NewDelegate(
-				logBroadcaster,
-				pipelineRunner,
-				pipelineORM,
-				ethClient,
-				store.DB,
-				cfg,
-			),
-			job.Keeper: keeper.NewDelegate(store.DB, txManager, jobORM, pipelineRunner, ethClient, headBroadcaster, logBroadcaster, cfg),
-			job.VRF: vrf.NewDelegate(
-				store.DB,
-				txManager,
-				keyStore,
-				pipelineRunner,
-				pipelineORM,
-				logBroadcaster,
-				headBroadcaster,
-				ethClient,
-				cfg),
+			job.DirectRequest: job.NewChainDelegate(job.DirectRequest, func(evmChainID *big.Int) (job.Delegate, error) {
+				chain, err2 := chainSet.Get(evmChainID)
+				if err2 != nil {
+					return nil, err2
+				}
+				return directrequest.NewDelegate(
+					chain.LogBroadcaster(),
+					pipelineRunner,
+					pipelineORM,
+					chain.Client(),
+					store.DB,
+					chain.Config(),
+				), nil
+			}),
+			job.Keeper: job.NewChainDelegate(job.Keeper, func(evmChainID *big.Int) (job.Delegate, error) {
+				chain, err2 := chainSet.Get(evmChainID)
+				if err2 != nil {
+					return nil, err2
+				}
+				return keeper.NewDelegate(store.DB, chain.TxManager(), jobORM, pipelineRunner, chain.Client(), chain.HeadBroadcaster(), chain.LogBroadcaster(), chain.Config()), nil
+			}),
+			job.VRF: job.NewChainDelegate(job.VRF, func(evmChainID *big.Int) (job.Delegate, error) {
+				chain, err2 := chainSet.Get(evmChainID)
+				if err2 != nil {
+					return nil, err2
+				}
+				return vrf.NewDelegate(
+					store.DB,
+					chain.TxManager(),
+					keyStore,
+					pipelineRunner,
+					pipelineORM,
+					chain.LogBroadcaster(),
+					chain.HeadBroadcaster(),
+					chain.Client(),
+					chain.Config()), nil
+			}),
 		}
 	)
 
@@ -252,44 +301,58 @@ func NewApplication(cfg *config.Config, ethClient eth.Client, advisoryLocker pos
 	if cfg.EthereumDisabled() {
 		delegates[job.FluxMonitor] = &job.NullDelegate{Type: job.FluxMonitor}
 	} else if cfg.Dev() || cfg.FeatureFluxMonitorV2() {
-		delegates[job.FluxMonitor] = fluxmonitorv2.NewDelegate(
-			txManager,
-			keyStore.Eth(),
-			jobORM,
-			pipelineORM,
-			pipelineRunner,
-			store.DB,
-			ethClient,
-			logBroadcaster,
-			fluxmonitorv2.Config{
-				DefaultHTTPTimeout:             cfg.DefaultHTTPTimeout().Duration(),
-				FlagsContractAddress:           cfg.FlagsContractAddress(),
-				MinContractPayment:             cfg.MinimumContractPayment(),
-				EthGasLimit:                    cfg.EthGasLimitDefault(),
-				EthMaxQueuedTransactions:       cfg.EthMaxQueuedTransactions(),
-				FMDefaultTransactionQueueDepth: cfg.FMDefaultTransactionQueueDepth(),
-			},
-		)
+		delegates[job.FluxMonitor] = job.NewChainDelegate(job.FluxMonitor, func(evmChainID *big.Int) (job.Delegate, error) {
+			chain, err2 := chainSet.Get(evmChainID)
+			if err2 != nil {
+				return nil, err2
+			}
+			chainCfg := chain.Config()
+			return fluxmonitorv2.NewDelegate(
+				chain.TxManager(),
+				keyStore.Eth(),
+				jobORM,
+				pipelineORM,
+				pipelineRunner,
+				store.DB,
+				chain.Client(),
+				chain.LogBroadcaster(),
+				fluxmonitorv2.Config{
+					DefaultHTTPTimeout:             chainCfg.DefaultHTTPTimeout().Duration(),
+					FlagsContractAddress:           chainCfg.FlagsContractAddress(),
+					MinContractPayment:             chainCfg.MinimumContractPayment(),
+					EthGasLimit:                    chainCfg.EthGasLimitDefault(),
+					EthMaxQueuedTransactions:       chainCfg.EthMaxQueuedTransactions(),
+					FMDefaultTransactionQueueDepth: chainCfg.FMDefaultTransactionQueueDepth(),
+					FMSimulateTransactions:         chainCfg.FMSimulateTransactions(),
+				},
+			), nil
+		})
 	}
 
 	if (cfg.Dev() && cfg.P2PListenPort() > 0) || cfg.FeatureOffchainReporting() {
 		logger.Debug("Off-chain reporting enabled")
 		concretePW := offchainreporting.NewSingletonPeerWrapper(keyStore.OCR(), cfg, store.DB)
 		subservices = append(subservices, concretePW)
-		delegates[job.OffchainReporting] = offchainreporting.NewDelegate(
-			store.DB,
-			txManager,
-			jobORM,
-			cfg,
-			keyStore.OCR(),
-			pipelineRunner,
-			ethClient,
-			logBroadcaster,
-			concretePW,
-			monitoringEndpoint,
-			cfg.Chain(),
-			headBroadcaster,
-		)
+		delegates[job.OffchainReporting] = job.NewChainDelegate(job.OffchainReporting, func(evmChainID *big.Int) (job.Delegate, error) {
+			chain, err2 := chainSet.Get(evmChainID)
+			if err2 != nil {
+				return nil, err2
+			}
+			return offchainreporting.NewDelegate(
+				store.DB,
+				chain.TxManager(),
+				jobORM,
+				chain.Config(),
+				keyStore.OCR(),
+				pipelineRunner,
+				chain.Client(),
+				chain.LogBroadcaster(),
+				concretePW,
+				monitoringEndpoint,
+				chain.Config().Chain(),
+				chain.HeadBroadcaster(),
+			), nil
+		})
 	} else {
 		logger.Debug("Off-chain reporting disabled")
 	}
@@ -308,13 +371,14 @@ func NewApplication(cfg *config.Config, ethClient eth.Client, advisoryLocker pos
 	}
 
 	jobSpawner := job.NewSpawner(jobORM, cfg, delegates, gormTxm)
-	subservices = append(subservices, jobSpawner, pipelineRunner, headBroadcaster)
+	subservices = append(subservices, chainSet, jobSpawner, pipelineRunner, headBroadcaster)
 
 	feedsORM := feeds.NewORM(store.DB)
 	feedsService := feeds.NewService(feedsORM, gormTxm, jobSpawner, keyStore.CSA(), keyStore.Eth(), cfg)
 
 	app := &ChainlinkApplication{
 		ethClient:                ethClient,
+		ChainSet:                 chainSet,
 		HeadBroadcaster:          headBroadcaster,
 		TxManager:                txManager,
 		LogBroadcaster:           logBroadcaster,
@@ -331,6 +395,7 @@ func NewApplication(cfg *config.Config, ethClient eth.Client, advisoryLocker pos
 		SessionReaper:            services.NewSessionReaper(store.DB, cfg),
 		Exiter:                   os.Exit,
 		ExternalInitiatorManager: externalInitiatorManager,
+		AuditLogger:              auditLogger,
 		shutdownSignal:           shutdownSignal,
 		balanceMonitor:           balanceMonitor,
 		explorerClient:           explorerClient,
@@ -361,6 +426,14 @@ func NewApplication(cfg *config.Config, ethClient eth.Client, advisoryLocker pos
 		return nil, err
 	}
 
+	if reporter, ok := ethClient.(eth.NodeHealthReporter); ok {
+		for name, node := range reporter.NodeCheckables() {
+			if err = app.HealthChecker.Register(name, node); err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	return app, nil
 }
 
@@ -543,6 +616,12 @@ func (app *ChainlinkApplication) GetEthClient() eth.Client {
 	return app.ethClient
 }
 
+// GetChainSet returns the default chain along with the chains that are
+// added through the API
+func (app *ChainlinkApplication) GetChainSet() evm.ChainSet {
+	return app.ChainSet
+}
+
 func (app *ChainlinkApplication) GetConfig() *config.Config {
 	return app.Config
 }
@@ -575,6 +654,10 @@ func (app *ChainlinkApplication) GetExternalInitiatorManager() webhook.ExternalI
 	return app.ExternalInitiatorManager
 }
 
+func (app *ChainlinkApplication) GetAuditLogger() audit.Logger {
+	return app.AuditLogger
+}
+
 func (app *ChainlinkApplication) GetHeadBroadcaster() httypes.HeadBroadcasterRegistry {
 	return app.HeadBroadcaster
 }
@@ -588,6 +671,10 @@ func (app *ChainlinkApplication) AddJobV2(ctx context.Context, j job.Job, name n
 	return app.jobSpawner.CreateJob(ctx, j, name)
 }
 
+func (app *ChainlinkApplication) UpdateJob(ctx context.Context, jobID int32, j job.Job) (job.Job, error) {
+	return app.jobSpawner.UpdateJob(ctx, jobID, j)
+}
+
 func (app *ChainlinkApplication) DeleteJob(ctx context.Context, jobID int32) error {
 	return app.jobSpawner.DeleteJob(ctx, jobID)
 }
@@ -632,6 +719,19 @@ func (app *ChainlinkApplication) RunJobV2(
 				BlockNumber: 10,
 				BlockHash:   utils.NewHash(),
 			}
+			if jb.VRFSpec.CoordinatorVersion == job.VRFCoordinatorV2 {
+				testLog.Data = bytes.Join([][]byte{
+					utils.NewHash().Bytes(),                      // requestID
+					common.BigToHash(big.NewInt(42)).Bytes(),     // preSeed
+					common.BigToHash(big.NewInt(3)).Bytes(),      // minimumRequestConfirmations
+					common.BigToHash(big.NewInt(100000)).Bytes(), // callbackGasLimit
+					common.BigToHash(big.NewInt(1)).Bytes()},     // numWords
+					[]byte{})
+				testLog.Topics = []common.Hash{{},
+					jb.VRFSpec.PublicKey.MustHash(),                  // key hash
+					common.BigToHash(big.NewInt(1)),                  // subID
+					common.BytesToHash(utils.NewHash().Bytes()[:20])} // sender
+			}
 			vars = map[string]interface{}{
 				"jobSpec": map[string]interface{}{
 					"databaseID":    jb.ID,
@@ -672,6 +772,26 @@ func (app *ChainlinkApplication) ResumeJobV2(
 	return app.pipelineRunner.Run(ctx, run, *logger.Default, false)
 }
 
+// SimulateJobV2 executes the pipeline of a job that has been validated but
+// not saved with the given vars, without side effects; see
+// pipeline.Runner.SimulateRun. Nothing is saved to the database.
+func (app *ChainlinkApplication) SimulateJobV2(
+	ctx context.Context,
+	jb job.Job,
+	vars map[string]interface{},
+) (pipeline.Run, *pipeline.Simulation, error) {
+	if jb.Pipeline.Source == "" {
+		return pipeline.Run{}, nil, errors.Errorf("%s jobs have no pipeline to simulate", jb.Type)
+	}
+	spec := pipeline.Spec{
+		DotDagSource:    jb.Pipeline.Source,
+		MaxTaskDuration: jb.MaxTaskDuration,
+		JobName:         jb.Name.ValueOrZero(),
+	}
+	run, _, sim, err := app.pipelineRunner.SimulateRun(ctx, spec, pipeline.NewVarsFrom(vars), *logger.Default)
+	return run, sim, err
+}
+
 func (app *ChainlinkApplication) GetFeedsService() feeds.Service {
 	return app.FeedsService
 }
@@ -5,10 +5,12 @@ import (
 	"github.com/pkg/errors"
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
 type DirectRequestToml struct {
 	ContractAddress ethkey.EIP55Address `toml:"contractAddress"`
+	EVMChainID      *utils.Big          `toml:"evmChainID"`
 }
 
 func ValidatedDirectRequestSpec(tomlString string) (job.Job, error) {
@@ -26,7 +28,7 @@ func ValidatedDirectRequestSpec(tomlString string) (job.Job, error) {
 	if err != nil {
 		return jb, err
 	}
-	jb.DirectRequestSpec = &job.DirectRequestSpec{ContractAddress: spec.ContractAddress}
+	jb.DirectRequestSpec = &job.DirectRequestSpec{ContractAddress: spec.ContractAddress, EVMChainID: spec.EVMChainID}
 
 	if jb.Type != job.DirectRequest {
 		return jb, errors.Errorf("unsupported type %s", jb.Type)
@@ -1,6 +1,7 @@
 package directrequest
 
 import (
+	"math/big"
 	"testing"
 	"time"
 
@@ -33,4 +34,23 @@ observationSource   = """
 	assert.NotZero(t, s.ExternalJobID.Bytes()[:])
 	assert.Equal(t, time.Time{}, s.DirectRequestSpec.CreatedAt)
 	assert.Equal(t, time.Time{}, s.DirectRequestSpec.UpdatedAt)
+	assert.Nil(t, s.EVMChainID())
+}
+
+func TestValidatedDirectRequestSpec_EVMChainID(t *testing.T) {
+	toml := `
+type                = "directrequest"
+schemaVersion       = 1
+contractAddress     = "0x613a38AC1659769640aaE063C651F48E0250454C"
+evmChainID          = 42
+observationSource   = """
+    ds1          [type=http method=GET url="example.com" allowunrestrictednetworkaccess="true"];
+"""
+`
+
+	s, err := ValidatedDirectRequestSpec(toml)
+	require.NoError(t, err)
+
+	assert.Equal(t, "42", s.DirectRequestSpec.EVMChainID.String())
+	assert.Equal(t, big.NewInt(42), s.EVMChainID())
 }
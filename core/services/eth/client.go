@@ -12,6 +12,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/health"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils"
 
@@ -91,7 +92,7 @@ func DefaultQueryCtx(ctxs ...context.Context) (ctx context.Context, cancel conte
 // client represents an abstract client that manages connections to
 // multiple ethereum nodes
 type client struct {
-	primary     *node
+	pool        *pool
 	secondaries []*secondarynode
 	mocked      bool
 
@@ -99,8 +100,17 @@ type client struct {
 }
 
 var _ Client = (*client)(nil)
+var _ NodeHealthReporter = (*client)(nil)
 
+// NewClient creates a client with a single primary node
 func NewClient(rpcUrl string, rpcHTTPURL *url.URL, secondaryRPCURLs []url.URL) (*client, error) {
+	return NewClientWithPrimaries(rpcUrl, rpcHTTPURL, nil, secondaryRPCURLs)
+}
+
+// NewClientWithPrimaries creates a client with a pool of primary nodes. The
+// node at rpcUrl (and rpcHTTPURL if set) is always the first primary; any
+// additionalPrimaryURLs must also be websocket urls.
+func NewClientWithPrimaries(rpcUrl string, rpcHTTPURL *url.URL, additionalPrimaryURLs []url.URL, secondaryRPCURLs []url.URL) (*client, error) {
 	parsed, err := url.ParseRequestURI(rpcUrl)
 	if err != nil {
 		return nil, err
@@ -112,8 +122,14 @@ func NewClient(rpcUrl string, rpcHTTPURL *url.URL, secondaryRPCURLs []url.URL) (
 
 	c := client{}
 
-	// for now only one primary is supported
-	c.primary = newNode(*parsed, rpcHTTPURL, "eth-primary-0")
+	primaries := []*node{newNode(*parsed, rpcHTTPURL, "eth-primary-0")}
+	for i, url := range additionalPrimaryURLs {
+		if url.Scheme != "ws" && url.Scheme != "wss" {
+			return nil, errors.Errorf("primary ethereum url scheme must be websocket: %s", url.String())
+		}
+		primaries = append(primaries, newNode(url, nil, fmt.Sprintf("eth-primary-%d", i+1)))
+	}
+	c.pool = newPool(primaries)
 
 	for i, url := range secondaryRPCURLs {
 		if url.Scheme != "http" && url.Scheme != "https" {
@@ -129,7 +145,7 @@ func (client *client) Dial(ctx context.Context) error {
 	if client.mocked {
 		return nil
 	}
-	if err := client.primary.Dial(ctx); err != nil {
+	if err := client.pool.Dial(ctx); err != nil {
 		return err
 	}
 
@@ -143,7 +159,16 @@ func (client *client) Dial(ctx context.Context) error {
 }
 
 func (client *client) Close() {
-	client.primary.Close()
+	client.pool.Close()
+}
+
+// NodeCheckables returns a health.Checkable for each primary node
+func (client *client) NodeCheckables() map[string]health.Checkable {
+	return client.pool.NodeCheckables()
+}
+
+func (client *client) primary() *node {
+	return client.pool.Active()
 }
 
 // CallArgs represents the data used to call the balance method of a contract.
@@ -192,7 +217,7 @@ func (client *client) GetEthBalance(ctx context.Context, account common.Address,
 // We wrap the GethClient's `TransactionReceipt` method so that we can ignore the error that arises
 // when we're talking to a Parity node that has no receipt yet.
 func (client *client) TransactionReceipt(ctx context.Context, txHash common.Hash) (receipt *types.Receipt, err error) {
-	receipt, err = client.primary.TransactionReceipt(ctx, txHash)
+	receipt, err = client.primary().TransactionReceipt(ctx, txHash)
 
 	if err != nil && strings.Contains(err.Error(), "missing required field") {
 		return nil, ethereum.NotFound
@@ -201,11 +226,11 @@ func (client *client) TransactionReceipt(ctx context.Context, txHash common.Hash
 }
 
 func (client *client) ChainID(ctx context.Context) (*big.Int, error) {
-	return client.primary.ChainID(ctx)
+	return client.primary().ChainID(ctx)
 }
 
 func (client *client) HeaderByNumber(ctx context.Context, n *big.Int) (*types.Header, error) {
-	return client.primary.HeaderByNumber(ctx, n)
+	return client.primary().HeaderByNumber(ctx, n)
 }
 
 // SendTransaction also uses the secondary HTTP RPC URLs if set
@@ -227,44 +252,44 @@ func (client *client) SendTransaction(ctx context.Context, tx *types.Transaction
 		}(s)
 	}
 
-	return client.primary.SendTransaction(ctx, tx)
+	return client.primary().SendTransaction(ctx, tx)
 }
 
 func (client *client) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
-	return client.primary.PendingNonceAt(ctx, account)
+	return client.primary().PendingNonceAt(ctx, account)
 }
 
 func (client *client) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
-	return client.primary.NonceAt(ctx, account, blockNumber)
+	return client.primary().NonceAt(ctx, account, blockNumber)
 }
 
 func (client *client) PendingCodeAt(ctx context.Context, account common.Address) ([]byte, error) {
-	return client.primary.PendingCodeAt(ctx, account)
+	return client.primary().PendingCodeAt(ctx, account)
 }
 
 func (client *client) EstimateGas(ctx context.Context, call ethereum.CallMsg) (gas uint64, err error) {
-	return client.primary.EstimateGas(ctx, call)
+	return client.primary().EstimateGas(ctx, call)
 }
 
 func (client *client) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
-	return client.primary.SuggestGasPrice(ctx)
+	return client.primary().SuggestGasPrice(ctx)
 }
 
 func (client *client) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
-	return client.primary.CallContract(ctx, msg, blockNumber)
+	return client.primary().CallContract(ctx, msg, blockNumber)
 }
 
 func (client *client) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
-	return client.primary.CodeAt(ctx, account, blockNumber)
+	return client.primary().CodeAt(ctx, account, blockNumber)
 }
 
 func (client *client) BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error) {
-	return client.primary.BlockByNumber(ctx, number)
+	return client.primary().BlockByNumber(ctx, number)
 }
 
 func (client *client) HeadByNumber(ctx context.Context, number *big.Int) (head *models.Head, err error) {
 	hex := toBlockNumArg(number)
-	err = client.primary.CallContext(ctx, &head, "eth_getBlockByNumber", hex, false)
+	err = client.primary().CallContext(ctx, &head, "eth_getBlockByNumber", hex, false)
 	if err == nil && head == nil {
 		err = ethereum.NotFound
 	}
@@ -279,40 +304,44 @@ func toBlockNumArg(number *big.Int) string {
 }
 
 func (client *client) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
-	return client.primary.BalanceAt(ctx, account, blockNumber)
+	return client.primary().BalanceAt(ctx, account, blockNumber)
 }
 
 func (client *client) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
-	return client.primary.FilterLogs(ctx, q)
+	return client.primary().FilterLogs(ctx, q)
 }
 
 func (client *client) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
 	logger.Debugw("eth.Client#SubscribeFilterLogs(...)",
 		"q", q,
 	)
-	return client.primary.SubscribeFilterLogs(ctx, q, ch)
+	return client.pool.Subscribe(ctx, func(ctx context.Context, n *node) (ethereum.Subscription, error) {
+		return n.SubscribeFilterLogs(ctx, q, ch)
+	})
 }
 
 func (client *client) SubscribeNewHead(ctx context.Context, ch chan<- *models.Head) (ethereum.Subscription, error) {
-	return client.primary.EthSubscribe(ctx, ch, "newHeads")
+	return client.EthSubscribe(ctx, ch, "newHeads")
 }
 
 func (client *client) EthSubscribe(ctx context.Context, channel interface{}, args ...interface{}) (ethereum.Subscription, error) {
-	return client.primary.EthSubscribe(ctx, channel, args...)
+	return client.pool.Subscribe(ctx, func(ctx context.Context, n *node) (ethereum.Subscription, error) {
+		return n.EthSubscribe(ctx, channel, args...)
+	})
 }
 
 func (client *client) Call(result interface{}, method string, args ...interface{}) error {
 	ctx, cancel := DefaultQueryCtx()
 	defer cancel()
-	return client.primary.CallContext(ctx, result, method, args...)
+	return client.primary().CallContext(ctx, result, method, args...)
 }
 
 func (client *client) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
-	return client.primary.CallContext(ctx, result, method, args...)
+	return client.primary().CallContext(ctx, result, method, args...)
 }
 
 func (client *client) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
-	return client.primary.BatchCallContext(ctx, b)
+	return client.primary().BatchCallContext(ctx, b)
 }
 
 // RoundRobinBatchCallContext rotates through Primary and all Secondaries, changing node on each call
@@ -334,5 +363,5 @@ func (client *client) RoundRobinBatchCallContext(ctx context.Context, b []rpc.Ba
 }
 
 func (client *client) SuggestGasTipCap(ctx context.Context) (tipCap *big.Int, err error) {
-	return client.primary.SuggestGasTipCap(ctx)
+	return client.primary().SuggestGasTipCap(ctx)
 }
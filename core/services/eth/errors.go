@@ -115,6 +115,16 @@ func (s *SendError) is(errorType int) bool {
 
 var hexDataRegex = regexp.MustCompile(`0x\w+$`)
 
+// Geth returns "execution reverted", followed by the reason if there is one.
+// Parity returns "VM execution error." with the revert data in the data field.
+var executionReverted = regexp.MustCompile(`(?i)(: |^)(execution reverted|vm execution error)`)
+
+// IsExecutionReverted returns true if the error was returned for an eth_call
+// that reverted
+func IsExecutionReverted(err error) bool {
+	return err != nil && executionReverted.MatchString(errors.Cause(err).Error())
+}
+
 // IsReplacementUnderpriced indicates that a transaction already exists in the mempool with this nonce but a different gas price or payload
 func (s *SendError) IsReplacementUnderpriced() bool {
 	return s.is(ReplacementTransactionUnderpriced)
@@ -217,7 +227,8 @@ func (err *JsonError) Error() string {
 }
 
 // ExtractRevertReasonFromRPCError attempts to extract the revert reason from the response of
-// an RPC eth_call that reverted by parsing the message from the "data" field
+// an RPC eth_call that reverted by parsing the message from the "data" field,
+// which is decoded with DecodeRevertReason if possible
 // ex:
 // kovan (parity)
 // { "error": { "code" : -32015, "data": "Reverted 0xABC123...", "message": "VM execution error." } } // revert reason always omitted
@@ -249,6 +260,12 @@ func ExtractRevertReasonFromRPCError(err error) (string, error) {
 	if len(hexData) < 8 {
 		return "", errors.New("unknown data payload format")
 	}
+	if data, err := hex.DecodeString(hexData); err == nil {
+		if revertReason, err := DecodeRevertReason(data); err == nil {
+			return revertReason, nil
+		}
+	}
+	// Fall back to reading everything after the selector as a string
 	revertReasonBytes, err := hex.DecodeString(hexData[8:])
 	if err != nil {
 		return "", errors.Wrap(err, "unable to decode hex to bytes")
@@ -258,6 +258,25 @@ func Test_Eth_Errors_Fatal(t *testing.T) {
 	}
 }
 
+func Test_IsExecutionReverted(t *testing.T) {
+	tests := []struct {
+		err      error
+		expected bool
+	}{
+		{nil, false},
+		{errors.New("execution reverted"), true},
+		{errors.New("execution reverted: important revert reason"), true},
+		{errors.Wrap(&eth.JsonError{Code: 3, Message: "execution reverted: foo"}, "eth_call failed"), true},
+		{&eth.JsonError{Code: -32015, Message: "VM execution error.", Data: "Reverted 0x"}, true},
+		{errors.New("nonce too low"), false},
+		{errors.New("429 Too Many Requests"), false},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, eth.IsExecutionReverted(test.err), "%v", test.err)
+	}
+}
+
 func Test_ExtractRevertReasonFromRPCError(t *testing.T) {
 	message := "important revert reason"
 	messageHex := utils.RemoveHexPrefix(hexutil.Encode([]byte(message)))
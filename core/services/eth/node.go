@@ -5,13 +5,19 @@ import (
 	"fmt"
 	"math/big"
 	"net/url"
+	"strings"
+	"sync"
+	"time"
 
 	ethereum "github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/smartcontractkit/chainlink/core/logger"
 )
 
@@ -21,17 +27,93 @@ type rawclient struct {
 	uri  url.URL
 }
 
+var (
+	promEthNodeState = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "eth_node_state",
+		Help: "State of each primary eth node: 0 = undialed, 1 = alive, 2 = unreachable",
+	},
+		[]string{"node_name"},
+	)
+	promEthNodeHeadHeight = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "eth_node_head_height",
+		Help: "Latest block number reported by each primary eth node",
+	},
+		[]string{"node_name"},
+	)
+	promEthNodeLatency = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "eth_node_latency_seconds",
+		Help: "Moving average of the round trip time of polling each primary eth node",
+	},
+		[]string{"node_name"},
+	)
+	promEthNodeErrorRate = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "eth_node_error_rate",
+		Help: "Moving average of the fraction of calls to each primary eth node that failed",
+	},
+		[]string{"node_name"},
+	)
+)
+
+const (
+	// nodeMaxConsecutivePollFailures is the number of polls in a row that
+	// may fail before a node is considered unreachable
+	nodeMaxConsecutivePollFailures = 3
+	// nodeEWMAWeight is the weight given to each new sample when updating
+	// the moving averages for latency and error rate
+	nodeEWMAWeight = 0.1
+)
+
+// nodeState represents the current state of a primary node
+type nodeState int
+
+const (
+	nodeStateUndialed nodeState = iota
+	nodeStateAlive
+	nodeStateUnreachable
+)
+
+func (s nodeState) String() string {
+	switch s {
+	case nodeStateUndialed:
+		return "undialed"
+	case nodeStateAlive:
+		return "alive"
+	case nodeStateUnreachable:
+		return "unreachable"
+	default:
+		return fmt.Sprintf("nodeState(%d)", int(s))
+	}
+}
+
+// nodeStats is a snapshot of the health of a node
+type nodeStats struct {
+	state             nodeState
+	latestBlockNumber int64
+	latency           time.Duration
+	errorRate         float64
+}
+
 // node represents one ethereum node.
 // It must have a ws url and may have a http url
 type node struct {
-	ws     rawclient
-	http   *rawclient
-	log    *logger.Logger
+	ws   rawclient
+	http *rawclient
+	log  *logger.Logger
+	name string
+
+	// dialMu guards the clients while they are dialed, which may happen
+	// from the pool and from polls at the same time
+	dialMu sync.Mutex
 	dialed bool
+
+	statsMu             sync.RWMutex
+	stats               nodeStats
+	consecutiveFailures int
 }
 
 func newNode(wsuri url.URL, httpuri *url.URL, name string) (n *node) {
 	n = new(node)
+	n.name = name
 	n.log = logger.CreateLogger(logger.Default.With(
 		"nodeName", name,
 		"nodeTier", "primary",
@@ -43,9 +125,12 @@ func newNode(wsuri url.URL, httpuri *url.URL, name string) (n *node) {
 	return
 }
 
+// Dial connects to the node, unless it is already connected
 func (n *node) Dial(ctx context.Context) error {
+	n.dialMu.Lock()
+	defer n.dialMu.Unlock()
 	if n.dialed {
-		panic("eth.Client.Dial(...) should only be called once during the node's lifetime.")
+		return nil
 	}
 
 	{
@@ -56,33 +141,161 @@ func (n *node) Dial(ctx context.Context) error {
 		n.log.Debugw("eth.Client#Dial(...)", "wsuri", n.ws.uri.String(), "httpuri", httpuri)
 	}
 
-	{
-		uri := n.ws.uri.String()
-		rpc, err := rpc.DialWebsocket(ctx, uri, "")
+	var httprpc *rpc.Client
+	if n.http != nil {
+		var err error
+		httprpc, err = rpc.DialHTTP(n.http.uri.String())
 		if err != nil {
 			return err
 		}
-		n.dialed = true
-		n.ws.rpc = rpc
-		n.ws.geth = ethclient.NewClient(rpc)
 	}
 
-	if n.http != nil {
-		uri := n.http.uri.String()
-		rpc, err := rpc.DialHTTP(uri)
-		if err != nil {
-			return err
+	wsrpc, err := rpc.DialWebsocket(ctx, n.ws.uri.String(), "")
+	if err != nil {
+		if httprpc != nil {
+			httprpc.Close()
+		}
+		return err
+	}
+
+	if httprpc != nil {
+		n.http.rpc = httprpc
+		n.http.geth = ethclient.NewClient(httprpc)
+	}
+	n.ws.rpc = wsrpc
+	n.ws.geth = ethclient.NewClient(wsrpc)
+	n.dialed = true
+
+	n.setState(nodeStateAlive)
+
+	return nil
+}
+
+// Stats returns a snapshot of the current health of the node
+func (n *node) Stats() nodeStats {
+	n.statsMu.RLock()
+	defer n.statsMu.RUnlock()
+	return n.stats
+}
+
+func (n *node) State() nodeState {
+	return n.Stats().state
+}
+
+func (n *node) setState(state nodeState) {
+	n.statsMu.Lock()
+	defer n.statsMu.Unlock()
+	n.setStateLocked(state)
+}
+
+func (n *node) setStateLocked(state nodeState) {
+	if n.stats.state != state {
+		n.log.Infow(fmt.Sprintf("eth node state changed from %s to %s", n.stats.state, state), "state", state)
+	}
+	n.stats.state = state
+	promEthNodeState.WithLabelValues(n.name).Set(float64(state))
+}
+
+// Poll asks the node for its latest block number over the websocket
+// connection, recording the round trip time and the result
+func (n *node) Poll(ctx context.Context) {
+	if err := n.Dial(ctx); err != nil {
+		n.log.Debugw("eth.Client#Poll(...) failed to dial node", "err", err)
+		return
+	}
+
+	var blockNumber hexutil.Uint64
+	start := time.Now()
+	err := n.ws.rpc.CallContext(ctx, &blockNumber, "eth_blockNumber")
+	latency := time.Since(start)
+
+	n.statsMu.Lock()
+	defer n.statsMu.Unlock()
+	n.recordCallLocked(err)
+	if err != nil {
+		n.consecutiveFailures++
+		n.log.Debugw("eth.Client#Poll(...) failed", "err", err, "consecutiveFailures", n.consecutiveFailures)
+		if n.consecutiveFailures >= nodeMaxConsecutivePollFailures {
+			n.setStateLocked(nodeStateUnreachable)
 		}
-		n.http.rpc = rpc
-		n.http.geth = ethclient.NewClient(rpc)
+		return
+	}
+	n.consecutiveFailures = 0
+	n.stats.latestBlockNumber = int64(blockNumber)
+	if n.stats.latency == 0 {
+		n.stats.latency = latency
+	} else {
+		n.stats.latency = time.Duration(ewma(float64(n.stats.latency), float64(latency)))
+	}
+	n.setStateLocked(nodeStateAlive)
+	promEthNodeHeadHeight.WithLabelValues(n.name).Set(float64(n.stats.latestBlockNumber))
+	promEthNodeLatency.WithLabelValues(n.name).Set(n.stats.latency.Seconds())
+}
+
+// MarkUnreachable is called when the websocket connection to the node is
+// known to have failed, e.g. because a subscription returned an error
+func (n *node) MarkUnreachable() {
+	n.statsMu.Lock()
+	defer n.statsMu.Unlock()
+	n.consecutiveFailures = nodeMaxConsecutivePollFailures
+	n.setStateLocked(nodeStateUnreachable)
+}
+
+func (n *node) recordCall(err error) {
+	n.statsMu.Lock()
+	defer n.statsMu.Unlock()
+	n.recordCallLocked(err)
+}
+
+func (n *node) recordCallLocked(err error) {
+	var sample float64
+	if isNodeFailure(err) {
+		sample = 1
 	}
+	n.stats.errorRate = ewma(n.stats.errorRate, sample)
+	promEthNodeErrorRate.WithLabelValues(n.name).Set(n.stats.errorRate)
+}
 
+// isNodeFailure returns true if the error indicates a problem with the node
+// itself, rather than an error returned by the node in response to a
+// well-formed request (e.g. a revert or a nonce too low error)
+func isNodeFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+	cause := errors.Cause(err)
+	if cause == ethereum.NotFound || cause == context.Canceled {
+		return false
+	}
+	if _, is := cause.(rpc.Error); is {
+		return false
+	}
+	// Parity returns receipts that fail to decode when the tx is not yet mined
+	return !strings.Contains(err.Error(), "missing required field")
+}
+
+func ewma(avg, sample float64) float64 {
+	return avg*(1-nodeEWMAWeight) + sample*nodeEWMAWeight
+}
+
+// Ready implements health.Checkable. Nodes are always considered ready, since
+// the pool routes requests around any node that is not alive.
+func (n *node) Ready() error {
+	return nil
+}
+
+// Healthy implements health.Checkable
+func (n *node) Healthy() error {
+	stats := n.Stats()
+	if stats.state != nodeStateAlive {
+		return errors.Errorf("eth node %s is %s", n.name, stats.state)
+	}
 	return nil
 }
 
 // RPC wrappers
 
-func (n node) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+func (n *node) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
 	n.log.Debugw("eth.Client#Call(...)",
 		"method", method,
 		"args", args,
@@ -94,7 +307,7 @@ func (n node) CallContext(ctx context.Context, result interface{}, method string
 	return n.wrapWS(n.ws.rpc.CallContext(ctx, result, method, args...))
 }
 
-func (n node) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
+func (n *node) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
 	n.log.Debugw("eth.Client#BatchCall(...)",
 		"nBatchElems", len(b),
 		"mode", switching(n),
@@ -105,18 +318,25 @@ func (n node) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
 	return n.wrapWS(n.ws.rpc.BatchCallContext(ctx, b))
 }
 
-func (n node) EthSubscribe(ctx context.Context, channel interface{}, args ...interface{}) (ethereum.Subscription, error) {
+func (n *node) EthSubscribe(ctx context.Context, channel interface{}, args ...interface{}) (ethereum.Subscription, error) {
 	n.log.Debugw("eth.Client#EthSubscribe", "mode", "websocket")
 	return n.ws.rpc.EthSubscribe(ctx, channel, args...)
 }
 
-func (n node) Close() {
-	n.ws.rpc.Close()
+func (n *node) Close() {
+	n.dialMu.Lock()
+	defer n.dialMu.Unlock()
+	if n.ws.rpc != nil {
+		n.ws.rpc.Close()
+	}
+	if n.http != nil && n.http.rpc != nil {
+		n.http.rpc.Close()
+	}
 }
 
 // GethClient wrappers
 
-func (n node) TransactionReceipt(ctx context.Context, txHash common.Hash) (receipt *types.Receipt, err error) {
+func (n *node) TransactionReceipt(ctx context.Context, txHash common.Hash) (receipt *types.Receipt, err error) {
 	n.log.Debugw("eth.Client#TransactionReceipt(...)",
 		"txHash", txHash,
 		"mode", switching(n),
@@ -134,16 +354,16 @@ func (n node) TransactionReceipt(ctx context.Context, txHash common.Hash) (recei
 }
 
 // NOTE: ChainID may need a bit of rethinking if we implement multiple clients since in theory they could have different ChainIDs
-func (n node) ChainID(ctx context.Context) (chainID *big.Int, err error) {
+func (n *node) ChainID(ctx context.Context) (chainID *big.Int, err error) {
 	n.log.Debugw("eth.Client#ChainID(...)", "mode", "websocket")
 	chainID, err = n.ws.geth.ChainID(ctx)
 	err = n.wrapWS(err)
 	return
 }
 
-func (n node) HeaderByNumber(ctx context.Context, number *big.Int) (header *types.Header, err error) {
+func (n *node) HeaderByNumber(ctx context.Context, number *big.Int) (header *types.Header, err error) {
 	n.log.Debugw("eth.Client#HeaderByNumber(...)",
-		"number", n,
+		"number", number,
 		"mode", switching(n),
 	)
 	if n.http != nil {
@@ -156,7 +376,7 @@ func (n node) HeaderByNumber(ctx context.Context, number *big.Int) (header *type
 	return
 }
 
-func (n node) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+func (n *node) SendTransaction(ctx context.Context, tx *types.Transaction) error {
 	n.log.Debugw("eth.Client#SendTransaction(...)",
 		"tx", tx,
 		"mode", switching(n),
@@ -167,7 +387,7 @@ func (n node) SendTransaction(ctx context.Context, tx *types.Transaction) error
 	return n.wrapWS(n.ws.geth.SendTransaction(ctx, tx))
 }
 
-func (n node) PendingNonceAt(ctx context.Context, account common.Address) (nonce uint64, err error) {
+func (n *node) PendingNonceAt(ctx context.Context, account common.Address) (nonce uint64, err error) {
 	n.log.Debugw("eth.Client#PendingNonceAt(...)",
 		"account", account,
 		"mode", switching(n),
@@ -182,7 +402,7 @@ func (n node) PendingNonceAt(ctx context.Context, account common.Address) (nonce
 	return
 }
 
-func (n node) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (nonce uint64, err error) {
+func (n *node) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (nonce uint64, err error) {
 	n.log.Debugw("eth.Client#NonceAt(...)",
 		"account", account,
 		"blockNumber", blockNumber,
@@ -198,7 +418,7 @@ func (n node) NonceAt(ctx context.Context, account common.Address, blockNumber *
 	return
 }
 
-func (n node) PendingCodeAt(ctx context.Context, account common.Address) (code []byte, err error) {
+func (n *node) PendingCodeAt(ctx context.Context, account common.Address) (code []byte, err error) {
 	n.log.Debugw("eth.Client#PendingCodeAt(...)",
 		"account", account,
 		"mode", switching(n),
@@ -213,7 +433,7 @@ func (n node) PendingCodeAt(ctx context.Context, account common.Address) (code [
 	return
 }
 
-func (n node) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) (code []byte, err error) {
+func (n *node) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) (code []byte, err error) {
 	n.log.Debugw("eth.Client#CodeAt(...)",
 		"account", account,
 		"blockNumber", blockNumber,
@@ -229,7 +449,7 @@ func (n node) CodeAt(ctx context.Context, account common.Address, blockNumber *b
 	return
 }
 
-func (n node) EstimateGas(ctx context.Context, call ethereum.CallMsg) (gas uint64, err error) {
+func (n *node) EstimateGas(ctx context.Context, call ethereum.CallMsg) (gas uint64, err error) {
 	n.log.Debugw("eth.Client#EstimateGas(...)",
 		"call", call,
 		"mode", switching(n),
@@ -244,14 +464,14 @@ func (n node) EstimateGas(ctx context.Context, call ethereum.CallMsg) (gas uint6
 	return
 }
 
-func (n node) SuggestGasPrice(ctx context.Context) (price *big.Int, err error) {
+func (n *node) SuggestGasPrice(ctx context.Context) (price *big.Int, err error) {
 	n.log.Debugw("eth.Client#SuggestGasPrice()", "mode", "websocket")
 	price, err = n.ws.geth.SuggestGasPrice(ctx)
 	err = n.wrapWS(err)
 	return
 }
 
-func (n node) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) (val []byte, err error) {
+func (n *node) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) (val []byte, err error) {
 	n.log.Debugw("eth.Client#CallContract()",
 		"mode", switching(n),
 	)
@@ -266,7 +486,7 @@ func (n node) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumbe
 
 }
 
-func (n node) BlockByNumber(ctx context.Context, number *big.Int) (b *types.Block, err error) {
+func (n *node) BlockByNumber(ctx context.Context, number *big.Int) (b *types.Block, err error) {
 	n.log.Debugw("eth.Client#BlockByNumber(...)",
 		"number", number,
 		"mode", switching(n),
@@ -281,7 +501,7 @@ func (n node) BlockByNumber(ctx context.Context, number *big.Int) (b *types.Bloc
 	return
 }
 
-func (n node) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (balance *big.Int, err error) {
+func (n *node) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (balance *big.Int, err error) {
 	n.log.Debugw("eth.Client#BalanceAt(...)",
 		"account", account,
 		"blockNumber", blockNumber,
@@ -297,7 +517,7 @@ func (n node) BalanceAt(ctx context.Context, account common.Address, blockNumber
 	return
 }
 
-func (n node) FilterLogs(ctx context.Context, q ethereum.FilterQuery) (l []types.Log, err error) {
+func (n *node) FilterLogs(ctx context.Context, q ethereum.FilterQuery) (l []types.Log, err error) {
 	n.log.Debugw("eth.Client#FilterLogs(...)",
 		"q", q,
 		"mode", switching(n),
@@ -312,14 +532,14 @@ func (n node) FilterLogs(ctx context.Context, q ethereum.FilterQuery) (l []types
 	return
 }
 
-func (n node) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- types.Log) (sub ethereum.Subscription, err error) {
+func (n *node) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- types.Log) (sub ethereum.Subscription, err error) {
 	n.log.Debugw("eth.Client#SubscribeFilterLogs(...)", "q", q, "mode", "websocket")
 	sub, err = n.ws.geth.SubscribeFilterLogs(ctx, q, ch)
 	err = n.wrapWS(err)
 	return
 }
 
-func (n node) SuggestGasTipCap(ctx context.Context) (tipCap *big.Int, err error) {
+func (n *node) SuggestGasTipCap(ctx context.Context) (tipCap *big.Int, err error) {
 	n.log.Debugw("eth.Client#SuggestGasTipCap(...)",
 		"mode", switching(n),
 	)
@@ -333,11 +553,13 @@ func (n node) SuggestGasTipCap(ctx context.Context) (tipCap *big.Int, err error)
 	return
 }
 
-func (n node) wrapWS(err error) error {
+func (n *node) wrapWS(err error) error {
+	n.recordCall(err)
 	return wrap(err, fmt.Sprintf("primary websocket (%s)", n.ws.uri.String()))
 }
 
-func (n node) wrapHTTP(err error) error {
+func (n *node) wrapHTTP(err error) error {
+	n.recordCall(err)
 	return wrap(err, fmt.Sprintf("primary http (%s)", n.http.uri.String()))
 }
 
@@ -351,7 +573,7 @@ func wrap(err error, tp string) error {
 	return errors.Wrapf(err, "%s call failed", tp)
 }
 
-func switching(n node) string {
+func switching(n *node) string {
 	if n.http != nil {
 		return "http"
 	}
@@ -2,9 +2,16 @@ package eth
 
 import (
 	"context"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func Test_NodeWrapError(t *testing.T) {
@@ -24,3 +31,61 @@ func Test_NodeWrapError(t *testing.T) {
 		assert.EqualError(t, err, "foo call failed: remote eth node timed out: context deadline exceeded")
 	})
 }
+
+type blockNumberService struct{}
+
+func (blockNumberService) BlockNumber() hexutil.Uint64 { return 42 }
+
+func newBlockNumberServer(t *testing.T) *url.URL {
+	t.Helper()
+	server := rpc.NewServer()
+	require.NoError(t, server.RegisterName("eth", blockNumberService{}))
+	ts := httptest.NewServer(server.WebsocketHandler([]string{"*"}))
+	t.Cleanup(ts.Close)
+	t.Cleanup(server.Stop)
+	u, err := url.Parse(strings.Replace(ts.URL, "http", "ws", 1))
+	require.NoError(t, err)
+	return u
+}
+
+func Test_Node_DialAndPollConcurrently(t *testing.T) {
+	t.Parallel()
+
+	n := newNode(*newBlockNumberServer(t), nil, "eth-primary-0")
+	defer n.Close()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, n.Dial(context.Background()))
+		}()
+		go func() {
+			defer wg.Done()
+			n.Poll(context.Background())
+		}()
+	}
+	wg.Wait()
+
+	n.Poll(context.Background())
+	assert.Equal(t, nodeStateAlive, n.State())
+	assert.Equal(t, int64(42), n.Stats().latestBlockNumber)
+}
+
+func Test_Node_DialFailure(t *testing.T) {
+	t.Parallel()
+
+	wsuri, err := url.Parse("ws://127.0.0.1:0")
+	require.NoError(t, err)
+	n := newNode(*wsuri, newBlockNumberServer(t), "eth-primary-0")
+
+	require.Error(t, n.Dial(context.Background()))
+	// The HTTP client is closed rather than kept when the websocket fails
+	assert.Nil(t, n.http.rpc)
+	assert.Nil(t, n.ws.rpc)
+	assert.Equal(t, nodeStateUndialed, n.State())
+
+	n.Poll(context.Background())
+	assert.Nil(t, n.http.rpc)
+}
@@ -0,0 +1,377 @@
+package eth
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/health"
+	"go.uber.org/multierr"
+)
+
+var (
+	promEthNodeActive = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "eth_node_active",
+		Help: "Set to 1 for the primary eth node that is currently receiving requests, 0 otherwise",
+	},
+		[]string{"node_name"},
+	)
+	promEthPoolFailovers = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "eth_pool_failovers",
+		Help: "The number of times the active primary eth node has been switched",
+	})
+)
+
+const (
+	// nodePollInterval is how often each node in a pool with more than one
+	// primary is polled for its latest block number
+	nodePollInterval = 5 * time.Second
+	// nodePollTimeout bounds each individual poll
+	nodePollTimeout = 3 * time.Second
+	// nodeMaxBlocksBehind is how far a node may lag behind the highest node in
+	// the pool before it is considered out of sync
+	nodeMaxBlocksBehind = 10
+	// nodeMaxErrorRate is the error rate above which a node will not be
+	// selected to receive requests
+	nodeMaxErrorRate = 0.5
+)
+
+// NodeHealthReporter is implemented by clients that can report on the health
+// of each of their individual primary nodes
+type NodeHealthReporter interface {
+	NodeCheckables() map[string]health.Checkable
+}
+
+// pool manages a set of primary nodes. It tracks the head height, latency
+// and error rate of each node and routes all requests and subscriptions to
+// the best of them, failing over if that node becomes unhealthy.
+//
+// With only a single primary node, the pool does no background polling and
+// subscription errors are returned to the caller as before.
+type pool struct {
+	nodes []*node
+
+	selectMu sync.Mutex
+	activeMu sync.RWMutex
+	active   *node
+
+	subsMu sync.Mutex
+	subs   map[*poolSubscription]struct{}
+
+	chStop chan struct{}
+	wgDone sync.WaitGroup
+}
+
+func newPool(nodes []*node) *pool {
+	return &pool{
+		nodes:  nodes,
+		subs:   make(map[*poolSubscription]struct{}),
+		chStop: make(chan struct{}),
+	}
+}
+
+// Dial dials every node in the pool. It only fails if no node could be
+// dialed at all; nodes that failed will be re-dialed in the background.
+func (p *pool) Dial(ctx context.Context) error {
+	var merr error
+	var nDialed int
+	for _, n := range p.nodes {
+		if err := n.Dial(ctx); err != nil {
+			if len(p.nodes) == 1 {
+				return err
+			}
+			n.log.Errorw("Failed to dial primary eth node, will retry in the background", "err", err)
+			merr = multierr.Append(merr, err)
+			continue
+		}
+		nDialed++
+	}
+	if nDialed == 0 {
+		return errors.Wrap(merr, "failed to dial any primary eth node")
+	}
+
+	p.selectActive()
+
+	if len(p.nodes) > 1 {
+		p.wgDone.Add(1)
+		go p.runLoop()
+	}
+	return nil
+}
+
+func (p *pool) Close() {
+	if len(p.nodes) > 1 {
+		close(p.chStop)
+		p.wgDone.Wait()
+	}
+	for _, n := range p.nodes {
+		n.Close()
+	}
+}
+
+func (p *pool) runLoop() {
+	defer p.wgDone.Done()
+
+	ticker := time.NewTicker(nodePollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			p.pollAll()
+			p.selectActive()
+		case <-p.chStop:
+			return
+		}
+	}
+}
+
+func (p *pool) pollAll() {
+	var wg sync.WaitGroup
+	wg.Add(len(p.nodes))
+	for _, n := range p.nodes {
+		go func(n *node) {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(context.Background(), nodePollTimeout)
+			defer cancel()
+			n.Poll(ctx)
+		}(n)
+	}
+	wg.Wait()
+}
+
+// Active returns the node that requests should currently be routed to
+func (p *pool) Active() *node {
+	p.activeMu.RLock()
+	defer p.activeMu.RUnlock()
+	if p.active == nil {
+		return p.nodes[0]
+	}
+	return p.active
+}
+
+// selectActive switches the active node if it is no longer eligible to
+// receive requests. The active node is kept for as long as it is eligible,
+// to avoid flapping between nodes of similar quality.
+func (p *pool) selectActive() {
+	p.selectMu.Lock()
+	defer p.selectMu.Unlock()
+
+	stats := make([]nodeStats, len(p.nodes))
+	var highest int64
+	for i, n := range p.nodes {
+		stats[i] = n.Stats()
+		if stats[i].state == nodeStateAlive && stats[i].latestBlockNumber > highest {
+			highest = stats[i].latestBlockNumber
+		}
+	}
+
+	var best, fallback *node
+	var bestScore float64
+	var fallbackHeight int64
+	p.activeMu.RLock()
+	current := p.active
+	p.activeMu.RUnlock()
+	for i, n := range p.nodes {
+		s := stats[i]
+		if s.state != nodeStateAlive {
+			continue
+		}
+		if fallback == nil || s.latestBlockNumber > fallbackHeight {
+			fallback, fallbackHeight = n, s.latestBlockNumber
+		}
+		if s.errorRate > nodeMaxErrorRate || highest-s.latestBlockNumber > nodeMaxBlocksBehind {
+			continue
+		}
+		if n == current {
+			// Current node is still eligible, no need to switch
+			return
+		}
+		if score := nodeScore(s); best == nil || score < bestScore {
+			best, bestScore = n, score
+		}
+	}
+	if best == nil {
+		// No node is eligible, so the best we can do is use whichever live
+		// node is furthest ahead
+		best = fallback
+	}
+	if best == nil || best == current {
+		return
+	}
+
+	p.activeMu.Lock()
+	prev := p.active
+	p.active = best
+	p.activeMu.Unlock()
+
+	for _, n := range p.nodes {
+		var active float64
+		if n == best {
+			active = 1
+		}
+		promEthNodeActive.WithLabelValues(n.name).Set(active)
+	}
+	if prev == nil {
+		return
+	}
+
+	promEthPoolFailovers.Inc()
+	logger.Warnw(fmt.Sprintf("Primary eth node %s is no longer eligible, switching to %s", prev.name, best.name),
+		"previousNode", prev.name,
+		"previousNodeState", prev.State(),
+		"newNode", best.name,
+	)
+
+	p.subsMu.Lock()
+	defer p.subsMu.Unlock()
+	for s := range p.subs {
+		s.notifySwitch()
+	}
+}
+
+// nodeScore ranks eligible nodes; lower is better. Latency is penalised by
+// the error rate so that a fast but flaky node is not preferred over a
+// slightly slower reliable one.
+func nodeScore(s nodeStats) float64 {
+	return float64(s.latency) * (1 + 10*s.errorRate)
+}
+
+// NodeCheckables returns a health.Checkable for each node in the pool
+func (p *pool) NodeCheckables() map[string]health.Checkable {
+	checkables := make(map[string]health.Checkable, len(p.nodes))
+	for _, n := range p.nodes {
+		checkables[fmt.Sprintf("EthNode(%s)", n.name)] = n
+	}
+	return checkables
+}
+
+// Subscribe opens a subscription on the active node. If there is more than
+// one node in the pool, the subscription is transparently moved to another
+// node if the active node fails or is replaced.
+func (p *pool) Subscribe(ctx context.Context, subscribe func(context.Context, *node) (ethereum.Subscription, error)) (ethereum.Subscription, error) {
+	n := p.Active()
+	inner, err := subscribe(ctx, n)
+	if err != nil {
+		return nil, err
+	}
+	if len(p.nodes) == 1 {
+		return inner, nil
+	}
+
+	s := &poolSubscription{
+		pool:      p,
+		subscribe: subscribe,
+		node:      n,
+		inner:     inner,
+		chSwitch:  make(chan struct{}, 1),
+		chErr:     make(chan error, 1),
+		chStop:    make(chan struct{}),
+		chDone:    make(chan struct{}),
+	}
+	p.subsMu.Lock()
+	p.subs[s] = struct{}{}
+	p.subsMu.Unlock()
+
+	go s.run()
+	return s, nil
+}
+
+func (p *pool) removeSubscription(s *poolSubscription) {
+	p.subsMu.Lock()
+	defer p.subsMu.Unlock()
+	delete(p.subs, s)
+}
+
+// poolSubscription wraps a subscription on a single node, re-subscribing on
+// the new active node whenever the pool fails over
+type poolSubscription struct {
+	pool      *pool
+	subscribe func(context.Context, *node) (ethereum.Subscription, error)
+
+	// node and inner are only accessed by the run goroutine after creation
+	node  *node
+	inner ethereum.Subscription
+
+	chSwitch  chan struct{}
+	chErr     chan error
+	chStop    chan struct{}
+	chDone    chan struct{}
+	unsubOnce sync.Once
+}
+
+var _ ethereum.Subscription = (*poolSubscription)(nil)
+
+func (s *poolSubscription) notifySwitch() {
+	select {
+	case s.chSwitch <- struct{}{}:
+	default:
+	}
+}
+
+func (s *poolSubscription) run() {
+	defer close(s.chDone)
+	defer close(s.chErr)
+	defer s.pool.removeSubscription(s)
+
+	for {
+		select {
+		case <-s.chStop:
+			s.inner.Unsubscribe()
+			return
+		case err := <-s.inner.Err():
+			s.node.log.Warnw("Subscription on primary eth node failed", "err", err)
+			s.node.MarkUnreachable()
+			s.pool.selectActive()
+			if !s.resubscribe() {
+				s.chErr <- err
+				return
+			}
+		case <-s.chSwitch:
+			// If this fails the existing subscription is kept, since it is
+			// still working even though its node is no longer preferred
+			s.resubscribe()
+		}
+	}
+}
+
+// resubscribe moves the subscription to the currently active node. The new
+// subscription is opened before the old one is closed, so consumers may see
+// duplicates but will not miss anything during the switch.
+func (s *poolSubscription) resubscribe() bool {
+	n := s.pool.Active()
+	if n == s.node {
+		// The pool had nowhere else to go
+		return n.State() == nodeStateAlive
+	}
+	ctx, cancel := DefaultQueryCtx()
+	defer cancel()
+	inner, err := s.subscribe(ctx, n)
+	if err != nil {
+		n.log.Errorw("Failed to move subscription to primary eth node", "err", err)
+		return false
+	}
+	s.inner.Unsubscribe()
+	s.node, s.inner = n, inner
+	n.log.Infow("Moved subscription to primary eth node")
+	return true
+}
+
+// Err returns a channel that receives an error if the subscription could not
+// be moved to a healthy node. It is closed on Unsubscribe.
+func (s *poolSubscription) Err() <-chan error {
+	return s.chErr
+}
+
+func (s *poolSubscription) Unsubscribe() {
+	s.unsubOnce.Do(func() {
+		close(s.chStop)
+	})
+	<-s.chDone
+}
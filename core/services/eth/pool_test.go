@@ -0,0 +1,203 @@
+package eth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/onsi/gomega"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeSubscription struct {
+	chErr        chan error
+	unsubscribed chan struct{}
+	once         sync.Once
+}
+
+func newFakeSubscription() *fakeSubscription {
+	return &fakeSubscription{chErr: make(chan error, 1), unsubscribed: make(chan struct{})}
+}
+
+func (s *fakeSubscription) Err() <-chan error { return s.chErr }
+func (s *fakeSubscription) Unsubscribe() {
+	s.once.Do(func() { close(s.unsubscribed) })
+}
+
+func newTestPool(t *testing.T, stats ...nodeStats) *pool {
+	t.Helper()
+	var nodes []*node
+	for i, s := range stats {
+		u, err := url.Parse(fmt.Sprintf("ws://node%d.example", i))
+		require.NoError(t, err)
+		n := newNode(*u, nil, fmt.Sprintf("eth-primary-%d", i))
+		n.stats = s
+		nodes = append(nodes, n)
+	}
+	return newPool(nodes)
+}
+
+func alive(height int64, latency time.Duration) nodeStats {
+	return nodeStats{state: nodeStateAlive, latestBlockNumber: height, latency: latency}
+}
+
+func Test_Pool_SelectActive(t *testing.T) {
+	t.Parallel()
+
+	t.Run("selects the eligible node with the best score", func(t *testing.T) {
+		p := newTestPool(t, alive(100, 50*time.Millisecond), alive(100, 10*time.Millisecond), alive(100, 20*time.Millisecond))
+		p.selectActive()
+		assert.Equal(t, p.nodes[1], p.Active())
+	})
+
+	t.Run("penalises nodes with a high error rate", func(t *testing.T) {
+		p := newTestPool(t, alive(100, 50*time.Millisecond), alive(100, 10*time.Millisecond))
+		p.nodes[1].stats.errorRate = 0.4
+		p.selectActive()
+		assert.Equal(t, p.nodes[0], p.Active())
+	})
+
+	t.Run("keeps the active node while it is eligible", func(t *testing.T) {
+		p := newTestPool(t, alive(100, 50*time.Millisecond), alive(100, 10*time.Millisecond))
+		p.active = p.nodes[0]
+		p.selectActive()
+		assert.Equal(t, p.nodes[0], p.Active())
+	})
+
+	t.Run("switches away from an unreachable node", func(t *testing.T) {
+		p := newTestPool(t, alive(100, 10*time.Millisecond), alive(100, 50*time.Millisecond))
+		p.selectActive()
+		require.Equal(t, p.nodes[0], p.Active())
+
+		p.nodes[0].MarkUnreachable()
+		p.selectActive()
+		assert.Equal(t, p.nodes[1], p.Active())
+	})
+
+	t.Run("switches away from a node that is too far behind", func(t *testing.T) {
+		p := newTestPool(t, alive(100, 10*time.Millisecond), alive(100+nodeMaxBlocksBehind+1, 50*time.Millisecond))
+		p.active = p.nodes[0]
+		p.selectActive()
+		assert.Equal(t, p.nodes[1], p.Active())
+	})
+
+	t.Run("falls back to the highest live node if none are eligible", func(t *testing.T) {
+		p := newTestPool(t, alive(100, 10*time.Millisecond), alive(90, 10*time.Millisecond), nodeStats{state: nodeStateUnreachable, latestBlockNumber: 200})
+		p.nodes[0].stats.errorRate = 0.9
+		p.nodes[1].stats.errorRate = 0.9
+		p.selectActive()
+		assert.Equal(t, p.nodes[0], p.Active())
+	})
+
+	t.Run("keeps the active node if no other node is alive", func(t *testing.T) {
+		p := newTestPool(t, alive(100, 10*time.Millisecond), nodeStats{state: nodeStateUnreachable})
+		p.selectActive()
+		p.nodes[0].MarkUnreachable()
+		p.selectActive()
+		assert.Equal(t, p.nodes[0], p.Active())
+	})
+}
+
+func Test_Pool_Subscribe(t *testing.T) {
+	t.Parallel()
+
+	t.Run("moves the subscription to another node when it fails", func(t *testing.T) {
+		g := gomega.NewGomegaWithT(t)
+		p := newTestPool(t, alive(100, 10*time.Millisecond), alive(100, 50*time.Millisecond))
+		p.selectActive()
+
+		var mu sync.Mutex
+		subs := make(map[*node]*fakeSubscription)
+		subscribe := func(ctx context.Context, n *node) (ethereum.Subscription, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			subs[n] = newFakeSubscription()
+			return subs[n], nil
+		}
+		getSub := func(n *node) *fakeSubscription {
+			mu.Lock()
+			defer mu.Unlock()
+			return subs[n]
+		}
+
+		sub, err := p.Subscribe(context.Background(), subscribe)
+		require.NoError(t, err)
+		require.NotNil(t, getSub(p.nodes[0]))
+
+		getSub(p.nodes[0]).chErr <- errors.New("connection reset")
+
+		g.Eventually(func() *fakeSubscription { return getSub(p.nodes[1]) }).ShouldNot(gomega.BeNil())
+		assert.Equal(t, p.nodes[1], p.Active())
+		assert.Equal(t, nodeStateUnreachable, p.nodes[0].State())
+
+		sub.Unsubscribe()
+		<-getSub(p.nodes[1]).unsubscribed
+		_, open := <-sub.Err()
+		assert.False(t, open)
+	})
+
+	t.Run("moves the subscription when the pool switches node", func(t *testing.T) {
+		g := gomega.NewGomegaWithT(t)
+		p := newTestPool(t, alive(100, 10*time.Millisecond), alive(100, 50*time.Millisecond))
+		p.selectActive()
+
+		chSubscribed := make(chan *node, 2)
+		subscribe := func(ctx context.Context, n *node) (ethereum.Subscription, error) {
+			chSubscribed <- n
+			return newFakeSubscription(), nil
+		}
+
+		sub, err := p.Subscribe(context.Background(), subscribe)
+		require.NoError(t, err)
+		defer sub.Unsubscribe()
+		require.Equal(t, p.nodes[0], <-chSubscribed)
+
+		p.nodes[0].stats.latestBlockNumber = 0
+		p.selectActive()
+
+		g.Eventually(chSubscribed).Should(gomega.Receive(gomega.Equal(p.nodes[1])))
+	})
+
+	t.Run("returns the error if there is no node to fail over to", func(t *testing.T) {
+		p := newTestPool(t, alive(100, 10*time.Millisecond), nodeStats{state: nodeStateUnreachable})
+		p.selectActive()
+
+		inner := newFakeSubscription()
+		sub, err := p.Subscribe(context.Background(), func(ctx context.Context, n *node) (ethereum.Subscription, error) {
+			return inner, nil
+		})
+		require.NoError(t, err)
+
+		inner.chErr <- errors.New("connection reset")
+
+		err = <-sub.Err()
+		require.EqualError(t, err, "connection reset")
+		sub.Unsubscribe()
+	})
+
+	t.Run("returns the underlying subscription with a single node", func(t *testing.T) {
+		p := newTestPool(t, alive(100, 10*time.Millisecond))
+		inner := newFakeSubscription()
+		sub, err := p.Subscribe(context.Background(), func(ctx context.Context, n *node) (ethereum.Subscription, error) {
+			return inner, nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, inner, sub)
+	})
+}
+
+func Test_IsNodeFailure(t *testing.T) {
+	t.Parallel()
+
+	assert.False(t, isNodeFailure(nil))
+	assert.False(t, isNodeFailure(ethereum.NotFound))
+	assert.False(t, isNodeFailure(wrap(context.Canceled, "foo")))
+	assert.True(t, isNodeFailure(wrap(context.DeadlineExceeded, "foo")))
+	assert.True(t, isNodeFailure(errors.New("websocket: close 1006 (abnormal closure)")))
+}
@@ -0,0 +1,131 @@
+package eth
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+)
+
+var (
+	// Solidity reverts with Error(string) for require and revert with a reason
+	errorSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
+	// Solidity >= 0.8.0 reverts with Panic(uint256) for failed asserts,
+	// arithmetic overflows, out of bounds indexing, etc.
+	panicSelector = crypto.Keccak256([]byte("Panic(uint256)"))[:4]
+)
+
+// panicReasons describes the Panic(uint256) codes, see:
+// https://docs.soliditylang.org/en/v0.8.7/control-structures.html#panic-via-assert-and-error-via-require
+var panicReasons = map[uint64]string{
+	0x00: "generic compiler inserted panic",
+	0x01: "assertion failed",
+	0x11: "arithmetic overflow or underflow",
+	0x12: "division or modulo by zero",
+	0x21: "invalid enum value",
+	0x22: "incorrectly encoded storage byte array",
+	0x31: "pop on empty array",
+	0x32: "array index out of bounds",
+	0x41: "out of memory",
+	0x51: "call to zero-initialized internal function",
+}
+
+type customError struct {
+	name   string
+	inputs abi.Arguments
+}
+
+var (
+	customErrors   = make(map[[4]byte]customError)
+	customErrorsMu sync.RWMutex
+)
+
+// RegisterRevertErrors registers the custom errors (Solidity >= 0.8.4)
+// declared in the given contract ABI, so that DecodeRevertReason can decode
+// them. None of the Chainlink contracts declare custom errors yet, so none are
+// registered by default.
+func RegisterRevertErrors(abiJSON string) error {
+	var fields []struct {
+		Type   string
+		Name   string
+		Inputs abi.Arguments
+	}
+	if err := json.Unmarshal([]byte(abiJSON), &fields); err != nil {
+		return errors.Wrap(err, "RegisterRevertErrors failed to parse ABI")
+	}
+
+	customErrorsMu.Lock()
+	defer customErrorsMu.Unlock()
+	for _, field := range fields {
+		if field.Type != "error" {
+			continue
+		}
+		types := make([]string, len(field.Inputs))
+		for i, input := range field.Inputs {
+			types[i] = input.Type.String()
+		}
+		var selector [4]byte
+		copy(selector[:], crypto.Keccak256([]byte(fmt.Sprintf("%s(%s)", field.Name, strings.Join(types, ","))))[:4])
+		customErrors[selector] = customError{field.Name, field.Inputs}
+	}
+	return nil
+}
+
+// DecodeRevertReason decodes the data returned by a reverted call into a human
+// readable reason. It understands Error(string), Panic(uint256) and the custom
+// errors registered with RegisterRevertErrors.
+func DecodeRevertReason(data []byte) (string, error) {
+	if len(data) < 4 {
+		return "", errors.New("revert data is too short")
+	}
+	selector := data[:4]
+
+	if bytes.Equal(selector, errorSelector) {
+		return abi.UnpackRevert(data)
+	}
+
+	if bytes.Equal(selector, panicSelector) {
+		uint256, _ := abi.NewType("uint256", "", nil)
+		values, err := abi.Arguments{{Type: uint256}}.Unpack(data[4:])
+		if err != nil {
+			return "", errors.Wrap(err, "failed to unpack Panic(uint256)")
+		}
+		code := values[0].(*big.Int)
+		if reason, exists := panicReasons[code.Uint64()]; exists && code.IsUint64() {
+			return fmt.Sprintf("panic: %s (0x%x)", reason, code), nil
+		}
+		return fmt.Sprintf("panic: unknown code 0x%x", code), nil
+	}
+
+	var key [4]byte
+	copy(key[:], selector)
+	customErrorsMu.RLock()
+	ce, exists := customErrors[key]
+	customErrorsMu.RUnlock()
+	if !exists {
+		return "", errors.Errorf("unknown error selector 0x%x", selector)
+	}
+	values, err := ce.inputs.Unpack(data[4:])
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to unpack error %s", ce.name)
+	}
+	args := make([]string, len(values))
+	for i, v := range values {
+		switch v := v.(type) {
+		case []byte:
+			args[i] = hexutil.Encode(v)
+		case [32]byte:
+			args[i] = hexutil.Encode(v[:])
+		default:
+			args[i] = fmt.Sprint(v)
+		}
+	}
+	return fmt.Sprintf("%s(%s)", ce.name, strings.Join(args, ", ")), nil
+}
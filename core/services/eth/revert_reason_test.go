@@ -0,0 +1,96 @@
+package eth_test
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustEncodeRevert(t *testing.T, signature string, types []string, values ...interface{}) []byte {
+	t.Helper()
+	var args abi.Arguments
+	for _, typ := range types {
+		abiType, err := abi.NewType(typ, "", nil)
+		require.NoError(t, err)
+		args = append(args, abi.Argument{Type: abiType})
+	}
+	packed, err := args.Pack(values...)
+	require.NoError(t, err)
+	return append(crypto.Keccak256([]byte(signature))[:4], packed...)
+}
+
+func Test_DecodeRevertReason(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, eth.RegisterRevertErrors(`[
+		{"type": "function", "name": "transmit", "inputs": [{"name": "report", "type": "bytes"}], "outputs": []},
+		{"type": "error", "name": "StaleReport", "inputs": [{"name": "epoch", "type": "uint32"}, {"name": "transmitter", "type": "address"}]},
+		{"type": "error", "name": "Unauthorized", "inputs": []}
+	]`))
+	transmitter := common.HexToAddress("0x6C03DDA95a2AEd917EeCc6eddD4b9D16E6380411")
+
+	tests := []struct {
+		name     string
+		data     []byte
+		expected string
+	}{
+		{"Error(string)", mustEncodeRevert(t, "Error(string)", []string{"string"}, "round not accepting submissions"), "round not accepting submissions"},
+		{"Panic(uint256)", mustEncodeRevert(t, "Panic(uint256)", []string{"uint256"}, big.NewInt(0x11)), "panic: arithmetic overflow or underflow (0x11)"},
+		{"Panic(uint256) with unknown code", mustEncodeRevert(t, "Panic(uint256)", []string{"uint256"}, big.NewInt(0x99)), "panic: unknown code 0x99"},
+		{"custom error", mustEncodeRevert(t, "StaleReport(uint32,address)", []string{"uint32", "address"}, uint32(42), transmitter), fmt.Sprintf("StaleReport(42, %s)", transmitter.Hex())},
+		{"custom error without arguments", mustEncodeRevert(t, "Unauthorized()", nil), "Unauthorized()"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			reason, err := eth.DecodeRevertReason(test.data)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, reason)
+		})
+	}
+
+	t.Run("unknown selector", func(t *testing.T) {
+		_, err := eth.DecodeRevertReason(hexutil.MustDecode("0x12345678"))
+		require.EqualError(t, err, "unknown error selector 0x12345678")
+	})
+
+	t.Run("too short", func(t *testing.T) {
+		_, err := eth.DecodeRevertReason([]byte{1, 2})
+		require.Error(t, err)
+	})
+}
+
+func Test_ExtractRevertReasonFromRPCError_Decodes(t *testing.T) {
+	t.Parallel()
+
+	data := mustEncodeRevert(t, "Error(string)", []string{"string"}, "important revert reason")
+	jsonErr := &eth.JsonError{
+		Code:    3,
+		Data:    hexutil.Encode(data),
+		Message: "execution reverted: important revert reason",
+	}
+
+	revertReason, err := eth.ExtractRevertReasonFromRPCError(jsonErr)
+	require.NoError(t, err)
+	assert.Equal(t, "important revert reason", revertReason)
+
+	data = mustEncodeRevert(t, "Panic(uint256)", []string{"uint256"}, big.NewInt(0x01))
+	jsonErr = &eth.JsonError{
+		Code:    -32015,
+		Data:    "Reverted " + hexutil.Encode(data),
+		Message: "VM execution error.",
+	}
+
+	revertReason, err = eth.ExtractRevertReasonFromRPCError(jsonErr)
+	require.NoError(t, err)
+	assert.Equal(t, "panic: assertion failed (0x1)", revertReason)
+}
@@ -444,6 +444,7 @@ func (s *service) generateJob(spec string) (*job.Job, error) {
 	if err != nil {
 		return nil, err
 	}
+	js.TOML = spec
 
 	return &js, nil
 }
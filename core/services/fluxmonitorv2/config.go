@@ -14,6 +14,7 @@ type Config struct {
 	EthGasLimit                    uint64
 	EthMaxQueuedTransactions       uint64
 	FMDefaultTransactionQueueDepth uint32
+	FMSimulateTransactions         bool
 }
 
 // MinimumPollingInterval returns the minimum duration between polling ticks
@@ -26,6 +26,7 @@ type FluxAggregatorContractSubmitter struct {
 	orm      ORM
 	keyStore KeyStoreInterface
 	gasLimit uint64
+	jobID    int32
 }
 
 // NewFluxAggregatorContractSubmitter constructs a new NewFluxAggregatorContractSubmitter
@@ -34,12 +35,14 @@ func NewFluxAggregatorContractSubmitter(
 	orm ORM,
 	keyStore KeyStoreInterface,
 	gasLimit uint64,
+	jobID int32,
 ) *FluxAggregatorContractSubmitter {
 	return &FluxAggregatorContractSubmitter{
 		FluxAggregatorInterface: contract,
 		orm:                     orm,
 		keyStore:                keyStore,
 		gasLimit:                gasLimit,
+		jobID:                   jobID,
 	}
 }
 
@@ -57,7 +60,7 @@ func (c *FluxAggregatorContractSubmitter) Submit(db *gorm.DB, roundID *big.Int,
 	}
 
 	return errors.Wrap(
-		c.orm.CreateEthTransaction(db, fromAddress, c.Address(), payload, c.gasLimit),
+		c.orm.CreateEthTransaction(db, fromAddress, c.Address(), payload, c.gasLimit, c.jobID),
 		"failed to send Eth transaction",
 	)
 }
@@ -21,7 +21,7 @@ func TestFluxAggregatorContractSubmitter_Submit(t *testing.T) {
 		orm            = new(fmmocks.ORM)
 		keyStore       = new(fmmocks.KeyStoreInterface)
 		gasLimit       = uint64(2100)
-		submitter      = fluxmonitorv2.NewFluxAggregatorContractSubmitter(fluxAggregator, orm, keyStore, gasLimit)
+		submitter      = fluxmonitorv2.NewFluxAggregatorContractSubmitter(fluxAggregator, orm, keyStore, gasLimit, 42)
 
 		toAddress   = cltest.NewAddress()
 		fromAddress = cltest.NewAddress()
@@ -34,7 +34,7 @@ func TestFluxAggregatorContractSubmitter_Submit(t *testing.T) {
 
 	keyStore.On("GetRoundRobinAddress", mock.Anything).Return(fromAddress, nil)
 	fluxAggregator.On("Address").Return(toAddress)
-	orm.On("CreateEthTransaction", mock.Anything, fromAddress, toAddress, payload, gasLimit).Return(nil)
+	orm.On("CreateEthTransaction", mock.Anything, fromAddress, toAddress, payload, gasLimit, int32(42)).Return(nil)
 
 	err = submitter.Submit(&gorm.DB{}, roundID, submission)
 	assert.NoError(t, err)
@@ -172,6 +172,7 @@ func NewFromJobSpec(
 		orm,
 		keyStore,
 		cfg.EthGasLimit,
+		jobSpec.ID,
 	)
 
 	flags, err := NewFlags(cfg.FlagsContractAddress, ethClient)
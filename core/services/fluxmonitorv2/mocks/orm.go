@@ -15,13 +15,13 @@ type ORM struct {
 	mock.Mock
 }
 
-// CreateEthTransaction provides a mock function with given fields: db, fromAddress, toAddress, payload, gasLimit
-func (_m *ORM) CreateEthTransaction(db *gorm.DB, fromAddress common.Address, toAddress common.Address, payload []byte, gasLimit uint64) error {
-	ret := _m.Called(db, fromAddress, toAddress, payload, gasLimit)
+// CreateEthTransaction provides a mock function with given fields: db, fromAddress, toAddress, payload, gasLimit, jobID
+func (_m *ORM) CreateEthTransaction(db *gorm.DB, fromAddress common.Address, toAddress common.Address, payload []byte, gasLimit uint64, jobID int32) error {
+	ret := _m.Called(db, fromAddress, toAddress, payload, gasLimit, jobID)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*gorm.DB, common.Address, common.Address, []byte, uint64) error); ok {
-		r0 = rf(db, fromAddress, toAddress, payload, gasLimit)
+	if rf, ok := ret.Get(0).(func(*gorm.DB, common.Address, common.Address, []byte, uint64, int32) error); ok {
+		r0 = rf(db, fromAddress, toAddress, payload, gasLimit, jobID)
 	} else {
 		r0 = ret.Error(0)
 	}
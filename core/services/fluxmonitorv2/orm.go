@@ -4,6 +4,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+	"github.com/smartcontractkit/chainlink/core/store/models"
 	"gorm.io/gorm"
 )
 
@@ -19,7 +20,7 @@ type ORM interface {
 	DeleteFluxMonitorRoundsBackThrough(aggregator common.Address, roundID uint32) error
 	FindOrCreateFluxMonitorRoundStats(aggregator common.Address, roundID uint32) (FluxMonitorRoundStatsV2, error)
 	UpdateFluxMonitorRoundStats(db *gorm.DB, aggregator common.Address, roundID uint32, runID int64) error
-	CreateEthTransaction(db *gorm.DB, fromAddress, toAddress common.Address, payload []byte, gasLimit uint64) error
+	CreateEthTransaction(db *gorm.DB, fromAddress, toAddress common.Address, payload []byte, gasLimit uint64, jobID int32) error
 }
 
 type orm struct {
@@ -101,7 +102,8 @@ func (o *orm) CreateEthTransaction(
 	toAddress common.Address,
 	payload []byte,
 	gasLimit uint64,
+	jobID int32,
 ) (err error) {
-	_, err = o.txm.CreateEthTransaction(db, fromAddress, toAddress, payload, gasLimit, nil, o.strategy)
+	_, err = o.txm.CreateEthTransaction(db, fromAddress, toAddress, payload, gasLimit, &models.EthTxMetaV2{JobID: jobID}, o.strategy)
 	return errors.Wrap(err, "Skipped Flux Monitor submission")
 }
@@ -16,6 +16,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/core/services/postgres"
+	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/stretchr/testify/require"
 )
 
@@ -175,9 +176,9 @@ func TestORM_CreateEthTransaction(t *testing.T) {
 		gasLimit = uint64(21000)
 	)
 
-	txm.On("CreateEthTransaction", corestore.DB, from, to, payload, gasLimit, nil, strategy).Return(bulletprooftxmanager.EthTx{}, nil).Once()
+	txm.On("CreateEthTransaction", corestore.DB, from, to, payload, gasLimit, &models.EthTxMetaV2{JobID: 42}, strategy).Return(bulletprooftxmanager.EthTx{}, nil).Once()
 
-	orm.CreateEthTransaction(corestore.DB, from, to, payload, gasLimit)
+	orm.CreateEthTransaction(corestore.DB, from, to, payload, gasLimit, 42)
 
 	txm.AssertExpectations(t)
 }
@@ -0,0 +1,229 @@
+package gas
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// ArbGasInfoAddress is the address of the ArbGasInfo precompile, which
+// returns the current prices of an Arbitrum chain
+var ArbGasInfoAddress = common.HexToAddress("0x000000000000000000000000000000000000006C")
+
+var arbGasInfoABI = eth.MustGetABI(`[{"inputs":[],"name":"getPricesInWei","outputs":[{"internalType":"uint256","name":"","type":"uint256"},{"internalType":"uint256","name":"","type":"uint256"},{"internalType":"uint256","name":"","type":"uint256"},{"internalType":"uint256","name":"","type":"uint256"},{"internalType":"uint256","name":"","type":"uint256"},{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"}]`)
+
+var _ CallEstimator = &arbitrumEstimator{}
+
+// ArbitrumPrices are the prices returned by ArbGasInfo.getPricesInWei
+type ArbitrumPrices struct {
+	// PerL2Tx is the fixed L1 cost of submitting a transaction
+	PerL2Tx *big.Int
+	// PerL1CalldataByte is the L1 cost of each byte of calldata
+	PerL1CalldataByte *big.Int
+	// PerArbGasTotal is the price of a unit of L2 gas
+	PerArbGasTotal *big.Int
+}
+
+type arbitrumEstimator struct {
+	utils.StartStopOnce
+
+	config     Config
+	ethClient  eth.Client
+	pollPeriod time.Duration
+
+	pricesMu sync.RWMutex
+	prices   *ArbitrumPrices
+
+	chInitialised chan struct{}
+	chStop        chan struct{}
+	chDone        chan struct{}
+}
+
+// NewArbitrumEstimator returns an estimator for Arbitrum chains. Gas prices
+// are polled from the ArbGasInfo precompile and gas limits include the cost
+// of posting the transaction to L1.
+func NewArbitrumEstimator(config Config, ethClient eth.Client) Estimator {
+	return &arbitrumEstimator{
+		utils.StartStopOnce{},
+		config,
+		ethClient,
+		10 * time.Second,
+		sync.RWMutex{},
+		nil,
+		make(chan struct{}),
+		make(chan struct{}),
+		make(chan struct{}),
+	}
+}
+
+func (a *arbitrumEstimator) Start() error {
+	return a.StartOnce("ArbitrumEstimator", func() error {
+		go a.run()
+		<-a.chInitialised
+		return nil
+	})
+}
+
+func (a *arbitrumEstimator) Close() error {
+	return a.StopOnce("ArbitrumEstimator", func() error {
+		close(a.chStop)
+		<-a.chDone
+		return nil
+	})
+}
+
+func (a *arbitrumEstimator) run() {
+	defer close(a.chDone)
+
+	t := a.refreshPrices()
+	close(a.chInitialised)
+
+	for {
+		select {
+		case <-a.chStop:
+			t.Stop()
+			return
+		case <-t.C:
+			t = a.refreshPrices()
+		}
+	}
+}
+
+func (a *arbitrumEstimator) refreshPrices() (t *time.Timer) {
+	t = time.NewTimer(utils.WithJitter(a.pollPeriod))
+
+	ctx, cancel := utils.CombinedContext(a.chStop, maxEthNodeRequestTime)
+	defer cancel()
+
+	prices, err := a.fetchPrices(ctx)
+	if err != nil {
+		logger.Warnf("ArbitrumEstimator: Failed to refresh prices, got error: %s", err)
+		return
+	}
+
+	logger.Debugw("ArbitrumEstimator#refreshPrices", "perL2Tx", prices.PerL2Tx, "perL1CalldataByte", prices.PerL1CalldataByte, "perArbGasTotal", prices.PerArbGasTotal)
+
+	a.pricesMu.Lock()
+	defer a.pricesMu.Unlock()
+	a.prices = prices
+	return
+}
+
+func (a *arbitrumEstimator) fetchPrices(ctx context.Context) (*ArbitrumPrices, error) {
+	data, err := arbGasInfoABI.Pack("getPricesInWei")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to pack getPricesInWei")
+	}
+	b, err := a.ethClient.CallContract(ctx, ethereum.CallMsg{To: &ArbGasInfoAddress, Data: data}, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "ArbGasInfo.getPricesInWei call failed")
+	}
+	res, err := arbGasInfoABI.Unpack("getPricesInWei", b)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to unpack ArbGasInfo.getPricesInWei response")
+	}
+	// (perL2Tx, perL1CalldataByte, perStorageAllocation, perArbGasBase,
+	// perArbGasCongestion, perArbGasTotal)
+	prices := &ArbitrumPrices{
+		PerL2Tx:           res[0].(*big.Int),
+		PerL1CalldataByte: res[1].(*big.Int),
+		PerArbGasTotal:    res[5].(*big.Int),
+	}
+	if prices.PerArbGasTotal.Sign() <= 0 {
+		return nil, errors.Errorf("ArbGasInfo returned invalid gas price %s", prices.PerArbGasTotal)
+	}
+	return prices, nil
+}
+
+// EstimateGas returns the L2 gas price and the given gas limit plus the gas
+// needed to pay for posting calldata to L1. It is used if the full call is
+// not known; see EstimateCallGas.
+func (a *arbitrumEstimator) EstimateGas(calldata []byte, gasLimit uint64, _ ...Opt) (gasPrice *big.Int, chainSpecificGasLimit uint64, err error) {
+	ok := a.IfStarted(func() {
+		prices := a.getPrices()
+		if prices == nil {
+			err = errors.New("failed to estimate arbitrum gas; gas prices not set")
+			return
+		}
+		gasPrice = a.clamp(prices.PerArbGasTotal)
+		l1GasLimit := ArbitrumL1GasLimit(*prices, calldata)
+		chainSpecificGasLimit = applyMultiplier(gasLimit+l1GasLimit, a.config.EthGasLimitMultiplier())
+		logger.Debugw("ArbitrumEstimator#EstimateGas", "gasPrice", gasPrice, "gasLimit", gasLimit, "l1GasLimit", l1GasLimit, "chainSpecificGasLimit", chainSpecificGasLimit)
+	})
+	if !ok {
+		return nil, 0, errors.New("estimator is not started")
+	}
+	return
+}
+
+// EstimateCallGas returns the L2 gas price and the gas limit returned by
+// eth_estimateGas for call, which includes the L1 costs. It falls back to
+// EstimateGas if eth_estimateGas fails, e.g. because the call reverts.
+func (a *arbitrumEstimator) EstimateCallGas(call ethereum.CallMsg, gasLimit uint64, opts ...Opt) (gasPrice *big.Int, chainSpecificGasLimit uint64, err error) {
+	gasPrice, chainSpecificGasLimit, err = a.EstimateGas(call.Data, gasLimit, opts...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), maxEthNodeRequestTime)
+	defer cancel()
+	estimated, err := a.ethClient.EstimateGas(ctx, call)
+	if err != nil {
+		logger.Warnw("ArbitrumEstimator: eth_estimateGas failed, falling back to gas limit plus L1 calldata costs", "err", err, "gasLimit", gasLimit, "chainSpecificGasLimit", chainSpecificGasLimit)
+		return gasPrice, chainSpecificGasLimit, nil
+	}
+	chainSpecificGasLimit = applyMultiplier(estimated, a.config.EthGasLimitMultiplier())
+	logger.Debugw("ArbitrumEstimator#EstimateCallGas", "gasPrice", gasPrice, "estimatedGas", estimated, "chainSpecificGasLimit", chainSpecificGasLimit)
+	return gasPrice, chainSpecificGasLimit, nil
+}
+
+// ArbitrumL1GasLimit returns the amount of L2 gas needed to pay for posting a
+// transaction with calldata to L1, rounded up
+func ArbitrumL1GasLimit(prices ArbitrumPrices, calldata []byte) uint64 {
+	l1Cost := new(big.Int).Mul(prices.PerL1CalldataByte, big.NewInt(int64(len(calldata))))
+	l1Cost.Add(l1Cost, prices.PerL2Tx)
+	l1Gas := new(big.Int).Add(l1Cost, new(big.Int).Sub(prices.PerArbGasTotal, big.NewInt(1)))
+	l1Gas.Div(l1Gas, prices.PerArbGasTotal)
+	return l1Gas.Uint64()
+}
+
+func (a *arbitrumEstimator) clamp(gasPrice *big.Int) *big.Int {
+	if minPrice := a.config.EthMinGasPriceWei(); minPrice != nil && gasPrice.Cmp(minPrice) < 0 {
+		return minPrice
+	}
+	if maxPrice := a.config.EthMaxGasPriceWei(); maxPrice != nil && gasPrice.Cmp(maxPrice) > 0 {
+		return maxPrice
+	}
+	return gasPrice
+}
+
+func (a *arbitrumEstimator) getPrices() *ArbitrumPrices {
+	a.pricesMu.RLock()
+	defer a.pricesMu.RUnlock()
+	return a.prices
+}
+
+func (a *arbitrumEstimator) BumpGas(_ *big.Int, _ uint64) (bumpedGasPrice *big.Int, chainSpecificGasLimit uint64, err error) {
+	return nil, 0, errors.New("bump gas is not supported for arbitrum")
+}
+
+func (a *arbitrumEstimator) GetDynamicFee(_ uint64) (fee DynamicFee, chainSpecificGasLimit uint64, err error) {
+	err = errors.New("dynamic fees are not supported for arbitrum")
+	return
+}
+
+func (a *arbitrumEstimator) BumpDynamicFee(_ DynamicFee, _ uint64) (bumped DynamicFee, chainSpecificGasLimit uint64, err error) {
+	err = errors.New("dynamic fees are not supported for arbitrum")
+	return
+}
+
+func (a *arbitrumEstimator) OnNewLongestChain(_ context.Context, _ models.Head) {}
@@ -0,0 +1,121 @@
+package gas_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/services/gas"
+	"github.com/smartcontractkit/chainlink/core/services/gas/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func packArbGasInfoPrices(t *testing.T, perL2Tx, perL1CalldataByte, perArbGasTotal int64) []byte {
+	uint256, err := abi.NewType("uint256", "", nil)
+	require.NoError(t, err)
+	args := abi.Arguments{{Type: uint256}, {Type: uint256}, {Type: uint256}, {Type: uint256}, {Type: uint256}, {Type: uint256}}
+	b, err := args.Pack(big.NewInt(perL2Tx), big.NewInt(perL1CalldataByte), big.NewInt(0), big.NewInt(perArbGasTotal), big.NewInt(0), big.NewInt(perArbGasTotal))
+	require.NoError(t, err)
+	return b
+}
+
+func isArbGasInfoCall(msg ethereum.CallMsg) bool {
+	return msg.To != nil && *msg.To == gas.ArbGasInfoAddress
+}
+
+func Test_ArbitrumEstimator(t *testing.T) {
+	t.Parallel()
+
+	calldata := []byte{0x00, 0x00, 0x01, 0x02, 0x03}
+	var gasLimit uint64 = 80000
+
+	newConfig := func() *mocks.Config {
+		config := new(mocks.Config)
+		config.On("EthMinGasPriceWei").Return(big.NewInt(1000000000))
+		config.On("EthMaxGasPriceWei").Return(big.NewInt(1000000000000))
+		config.On("EthGasLimitMultiplier").Return(float32(1))
+		return config
+	}
+
+	t.Run("calling EstimateGas on unstarted estimator returns error", func(t *testing.T) {
+		a := gas.NewArbitrumEstimator(newConfig(), cltest.NewEthClientMock(t))
+		_, _, err := a.EstimateGas(calldata, gasLimit)
+		assert.EqualError(t, err, "estimator is not started")
+	})
+
+	t.Run("EstimateGas adds the L1 calldata costs to the gas limit", func(t *testing.T) {
+		ethClient := cltest.NewEthClientMock(t)
+		ethClient.On("CallContract", mock.Anything, mock.MatchedBy(isArbGasInfoCall), (*big.Int)(nil)).
+			Return(packArbGasInfoPrices(t, 200000000000000, 30000000000000, 2000000000), nil)
+		a := gas.NewArbitrumEstimator(newConfig(), ethClient)
+		require.NoError(t, a.Start())
+		defer a.Close()
+
+		gasPrice, chainSpecificGasLimit, err := a.EstimateGas(calldata, gasLimit)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(2000000000), gasPrice)
+		// (200000000000000 + 5 * 30000000000000) / 2000000000 = 175000
+		assert.Equal(t, gasLimit+175000, chainSpecificGasLimit)
+	})
+
+	t.Run("EstimateGas clamps the gas price", func(t *testing.T) {
+		ethClient := cltest.NewEthClientMock(t)
+		ethClient.On("CallContract", mock.Anything, mock.MatchedBy(isArbGasInfoCall), (*big.Int)(nil)).
+			Return(packArbGasInfoPrices(t, 0, 0, 1), nil)
+		a := gas.NewArbitrumEstimator(newConfig(), ethClient)
+		require.NoError(t, a.Start())
+		defer a.Close()
+
+		gasPrice, _, err := a.EstimateGas(calldata, gasLimit)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(1000000000), gasPrice)
+	})
+
+	t.Run("EstimateCallGas uses eth_estimateGas", func(t *testing.T) {
+		ethClient := cltest.NewEthClientMock(t)
+		ethClient.On("CallContract", mock.Anything, mock.MatchedBy(isArbGasInfoCall), (*big.Int)(nil)).
+			Return(packArbGasInfoPrices(t, 200000000000000, 30000000000000, 2000000000), nil)
+		to := common.HexToAddress("0x9E40733cC9df84636505f4e6Db28DCa0dC5D1bba")
+		call := ethereum.CallMsg{To: &to, Data: calldata}
+		ethClient.On("EstimateGas", mock.Anything, call).Return(uint64(654321), nil).Once()
+		a := gas.NewArbitrumEstimator(newConfig(), ethClient)
+		require.NoError(t, a.Start())
+		defer a.Close()
+
+		gasPrice, chainSpecificGasLimit, err := a.(gas.CallEstimator).EstimateCallGas(call, gasLimit)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(2000000000), gasPrice)
+		assert.Equal(t, uint64(654321), chainSpecificGasLimit)
+
+		ethClient.On("EstimateGas", mock.Anything, call).Return(uint64(0), errors.New("execution reverted")).Once()
+		_, chainSpecificGasLimit, err = a.(gas.CallEstimator).EstimateCallGas(call, gasLimit)
+		require.NoError(t, err)
+		assert.Equal(t, gasLimit+175000, chainSpecificGasLimit)
+
+		ethClient.AssertExpectations(t)
+	})
+
+	t.Run("EstimateGas returns error if prices could not be fetched", func(t *testing.T) {
+		ethClient := cltest.NewEthClientMock(t)
+		ethClient.On("CallContract", mock.Anything, mock.MatchedBy(isArbGasInfoCall), (*big.Int)(nil)).
+			Return(nil, errors.New("kaboom"))
+		a := gas.NewArbitrumEstimator(newConfig(), ethClient)
+		require.NoError(t, a.Start())
+		defer a.Close()
+
+		_, _, err := a.EstimateGas(calldata, gasLimit)
+		assert.EqualError(t, err, "failed to estimate arbitrum gas; gas prices not set")
+	})
+
+	t.Run("calling BumpGas always returns error", func(t *testing.T) {
+		a := gas.NewArbitrumEstimator(newConfig(), cltest.NewEthClientMock(t))
+		_, _, err := a.BumpGas(big.NewInt(42), gasLimit)
+		assert.EqualError(t, err, "bump gas is not supported for arbitrum")
+	})
+}
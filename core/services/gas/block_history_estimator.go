@@ -44,6 +44,13 @@ var (
 	},
 		[]string{"percentile"},
 	)
+
+	promBlockHistoryEstimatorSetTipCap = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "gas_updater_set_tip_cap",
+		Help: "Gas updater set EIP-1559 tip cap (in Wei)",
+	},
+		[]string{"percentile"},
+	)
 )
 
 var _ Estimator = &BlockHistoryEstimator{}
@@ -60,8 +67,10 @@ type (
 		ctx                 context.Context
 		ctxCancel           context.CancelFunc
 
-		gasPrice   *big.Int
-		gasPriceMu sync.RWMutex
+		gasPrice      *big.Int
+		tipCap        *big.Int
+		latestBaseFee *big.Int
+		gasPriceMu    sync.RWMutex
 
 		logger *logger.Logger
 	}
@@ -82,6 +91,8 @@ func NewBlockHistoryEstimator(ethClient eth.Client, config Config) Estimator {
 		ctx,
 		cancel,
 		nil,
+		nil,
+		nil,
 		sync.RWMutex{},
 		logger.CreateLogger(logger.Default.With("id", "block_history_estimator")),
 	}
@@ -143,6 +154,47 @@ func (b *BlockHistoryEstimator) BumpGas(originalGasPrice *big.Int, gasLimit uint
 	return BumpGasPriceOnly(b.config, originalGasPrice, gasLimit)
 }
 
+// GetDynamicFee returns the percentile tip cap calculated from block history,
+// along with a fee cap that is high enough to absorb several consecutive
+// full blocks' worth of base fee increases (2 * baseFee + tipCap).
+//
+// If no base fee is known (e.g. the history is still empty) the fee cap falls
+// back to ETH_GAS_PRICE_DEFAULT.
+func (b *BlockHistoryEstimator) GetDynamicFee(gasLimit uint64) (fee DynamicFee, chainSpecificGasLimit uint64, err error) {
+	var tipCap, baseFee *big.Int
+	ok := b.IfStarted(func() {
+		chainSpecificGasLimit = applyMultiplier(gasLimit, b.config.EthGasLimitMultiplier())
+		b.gasPriceMu.RLock()
+		defer b.gasPriceMu.RUnlock()
+		tipCap = b.tipCap
+		baseFee = b.latestBaseFee
+	})
+	if !ok {
+		return fee, 0, errors.New("BlockHistoryEstimator is not started; cannot estimate gas")
+	}
+	if tipCap == nil {
+		tipCap = b.config.EthGasTipCapDefault()
+	}
+	maxGasPrice := b.config.EthMaxGasPriceWei()
+	var feeCap *big.Int
+	if baseFee != nil {
+		feeCap = new(big.Int).Mul(baseFee, big.NewInt(2))
+		feeCap.Add(feeCap, tipCap)
+	} else {
+		feeCap = max(b.config.EthGasPriceDefault(), tipCap)
+	}
+	if feeCap.Cmp(maxGasPrice) > 0 {
+		b.logger.Warnw(fmt.Sprintf("Calculated fee cap of %s Wei exceeds ETH_MAX_GAS_PRICE_WEI=%[2]s, setting fee cap to the maximum allowed value of %[2]s Wei instead", feeCap.String(), maxGasPrice.String()), "feeCapWei", feeCap, "tipCapWei", tipCap, "baseFeeWei", baseFee, "maxGasPriceWei", maxGasPrice)
+		feeCap = maxGasPrice
+	}
+	fee = DynamicFee{FeeCap: feeCap, TipCap: tipCap}
+	return
+}
+
+func (b *BlockHistoryEstimator) BumpDynamicFee(originalFee DynamicFee, gasLimit uint64) (bumped DynamicFee, chainSpecificGasLimit uint64, err error) {
+	return BumpDynamicFeeOnly(b.config, originalFee, gasLimit)
+}
+
 func (b *BlockHistoryEstimator) runLoop() {
 	defer b.wg.Done()
 	for {
@@ -211,6 +263,38 @@ func (b *BlockHistoryEstimator) Recalculate(head models.Head) {
 	)
 	b.setPercentileGasPrice(percentileGasPrice)
 	promBlockHistoryEstimatorSetGasPrice.WithLabelValues(fmt.Sprintf("%v%%", percentile)).Set(float64(percentileGasPrice.Int64()))
+
+	b.recalculateDynamicFee(head, percentile)
+}
+
+// recalculateDynamicFee updates the tip cap and latest base fee from any
+// blocks in the history that have EIP-1559 activated. It is a no-op on chains
+// that do not support EIP-1559.
+func (b *BlockHistoryEstimator) recalculateDynamicFee(head models.Head, percentile int) {
+	latestBaseFee := b.latestBaseFeeInHistory()
+	if latestBaseFee == nil {
+		return
+	}
+	b.gasPriceMu.Lock()
+	b.latestBaseFee = latestBaseFee
+	b.gasPriceMu.Unlock()
+
+	percentileTipCap, err := b.percentileTipCap(percentile)
+	if err != nil {
+		if err == ErrNoSuitableTransactions {
+			b.logger.Debug("BlockHistoryEstimator: no suitable transactions for tip cap, skipping")
+		} else {
+			b.logger.Warnw("BlockHistoryEstimator: cannot calculate percentile tip cap", "err", err)
+		}
+		return
+	}
+	b.logger.Debugw(fmt.Sprintf("BlockHistoryEstimator: setting new default tip cap: %v Wei", percentileTipCap),
+		"tipCapWei", percentileTipCap,
+		"baseFeeWei", latestBaseFee,
+		"headNum", head.Number,
+	)
+	b.setPercentileTipCap(percentileTipCap)
+	promBlockHistoryEstimatorSetTipCap.WithLabelValues(fmt.Sprintf("%v%%", percentile)).Set(float64(percentileTipCap.Int64()))
 }
 
 func (b *BlockHistoryEstimator) FetchBlocks(ctx context.Context, head models.Head) error {
@@ -374,6 +458,86 @@ func (b *BlockHistoryEstimator) setPercentileGasPrice(gasPrice *big.Int) {
 	}
 }
 
+// latestBaseFeeInHistory returns the base fee of the most recent block in the
+// history, or nil if that block does not have a base fee
+func (b *BlockHistoryEstimator) latestBaseFeeInHistory() *big.Int {
+	if len(b.rollingBlockHistory) == 0 {
+		return nil
+	}
+	return b.rollingBlockHistory[len(b.rollingBlockHistory)-1].BaseFeePerGas
+}
+
+// percentileTipCap calculates the given percentile of the effective tips paid
+// by transactions in blocks with a known base fee
+func (b *BlockHistoryEstimator) percentileTipCap(percentile int) (*big.Int, error) {
+	minGasPriceWei := b.config.EthMinGasPriceWei()
+	chainID := b.config.ChainID()
+	tipCaps := make([]*big.Int, 0)
+	for _, block := range b.rollingBlockHistory {
+		if block.BaseFeePerGas == nil {
+			continue
+		}
+		for _, tx := range block.Transactions {
+			if !isUsableTx(tx, minGasPriceWei, chainID) {
+				continue
+			}
+			if tip := effectiveTipCap(tx, block.BaseFeePerGas); tip != nil {
+				tipCaps = append(tipCaps, tip)
+			}
+		}
+	}
+	if len(tipCaps) == 0 {
+		return big.NewInt(0), ErrNoSuitableTransactions
+	}
+	sort.Slice(tipCaps, func(i, j int) bool { return tipCaps[i].Cmp(tipCaps[j]) < 0 })
+	idx := ((len(tipCaps) - 1) * percentile) / 100
+	return tipCaps[idx], nil
+}
+
+func (b *BlockHistoryEstimator) setPercentileTipCap(tipCap *big.Int) {
+	max := b.config.EthMaxGasPriceWei()
+	min := b.config.EthGasTipCapMinimum()
+
+	b.gasPriceMu.Lock()
+	defer b.gasPriceMu.Unlock()
+	if tipCap.Cmp(max) > 0 {
+		b.logger.Warnw(fmt.Sprintf("Calculated tip cap of %s Wei exceeds ETH_MAX_GAS_PRICE_WEI=%[2]s, setting tip cap to the maximum allowed value of %[2]s Wei instead", tipCap.String(), max.String()), "tipCapWei", tipCap, "maxGasPriceWei", max)
+		b.tipCap = max
+	} else if tipCap.Cmp(min) < 0 {
+		b.logger.Debugw(fmt.Sprintf("Calculated tip cap of %s Wei falls below ETH_GAS_TIP_CAP_MINIMUM=%[2]s, setting tip cap to the minimum allowed value of %[2]s Wei instead", tipCap.String(), min.String()), "tipCapWei", tipCap, "minTipCapWei", min)
+		b.tipCap = min
+	} else {
+		b.tipCap = tipCap
+	}
+}
+
+// effectiveTipCap returns the tip actually paid to the miner by a transaction
+// included in a block with the given base fee, or nil if it cannot be
+// determined
+func effectiveTipCap(tx Transaction, baseFee *big.Int) *big.Int {
+	if tx.Type == DynamicFeeTxType {
+		if tx.MaxPriorityFeePerGas == nil || tx.MaxFeePerGas == nil {
+			return nil
+		}
+		headroom := new(big.Int).Sub(tx.MaxFeePerGas, baseFee)
+		if headroom.Sign() < 0 {
+			return nil
+		}
+		if headroom.Cmp(tx.MaxPriorityFeePerGas) < 0 {
+			return headroom
+		}
+		return tx.MaxPriorityFeePerGas
+	}
+	if tx.GasPrice == nil {
+		return nil
+	}
+	tip := new(big.Int).Sub(tx.GasPrice, baseFee)
+	if tip.Sign() < 0 {
+		return nil
+	}
+	return tip
+}
+
 func (b *BlockHistoryEstimator) RollingBlockHistory() []Block {
 	return b.rollingBlockHistory
 }
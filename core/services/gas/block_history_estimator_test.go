@@ -600,6 +600,153 @@ func TestBlockHistoryEstimator_Recalculate(t *testing.T) {
 	})
 }
 
+func TestBlockHistoryEstimator_RecalculateTipCap(t *testing.T) {
+	t.Parallel()
+
+	maxGasPrice := big.NewInt(1000)
+
+	newEstimator := func(t *testing.T, tipCapMinimum int64) (*gas.BlockHistoryEstimator, *gumocks.Config) {
+		ethClient := cltest.NewEthClientMock(t)
+		config := new(gumocks.Config)
+
+		config.On("BlockHistoryEstimatorTransactionPercentile").Return(uint16(50))
+		config.On("EthMaxGasPriceWei").Return(maxGasPrice)
+		config.On("EthMinGasPriceWei").Return(big.NewInt(0))
+		config.On("EthGasTipCapMinimum").Return(big.NewInt(tipCapMinimum))
+		config.On("ChainID").Return(big.NewInt(0))
+
+		return gas.BlockHistoryEstimatorFromInterface(gas.NewBlockHistoryEstimator(ethClient, config)), config
+	}
+
+	blocks := []gas.Block{
+		{
+			// Blocks without a base fee are ignored
+			Number:       0,
+			Hash:         utils.NewHash(),
+			Transactions: cltest.TransactionsFromGasPrices(500),
+		},
+		{
+			Number:        1,
+			Hash:          utils.NewHash(),
+			BaseFeePerGas: big.NewInt(100),
+			Transactions: []gas.Transaction{
+				// Legacy transactions tip their gas price above the base fee
+				{GasPrice: big.NewInt(110), GasLimit: 42},
+				// Legacy transactions priced below the base fee are ignored
+				{GasPrice: big.NewInt(90), GasLimit: 42},
+				// Dynamic fee transactions tip their tip cap...
+				{GasPrice: big.NewInt(120), GasLimit: 42, Type: gas.DynamicFeeTxType, MaxFeePerGas: big.NewInt(200), MaxPriorityFeePerGas: big.NewInt(20)},
+				// ...unless the fee cap leaves less headroom above the base fee
+				{GasPrice: big.NewInt(130), GasLimit: 42, Type: gas.DynamicFeeTxType, MaxFeePerGas: big.NewInt(130), MaxPriorityFeePerGas: big.NewInt(50)},
+			},
+		},
+	}
+
+	t.Run("sets the percentile of the tips paid in blocks with a base fee", func(t *testing.T) {
+		bhe, config := newEstimator(t, 1)
+		gas.SetRollingBlockHistory(bhe, blocks)
+
+		bhe.Recalculate(*cltest.Head(1))
+
+		assert.Equal(t, big.NewInt(20), gas.GetTipCap(bhe))
+		config.AssertExpectations(t)
+	})
+
+	t.Run("sets tip cap to ETH_GAS_TIP_CAP_MINIMUM if the calculation would otherwise fall below it", func(t *testing.T) {
+		bhe, config := newEstimator(t, 25)
+		gas.SetRollingBlockHistory(bhe, blocks)
+
+		bhe.Recalculate(*cltest.Head(1))
+
+		assert.Equal(t, big.NewInt(25), gas.GetTipCap(bhe))
+		config.AssertExpectations(t)
+	})
+
+	t.Run("does not set a tip cap on chains without a base fee", func(t *testing.T) {
+		bhe, _ := newEstimator(t, 1)
+		gas.SetRollingBlockHistory(bhe, blocks[:1])
+
+		bhe.Recalculate(*cltest.Head(0))
+
+		assert.Nil(t, gas.GetTipCap(bhe))
+	})
+}
+
+func TestBlockHistoryEstimator_GetDynamicFee(t *testing.T) {
+	t.Parallel()
+
+	newEstimator := func(t *testing.T, maxGasPrice int64) (*gas.BlockHistoryEstimator, *gumocks.Config) {
+		ethClient := cltest.NewEthClientMock(t)
+		config := new(gumocks.Config)
+
+		config.On("BlockHistoryEstimatorBlockHistorySize").Return(uint16(1))
+		config.On("EthFinalityDepth").Return(uint(42))
+		config.On("BlockHistoryEstimatorTransactionPercentile").Return(uint16(50))
+		config.On("EthMaxGasPriceWei").Return(big.NewInt(maxGasPrice))
+		config.On("EthMinGasPriceWei").Return(big.NewInt(0))
+		config.On("EthGasTipCapMinimum").Return(big.NewInt(1))
+		config.On("EthGasLimitMultiplier").Return(float32(1.5))
+		config.On("ChainID").Return(big.NewInt(0))
+		ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(nil, errors.New("no head"))
+
+		bhe := gas.BlockHistoryEstimatorFromInterface(gas.NewBlockHistoryEstimator(ethClient, config))
+		require.NoError(t, bhe.Start())
+		t.Cleanup(func() { assert.NoError(t, bhe.Close()) })
+		return bhe, config
+	}
+
+	blocks := []gas.Block{
+		{
+			Number:        1,
+			Hash:          utils.NewHash(),
+			BaseFeePerGas: big.NewInt(100),
+			Transactions:  cltest.TransactionsFromGasPrices(110, 120, 130),
+		},
+	}
+
+	t.Run("errors if not started", func(t *testing.T) {
+		bhe := gas.NewBlockHistoryEstimator(cltest.NewEthClientMock(t), new(gumocks.Config))
+
+		_, _, err := bhe.GetDynamicFee(100000)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "BlockHistoryEstimator is not started")
+	})
+
+	t.Run("returns the tip cap and twice the latest base fee plus tip cap as fee cap", func(t *testing.T) {
+		bhe, _ := newEstimator(t, 1000)
+		gas.SetRollingBlockHistory(bhe, blocks)
+		bhe.Recalculate(*cltest.Head(1))
+
+		fee, gasLimit, err := bhe.GetDynamicFee(100000)
+		require.NoError(t, err)
+		assert.Equal(t, 150000, int(gasLimit))
+		assert.Equal(t, big.NewInt(20), fee.TipCap)
+		assert.Equal(t, big.NewInt(220), fee.FeeCap)
+	})
+
+	t.Run("caps the fee cap at ETH_MAX_GAS_PRICE_WEI", func(t *testing.T) {
+		bhe, _ := newEstimator(t, 150)
+		gas.SetRollingBlockHistory(bhe, blocks)
+		bhe.Recalculate(*cltest.Head(1))
+
+		fee, _, err := bhe.GetDynamicFee(100000)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(20), fee.TipCap)
+		assert.Equal(t, big.NewInt(150), fee.FeeCap)
+	})
+
+	t.Run("falls back to the default tip cap and gas price without a base fee", func(t *testing.T) {
+		bhe, config := newEstimator(t, 1000)
+		config.On("EthGasTipCapDefault").Return(big.NewInt(5))
+		config.On("EthGasPriceDefault").Return(big.NewInt(300))
+
+		fee, _, err := bhe.GetDynamicFee(100000)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(5), fee.TipCap)
+		assert.Equal(t, big.NewInt(300), fee.FeeCap)
+	})
+}
+
 func TestBlockHistoryEstimator_Block(t *testing.T) {
 	blockJSON := `
 {
@@ -674,6 +821,41 @@ func TestBlockHistoryEstimator_Block(t *testing.T) {
 
 	assert.Equal(t, int64(0), block.Transactions[0].GasPrice.Int64())
 	assert.Equal(t, uint64(0), block.Transactions[0].GasLimit)
+	assert.Equal(t, gas.LegacyTxType, block.Transactions[0].Type)
 	assert.Equal(t, big.NewInt(4566182400000), block.Transactions[1].GasPrice)
 	assert.Equal(t, uint64(2000000), block.Transactions[1].GasLimit)
 }
+
+func TestBlockHistoryEstimator_Block_DynamicFeeTransaction(t *testing.T) {
+	blockJSON := `
+{
+    "hash": "0x317cfd032b5d6657995f17fe768f7cc4ea0ada27ad421c4caa685a9071ea955c",
+    "number": "0xf47e79",
+    "parentHash": "0xb47ab3b1dc5c2c090dcecdc744a65a279ea6bb8dec11fb3c247df4cc2f584848",
+    "baseFeePerGas": "0x7",
+    "transactions": [
+      {
+        "gasPrice": "0x1e",
+        "gas": "0x5208",
+        "type": "0x2",
+        "maxFeePerGas": "0x64",
+        "maxPriorityFeePerGas": "0x17"
+      }
+    ]
+}
+`
+
+	var block gas.Block
+	err := json.Unmarshal([]byte(blockJSON), &block)
+	require.NoError(t, err)
+
+	assert.Equal(t, big.NewInt(7), block.BaseFeePerGas)
+	require.Len(t, block.Transactions, 1)
+	assert.Equal(t, gas.DynamicFeeTxType, block.Transactions[0].Type)
+	assert.Equal(t, big.NewInt(30), block.Transactions[0].GasPrice)
+	assert.Equal(t, big.NewInt(100), block.Transactions[0].MaxFeePerGas)
+	assert.Equal(t, big.NewInt(23), block.Transactions[0].MaxPriorityFeePerGas)
+
+	err = json.Unmarshal([]byte(`{"gas": "0x5208", "type": "2"}`), &block.Transactions[0])
+	require.Error(t, err)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"math/big"
 
+	"github.com/pkg/errors"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 )
 
@@ -30,3 +31,20 @@ func (f *fixedPriceEstimator) EstimateGas(_ []byte, gasLimit uint64, _ ...Opt) (
 func (f *fixedPriceEstimator) BumpGas(originalGasPrice *big.Int, originalGasLimit uint64) (gasPrice *big.Int, gasLimit uint64, err error) {
 	return BumpGasPriceOnly(f.config, originalGasPrice, originalGasLimit)
 }
+
+func (f *fixedPriceEstimator) GetDynamicFee(originalGasLimit uint64) (fee DynamicFee, chainSpecificGasLimit uint64, err error) {
+	tipCap := f.config.EthGasTipCapDefault()
+	if tipCap == nil {
+		return fee, 0, errors.New("cannot calculate dynamic fee: EthGasTipCapDefault was not set")
+	}
+	chainSpecificGasLimit = applyMultiplier(originalGasLimit, f.config.EthGasLimitMultiplier())
+	// The fee cap is only an upper bound; the actual price paid is base fee +
+	// tip. Use the default gas price rather than the maximum so that there is
+	// headroom left for bumping.
+	fee = DynamicFee{FeeCap: max(f.config.EthGasPriceDefault(), tipCap), TipCap: tipCap}
+	return
+}
+
+func (f *fixedPriceEstimator) BumpDynamicFee(originalFee DynamicFee, originalGasLimit uint64) (bumped DynamicFee, chainSpecificGasLimit uint64, err error) {
+	return BumpDynamicFeeOnly(f.config, originalFee, originalGasLimit)
+}
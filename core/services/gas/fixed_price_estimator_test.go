@@ -50,4 +50,21 @@ func Test_FixedPriceEstimator(t *testing.T) {
 
 		config.AssertExpectations(t)
 	})
+
+	t.Run("GetDynamicFee returns EthGasTipCapDefault and EthGasPriceDefault as fee cap", func(t *testing.T) {
+		config := new(mocks.Config)
+		f := gas.NewFixedPriceEstimator(config)
+
+		config.On("EthGasTipCapDefault").Return(big.NewInt(5))
+		config.On("EthGasPriceDefault").Return(big.NewInt(42))
+		config.On("EthGasLimitMultiplier").Return(float32(1.1))
+
+		fee, gasLimit, err := f.GetDynamicFee(100000)
+		require.NoError(t, err)
+		assert.Equal(t, 110000, int(gasLimit))
+		assert.Equal(t, big.NewInt(5), fee.TipCap)
+		assert.Equal(t, big.NewInt(42), fee.FeeCap)
+
+		config.AssertExpectations(t)
+	})
 }
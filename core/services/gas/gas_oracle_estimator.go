@@ -0,0 +1,307 @@
+package gas
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/tidwall/gjson"
+)
+
+const (
+	// maxGasOracleRequestTime is the worst case time we will wait for a
+	// response from a gas oracle
+	maxGasOracleRequestTime = 10 * time.Second
+	// maxGasOracleResponseSize caps the size of a gas oracle response body
+	maxGasOracleResponseSize = 1 << 20
+	// gasOraclePriceMaxAgePolls is the number of poll periods after which a
+	// price fetched from a gas oracle is no longer used
+	gasOraclePriceMaxAgePolls = 3
+)
+
+var _ Estimator = &gasOracleEstimator{}
+
+type (
+	// gasOracle is an external HTTP endpoint returning a gas price in JSON
+	gasOracle struct {
+		// url is the URL that is queried, without the fragment
+		url string
+		// host identifies the oracle in logs, as its URL may hold API keys
+		host string
+		// path is the gjson path of the gas price in the response; an empty
+		// path means the response body is the price itself
+		path string
+	}
+
+	gasOraclePrice struct {
+		price     *big.Int
+		fetchedAt time.Time
+	}
+
+	gasOracleEstimator struct {
+		utils.StartStopOnce
+
+		config       Config
+		blockHistory Estimator
+		client       *http.Client
+		oracles      []gasOracle
+
+		pricesMu sync.RWMutex
+		prices   []gasOraclePrice
+
+		chStop chan struct{}
+		chDone chan struct{}
+
+		logger *logger.Logger
+	}
+)
+
+// NewGasOracleEstimator returns an estimator that combines the gas prices
+// returned by the external gas oracles configured in GAS_ORACLE_URLS with the
+// gas price calculated by blockHistory. If none of the oracles returned a
+// price recently, including until they are first queried after Start, it
+// falls back to blockHistory alone.
+func NewGasOracleEstimator(config Config, blockHistory Estimator) Estimator {
+	urls := config.GasOracleURLs()
+	oracles := make([]gasOracle, len(urls))
+	for i, u := range urls {
+		oracles[i].path = u.Fragment
+		u.Fragment = ""
+		oracles[i].url = u.String()
+		oracles[i].host = u.Host
+	}
+	return &gasOracleEstimator{
+		utils.StartStopOnce{},
+		config,
+		blockHistory,
+		&http.Client{Timeout: maxGasOracleRequestTime},
+		oracles,
+		sync.RWMutex{},
+		make([]gasOraclePrice, len(oracles)),
+		make(chan struct{}),
+		make(chan struct{}),
+		logger.CreateLogger(logger.Default.With("id", "gas_oracle_estimator")),
+	}
+}
+
+func (o *gasOracleEstimator) Start() error {
+	return o.StartOnce("GasOracleEstimator", func() error {
+		if err := o.blockHistory.Start(); err != nil {
+			return errors.Wrap(err, "GasOracleEstimator: failed to start block history estimator")
+		}
+		go o.run()
+		return nil
+	})
+}
+
+func (o *gasOracleEstimator) Close() error {
+	return o.StopOnce("GasOracleEstimator", func() error {
+		close(o.chStop)
+		<-o.chDone
+		return o.blockHistory.Close()
+	})
+}
+
+func (o *gasOracleEstimator) run() {
+	defer close(o.chDone)
+
+	o.refreshPrices()
+
+	pollPeriod := o.config.GasOraclePollPeriod()
+	t := time.NewTimer(utils.WithJitter(pollPeriod))
+	for {
+		select {
+		case <-o.chStop:
+			t.Stop()
+			return
+		case <-t.C:
+			o.refreshPrices()
+			t = time.NewTimer(utils.WithJitter(pollPeriod))
+		}
+	}
+}
+
+// refreshPrices queries all gas oracles concurrently. Oracles that fail keep
+// their previous price, which expires once it gets too old.
+func (o *gasOracleEstimator) refreshPrices() {
+	ctx, cancel := utils.CombinedContext(o.chStop, maxGasOracleRequestTime)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	wg.Add(len(o.oracles))
+	for i := range o.oracles {
+		go func(i int) {
+			defer wg.Done()
+			oracle := o.oracles[i]
+			price, err := o.fetchPrice(ctx, oracle)
+			if err != nil {
+				o.logger.Warnw("GasOracleEstimator: failed to fetch gas price from oracle", "oracle", oracle.host, "err", err)
+				return
+			}
+			o.logger.Debugw("GasOracleEstimator: fetched gas price from oracle", "oracle", oracle.host, "gasPrice", price)
+			o.pricesMu.Lock()
+			defer o.pricesMu.Unlock()
+			o.prices[i] = gasOraclePrice{price, time.Now()}
+		}(i)
+	}
+	wg.Wait()
+}
+
+func (o *gasOracleEstimator) fetchPrice(ctx context.Context, oracle gasOracle) (*big.Int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, oracle.url, nil)
+	if err != nil {
+		return nil, withoutURL(err)
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := o.client.Do(req)
+	if err != nil {
+		return nil, withoutURL(err)
+	}
+	defer logger.ErrorIfCalling(resp.Body.Close)
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("got status code %d", resp.StatusCode)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxGasOracleResponseSize))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read response body")
+	}
+	return parseGasOraclePrice(body, oracle.path, o.config.GasOraclePriceUnit())
+}
+
+// withoutURL strips the URL, which may hold API keys, that net/http adds to
+// its errors
+func withoutURL(err error) error {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return errors.Errorf("%s failed: %v", urlErr.Op, urlErr.Err)
+	}
+	return err
+}
+
+// parseGasOraclePrice extracts the gas price at path from a gas oracle
+// response and converts it from unit to wei
+func parseGasOraclePrice(body []byte, path string, unit string) (*big.Int, error) {
+	raw := string(bytes.TrimSpace(body))
+	if path != "" {
+		result := gjson.GetBytes(body, path)
+		if !result.Exists() {
+			return nil, errors.Errorf("response has no value at path %q", path)
+		}
+		raw = result.String()
+	}
+	price, err := decimal.NewFromString(raw)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse gas price %q", raw)
+	}
+	if unit == "gwei" {
+		price = price.Shift(9)
+	}
+	if !price.IsPositive() {
+		return nil, errors.Errorf("gas price must be positive, got %s wei", price)
+	}
+	return price.BigInt(), nil
+}
+
+func (o *gasOracleEstimator) EstimateGas(calldata []byte, gasLimit uint64, opts ...Opt) (gasPrice *big.Int, chainSpecificGasLimit uint64, err error) {
+	ok := o.IfStarted(func() {
+		var blockHistoryPrice *big.Int
+		blockHistoryPrice, chainSpecificGasLimit, err = o.blockHistory.EstimateGas(calldata, gasLimit, opts...)
+		if err != nil {
+			return
+		}
+		prices := o.freshPrices()
+		if len(prices) == 0 {
+			o.logger.Debugw("GasOracleEstimator: no recent gas oracle prices, falling back to block history", "blockHistoryPrice", blockHistoryPrice)
+		}
+		if blockHistoryPrice != nil {
+			prices = append(prices, blockHistoryPrice)
+		}
+		if len(prices) == 0 {
+			gasPrice = o.config.EthGasPriceDefault()
+		} else if o.config.GasOracleAggregation() == "max" {
+			gasPrice = maxPrice(prices)
+		} else {
+			gasPrice = medianPrice(prices)
+		}
+		gasPrice = o.clamp(gasPrice)
+	})
+	if !ok {
+		return nil, 0, errors.New("GasOracleEstimator is not started; cannot estimate gas")
+	}
+	return
+}
+
+// freshPrices returns the oracle prices fetched within the last few poll
+// periods
+func (o *gasOracleEstimator) freshPrices() (prices []*big.Int) {
+	cutoff := time.Now().Add(-gasOraclePriceMaxAgePolls * o.config.GasOraclePollPeriod())
+	o.pricesMu.RLock()
+	defer o.pricesMu.RUnlock()
+	for _, p := range o.prices {
+		if p.price != nil && p.fetchedAt.After(cutoff) {
+			prices = append(prices, p.price)
+		}
+	}
+	return prices
+}
+
+func (o *gasOracleEstimator) clamp(gasPrice *big.Int) *big.Int {
+	if minPrice := o.config.EthMinGasPriceWei(); minPrice != nil && gasPrice.Cmp(minPrice) < 0 {
+		return minPrice
+	}
+	if maxGasPrice := o.config.EthMaxGasPriceWei(); maxGasPrice != nil && gasPrice.Cmp(maxGasPrice) > 0 {
+		return maxGasPrice
+	}
+	return gasPrice
+}
+
+func (o *gasOracleEstimator) BumpGas(originalGasPrice *big.Int, gasLimit uint64) (bumpedGasPrice *big.Int, chainSpecificGasLimit uint64, err error) {
+	return BumpGasPriceOnly(o.config, originalGasPrice, gasLimit)
+}
+
+func (o *gasOracleEstimator) GetDynamicFee(gasLimit uint64) (fee DynamicFee, chainSpecificGasLimit uint64, err error) {
+	return o.blockHistory.GetDynamicFee(gasLimit)
+}
+
+func (o *gasOracleEstimator) BumpDynamicFee(original DynamicFee, gasLimit uint64) (bumped DynamicFee, chainSpecificGasLimit uint64, err error) {
+	return o.blockHistory.BumpDynamicFee(original, gasLimit)
+}
+
+func (o *gasOracleEstimator) OnNewLongestChain(ctx context.Context, head models.Head) {
+	o.blockHistory.OnNewLongestChain(ctx, head)
+}
+
+func maxPrice(prices []*big.Int) *big.Int {
+	m := prices[0]
+	for _, p := range prices[1:] {
+		if p.Cmp(m) > 0 {
+			m = p
+		}
+	}
+	return m
+}
+
+// medianPrice returns the median of prices, rounded down to the nearest wei
+// for an even number of prices
+func medianPrice(prices []*big.Int) *big.Int {
+	sorted := make([]*big.Int, len(prices))
+	copy(sorted, prices)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Cmp(sorted[j]) < 0 })
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return sorted[mid]
+	}
+	sum := new(big.Int).Add(sorted[mid-1], sorted[mid])
+	return sum.Div(sum, big.NewInt(2))
+}
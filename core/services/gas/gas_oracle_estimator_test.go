@@ -0,0 +1,225 @@
+package gas_test
+
+import (
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/services/gas"
+	"github.com/smartcontractkit/chainlink/core/services/gas/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newGasOracleServer(t *testing.T, status int, body string) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func mustGasOracleURL(t *testing.T, server *httptest.Server, path string) url.URL {
+	u, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	u.Fragment = path
+	return *u
+}
+
+func newGasOracleConfig(urls []url.URL, aggregation, unit string) *mocks.Config {
+	config := new(mocks.Config)
+	config.On("GasOracleURLs").Return(urls)
+	config.On("GasOracleAggregation").Return(aggregation)
+	config.On("GasOraclePriceUnit").Return(unit)
+	config.On("GasOraclePollPeriod").Return(time.Hour)
+	config.On("EthMinGasPriceWei").Return(big.NewInt(1000000000))
+	config.On("EthMaxGasPriceWei").Return(big.NewInt(500000000000))
+	config.On("EthGasPriceDefault").Return(big.NewInt(20000000000))
+	return config
+}
+
+func newBlockHistoryStandIn(gasPrice *big.Int) *mocks.Estimator {
+	blockHistory := new(mocks.Estimator)
+	blockHistory.On("Start").Return(nil)
+	blockHistory.On("Close").Return(nil)
+	blockHistory.On("EstimateGas", mock.Anything, uint64(100000)).Return(gasPrice, uint64(110000), nil)
+	return blockHistory
+}
+
+// requireGasPriceEventually waits for the first refresh of the oracles, which
+// runs in the background after Start
+func requireGasPriceEventually(t *testing.T, o gas.Estimator, expected *big.Int) {
+	t.Helper()
+	require.Eventually(t, func() bool {
+		gasPrice, _, err := o.EstimateGas(nil, 100000)
+		require.NoError(t, err)
+		return gasPrice.Cmp(expected) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func Test_GasOracleEstimator(t *testing.T) {
+	t.Parallel()
+
+	fast := newGasOracleServer(t, http.StatusOK, `{"fast": {"maxFee": 40.5}}`)
+	standard := newGasOracleServer(t, http.StatusOK, `{"result": {"ProposeGasPrice": "30"}}`)
+	wei := newGasOracleServer(t, http.StatusOK, `25000000000`)
+	down := newGasOracleServer(t, http.StatusServiceUnavailable, `{}`)
+	garbage := newGasOracleServer(t, http.StatusOK, `{"fast": "soon"}`)
+
+	t.Run("calling EstimateGas on unstarted estimator returns error", func(t *testing.T) {
+		config := newGasOracleConfig(nil, "median", "gwei")
+		o := gas.NewGasOracleEstimator(config, newBlockHistoryStandIn(nil))
+
+		_, _, err := o.EstimateGas(nil, 100000)
+		assert.EqualError(t, err, "GasOracleEstimator is not started; cannot estimate gas")
+	})
+
+	t.Run("median of oracle prices and block history", func(t *testing.T) {
+		config := newGasOracleConfig([]url.URL{
+			mustGasOracleURL(t, fast, "fast.maxFee"),
+			mustGasOracleURL(t, standard, "result.ProposeGasPrice"),
+		}, "median", "gwei")
+		blockHistory := newBlockHistoryStandIn(big.NewInt(10000000000))
+		o := gas.NewGasOracleEstimator(config, blockHistory)
+		require.NoError(t, o.Start())
+		defer o.Close()
+
+		requireGasPriceEventually(t, o, big.NewInt(30000000000))
+		_, gasLimit, err := o.EstimateGas(nil, 100000)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(110000), gasLimit)
+	})
+
+	t.Run("median of an even number of prices", func(t *testing.T) {
+		config := newGasOracleConfig([]url.URL{
+			mustGasOracleURL(t, fast, "fast.maxFee"),
+		}, "median", "gwei")
+		o := gas.NewGasOracleEstimator(config, newBlockHistoryStandIn(big.NewInt(10000000000)))
+		require.NoError(t, o.Start())
+		defer o.Close()
+
+		requireGasPriceEventually(t, o, big.NewInt(25250000000))
+	})
+
+	t.Run("max of oracle prices and block history", func(t *testing.T) {
+		config := newGasOracleConfig([]url.URL{
+			mustGasOracleURL(t, fast, "fast.maxFee"),
+			mustGasOracleURL(t, standard, "result.ProposeGasPrice"),
+		}, "max", "gwei")
+		o := gas.NewGasOracleEstimator(config, newBlockHistoryStandIn(big.NewInt(10000000000)))
+		require.NoError(t, o.Start())
+		defer o.Close()
+
+		requireGasPriceEventually(t, o, big.NewInt(40500000000))
+	})
+
+	t.Run("prices in wei and an empty path", func(t *testing.T) {
+		config := newGasOracleConfig([]url.URL{
+			mustGasOracleURL(t, wei, ""),
+		}, "max", "wei")
+		o := gas.NewGasOracleEstimator(config, newBlockHistoryStandIn(nil))
+		require.NoError(t, o.Start())
+		defer o.Close()
+
+		requireGasPriceEventually(t, o, big.NewInt(25000000000))
+	})
+
+	t.Run("clamps to EthMinGasPriceWei and EthMaxGasPriceWei", func(t *testing.T) {
+		config := newGasOracleConfig([]url.URL{
+			mustGasOracleURL(t, wei, ""),
+		}, "max", "gwei")
+		o := gas.NewGasOracleEstimator(config, newBlockHistoryStandIn(big.NewInt(1)))
+		require.NoError(t, o.Start())
+		defer o.Close()
+
+		requireGasPriceEventually(t, o, big.NewInt(500000000000))
+
+		config = newGasOracleConfig(nil, "median", "gwei")
+		o = gas.NewGasOracleEstimator(config, newBlockHistoryStandIn(big.NewInt(1)))
+		require.NoError(t, o.Start())
+		defer o.Close()
+
+		gasPrice, _, err := o.EstimateGas(nil, 100000)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(1000000000), gasPrice)
+	})
+
+	t.Run("falls back to block history if the oracles fail", func(t *testing.T) {
+		config := newGasOracleConfig([]url.URL{
+			mustGasOracleURL(t, down, "fast"),
+			mustGasOracleURL(t, garbage, "fast"),
+			mustGasOracleURL(t, standard, "missing.path"),
+		}, "max", "gwei")
+		o := gas.NewGasOracleEstimator(config, newBlockHistoryStandIn(big.NewInt(10000000000)))
+		require.NoError(t, o.Start())
+		defer o.Close()
+
+		gasPrice, gasLimit, err := o.EstimateGas(nil, 100000)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(10000000000), gasPrice)
+		assert.Equal(t, uint64(110000), gasLimit)
+	})
+
+	t.Run("falls back to EthGasPriceDefault without any price", func(t *testing.T) {
+		config := newGasOracleConfig([]url.URL{
+			mustGasOracleURL(t, down, "fast"),
+		}, "median", "gwei")
+		o := gas.NewGasOracleEstimator(config, newBlockHistoryStandIn(nil))
+		require.NoError(t, o.Start())
+		defer o.Close()
+
+		gasPrice, _, err := o.EstimateGas(nil, 100000)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(20000000000), gasPrice)
+	})
+
+	t.Run("does not wait for the oracles to start", func(t *testing.T) {
+		unblock := make(chan struct{})
+		slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			<-unblock
+			_, _ = w.Write([]byte(`{"fast": 100}`))
+		}))
+		t.Cleanup(slow.Close)
+		t.Cleanup(func() { close(unblock) })
+		config := newGasOracleConfig([]url.URL{
+			mustGasOracleURL(t, slow, "fast"),
+		}, "max", "gwei")
+		o := gas.NewGasOracleEstimator(config, newBlockHistoryStandIn(big.NewInt(10000000000)))
+		require.NoError(t, o.Start())
+		defer o.Close()
+
+		gasPrice, _, err := o.EstimateGas(nil, 100000)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(10000000000), gasPrice)
+	})
+
+	t.Run("closing closes block history", func(t *testing.T) {
+		config := newGasOracleConfig(nil, "median", "gwei")
+		blockHistory := newBlockHistoryStandIn(nil)
+		o := gas.NewGasOracleEstimator(config, blockHistory)
+		require.NoError(t, o.Start())
+		require.NoError(t, o.Close())
+
+		blockHistory.AssertCalled(t, "Close")
+	})
+}
+
+func Test_GasOracleEstimator_WithoutURL(t *testing.T) {
+	t.Parallel()
+
+	_, err := http.Get("http://127.0.0.1:0/gas?apikey=secret")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "secret")
+
+	err = gas.WithoutURL(err)
+	assert.NotContains(t, err.Error(), "secret")
+	assert.Contains(t, err.Error(), "Get failed")
+
+	assert.EqualError(t, gas.WithoutURL(errors.New("other")), "other")
+}
@@ -137,6 +137,45 @@ func Test_BumpGasPriceOnly_NoBumpError(t *testing.T) {
 	require.Contains(t, err.Error(), "bumped gas price of 40000000000 is equal to original gas price of 40000000000. ACTION REQUIRED: This is a configuration error, you must increase either ETH_GAS_BUMP_PERCENT or ETH_GAS_BUMP_WEI")
 }
 
+func Test_BumpDynamicFeeOnly(t *testing.T) {
+	t.Parallel()
+	cfg := config.NewConfig()
+	cfg.Set("ETH_GAS_BUMP_PERCENT", "20")
+	cfg.Set("ETH_GAS_BUMP_WEI", toBigInt("5e9"))        // 5 GWei
+	cfg.Set("ETH_GAS_TIP_CAP_DEFAULT", toBigInt("2e9")) // 2 GWei
+	cfg.Set("ETH_MAX_GAS_PRICE_WEI", toBigInt("5e11"))  // 500 GWei
+	cfg.Set("ETH_GAS_LIMIT_MULTIPLIER", float32(1.1))
+
+	original := gas.DynamicFee{TipCap: toBigInt("1e9"), FeeCap: toBigInt("4e10")}
+	bumped, limit, err := gas.BumpDynamicFeeOnly(cfg, original, 100000)
+	require.NoError(t, err)
+	// Tip cap is bumped from the default since it is higher than the original
+	assert.Equal(t, toBigInt("7e9").String(), bumped.TipCap.String())
+	assert.Equal(t, toBigInt("4.8e10").String(), bumped.FeeCap.String())
+	assert.Equal(t, 110000, int(limit))
+
+	t.Run("fee cap is clamped to the max", func(t *testing.T) {
+		original := gas.DynamicFee{TipCap: toBigInt("2e9"), FeeCap: toBigInt("4.9e11")}
+		bumped, _, err := gas.BumpDynamicFeeOnly(cfg, original, 100000)
+		require.NoError(t, err)
+		assert.Equal(t, toBigInt("5e11").String(), bumped.FeeCap.String())
+	})
+
+	t.Run("errors if the fee cap is already at the max", func(t *testing.T) {
+		original := gas.DynamicFee{TipCap: toBigInt("2e9"), FeeCap: toBigInt("5e11")}
+		_, _, err := gas.BumpDynamicFeeOnly(cfg, original, 100000)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "bumped fee cap of 600000000000 would exceed configured max gas price of 500000000000 (original fee cap was 500000000000)")
+	})
+
+	t.Run("errors if the tip cap would exceed the max", func(t *testing.T) {
+		original := gas.DynamicFee{TipCap: toBigInt("4.9e11"), FeeCap: toBigInt("5e11")}
+		_, _, err := gas.BumpDynamicFeeOnly(cfg, original, 100000)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "bumped tip cap of 588000000000 would exceed configured max gas price of 500000000000 (original tip cap was 490000000000)")
+	})
+}
+
 // toBigInt is used to convert scientific notation string to a *big.Int
 func toBigInt(input string) *big.Int {
 	flt, _, err := big.ParseFloat(input, 10, 0, big.ToNearestEven)
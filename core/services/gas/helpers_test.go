@@ -15,3 +15,13 @@ func GetGasPrice(b *BlockHistoryEstimator) *big.Int {
 	defer b.gasPriceMu.Unlock()
 	return b.gasPrice
 }
+
+func GetTipCap(b *BlockHistoryEstimator) *big.Int {
+	b.gasPriceMu.Lock()
+	defer b.gasPriceMu.Unlock()
+	return b.tipCap
+}
+
+func WithoutURL(err error) error {
+	return withoutURL(err)
+}
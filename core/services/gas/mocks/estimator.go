@@ -17,6 +17,34 @@ type Estimator struct {
 	mock.Mock
 }
 
+// BumpDynamicFee provides a mock function with given fields: original, gasLimit
+func (_m *Estimator) BumpDynamicFee(original gas.DynamicFee, gasLimit uint64) (gas.DynamicFee, uint64, error) {
+	ret := _m.Called(original, gasLimit)
+
+	var r0 gas.DynamicFee
+	if rf, ok := ret.Get(0).(func(gas.DynamicFee, uint64) gas.DynamicFee); ok {
+		r0 = rf(original, gasLimit)
+	} else {
+		r0 = ret.Get(0).(gas.DynamicFee)
+	}
+
+	var r1 uint64
+	if rf, ok := ret.Get(1).(func(gas.DynamicFee, uint64) uint64); ok {
+		r1 = rf(original, gasLimit)
+	} else {
+		r1 = ret.Get(1).(uint64)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(gas.DynamicFee, uint64) error); ok {
+		r2 = rf(original, gasLimit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // BumpGas provides a mock function with given fields: originalGasPrice, gasLimit
 func (_m *Estimator) BumpGas(originalGasPrice *big.Int, gasLimit uint64) (*big.Int, uint64, error) {
 	ret := _m.Called(originalGasPrice, gasLimit)
@@ -98,6 +126,34 @@ func (_m *Estimator) EstimateGas(calldata []byte, gasLimit uint64, opts ...gas.O
 	return r0, r1, r2
 }
 
+// GetDynamicFee provides a mock function with given fields: gasLimit
+func (_m *Estimator) GetDynamicFee(gasLimit uint64) (gas.DynamicFee, uint64, error) {
+	ret := _m.Called(gasLimit)
+
+	var r0 gas.DynamicFee
+	if rf, ok := ret.Get(0).(func(uint64) gas.DynamicFee); ok {
+		r0 = rf(gasLimit)
+	} else {
+		r0 = ret.Get(0).(gas.DynamicFee)
+	}
+
+	var r1 uint64
+	if rf, ok := ret.Get(1).(func(uint64) uint64); ok {
+		r1 = rf(gasLimit)
+	} else {
+		r1 = ret.Get(1).(uint64)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(uint64) error); ok {
+		r2 = rf(gasLimit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // OnNewLongestChain provides a mock function with given fields: _a0, _a1
 func (_m *Estimator) OnNewLongestChain(_a0 context.Context, _a1 models.Head) {
 	_m.Called(_a0, _a1)
@@ -0,0 +1,114 @@
+package gas
+
+import (
+	"context"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+var _ CallEstimator = &modeSwitchingEstimator{}
+
+// modeSwitchingEstimator runs the estimator for the configured
+// GasEstimatorMode. The mode is checked on every new head and, if it has
+// changed, the estimator is replaced, so that changes to the chain config
+// take effect without restarting the chain.
+type modeSwitchingEstimator struct {
+	utils.StartStopOnce
+	ethClient eth.Client
+	config    Config
+
+	mu        sync.RWMutex
+	mode      string
+	estimator Estimator
+}
+
+func newModeSwitchingEstimator(ethClient eth.Client, config Config) *modeSwitchingEstimator {
+	mode := config.GasEstimatorMode()
+	return &modeSwitchingEstimator{
+		ethClient: ethClient,
+		config:    config,
+		mode:      mode,
+		estimator: newEstimatorForMode(ethClient, config, mode),
+	}
+}
+
+func (m *modeSwitchingEstimator) Start() error {
+	return m.StartOnce("ModeSwitchingEstimator", func() error {
+		return m.current().Start()
+	})
+}
+
+func (m *modeSwitchingEstimator) Close() error {
+	return m.StopOnce("ModeSwitchingEstimator", func() error {
+		return m.current().Close()
+	})
+}
+
+func (m *modeSwitchingEstimator) current() Estimator {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.estimator
+}
+
+func (m *modeSwitchingEstimator) OnNewLongestChain(ctx context.Context, head models.Head) {
+	m.IfStarted(m.switchIfModeChanged)
+	m.current().OnNewLongestChain(ctx, head)
+}
+
+// switchIfModeChanged must be called while started, so that the estimator
+// cannot be closed while it is being replaced
+func (m *modeSwitchingEstimator) switchIfModeChanged() {
+	mode := m.config.GasEstimatorMode()
+	m.mu.RLock()
+	oldMode := m.mode
+	m.mu.RUnlock()
+	if mode == oldMode {
+		return
+	}
+
+	logger.Infow("GasEstimator: mode changed, replacing estimator", "oldMode", oldMode, "newMode", mode)
+	estimator := newEstimatorForMode(m.ethClient, m.config, mode)
+	if err := estimator.Start(); err != nil {
+		logger.Errorw("GasEstimator: failed to start estimator for new mode, keeping the old one", "oldMode", oldMode, "newMode", mode, "err", err)
+		return
+	}
+
+	m.mu.Lock()
+	old := m.estimator
+	m.mode, m.estimator = mode, estimator
+	m.mu.Unlock()
+
+	logger.ErrorIfCalling(old.Close)
+}
+
+func (m *modeSwitchingEstimator) EstimateGas(calldata []byte, gasLimit uint64, opts ...Opt) (gasPrice *big.Int, chainSpecificGasLimit uint64, err error) {
+	return m.current().EstimateGas(calldata, gasLimit, opts...)
+}
+
+// EstimateCallGas uses the full call if the current estimator supports it,
+// otherwise only the calldata
+func (m *modeSwitchingEstimator) EstimateCallGas(call ethereum.CallMsg, gasLimit uint64, opts ...Opt) (gasPrice *big.Int, chainSpecificGasLimit uint64, err error) {
+	estimator := m.current()
+	if ce, is := estimator.(CallEstimator); is {
+		return ce.EstimateCallGas(call, gasLimit, opts...)
+	}
+	return estimator.EstimateGas(call.Data, gasLimit, opts...)
+}
+
+func (m *modeSwitchingEstimator) BumpGas(originalGasPrice *big.Int, gasLimit uint64) (bumpedGasPrice *big.Int, chainSpecificGasLimit uint64, err error) {
+	return m.current().BumpGas(originalGasPrice, gasLimit)
+}
+
+func (m *modeSwitchingEstimator) GetDynamicFee(gasLimit uint64) (fee DynamicFee, chainSpecificGasLimit uint64, err error) {
+	return m.current().GetDynamicFee(gasLimit)
+}
+
+func (m *modeSwitchingEstimator) BumpDynamicFee(original DynamicFee, gasLimit uint64) (bumped DynamicFee, chainSpecificGasLimit uint64, err error) {
+	return m.current().BumpDynamicFee(original, gasLimit)
+}
@@ -0,0 +1,74 @@
+package gas_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/services/gas"
+	gumocks "github.com/smartcontractkit/chainlink/core/services/gas/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEstimator_SwitchesModeOnNewHead(t *testing.T) {
+	t.Parallel()
+
+	ethClient := cltest.NewEthClientMock(t)
+	config := new(gumocks.Config)
+	config.On("BlockHistoryEstimatorBlockHistorySize").Return(uint16(2))
+	config.On("EthFinalityDepth").Return(uint(42))
+	config.On("EthGasLimitMultiplier").Return(float32(1))
+	config.On("EthGasPriceDefault").Return(big.NewInt(42))
+	ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(nil, errors.New("no heads yet"))
+
+	config.On("GasEstimatorMode").Return("BlockHistory").Once()
+	estimator := gas.NewEstimator(ethClient, config)
+	require.NoError(t, estimator.Start())
+	defer estimator.Close()
+
+	// The block history estimator has no price until it has seen blocks
+	gasPrice, _, err := estimator.EstimateGas(nil, 100000)
+	require.NoError(t, err)
+	assert.Nil(t, gasPrice)
+
+	config.On("GasEstimatorMode").Return("FixedPrice")
+	estimator.OnNewLongestChain(context.Background(), *cltest.Head(43))
+
+	gasPrice, gasLimit, err := estimator.EstimateGas(nil, 100000)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(42), gasPrice)
+	assert.Equal(t, uint64(100000), gasLimit)
+
+	// An unchanged mode keeps the current estimator
+	estimator.OnNewLongestChain(context.Background(), *cltest.Head(44))
+	gasPrice, _, err = estimator.EstimateGas(nil, 100000)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(42), gasPrice)
+
+	ethClient.AssertExpectations(t)
+}
+
+func TestEstimator_DoesNotSwitchModeWhenStopped(t *testing.T) {
+	t.Parallel()
+
+	ethClient := cltest.NewEthClientMock(t)
+	config := new(gumocks.Config)
+	config.On("EthGasLimitMultiplier").Return(float32(1))
+	config.On("EthGasPriceDefault").Return(big.NewInt(42))
+
+	config.On("GasEstimatorMode").Return("FixedPrice").Once()
+	estimator := gas.NewEstimator(ethClient, config)
+
+	// Starting a block history estimator would call the eth client, which
+	// has no expectations
+	config.On("GasEstimatorMode").Return("BlockHistory")
+	estimator.OnNewLongestChain(context.Background(), *cltest.Head(43))
+
+	gasPrice, _, err := estimator.EstimateGas(nil, 100000)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(42), gasPrice)
+}
@@ -4,7 +4,12 @@ import (
 	"context"
 	"encoding/json"
 	"math/big"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
@@ -29,8 +34,13 @@ var (
 	})
 )
 
+// NewEstimator returns an estimator for the configured GasEstimatorMode,
+// which is replaced on the next head whenever the mode changes
 func NewEstimator(ethClient eth.Client, config Config) Estimator {
-	s := config.GasEstimatorMode()
+	return newModeSwitchingEstimator(ethClient, config)
+}
+
+func newEstimatorForMode(ethClient eth.Client, config Config, s string) Estimator {
 	switch s {
 	case "BlockHistory":
 		return NewBlockHistoryEstimator(ethClient, config)
@@ -38,6 +48,10 @@ func NewEstimator(ethClient eth.Client, config Config) Estimator {
 		return NewFixedPriceEstimator(config)
 	case "Optimism":
 		return NewOptimismEstimator(config, ethClient)
+	case "GasOracle":
+		return NewGasOracleEstimator(config, NewBlockHistoryEstimator(ethClient, config))
+	case "Arbitrum":
+		return NewArbitrumEstimator(config, ethClient)
 	default:
 		logger.Warnf("GasEstimator: unrecognised mode '%s', falling back to FixedPriceEstimator", s)
 		return NewFixedPriceEstimator(config)
@@ -52,6 +66,26 @@ type Estimator interface {
 	Close() error
 	EstimateGas(calldata []byte, gasLimit uint64, opts ...Opt) (gasPrice *big.Int, chainSpecificGasLimit uint64, err error)
 	BumpGas(originalGasPrice *big.Int, gasLimit uint64) (bumpedGasPrice *big.Int, chainSpecificGasLimit uint64, err error)
+	// GetDynamicFee returns the tip and fee cap for an EIP-1559 (type 2) transaction
+	GetDynamicFee(gasLimit uint64) (fee DynamicFee, chainSpecificGasLimit uint64, err error)
+	BumpDynamicFee(original DynamicFee, gasLimit uint64) (bumped DynamicFee, chainSpecificGasLimit uint64, err error)
+}
+
+// CallEstimator is an Estimator that can also estimate the gas limit of a
+// full call, for chains where the gas limit depends on more than the calldata
+type CallEstimator interface {
+	Estimator
+	EstimateCallGas(call ethereum.CallMsg, gasLimit uint64, opts ...Opt) (gasPrice *big.Int, chainSpecificGasLimit uint64, err error)
+}
+
+// DynamicFee encompasses both FeeCap and TipCap for EIP-1559 transactions
+type DynamicFee struct {
+	// FeeCap is the maximum total fee per gas (maxFeePerGas) that the sender
+	// is willing to pay, including the base fee
+	FeeCap *big.Int
+	// TipCap is the maximum priority fee per gas (maxPriorityFeePerGas) paid
+	// to the miner on top of the base fee
+	TipCap *big.Int
 }
 
 // Opt is an option for a gas estimator
@@ -79,9 +113,15 @@ type Config interface {
 	EthGasBumpWei() *big.Int
 	EthGasLimitMultiplier() float32
 	EthGasPriceDefault() *big.Int
+	EthGasTipCapDefault() *big.Int
+	EthGasTipCapMinimum() *big.Int
 	EthMaxGasPriceWei() *big.Int
 	EthMinGasPriceWei() *big.Int
 	GasEstimatorMode() string
+	GasOracleAggregation() string
+	GasOraclePollPeriod() time.Duration
+	GasOraclePriceUnit() string
+	GasOracleURLs() []url.URL
 }
 
 // Int64ToHex converts an int64 into go-ethereum's hex representation
@@ -113,17 +153,20 @@ func HexToInt64(input interface{}) int64 {
 // Block represents an ethereum block
 // This type is only used for the block history estimator, and can be expensive to unmarshal. Don't add unnecessary fields here.
 type Block struct {
-	Number       int64
-	Hash         common.Hash
-	ParentHash   common.Hash
-	Transactions []Transaction
+	Number int64
+	Hash   common.Hash
+	// BaseFeePerGas is only set on chains that have activated EIP-1559
+	BaseFeePerGas *big.Int
+	ParentHash    common.Hash
+	Transactions  []Transaction
 }
 
 type blockInternal struct {
-	Number       string
-	Hash         common.Hash
-	ParentHash   common.Hash
-	Transactions []Transaction
+	Number        string
+	Hash          common.Hash
+	BaseFeePerGas *hexutil.Big `json:"baseFeePerGas"`
+	ParentHash    common.Hash
+	Transactions  []Transaction
 }
 
 // MarshalJSON implements json marshalling for Block
@@ -131,6 +174,7 @@ func (b Block) MarshalJSON() ([]byte, error) {
 	return json.Marshal(blockInternal{
 		Int64ToHex(b.Number),
 		b.Hash,
+		(*hexutil.Big)(b.BaseFeePerGas),
 		b.ParentHash,
 		b.Transactions,
 	})
@@ -149,6 +193,7 @@ func (b *Block) UnmarshalJSON(data []byte) error {
 	*b = Block{
 		n.Int64(),
 		bi.Hash,
+		(*big.Int)(bi.BaseFeePerGas),
 		bi.ParentHash,
 		bi.Transactions,
 	}
@@ -156,8 +201,36 @@ func (b *Block) UnmarshalJSON(data []byte) error {
 }
 
 type transactionInternal struct {
-	GasPrice *hexutil.Big    `json:"gasPrice"`
-	Gas      *hexutil.Uint64 `json:"gas"`
+	GasPrice             *hexutil.Big     `json:"gasPrice"`
+	Gas                  *hexutil.Uint64  `json:"gas"`
+	Type                 *TransactionType `json:"type"`
+	MaxFeePerGas         *hexutil.Big     `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas *hexutil.Big     `json:"maxPriorityFeePerGas"`
+}
+
+// TransactionType is the EIP-2718 envelope type of a transaction
+type TransactionType uint8
+
+const (
+	// LegacyTxType is a pre-EIP-2718 transaction priced with a single gas price
+	LegacyTxType TransactionType = 0x0
+	// DynamicFeeTxType is an EIP-1559 transaction priced with a tip and fee cap
+	DynamicFeeTxType TransactionType = 0x2
+)
+
+// UnmarshalText decodes a hex transaction type. Unlike hexutil it accepts
+// leading zeros, e.g. "0x00", which some chains return.
+func (t *TransactionType) UnmarshalText(input []byte) error {
+	s := string(input)
+	if !strings.HasPrefix(s, "0x") && !strings.HasPrefix(s, "0X") {
+		return errors.Errorf("expected transaction type to be hex with 0x prefix, got: '%s'", s)
+	}
+	n, err := strconv.ParseUint(s[2:], 16, 8)
+	if err != nil {
+		return errors.Wrapf(err, "failed to decode transaction type, got: '%s'", s)
+	}
+	*t = TransactionType(n)
+	return nil
 }
 
 // Transaction represents an ethereum transaction
@@ -165,8 +238,11 @@ type transactionInternal struct {
 // gas used, which can occur on other chains.
 // This type is only used for the block history estimator, and can be expensive to unmarshal. Don't add unnecessary fields here.
 type Transaction struct {
-	GasPrice *big.Int
-	GasLimit uint64
+	GasPrice             *big.Int
+	GasLimit             uint64
+	Type                 TransactionType
+	MaxFeePerGas         *big.Int
+	MaxPriorityFeePerGas *big.Int
 }
 
 // UnmarshalJSON unmarshals a Transaction
@@ -178,9 +254,16 @@ func (t *Transaction) UnmarshalJSON(data []byte) error {
 	if ti.Gas == nil {
 		return errors.Errorf("expected 'gas' to not be null, got: '%s'", data)
 	}
+	var txType TransactionType
+	if ti.Type != nil {
+		txType = *ti.Type
+	}
 	*t = Transaction{
 		(*big.Int)(ti.GasPrice),
 		uint64(*ti.Gas),
+		txType,
+		(*big.Int)(ti.MaxFeePerGas),
+		(*big.Int)(ti.MaxPriorityFeePerGas),
 	}
 	return nil
 }
@@ -201,15 +284,7 @@ func BumpGasPriceOnly(config Config, originalGasPrice *big.Int, originalGasLimit
 // The baseline price is the maximum of the previous gas price attempt and the node's current gas price.
 func bumpGasPrice(config Config, originalGasPrice *big.Int) (*big.Int, error) {
 	baselinePrice := max(originalGasPrice, config.EthGasPriceDefault())
-
-	var priceByPercentage = new(big.Int)
-	priceByPercentage.Mul(baselinePrice, big.NewInt(int64(100+config.EthGasBumpPercent())))
-	priceByPercentage.Div(priceByPercentage, big.NewInt(100))
-
-	var priceByIncrement = new(big.Int)
-	priceByIncrement.Add(baselinePrice, config.EthGasBumpWei())
-
-	bumpedGasPrice := max(priceByPercentage, priceByIncrement)
+	bumpedGasPrice := bumpByConfig(config, baselinePrice)
 	if bumpedGasPrice.Cmp(config.EthMaxGasPriceWei()) > 0 {
 		promGasBumpExceedsLimit.Inc()
 		return config.EthMaxGasPriceWei(), errors.Errorf("bumped gas price of %s would exceed configured max gas price of %s (original price was %s). %s",
@@ -227,6 +302,71 @@ func bumpGasPrice(config Config, originalGasPrice *big.Int) (*big.Int, error) {
 	return bumpedGasPrice, nil
 }
 
+// BumpDynamicFeeOnly bumps the tip cap and fee cap by the configured bump
+// percentage/wei and applies the multiplier to the gas limit
+func BumpDynamicFeeOnly(config Config, originalFee DynamicFee, originalGasLimit uint64) (bumped DynamicFee, chainSpecificGasLimit uint64, err error) {
+	bumped, err = bumpDynamicFee(config, originalFee)
+	if err != nil {
+		return bumped, 0, err
+	}
+	chainSpecificGasLimit = applyMultiplier(originalGasLimit, config.EthGasLimitMultiplier())
+	return
+}
+
+// bumpDynamicFee computes the next tip cap and fee cap to attempt.
+// Both must be bumped, since geth (and most other clients) require a minimum
+// increase in both values in order to accept a replacement transaction.
+//
+// The tip cap is bumped using the same rules as legacy gas price (see
+// bumpGasPrice), with a baseline of ETH_GAS_TIP_CAP_DEFAULT. The fee cap is
+// bumped by the same rules and is always at least as large as the bumped tip
+// cap. Neither value may exceed ETH_MAX_GAS_PRICE_WEI.
+func bumpDynamicFee(config Config, originalFee DynamicFee) (bumped DynamicFee, err error) {
+	maxGasPrice := config.EthMaxGasPriceWei()
+	baselineTipCap := max(originalFee.TipCap, config.EthGasTipCapDefault())
+	bumpedTipCap := bumpByConfig(config, baselineTipCap)
+	if bumpedTipCap.Cmp(maxGasPrice) > 0 {
+		promGasBumpExceedsLimit.Inc()
+		return bumped, errors.Errorf("bumped tip cap of %s would exceed configured max gas price of %s (original tip cap was %s). %s",
+			bumpedTipCap.String(), maxGasPrice, originalFee.TipCap.String(), static.EthNodeConnectivityProblemLabel)
+	} else if bumpedTipCap.Cmp(originalFee.TipCap) <= 0 {
+		// NOTE: This really shouldn't happen since we enforce minimums for
+		// ETH_GAS_BUMP_PERCENT and ETH_GAS_BUMP_WEI in the config validation,
+		// but it's here anyway for a "belts and braces" approach
+		return bumped, errors.Errorf("bumped tip cap of %s is less than or equal to original tip cap of %s."+
+			" ACTION REQUIRED: This is a configuration error, you must increase either "+
+			"ETH_GAS_BUMP_PERCENT or ETH_GAS_BUMP_WEI", bumpedTipCap.String(), originalFee.TipCap.String())
+	}
+
+	bumpedFeeCap := max(bumpByConfig(config, originalFee.FeeCap), bumpedTipCap)
+	if bumpedFeeCap.Cmp(maxGasPrice) > 0 {
+		// The fee cap is only an upper bound on what we pay, so it is safe to
+		// clamp it to the maximum as long as it still increased
+		bumpedFeeCap = maxGasPrice
+	}
+	if bumpedFeeCap.Cmp(originalFee.FeeCap) <= 0 {
+		promGasBumpExceedsLimit.Inc()
+		return bumped, errors.Errorf("bumped fee cap of %s would exceed configured max gas price of %s (original fee cap was %s). %s",
+			bumpByConfig(config, originalFee.FeeCap).String(), maxGasPrice, originalFee.FeeCap.String(), static.EthNodeConnectivityProblemLabel)
+	}
+
+	promNumGasBumps.Inc()
+	return DynamicFee{FeeCap: bumpedFeeCap, TipCap: bumpedTipCap}, nil
+}
+
+// bumpByConfig returns the larger of the configured percentage bump and the
+// configured fixed wei bump applied to the given value
+func bumpByConfig(config Config, original *big.Int) *big.Int {
+	var byPercentage = new(big.Int)
+	byPercentage.Mul(original, big.NewInt(int64(100+config.EthGasBumpPercent())))
+	byPercentage.Div(byPercentage, big.NewInt(100))
+
+	var byIncrement = new(big.Int)
+	byIncrement.Add(original, config.EthGasBumpWei())
+
+	return max(byPercentage, byIncrement)
+}
+
 func max(a, b *big.Int) *big.Int {
 	if a.Cmp(b) >= 0 {
 		return a
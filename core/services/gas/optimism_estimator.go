@@ -162,6 +162,16 @@ func (o *optimismEstimator) BumpGas(originalGasPrice *big.Int, originalGasLimit
 	return nil, 0, errors.New("bump gas is not supported for optimism")
 }
 
+func (o *optimismEstimator) GetDynamicFee(_ uint64) (fee DynamicFee, chainSpecificGasLimit uint64, err error) {
+	err = errors.New("dynamic fees are not supported for optimism")
+	return
+}
+
+func (o *optimismEstimator) BumpDynamicFee(_ DynamicFee, _ uint64) (bumped DynamicFee, chainSpecificGasLimit uint64, err error) {
+	err = errors.New("dynamic fees are not supported for optimism")
+	return
+}
+
 func (o *optimismEstimator) OnNewLongestChain(_ context.Context, _ models.Head) {}
 
 func (o *optimismEstimator) calcGas(calldata []byte, l2GasLimit uint64) (chainSpecificGasPrice *big.Int, chainSpecificGasLimit uint64, err error) {
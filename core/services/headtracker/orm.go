@@ -2,25 +2,47 @@ package headtracker
 
 import (
 	"context"
+	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
 
 type ORM struct {
-	db *gorm.DB
+	db      *gorm.DB
+	chainID *utils.Big
 }
 
+// NewORM returns an ORM for the heads of the node's default chain
 func NewORM(db *gorm.DB) *ORM {
-	return &ORM{db}
+	return &ORM{db: db}
+}
+
+// NewChainScopedORM returns an ORM for the heads of the given chain
+func NewChainScopedORM(db *gorm.DB, chainID *big.Int) *ORM {
+	if chainID == nil {
+		return NewORM(db)
+	}
+	return &ORM{db, utils.NewBig(chainID)}
+}
+
+// chainIDArg is compared to heads.evm_chain_id with IS NOT DISTINCT FROM, so
+// that the default chain matches heads with a NULL evm_chain_id
+func (orm *ORM) chainIDArg() interface{} {
+	if orm.chainID == nil {
+		return nil
+	}
+	return orm.chainID
 }
 
 // IdempotentInsertHead inserts a head only if the hash is new. Will do nothing if hash exists already.
 // No advisory lock required because this is thread safe.
 func (orm *ORM) IdempotentInsertHead(ctx context.Context, h models.Head) error {
+	h.EVMChainID = orm.chainID
 	err := orm.db.
 		WithContext(ctx).
 		Clauses(clause.OnConflict{
@@ -38,14 +60,15 @@ func (orm *ORM) IdempotentInsertHead(ctx context.Context, h models.Head) error {
 func (orm *ORM) TrimOldHeads(ctx context.Context, n uint) (err error) {
 	return orm.db.WithContext(ctx).Exec(`
 	DELETE FROM heads
-	WHERE number < (
+	WHERE evm_chain_id IS NOT DISTINCT FROM ? AND number < (
 		SELECT min(number) FROM (
 			SELECT number
 			FROM heads
+			WHERE evm_chain_id IS NOT DISTINCT FROM ?
 			ORDER BY number DESC
 			LIMIT ?
 		) numbers
-	)`, n).Error
+	)`, orm.chainIDArg(), orm.chainIDArg(), n).Error
 }
 
 // Chain return the chain of heads starting at hash and up to lookback parents
@@ -53,12 +76,12 @@ func (orm *ORM) TrimOldHeads(ctx context.Context, n uint) (err error) {
 func (orm *ORM) Chain(ctx context.Context, hash common.Hash, lookback uint) (models.Head, error) {
 	rows, err := orm.db.WithContext(ctx).Raw(`
 	WITH RECURSIVE chain AS (
-		SELECT * FROM heads WHERE hash = ?
+		SELECT * FROM heads WHERE hash = ? AND evm_chain_id IS NOT DISTINCT FROM ?
 	UNION
 		SELECT h.* FROM heads h
-		JOIN chain ON chain.parent_hash = h.hash
+		JOIN chain ON chain.parent_hash = h.hash AND h.evm_chain_id IS NOT DISTINCT FROM chain.evm_chain_id
 	) SELECT id, hash, number, parent_hash, timestamp, created_at FROM chain LIMIT ?
-	`, hash, lookback).Rows()
+	`, hash, orm.chainIDArg(), lookback).Rows()
 	if err != nil {
 		return models.Head{}, err
 	}
@@ -87,7 +110,10 @@ func (orm *ORM) Chain(ctx context.Context, hash common.Hash, lookback uint) (mod
 // due to re-org) it returns the most recently seen head entry.
 func (orm *ORM) LastHead(ctx context.Context) (*models.Head, error) {
 	number := &models.Head{}
-	err := orm.db.WithContext(ctx).Order("number DESC, created_at DESC, id DESC").First(number).Error
+	err := orm.db.WithContext(ctx).
+		Where("evm_chain_id IS NOT DISTINCT FROM ?", orm.chainIDArg()).
+		Order("number DESC, created_at DESC, id DESC").
+		First(number).Error
 	if err == gorm.ErrRecordNotFound {
 		return nil, nil
 	}
@@ -97,7 +123,7 @@ func (orm *ORM) LastHead(ctx context.Context) (*models.Head, error) {
 // HeadByHash fetches the head with the given hash from the db, returns nil if none exists
 func (orm *ORM) HeadByHash(ctx context.Context, hash common.Hash) (*models.Head, error) {
 	head := &models.Head{}
-	err := orm.db.WithContext(ctx).Where("hash = ?", hash).First(head).Error
+	err := orm.db.WithContext(ctx).Where("hash = ? AND evm_chain_id IS NOT DISTINCT FROM ?", hash, orm.chainIDArg()).First(head).Error
 	if err == gorm.ErrRecordNotFound {
 		return nil, nil
 	}
@@ -2,6 +2,7 @@ package job_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -18,6 +19,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/vrf"
 	"github.com/smartcontractkit/chainlink/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/core/testdata/testspecs"
+	"github.com/smartcontractkit/chainlink/core/utils"
 
 	"github.com/pelletier/go-toml"
 	uuid "github.com/satori/go.uuid"
@@ -308,6 +310,71 @@ func TestORM_UnclaimJob(t *testing.T) {
 	advisoryLocker.AssertExpectations(t)
 }
 
+func TestORM_FindJobIDsWithEVMChainID(t *testing.T) {
+	t.Parallel()
+
+	config, cleanup := cltest.NewConfig(t)
+	defer cleanup()
+	store, cleanup := cltest.NewStoreWithConfig(t, config)
+	defer cleanup()
+	db := store.DB
+
+	pipelineORM, eventBroadcaster, cleanupORM := cltest.NewPipelineORM(t, config, db)
+	defer cleanupORM()
+	orm := job.NewORM(db, config.Config, pipelineORM, eventBroadcaster, &postgres.NullAdvisoryLocker{})
+	defer orm.Close()
+
+	for _, id := range []int{10, 20} {
+		require.NoError(t, db.Exec(`INSERT INTO evm_chains (id, created_at, updated_at) VALUES (?, NOW(), NOW())`, id).Error)
+	}
+
+	createDirectRequestJob := func(t *testing.T, evmChainID string) int32 {
+		jb, err := directrequest.ValidatedDirectRequestSpec(fmt.Sprintf(`
+type                = "directrequest"
+schemaVersion       = 1
+contractAddress     = "0x613a38AC1659769640aaE063C651F48E0250454C"
+externalJobID       = "%s"
+%s
+observationSource   = """
+    ds1 [type=http method=GET url="http://example.com"];
+"""
+`, uuid.NewV4(), evmChainID))
+		require.NoError(t, err)
+		created, err := orm.CreateJob(context.Background(), &jb, jb.Pipeline)
+		require.NoError(t, err)
+		return created.ID
+	}
+	eim := webhook.NewExternalInitiatorManager(db, nil)
+	createWebhookJob := func(t *testing.T, evmChainID string) int32 {
+		jb, err := webhook.ValidatedWebhookSpec(fmt.Sprintf(`
+type            = "webhook"
+schemaVersion   = 1
+externalJobID   = "%s"
+observationSource   = """
+    tx [type=ethtx to="0x613a38AC1659769640aaE063C651F48E0250454C" data="0x" evmChainID="%s"];
+"""
+`, uuid.NewV4(), evmChainID), eim)
+		require.NoError(t, err)
+		created, err := orm.CreateJob(context.Background(), &jb, jb.Pipeline)
+		require.NoError(t, err)
+		return created.ID
+	}
+
+	specOnChain := createDirectRequestJob(t, `evmChainID = 10`)
+	createDirectRequestJob(t, `evmChainID = 20`)
+	createDirectRequestJob(t, ``)
+	taskOnChain := createWebhookJob(t, "10")
+	createWebhookJob(t, "20")
+
+	jids, err := orm.FindJobIDsWithEVMChainID(*utils.NewBigI(10))
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []int32{specOnChain, taskOnChain}, jids)
+
+	jids, err = orm.FindJobIDsWithEVMChainID(*utils.NewBigI(30))
+	require.NoError(t, err)
+	assert.Empty(t, jids)
+}
+
 func TestORM_DeleteJob_DeletesAssociatedRecords(t *testing.T) {
 	t.Parallel()
 	config, cleanup := cltest.NewConfig(t)
@@ -11,6 +11,8 @@ import (
 	pipeline "github.com/smartcontractkit/chainlink/core/services/pipeline"
 
 	postgres "github.com/smartcontractkit/chainlink/core/services/postgres"
+
+	utils "github.com/smartcontractkit/chainlink/core/utils"
 )
 
 // ORM is an autogenerated mock type for the ORM type
@@ -171,6 +173,50 @@ func (_m *ORM) FindJobIDsWithBridge(name string) ([]int32, error) {
 	return r0, r1
 }
 
+// FindJobIDsWithEVMChainID provides a mock function with given fields: id
+func (_m *ORM) FindJobIDsWithEVMChainID(id utils.Big) ([]int32, error) {
+	ret := _m.Called(id)
+
+	var r0 []int32
+	if rf, ok := ret.Get(0).(func(utils.Big) []int32); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int32)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(utils.Big) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindJobSpecVersion provides a mock function with given fields: jobID, version
+func (_m *ORM) FindJobSpecVersion(jobID int32, version int32) (job.SpecVersion, error) {
+	ret := _m.Called(jobID, version)
+
+	var r0 job.SpecVersion
+	if rf, ok := ret.Get(0).(func(int32, int32) job.SpecVersion); ok {
+		r0 = rf(jobID, version)
+	} else {
+		r0 = ret.Get(0).(job.SpecVersion)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int32, int32) error); ok {
+		r1 = rf(jobID, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FindJobTx provides a mock function with given fields: id
 func (_m *ORM) FindJobTx(id int32) (job.Job, error) {
 	ret := _m.Called(id)
@@ -192,6 +238,29 @@ func (_m *ORM) FindJobTx(id int32) (job.Job, error) {
 	return r0, r1
 }
 
+// JobSpecVersions provides a mock function with given fields: jobID
+func (_m *ORM) JobSpecVersions(jobID int32) ([]job.SpecVersion, error) {
+	ret := _m.Called(jobID)
+
+	var r0 []job.SpecVersion
+	if rf, ok := ret.Get(0).(func(int32) []job.SpecVersion); ok {
+		r0 = rf(jobID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]job.SpecVersion)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int32) error); ok {
+		r1 = rf(jobID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // JobsV2 provides a mock function with given fields: offset, limit
 func (_m *ORM) JobsV2(offset int, limit int) ([]job.Job, int, error) {
 	ret := _m.Called(offset, limit)
@@ -346,3 +415,24 @@ func (_m *ORM) UnclaimJob(ctx context.Context, id int32) error {
 
 	return r0
 }
+
+// UpdateJob provides a mock function with given fields: ctx, jobSpec, _a2
+func (_m *ORM) UpdateJob(ctx context.Context, jobSpec *job.Job, _a2 pipeline.Pipeline) (job.Job, error) {
+	ret := _m.Called(ctx, jobSpec, _a2)
+
+	var r0 job.Job
+	if rf, ok := ret.Get(0).(func(context.Context, *job.Job, pipeline.Pipeline) job.Job); ok {
+		r0 = rf(ctx, jobSpec, _a2)
+	} else {
+		r0 = ret.Get(0).(job.Job)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *job.Job, pipeline.Pipeline) error); ok {
+		r1 = rf(ctx, jobSpec, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -122,3 +122,24 @@ func (_m *Spawner) Start() error {
 
 	return r0
 }
+
+// UpdateJob provides a mock function with given fields: ctx, jobID, spec
+func (_m *Spawner) UpdateJob(ctx context.Context, jobID int32, spec job.Job) (job.Job, error) {
+	ret := _m.Called(ctx, jobID, spec)
+
+	var r0 job.Job
+	if rf, ok := ret.Get(0).(func(context.Context, int32, job.Job) job.Job); ok {
+		r0 = rf(ctx, jobID, spec)
+	} else {
+		r0 = ret.Get(0).(job.Job)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int32, job.Job) error); ok {
+		r1 = rf(ctx, jobID, spec)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -2,6 +2,7 @@ package job
 
 import (
 	"fmt"
+	"math/big"
 	"strconv"
 	"strings"
 	"time"
@@ -90,9 +91,19 @@ type Job struct {
 	JobSpecErrors                 []SpecError `gorm:"foreignKey:JobID"`
 	Type                          Type
 	SchemaVersion                 uint32
+	Version                       int32 `toml:"-" gorm:"default:1"`
 	Name                          null.String
 	MaxTaskDuration               models.Interval
 	Pipeline                      pipeline.Pipeline `toml:"observationSource" gorm:"-"`
+	// TOML is the spec the job was validated from, which is saved with the
+	// version of the job created from it
+	TOML string `toml:"-" gorm:"-"`
+	// EthTxLimitMaxTxs, EthTxLimitMaxDailySpendWei and
+	// EthTxLimitMaxValuePerTxWei override the node's per job ETH_TX_LIMIT_*
+	// limits for the transactions of this job
+	EthTxLimitMaxTxs           clnull.Uint32 `toml:"ethTxLimitMaxTxs"`
+	EthTxLimitMaxDailySpendWei *utils.Big    `toml:"ethTxLimitMaxDailySpendWei"`
+	EthTxLimitMaxValuePerTxWei *utils.Big    `toml:"ethTxLimitMaxValuePerTxWei"`
 }
 
 // The external job ID (UUID) can be encoded into a log topic (32 bytes)
@@ -112,6 +123,28 @@ func (j Job) TableName() string {
 	return "jobs"
 }
 
+// EVMChainID returns the chain that the job runs on, or nil for the node's
+// default chain
+func (j Job) EVMChainID() *big.Int {
+	var id *utils.Big
+	switch {
+	case j.DirectRequestSpec != nil:
+		id = j.DirectRequestSpec.EVMChainID
+	case j.FluxMonitorSpec != nil:
+		id = j.FluxMonitorSpec.EVMChainID
+	case j.OffchainreportingOracleSpec != nil:
+		id = j.OffchainreportingOracleSpec.EVMChainID
+	case j.KeeperSpec != nil:
+		id = j.KeeperSpec.EVMChainID
+	case j.VRFSpec != nil:
+		id = j.VRFSpec.EVMChainID
+	}
+	if id == nil {
+		return nil
+	}
+	return id.ToInt()
+}
+
 // SetID takes the id as a string and attempts to convert it to an int32. If
 // it succeeds, it will set it as the id on the job
 func (j *Job) SetID(value string) error {
@@ -123,6 +156,24 @@ func (j *Job) SetID(value string) error {
 	return nil
 }
 
+// SpecVersion is an immutable version of the spec of a job. Every update of
+// a job creates a new version.
+type SpecVersion struct {
+	ID      int64 `gorm:"primary_key"`
+	JobID   int32
+	Version int32
+	// TOML is the spec the version was created from. It is null for the first
+	// version of jobs that were created before specs were versioned.
+	TOML           null.String `gorm:"column:toml"`
+	PipelineSpecID int32
+	PipelineSpec   *pipeline.Spec
+	CreatedAt      time.Time
+}
+
+func (SpecVersion) TableName() string {
+	return "job_spec_versions"
+}
+
 type SpecError struct {
 	ID          int64 `gorm:"primary_key"`
 	JobID       int32
@@ -168,6 +219,7 @@ type OffchainReportingOracleSpec struct {
 	ContractConfigTrackerSubscribeInterval models.Interval      `toml:"contractConfigTrackerSubscribeInterval" gorm:"default:null"`
 	ContractConfigTrackerPollInterval      models.Interval      `toml:"contractConfigTrackerPollInterval" gorm:"type:bigint;default:null"`
 	ContractConfigConfirmations            uint16               `toml:"contractConfigConfirmations"`
+	EVMChainID                             *utils.Big           `toml:"evmChainID" gorm:"column:evm_chain_id"`
 	CreatedAt                              time.Time            `toml:"-"`
 	UpdatedAt                              time.Time            `toml:"-"`
 }
@@ -236,6 +288,7 @@ type DirectRequestSpec struct {
 	ID                       int32               `toml:"-" gorm:"primary_key"`
 	ContractAddress          ethkey.EIP55Address `toml:"contractAddress"`
 	MinIncomingConfirmations clnull.Uint32       `toml:"minIncomingConfirmations"`
+	EVMChainID               *utils.Big          `toml:"evmChainID" gorm:"column:evm_chain_id"`
 	CreatedAt                time.Time           `toml:"-"`
 	UpdatedAt                time.Time           `toml:"-"`
 }
@@ -295,23 +348,46 @@ type FluxMonitorSpec struct {
 	DrumbeatRandomDelay time.Duration
 	DrumbeatEnabled     bool
 	MinPayment          *assets.Link
-	CreatedAt           time.Time `toml:"-"`
-	UpdatedAt           time.Time `toml:"-"`
+	EVMChainID          *utils.Big `toml:"evmChainID" gorm:"column:evm_chain_id"`
+	CreatedAt           time.Time  `toml:"-"`
+	UpdatedAt           time.Time  `toml:"-"`
 }
 
 type KeeperSpec struct {
 	ID              int32               `toml:"-" gorm:"primary_key"`
 	ContractAddress ethkey.EIP55Address `toml:"contractAddress"`
 	FromAddress     ethkey.EIP55Address `toml:"fromAddress"`
+	EVMChainID      *utils.Big          `toml:"evmChainID" gorm:"column:evm_chain_id"`
 	CreatedAt       time.Time           `toml:"-"`
 	UpdatedAt       time.Time           `toml:"-"`
 }
 
+// VRFCoordinatorVersion is the version of the coordinator contract a VRF job
+// fulfills requests for
+type VRFCoordinatorVersion string
+
+const (
+	// VRFCoordinatorV1 is paid per request by the requesting contract
+	VRFCoordinatorV1 VRFCoordinatorVersion = "v1"
+	// VRFCoordinatorV2 is paid from the subscription of the requesting contract
+	VRFCoordinatorV2 VRFCoordinatorVersion = "v2"
+)
+
 type VRFSpec struct {
 	ID                 int32
-	CoordinatorAddress ethkey.EIP55Address `toml:"coordinatorAddress"`
-	PublicKey          secp256k1.PublicKey `toml:"publicKey"`
-	Confirmations      uint32              `toml:"confirmations"`
-	CreatedAt          time.Time           `toml:"-"`
-	UpdatedAt          time.Time           `toml:"-"`
+	CoordinatorAddress ethkey.EIP55Address   `toml:"coordinatorAddress"`
+	CoordinatorVersion VRFCoordinatorVersion `toml:"coordinatorVersion"`
+	PublicKey          secp256k1.PublicKey   `toml:"publicKey"`
+	Confirmations      uint32                `toml:"confirmations"`
+	// FromAddress is the key fulfillments of a v2 coordinator are sent from,
+	// defaults to any of the node's keys
+	FromAddress *ethkey.EIP55Address `toml:"fromAddress"`
+	// BatchCoordinatorAddress enables batching the fulfillments of a v2
+	// coordinator through the batch coordinator at this address
+	BatchCoordinatorAddress *ethkey.EIP55Address `toml:"batchCoordinatorAddress"`
+	// BatchFulfillmentGasLimit caps the gas of a batch of fulfillments
+	BatchFulfillmentGasLimit uint64     `toml:"batchFulfillmentGasLimit"`
+	EVMChainID               *utils.Big `toml:"evmChainID" gorm:"column:evm_chain_id"`
+	CreatedAt                time.Time  `toml:"-"`
+	UpdatedAt                time.Time  `toml:"-"`
 }
@@ -11,11 +11,13 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/core/services/postgres"
 	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
 
 	"github.com/jackc/pgconn"
 	"github.com/lib/pq"
 	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
+	"gopkg.in/guregu/null.v4"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
@@ -38,10 +40,14 @@ type ORM interface {
 	ListenForDeletedJobs() (postgres.Subscription, error)
 	ClaimUnclaimedJobs(ctx context.Context) ([]Job, error)
 	CreateJob(ctx context.Context, jobSpec *Job, pipeline pipeline.Pipeline) (Job, error)
+	UpdateJob(ctx context.Context, jobSpec *Job, pipeline pipeline.Pipeline) (Job, error)
 	JobsV2(offset, limit int) ([]Job, int, error)
 	FindJobTx(id int32) (Job, error)
 	FindJob(ctx context.Context, id int32) (Job, error)
 	FindJobIDsWithBridge(name string) ([]int32, error)
+	FindJobIDsWithEVMChainID(id utils.Big) ([]int32, error)
+	JobSpecVersions(jobID int32) ([]SpecVersion, error)
+	FindJobSpecVersion(jobID int32, version int32) (SpecVersion, error)
 	DeleteJob(ctx context.Context, id int32) error
 	RecordError(ctx context.Context, jobID int32, description string)
 	DismissError(ctx context.Context, errorID int32) error
@@ -166,18 +172,8 @@ func (o *orm) claimedJobIDs() (ids []int32) {
 // Returns a fully populated Job.
 func (o *orm) CreateJob(ctx context.Context, jobSpec *Job, p pipeline.Pipeline) (Job, error) {
 	var jb Job
-	for _, task := range p.Tasks {
-		if task.Type() == pipeline.TaskTypeBridge {
-			// Bridge must exist
-			name := task.(*pipeline.BridgeTask).Name
-			bt := models.BridgeType{}
-			if err := o.db.First(&bt, "name = ?", name).Error; err != nil {
-				if errors.Is(err, gorm.ErrRecordNotFound) {
-					return jb, errors.Wrap(pipeline.ErrNoSuchBridge, name)
-				}
-				return jb, err
-			}
-		}
+	if err := o.assertBridgesExist(p); err != nil {
+		return jb, err
 	}
 
 	tx := postgres.TxFromContext(ctx, o.db)
@@ -202,22 +198,8 @@ func (o *orm) CreateJob(ctx context.Context, jobSpec *Job, p pipeline.Pipeline)
 		jobSpec.FluxMonitorSpecID = &jobSpec.FluxMonitorSpec.ID
 	case OffchainReporting:
 		err := tx.Create(&jobSpec.OffchainreportingOracleSpec).Error
-		pqErr, ok := err.(*pgconn.PgError)
-		if err != nil && ok && pqErr.Code == "23503" {
-			if pqErr.ConstraintName == "offchainreporting_oracle_specs_p2p_peer_id_fkey" {
-				return jb, errors.Wrapf(ErrNoSuchPeerID, "%v", jobSpec.OffchainreportingOracleSpec.P2PPeerID)
-			}
-			if jobSpec.OffchainreportingOracleSpec != nil && !jobSpec.OffchainreportingOracleSpec.IsBootstrapPeer {
-				if pqErr.ConstraintName == "offchainreporting_oracle_specs_transmitter_address_fkey" {
-					return jb, errors.Wrapf(ErrNoSuchTransmitterAddress, "%v", jobSpec.OffchainreportingOracleSpec.TransmitterAddress)
-				}
-				if pqErr.ConstraintName == "offchainreporting_oracle_specs_encrypted_ocr_key_bundle_id_fkey" {
-					return jb, errors.Wrapf(ErrNoSuchKeyBundle, "%v", jobSpec.OffchainreportingOracleSpec.EncryptedOCRKeyBundleID)
-				}
-			}
-		}
-		if err != nil {
-			return jb, errors.Wrap(err, "failed to create OffchainreportingOracleSpec for jobSpec")
+		if err = ocrSpecError(err, jobSpec.OffchainreportingOracleSpec, "failed to create OffchainreportingOracleSpec for jobSpec"); err != nil {
+			return jb, err
 		}
 		jobSpec.OffchainreportingOracleSpecID = &jobSpec.OffchainreportingOracleSpec.ID
 	case Keeper:
@@ -234,14 +216,8 @@ func (o *orm) CreateJob(ctx context.Context, jobSpec *Job, p pipeline.Pipeline)
 		jobSpec.CronSpecID = &jobSpec.CronSpec.ID
 	case VRF:
 		err := tx.Create(&jobSpec.VRFSpec).Error
-		pqErr, ok := err.(*pgconn.PgError)
-		if err != nil && ok && pqErr.Code == "23503" {
-			if pqErr.ConstraintName == "vrf_specs_public_key_fkey" {
-				return jb, errors.Wrapf(ErrNoSuchPublicKey, "%s", jobSpec.VRFSpec.PublicKey.String())
-			}
-		}
-		if err != nil {
-			return jb, errors.Wrap(err, "failed to create VRFSpec for jobSpec")
+		if err = vrfSpecError(err, jobSpec.VRFSpec, "failed to create VRFSpec for jobSpec"); err != nil {
+			return jb, err
 		}
 		jobSpec.VRFSpecID = &jobSpec.VRFSpec.ID
 	case Webhook:
@@ -266,14 +242,198 @@ func (o *orm) CreateJob(ctx context.Context, jobSpec *Job, p pipeline.Pipeline)
 		return jb, errors.Wrap(err, "failed to create pipeline spec")
 	}
 	jobSpec.PipelineSpecID = pipelineSpecID
+	jobSpec.Version = 1
 	err = tx.Create(jobSpec).Error
 	if err != nil {
 		return jb, errors.Wrap(err, "failed to create job")
 	}
+	if err = createSpecVersion(tx, *jobSpec); err != nil {
+		return jb, err
+	}
+
+	return o.FindJob(ctx, jobSpec.ID)
+}
+
+// UpdateJob saves jobSpec as a new version of the job with the same ID.
+//
+// The type specific spec of the job is overwritten in place, so that state
+// kept for it, such as the persistent state of OCR, is retained. The pipeline
+// spec is replaced by a new one, so that the runs of earlier versions stay
+// linked to their pipeline. The type and external job ID of a job cannot be
+// changed.
+//
+// NOTE: This is not wrapped in a db transaction so if you call this, you should
+// use postgres.TransactionManager to create the transaction in the context.
+// Returns the fully populated new version of the Job.
+func (o *orm) UpdateJob(ctx context.Context, jobSpec *Job, p pipeline.Pipeline) (Job, error) {
+	var jb Job
+	if err := o.assertBridgesExist(p); err != nil {
+		return jb, err
+	}
+
+	tx := postgres.TxFromContext(ctx, o.db)
+
+	current, err := o.FindJob(ctx, jobSpec.ID)
+	if err != nil {
+		return jb, errors.Wrap(err, "failed to load job")
+	}
+	if jobSpec.Type != current.Type {
+		return jb, errors.Errorf("cannot change the type of job %d from %s to %s", current.ID, current.Type, jobSpec.Type)
+	}
+	if jobSpec.ExternalJobID == (uuid.UUID{}) {
+		jobSpec.ExternalJobID = current.ExternalJobID
+	} else if jobSpec.ExternalJobID != current.ExternalJobID {
+		return jb, errors.Errorf("cannot change the externalJobID of job %d from %s to %s", current.ID, current.ExternalJobID, jobSpec.ExternalJobID)
+	}
+
+	// Specs are saved with the ID and creation time of the current spec, so
+	// that they overwrite it
+	switch jobSpec.Type {
+	case DirectRequest:
+		jobSpec.DirectRequestSpec.ID, jobSpec.DirectRequestSpec.CreatedAt = current.DirectRequestSpec.ID, current.DirectRequestSpec.CreatedAt
+		if err = tx.Save(jobSpec.DirectRequestSpec).Error; err != nil {
+			return jb, errors.Wrap(err, "failed to update DirectRequestSpec for jobSpec")
+		}
+		jobSpec.DirectRequestSpecID = &jobSpec.DirectRequestSpec.ID
+	case FluxMonitor:
+		jobSpec.FluxMonitorSpec.ID, jobSpec.FluxMonitorSpec.CreatedAt = current.FluxMonitorSpec.ID, current.FluxMonitorSpec.CreatedAt
+		if err = tx.Save(jobSpec.FluxMonitorSpec).Error; err != nil {
+			return jb, errors.Wrap(err, "failed to update FluxMonitorSpec for jobSpec")
+		}
+		jobSpec.FluxMonitorSpecID = &jobSpec.FluxMonitorSpec.ID
+	case OffchainReporting:
+		jobSpec.OffchainreportingOracleSpec.ID, jobSpec.OffchainreportingOracleSpec.CreatedAt = current.OffchainreportingOracleSpec.ID, current.OffchainreportingOracleSpec.CreatedAt
+		err = tx.Save(jobSpec.OffchainreportingOracleSpec).Error
+		if err = ocrSpecError(err, jobSpec.OffchainreportingOracleSpec, "failed to update OffchainreportingOracleSpec for jobSpec"); err != nil {
+			return jb, err
+		}
+		jobSpec.OffchainreportingOracleSpecID = &jobSpec.OffchainreportingOracleSpec.ID
+	case Keeper:
+		jobSpec.KeeperSpec.ID, jobSpec.KeeperSpec.CreatedAt = current.KeeperSpec.ID, current.KeeperSpec.CreatedAt
+		if err = tx.Save(jobSpec.KeeperSpec).Error; err != nil {
+			return jb, errors.Wrap(err, "failed to update KeeperSpec for jobSpec")
+		}
+		jobSpec.KeeperSpecID = &jobSpec.KeeperSpec.ID
+	case Cron:
+		jobSpec.CronSpec.ID, jobSpec.CronSpec.CreatedAt = current.CronSpec.ID, current.CronSpec.CreatedAt
+		if err = tx.Save(jobSpec.CronSpec).Error; err != nil {
+			return jb, errors.Wrap(err, "failed to update CronSpec for jobSpec")
+		}
+		jobSpec.CronSpecID = &jobSpec.CronSpec.ID
+	case VRF:
+		jobSpec.VRFSpec.ID, jobSpec.VRFSpec.CreatedAt = current.VRFSpec.ID, current.VRFSpec.CreatedAt
+		err = tx.Save(jobSpec.VRFSpec).Error
+		if err = vrfSpecError(err, jobSpec.VRFSpec, "failed to update VRFSpec for jobSpec"); err != nil {
+			return jb, err
+		}
+		jobSpec.VRFSpecID = &jobSpec.VRFSpec.ID
+	case Webhook:
+		jobSpec.WebhookSpec.ID, jobSpec.WebhookSpec.CreatedAt = current.WebhookSpec.ID, current.WebhookSpec.CreatedAt
+		if err = tx.Omit(clause.Associations).Save(jobSpec.WebhookSpec).Error; err != nil {
+			return jb, errors.Wrap(err, "failed to update WebhookSpec for jobSpec")
+		}
+		jobSpec.WebhookSpecID = &jobSpec.WebhookSpec.ID
+		if err = tx.Exec(`DELETE FROM external_initiator_webhook_specs WHERE webhook_spec_id = ?`, jobSpec.WebhookSpec.ID).Error; err != nil {
+			return jb, errors.Wrap(err, "failed to delete ExternalInitiatorWebhookSpecs for WebhookSpec")
+		}
+		for i, eiWS := range jobSpec.WebhookSpec.ExternalInitiatorWebhookSpecs {
+			jobSpec.WebhookSpec.ExternalInitiatorWebhookSpecs[i].WebhookSpecID = jobSpec.WebhookSpec.ID
+			err = tx.Create(&jobSpec.WebhookSpec.ExternalInitiatorWebhookSpecs[i]).Error
+			if err != nil {
+				return jb, errors.Wrapf(err, "failed to create ExternalInitiatorWebhookSpec for WebhookSpec: %#v", eiWS)
+			}
+		}
+	default:
+		return jb, errors.Errorf("unsupported jobSpec.Type: %v", jobSpec.Type)
+	}
+
+	pipelineSpecID, err := o.pipelineORM.CreateSpec(ctx, tx, p, jobSpec.MaxTaskDuration)
+	if err != nil {
+		return jb, errors.Wrap(err, "failed to create pipeline spec")
+	}
+	jobSpec.PipelineSpecID = pipelineSpecID
+	jobSpec.Version = current.Version + 1
+
+	// The version check guards against concurrent updates of the same job
+	res := tx.Exec(`UPDATE jobs SET pipeline_spec_id = ?, name = ?, schema_version = ?, max_task_duration = ?,
+eth_tx_limit_max_txs = ?, eth_tx_limit_max_daily_spend_wei = ?, eth_tx_limit_max_value_per_tx_wei = ?, version = ?
+WHERE id = ? AND version = ?`,
+		jobSpec.PipelineSpecID, jobSpec.Name, jobSpec.SchemaVersion, jobSpec.MaxTaskDuration,
+		jobSpec.EthTxLimitMaxTxs, jobSpec.EthTxLimitMaxDailySpendWei, jobSpec.EthTxLimitMaxValuePerTxWei, jobSpec.Version,
+		jobSpec.ID, current.Version)
+	if res.Error != nil {
+		return jb, errors.Wrap(res.Error, "failed to update job")
+	}
+	if res.RowsAffected == 0 {
+		return jb, errors.Errorf("job %d was updated concurrently", jobSpec.ID)
+	}
+	if err = createSpecVersion(tx, *jobSpec); err != nil {
+		return jb, err
+	}
 
 	return o.FindJob(ctx, jobSpec.ID)
 }
 
+func createSpecVersion(tx *gorm.DB, jb Job) error {
+	version := SpecVersion{
+		JobID:          jb.ID,
+		Version:        jb.Version,
+		TOML:           null.NewString(jb.TOML, jb.TOML != ""),
+		PipelineSpecID: jb.PipelineSpecID,
+		CreatedAt:      time.Now(),
+	}
+	return errors.Wrap(tx.Create(&version).Error, "failed to create job spec version")
+}
+
+func (o *orm) assertBridgesExist(p pipeline.Pipeline) error {
+	for _, task := range p.Tasks {
+		if task.Type() == pipeline.TaskTypeBridge {
+			// Bridge must exist
+			name := task.(*pipeline.BridgeTask).Name
+			bt := models.BridgeType{}
+			if err := o.db.First(&bt, "name = ?", name).Error; err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					return errors.Wrap(pipeline.ErrNoSuchBridge, name)
+				}
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ocrSpecError translates foreign key violations on saving spec into errors
+// naming the missing key, and wraps any other error with msg
+func ocrSpecError(err error, spec *OffchainReportingOracleSpec, msg string) error {
+	pqErr, ok := err.(*pgconn.PgError)
+	if err != nil && ok && pqErr.Code == "23503" {
+		if pqErr.ConstraintName == "offchainreporting_oracle_specs_p2p_peer_id_fkey" {
+			return errors.Wrapf(ErrNoSuchPeerID, "%v", spec.P2PPeerID)
+		}
+		if spec != nil && !spec.IsBootstrapPeer {
+			if pqErr.ConstraintName == "offchainreporting_oracle_specs_transmitter_address_fkey" {
+				return errors.Wrapf(ErrNoSuchTransmitterAddress, "%v", spec.TransmitterAddress)
+			}
+			if pqErr.ConstraintName == "offchainreporting_oracle_specs_encrypted_ocr_key_bundle_id_fkey" {
+				return errors.Wrapf(ErrNoSuchKeyBundle, "%v", spec.EncryptedOCRKeyBundleID)
+			}
+		}
+	}
+	return errors.Wrap(err, msg)
+}
+
+// vrfSpecError translates foreign key violations on saving spec into errors
+// naming the missing key, and wraps any other error with msg
+func vrfSpecError(err error, spec *VRFSpec, msg string) error {
+	pqErr, ok := err.(*pgconn.PgError)
+	if err != nil && ok && pqErr.Code == "23503" {
+		if pqErr.ConstraintName == "vrf_specs_public_key_fkey" {
+			return errors.Wrapf(ErrNoSuchPublicKey, "%s", spec.PublicKey.String())
+		}
+	}
+	return errors.Wrap(err, msg)
+}
+
 // DeleteJob removes a job that is claimed by this orm
 func (o *orm) DeleteJob(ctx context.Context, id int32) error {
 	o.claimedJobsMu.Lock()
@@ -312,8 +472,11 @@ func (o *orm) DeleteJob(ctx context.Context, id int32) error {
 		deleted_dr_specs AS (
 			DELETE FROM direct_request_specs WHERE id IN (SELECT direct_request_spec_id FROM deleted_jobs)
 		)
-		DELETE FROM pipeline_specs WHERE id IN (SELECT pipeline_spec_id FROM deleted_jobs)
-	`, id).Error
+		DELETE FROM pipeline_specs WHERE id IN (
+			SELECT pipeline_spec_id FROM deleted_jobs
+			UNION SELECT pipeline_spec_id FROM job_spec_versions WHERE job_id = ?
+		)
+	`, id, id).Error
 	if err != nil {
 		return errors.Wrap(err, "DeleteJob failed to delete job")
 	}
@@ -504,6 +667,88 @@ func (o *orm) FindJobIDsWithBridge(name string) ([]int32, error) {
 	return jids, nil
 }
 
+// FindJobIDsWithEVMChainID returns the IDs of jobs that run on the chain,
+// either through their spec or through an ethtx or ethcall task
+func (o *orm) FindJobIDsWithEVMChainID(id utils.Big) ([]int32, error) {
+	const specChainID = `COALESCE(direct_request_specs.evm_chain_id, flux_monitor_specs.evm_chain_id,
+	offchainreporting_oracle_specs.evm_chain_id, keeper_specs.evm_chain_id, vrf_specs.evm_chain_id)`
+	q := o.db.Table("jobs").
+		Joins("LEFT JOIN direct_request_specs ON direct_request_specs.id = jobs.direct_request_spec_id").
+		Joins("LEFT JOIN flux_monitor_specs ON flux_monitor_specs.id = jobs.flux_monitor_spec_id").
+		Joins("LEFT JOIN offchainreporting_oracle_specs ON offchainreporting_oracle_specs.id = jobs.offchainreporting_oracle_spec_id").
+		Joins("LEFT JOIN keeper_specs ON keeper_specs.id = jobs.keeper_spec_id").
+		Joins("LEFT JOIN vrf_specs ON vrf_specs.id = jobs.vrf_spec_id")
+
+	var jids []int32
+	err := q.Session(&gorm.Session{}).
+		Where(specChainID+" = ?", id).
+		Order("jobs.id").
+		Pluck("jobs.id", &jids).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load jobs by chain of their spec")
+	}
+
+	// Only the pipelines of the other jobs that set a chain on any of their
+	// tasks need to be parsed
+	var candidates []struct {
+		ID           int32
+		DotDagSource string
+	}
+	err = q.Session(&gorm.Session{}).
+		Select("jobs.id, pipeline_specs.dot_dag_source").
+		Joins("JOIN pipeline_specs ON pipeline_specs.id = jobs.pipeline_spec_id").
+		Where(specChainID+" IS DISTINCT FROM ?", id).
+		Where("pipeline_specs.dot_dag_source ILIKE '%evmchainid%'").
+		Order("jobs.id").
+		Scan(&candidates).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load jobs with tasks on a chain")
+	}
+	for _, c := range candidates {
+		p, err := pipeline.Parse(c.DotDagSource)
+		if err != nil {
+			return nil, err
+		}
+		for _, task := range p.Tasks {
+			var taskChainID string
+			switch task.Type() {
+			case pipeline.TaskTypeETHTx:
+				taskChainID = task.(*pipeline.ETHTxTask).EVMChainID
+			case pipeline.TaskTypeETHCall:
+				taskChainID = task.(*pipeline.ETHCallTask).EVMChainID
+			}
+			if taskChainID == id.String() {
+				jids = append(jids, c.ID)
+				break
+			}
+		}
+	}
+	return jids, nil
+}
+
+// JobSpecVersions returns the versions of the spec of a job, most recent
+// first
+func (o *orm) JobSpecVersions(jobID int32) ([]SpecVersion, error) {
+	var versions []SpecVersion
+	err := o.db.
+		Preload("PipelineSpec").
+		Where("job_id = ?", jobID).
+		Order("version DESC").
+		Find(&versions).
+		Error
+	return versions, errors.Wrap(err, "failed to load job spec versions")
+}
+
+// FindJobSpecVersion returns a version of the spec of a job
+func (o *orm) FindJobSpecVersion(jobID int32, version int32) (SpecVersion, error) {
+	var v SpecVersion
+	err := o.db.
+		Preload("PipelineSpec").
+		First(&v, "job_id = ? AND version = ?", jobID, version).
+		Error
+	return v, err
+}
+
 // PipelineRunsByJobID returns all pipeline runs
 func (o *orm) PipelineRuns(offset, size int) ([]pipeline.Run, int, error) {
 	var pipelineRuns []pipeline.Run
@@ -532,14 +777,20 @@ func (o *orm) PipelineRuns(offset, size int) ([]pipeline.Run, int, error) {
 	return pipelineRuns, int(count), err
 }
 
-// PipelineRunsByJobID returns pipeline runs for a job
+// jobPipelineSpecsCondition matches the runs of all versions of a job
+const jobPipelineSpecsCondition = `pipeline_runs.pipeline_spec_id IN (
+	SELECT pipeline_spec_id FROM jobs WHERE id = ?
+	UNION SELECT pipeline_spec_id FROM job_spec_versions WHERE job_id = ?
+)`
+
+// PipelineRunsByJobID returns pipeline runs for a job, including the runs of
+// earlier versions of its spec
 func (o *orm) PipelineRunsByJobID(jobID int32, offset, size int) ([]pipeline.Run, int, error) {
 	var pipelineRuns []pipeline.Run
 	var count int64
 	err := o.db.
 		Model(pipeline.Run{}).
-		Joins("INNER JOIN jobs ON pipeline_runs.pipeline_spec_id = jobs.pipeline_spec_id").
-		Where("jobs.id = ?", jobID).
+		Where(jobPipelineSpecsCondition, jobID, jobID).
 		Count(&count).
 		Error
 
@@ -553,8 +804,7 @@ func (o *orm) PipelineRunsByJobID(jobID int32, offset, size int) ([]pipeline.Run
 			return db.
 				Order("created_at ASC, id ASC")
 		}).
-		Joins("INNER JOIN jobs ON pipeline_runs.pipeline_spec_id = jobs.pipeline_spec_id").
-		Where("jobs.id = ?", jobID).
+		Where(jobPipelineSpecsCondition, jobID, jobID).
 		Limit(size).
 		Offset(offset).
 		Order("created_at DESC, id DESC").
@@ -2,6 +2,7 @@ package job
 
 import (
 	"context"
+	"math/big"
 	"reflect"
 	"strconv"
 	"sync"
@@ -26,6 +27,7 @@ type (
 	Spawner interface {
 		service.Service
 		CreateJob(ctx context.Context, spec Job, name null.String) (Job, error)
+		UpdateJob(ctx context.Context, jobID int32, spec Job) (Job, error)
 		DeleteJob(ctx context.Context, jobID int32) error
 		ActiveJobs() map[int32]Job
 	}
@@ -201,23 +203,29 @@ func (js *spawner) startUnclaimedServices() {
 			continue
 		}
 
-		logger.Debugw("JobSpawner: Starting services for job", "jobID", spec.ID, "count", len(services))
-
-		aj := activeJob{delegate: delegate, spec: spec}
-		for _, service := range services {
-			err := service.Start()
-			if err != nil {
-				logger.Errorw("Error creating service for job", "jobID", spec.ID, "error", err)
-				continue
-			}
-			aj.services = append(aj.services, service)
-		}
-		js.activeJobs[spec.ID] = aj
+		js.startServices(delegate, spec, services)
 	}
 
 	logger.Infow("JobSpawner: all jobs running", "count", len(specs))
 }
 
+// startServices starts the services of a job and marks it as active. It must
+// be called with activeJobsMu held.
+func (js *spawner) startServices(delegate Delegate, spec Job, services []Service) {
+	logger.Debugw("JobSpawner: Starting services for job", "jobID", spec.ID, "count", len(services))
+
+	aj := activeJob{delegate: delegate, spec: spec}
+	for _, service := range services {
+		err := service.Start()
+		if err != nil {
+			logger.Errorw("Error creating service for job", "jobID", spec.ID, "error", err)
+			continue
+		}
+		aj.services = append(aj.services, service)
+	}
+	js.activeJobs[spec.ID] = aj
+}
+
 func (js *spawner) stopAllServices() {
 	var jobIDs []int32
 	func() {
@@ -322,6 +330,83 @@ func (js *spawner) CreateJob(ctx context.Context, spec Job, name null.String) (J
 	return jb, err
 }
 
+// UpdateJob saves spec as a new version of the job and replaces the running
+// services of the job with services for the new version. The services of the
+// current version are stopped first, so that both versions never run at the
+// same time. If the new version cannot be saved or its services cannot be
+// created, the current version is restarted and the job is left unchanged.
+func (js *spawner) UpdateJob(ctx context.Context, jobID int32, spec Job) (Job, error) {
+	var jb Job
+	if jobID == 0 {
+		return jb, errors.New("will not update job with 0 ID")
+	}
+
+	var aj activeJob
+	var exists bool
+	func() {
+		js.activeJobsMu.RLock()
+		defer js.activeJobsMu.RUnlock()
+		aj, exists = js.activeJobs[jobID]
+	}()
+	if !exists {
+		return jb, errors.Errorf("job not found (id: %v)", jobID)
+	}
+	if spec.Type != aj.spec.Type {
+		return jb, errors.Errorf("cannot change the type of job %d from %s to %s", jobID, aj.spec.Type, spec.Type)
+	}
+
+	ctx, cancel := utils.CombinedContext(js.chStop, ctx)
+	defer cancel()
+
+	js.stopService(jobID)
+	aj.delegate.BeforeJobDeleted(aj.spec)
+
+	spec.ID = jobID
+	var services []Service
+	err := js.txm.TransactWithContext(ctx, func(ctx context.Context) error {
+		var err error
+		jb, err = js.orm.UpdateJob(ctx, &spec, spec.Pipeline)
+		if err != nil {
+			return err
+		}
+		// Services are created before committing, so that the update is rolled
+		// back if the new version cannot run
+		services, err = aj.delegate.ServicesForSpec(jb)
+		return errors.Wrap(err, "failed to create services for job")
+	})
+	if err != nil {
+		logger.Errorw("Error updating job, restarting current version", "jobID", jobID, "version", aj.spec.Version, "error", err)
+		js.restartServices(ctx, aj)
+		return jb, err
+	}
+
+	func() {
+		js.activeJobsMu.Lock()
+		defer js.activeJobsMu.Unlock()
+		js.startServices(aj.delegate, jb, services)
+	}()
+	aj.delegate.AfterJobCreated(jb)
+
+	logger.Infow("Updated job", "type", jb.Type, "jobID", jb.ID, "version", jb.Version)
+	return jb, nil
+}
+
+// restartServices starts new services for a job whose services were stopped
+func (js *spawner) restartServices(ctx context.Context, aj activeJob) {
+	services, err := aj.delegate.ServicesForSpec(aj.spec)
+	if err != nil {
+		logger.Errorw("Error creating services for job", "jobID", aj.spec.ID, "error", err)
+		js.orm.RecordError(ctx, aj.spec.ID, err.Error())
+	}
+
+	func() {
+		js.activeJobsMu.Lock()
+		defer js.activeJobsMu.Unlock()
+		js.startServices(aj.delegate, aj.spec, services)
+	}()
+	aj.delegate.AfterJobCreated(aj.spec)
+}
+
 func (js *spawner) DeleteJob(ctx context.Context, jobID int32) error {
 	if jobID == 0 {
 		return errors.New("will not delete job with 0 ID")
@@ -383,3 +468,49 @@ func (n *NullDelegate) ServicesForSpec(spec Job) (s []Service, err error) {
 
 func (*NullDelegate) AfterJobCreated(spec Job)  {}
 func (*NullDelegate) BeforeJobDeleted(spec Job) {}
+
+var _ Delegate = &ChainDelegate{}
+
+// ChainDelegate hands each job to a delegate for the EVM chain that it runs
+// on. The delegate is created for every call so that it always uses the
+// chain's current services.
+type ChainDelegate struct {
+	typ         Type
+	delegateFor func(evmChainID *big.Int) (Delegate, error)
+}
+
+// NewChainDelegate returns a ChainDelegate for jobs of type typ. delegateFor
+// is passed nil for jobs on the node's default chain.
+func NewChainDelegate(typ Type, delegateFor func(evmChainID *big.Int) (Delegate, error)) *ChainDelegate {
+	return &ChainDelegate{typ, delegateFor}
+}
+
+func (d *ChainDelegate) JobType() Type {
+	return d.typ
+}
+
+func (d *ChainDelegate) ServicesForSpec(spec Job) ([]Service, error) {
+	delegate, err := d.delegateFor(spec.EVMChainID())
+	if err != nil {
+		return nil, err
+	}
+	return delegate.ServicesForSpec(spec)
+}
+
+func (d *ChainDelegate) AfterJobCreated(spec Job) {
+	delegate, err := d.delegateFor(spec.EVMChainID())
+	if err != nil {
+		logger.Errorw("ChainDelegate: no delegate for job", "jobID", spec.ID, "err", err)
+		return
+	}
+	delegate.AfterJobCreated(spec)
+}
+
+func (d *ChainDelegate) BeforeJobDeleted(spec Job) {
+	delegate, err := d.delegateFor(spec.EVMChainID())
+	if err != nil {
+		logger.Errorw("ChainDelegate: no delegate for job", "jobID", spec.ID, "err", err)
+		return
+	}
+	delegate.BeforeJobDeleted(spec)
+}
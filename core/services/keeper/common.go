@@ -9,10 +9,15 @@ import (
 
 var RegistryABI = eth.MustGetABI(keeper_registry_wrapper.KeeperRegistryABI)
 
+// multicallABI is the subset of the Multicall2 ABI used to aggregate checkUpkeep calls
+var multicallABI = eth.MustGetABI(`[{"inputs":[{"internalType":"bool","name":"requireSuccess","type":"bool"},{"components":[{"internalType":"address","name":"target","type":"address"},{"internalType":"bytes","name":"callData","type":"bytes"}],"internalType":"struct Multicall2.Call[]","name":"calls","type":"tuple[]"}],"name":"tryAggregate","outputs":[{"components":[{"internalType":"bool","name":"success","type":"bool"},{"internalType":"bytes","name":"returnData","type":"bytes"}],"internalType":"struct Multicall2.Result[]","name":"returnData","type":"tuple[]"}],"stateMutability":"nonpayable","type":"function"}]`)
+
 type Config interface {
+	KeeperCheckUpkeepBatchSize() uint32
 	KeeperDefaultTransactionQueueDepth() uint32
 	KeeperMaximumGracePeriod() int64
 	KeeperMinimumRequiredConfirmations() uint64
+	KeeperMulticallAddress() string
 	KeeperRegistryCheckGasOverhead() uint64
 	KeeperRegistryPerformGasOverhead() uint64
 	KeeperRegistrySyncInterval() time.Duration
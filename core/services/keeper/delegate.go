@@ -76,13 +76,16 @@ func (d *Delegate) ServicesForSpec(spec job.Job) (services []job.Service, err er
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to create keeper registry contract wrapper")
 	}
-	strategy := bulletprooftxmanager.NewQueueingTxStrategy(spec.ExternalJobID, d.config.KeeperDefaultTransactionQueueDepth())
+	// Upkeeps are not simulated again since checkUpkeep already simulates
+	// performUpkeep before every transaction
+	strategy := bulletprooftxmanager.NewQueueingTxStrategy(spec.ExternalJobID, d.config.KeeperDefaultTransactionQueueDepth(), false)
 
 	orm := NewORM(d.db, d.txm, d.config, strategy)
 
 	registrySynchronizer := NewRegistrySynchronizer(
 		spec,
 		contract,
+		d.ethClient,
 		orm,
 		d.jrm,
 		d.logBroadcaster,
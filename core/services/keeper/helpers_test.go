@@ -13,3 +13,5 @@ func (rs *RegistrySynchronizer) ExportedProcessLogs() {
 func (executer *UpkeepExecuter) ExportedConstructCheckUpkeepCallMsg(upkeep UpkeepRegistration) (ethereum.CallMsg, error) {
 	return executer.constructCheckUpkeepCallMsg(upkeep)
 }
+
+var MulticallABI = multicallABI
@@ -1,6 +1,12 @@
 package keeper
 
-import "github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"gopkg.in/guregu/null.v4"
+)
 
 type Registry struct {
 	ID                int32 `gorm:"primary_key"`
@@ -11,6 +17,8 @@ type Registry struct {
 	JobID             int32
 	KeeperIndex       int32
 	NumKeepers        int32
+	Version           RegistryVersion
+	Paused            bool
 }
 
 func NewRegistry(address ethkey.EIP55Address, from ethkey.EIP55Address, jobID int32) Registry {
@@ -18,6 +26,7 @@ func NewRegistry(address ethkey.EIP55Address, from ethkey.EIP55Address, jobID in
 		ContractAddress: address,
 		FromAddress:     from,
 		JobID:           jobID,
+		Version:         RegistryVersion_1_0,
 	}
 }
 
@@ -35,3 +44,29 @@ type UpkeepRegistration struct {
 	UpkeepID            int64
 	PositioningConstant int32
 }
+
+// UpkeepPerform records an eth_tx created by this node to perform an upkeep.
+// Success is set once the UpkeepPerformed log of the transaction is received.
+type UpkeepPerform struct {
+	ID                   int64
+	UpkeepRegistrationID int32
+	EthTxID              int64
+	BlockNumber          int64
+	Success              null.Bool
+	CreatedAt            time.Time
+}
+
+func (UpkeepPerform) TableName() string {
+	return "upkeep_performs"
+}
+
+// UpkeepPerformAttempt is an UpkeepPerform along with the state of its eth_tx
+// and, once mined, its receipt
+type UpkeepPerformAttempt struct {
+	UpkeepPerform
+	TxState              null.String
+	TxHash               *common.Hash
+	ConfirmedBlockNumber null.Int
+	GasUsed              null.String
+	ReceiptStatus        null.String
+}
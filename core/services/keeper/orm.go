@@ -3,8 +3,10 @@ package keeper
 import (
 	"context"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/store/models"
 
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
@@ -48,7 +50,7 @@ func (korm ORM) UpsertRegistry(ctx context.Context, registry *Registry) error {
 		Clauses(clause.OnConflict{
 			Columns: []clause.Column{{Name: "job_id"}},
 			DoUpdates: clause.AssignmentColumns(
-				[]string{"keeper_index", "check_gas", "block_count_per_turn", "num_keepers"},
+				[]string{"keeper_index", "check_gas", "block_count_per_turn", "num_keepers", "version", "paused"},
 			),
 		}).
 		Create(registry).
@@ -92,6 +94,7 @@ func (korm ORM) EligibleUpkeepsForRegistry(
 		Where(`
 			keeper_registries.contract_address = ? AND
 			keeper_registries.num_keepers > 0 AND
+			NOT keeper_registries.paused AND
 			(
 				upkeep_registrations.last_run_block_height = 0 OR (
 					upkeep_registrations.last_run_block_height + ? < ? AND
@@ -135,9 +138,69 @@ func (korm ORM) SetLastRunHeightForUpkeepOnJob(db *gorm.DB, jobID int32, upkeepI
 		).Error
 }
 
+func (korm ORM) InsertUpkeepPerform(db *gorm.DB, perform *UpkeepPerform) error {
+	return db.Create(perform).Error
+}
+
+// SetUpkeepPerformSuccess records the success reported by the UpkeepPerformed
+// log of a transaction sent by this node. Performs by other keepers are ignored.
+func (korm ORM) SetUpkeepPerformSuccess(db *gorm.DB, txHash common.Hash, success bool) error {
+	return db.Exec(`UPDATE upkeep_performs SET success = ?
+		FROM eth_tx_attempts
+		WHERE eth_tx_attempts.eth_tx_id = upkeep_performs.eth_tx_id AND eth_tx_attempts.hash = ?`,
+		success,
+		txHash,
+	).Error
+}
+
+// UpkeepsForJob returns the upkeeps on the registry of the job, along with the
+// registry
+func (korm ORM) UpkeepsForJob(ctx context.Context, jobID int32) (upkeeps []UpkeepRegistration, _ error) {
+	err := korm.DB.
+		WithContext(ctx).
+		Preload("Registry").
+		Joins("INNER JOIN keeper_registries ON keeper_registries.id = upkeep_registrations.registry_id").
+		Where("keeper_registries.job_id = ?", jobID).
+		Order("upkeep_registrations.upkeep_id ASC").
+		Find(&upkeeps).
+		Error
+	return upkeeps, err
+}
+
+// UpkeepPerformHistory returns, for each of the given upkeep registrations,
+// its most recent performs up to limit, newest first. The transaction hash is
+// that of the attempt that was mined, or of the latest attempt if none was.
+func (korm ORM) UpkeepPerformHistory(ctx context.Context, upkeepRegistrationIDs []int32, limit int) (attempts []UpkeepPerformAttempt, _ error) {
+	err := korm.DB.
+		WithContext(ctx).
+		Raw(`
+SELECT performs.id, performs.upkeep_registration_id, performs.eth_tx_id, performs.block_number, performs.success, performs.created_at,
+	eth_txes.state AS tx_state, attempts.hash AS tx_hash, attempts.block_number AS confirmed_block_number,
+	attempts.receipt->>'gasUsed' AS gas_used, attempts.receipt->>'status' AS receipt_status
+FROM (
+	SELECT *, row_number() OVER (PARTITION BY upkeep_registration_id ORDER BY id DESC) AS n
+	FROM upkeep_performs
+	WHERE upkeep_registration_id IN (?)
+) performs
+LEFT JOIN eth_txes ON eth_txes.id = performs.eth_tx_id
+LEFT JOIN LATERAL (
+	SELECT eth_tx_attempts.hash, eth_receipts.block_number, eth_receipts.receipt
+	FROM eth_tx_attempts
+	LEFT JOIN eth_receipts ON eth_receipts.tx_hash = eth_tx_attempts.hash
+	WHERE eth_tx_attempts.eth_tx_id = performs.eth_tx_id
+	ORDER BY eth_receipts.block_number DESC NULLS LAST, eth_tx_attempts.id DESC
+	LIMIT 1
+) attempts ON TRUE
+WHERE performs.n <= ?
+ORDER BY performs.id DESC`, upkeepRegistrationIDs, limit).
+		Scan(&attempts).
+		Error
+	return attempts, err
+}
+
 func (korm ORM) CreateEthTransactionForUpkeep(tx *gorm.DB, upkeep UpkeepRegistration, payload []byte) (bulletprooftxmanager.EthTx, error) {
 	from := upkeep.Registry.FromAddress.Address()
 	to := upkeep.Registry.ContractAddress.Address()
 	gasLimit := upkeep.ExecuteGas + korm.config.KeeperRegistryPerformGasOverhead()
-	return korm.txm.CreateEthTransaction(tx, from, to, payload, gasLimit, nil, korm.strategy)
+	return korm.txm.CreateEthTransaction(tx, from, to, payload, gasLimit, &models.EthTxMetaV2{JobID: upkeep.Registry.JobID}, korm.strategy)
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/services/keeper"
 	"github.com/smartcontractkit/chainlink/core/store"
+	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -365,7 +366,7 @@ func TestKeeperDB_CreateEthTransactionForUpkeep(t *testing.T) {
 	defer cancel()
 	gasLimit := upkeep.ExecuteGas + store.Config.KeeperRegistryPerformGasOverhead()
 	err = postgres.GormTransaction(ctx, orm.DB, func(tx *gorm.DB) error {
-		txm.On("CreateEthTransaction", tx, fromAddress, toAddress, payload, gasLimit, nil, bulletprooftxmanager.SendEveryStrategy{}).Once().Return(bulletprooftxmanager.EthTx{
+		txm.On("CreateEthTransaction", tx, fromAddress, toAddress, payload, gasLimit, &models.EthTxMetaV2{JobID: registry.JobID}, bulletprooftxmanager.SendEveryStrategy{}).Once().Return(bulletprooftxmanager.EthTx{
 			FromAddress:    fromAddress,
 			ToAddress:      toAddress,
 			EncodedPayload: payload,
@@ -383,3 +384,81 @@ func TestKeeperDB_CreateEthTransactionForUpkeep(t *testing.T) {
 
 	txm.AssertExpectations(t)
 }
+
+func TestKeeperDB_EligibleUpkeeps_PausedRegistry(t *testing.T) {
+	t.Parallel()
+	store, orm, cleanup := setupKeeperDB(t)
+	defer cleanup()
+	ethKeyStore := cltest.NewKeyStore(t, store.DB).Eth()
+
+	registry, _ := cltest.MustInsertKeeperRegistry(t, store, ethKeyStore)
+	cltest.MustInsertUpkeepForRegistry(t, store, registry)
+
+	registry.Paused = true
+	require.NoError(t, orm.UpsertRegistry(context.Background(), &registry))
+
+	list, err := orm.EligibleUpkeepsForRegistry(context.Background(), registry.ContractAddress, 20, 0)
+	require.NoError(t, err)
+	assert.Len(t, list, 0)
+}
+
+func TestKeeperDB_UpkeepPerformHistory(t *testing.T) {
+	t.Parallel()
+	store, orm, cleanup := setupKeeperDB(t)
+	defer cleanup()
+	db := store.DB
+	ethKeyStore := cltest.NewKeyStore(t, store.DB).Eth()
+
+	registry, j := cltest.MustInsertKeeperRegistry(t, store, ethKeyStore)
+	upkeep1 := cltest.MustInsertUpkeepForRegistry(t, store, registry)
+	upkeep2 := cltest.MustInsertUpkeepForRegistry(t, store, registry)
+
+	upkeeps, err := orm.UpkeepsForJob(context.Background(), j.ID)
+	require.NoError(t, err)
+	require.Len(t, upkeeps, 2)
+	assert.Equal(t, registry.ContractAddress, upkeeps[0].Registry.ContractAddress)
+
+	fromAddress := registry.FromAddress.Address()
+	confirmed := cltest.MustInsertConfirmedEthTxWithReceipt(t, db, fromAddress, 0, 42)
+	unconfirmed := cltest.MustInsertUnconfirmedEthTxWithBroadcastAttempt(t, db, 1, fromAddress)
+
+	for i, etx := range []bulletprooftxmanager.EthTx{confirmed, unconfirmed} {
+		require.NoError(t, orm.InsertUpkeepPerform(db, &keeper.UpkeepPerform{
+			UpkeepRegistrationID: upkeep1.ID,
+			EthTxID:              etx.ID,
+			BlockNumber:          int64(40 + i),
+		}))
+	}
+	require.NoError(t, orm.InsertUpkeepPerform(db, &keeper.UpkeepPerform{
+		UpkeepRegistrationID: upkeep2.ID,
+		EthTxID:              confirmed.ID,
+		BlockNumber:          40,
+	}))
+	cltest.AssertCount(t, db, &keeper.UpkeepPerform{}, 3)
+
+	require.NoError(t, orm.SetUpkeepPerformSuccess(db, confirmed.EthTxAttempts[0].Hash, true))
+
+	history, err := orm.UpkeepPerformHistory(context.Background(), []int32{upkeep1.ID}, 10)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+
+	assert.Equal(t, unconfirmed.ID, history[0].EthTxID)
+	assert.Equal(t, int64(41), history[0].BlockNumber)
+	assert.Equal(t, string(bulletprooftxmanager.EthTxUnconfirmed), history[0].TxState.String)
+	assert.Equal(t, unconfirmed.EthTxAttempts[0].Hash, *history[0].TxHash)
+	assert.False(t, history[0].ConfirmedBlockNumber.Valid)
+	assert.False(t, history[0].Success.Valid)
+
+	assert.Equal(t, confirmed.ID, history[1].EthTxID)
+	assert.Equal(t, string(bulletprooftxmanager.EthTxConfirmed), history[1].TxState.String)
+	assert.Equal(t, confirmed.EthTxAttempts[0].Hash, *history[1].TxHash)
+	assert.Equal(t, int64(42), history[1].ConfirmedBlockNumber.Int64)
+	assert.True(t, history[1].Success.Bool)
+
+	history, err = orm.UpkeepPerformHistory(context.Background(), []int32{upkeep1.ID, upkeep2.ID}, 1)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	assert.Equal(t, upkeep2.ID, history[0].UpkeepRegistrationID)
+	assert.Equal(t, upkeep1.ID, history[1].UpkeepRegistrationID)
+	assert.Equal(t, unconfirmed.ID, history[1].EthTxID)
+}
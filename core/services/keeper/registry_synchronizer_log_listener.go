@@ -35,6 +35,12 @@ func (rs *RegistrySynchronizer) HandleLog(broadcast log.Broadcast) {
 	case *keeper_registry_wrapper.KeeperRegistryConfigSet:
 		wasOverCapacity = rs.mailRoom.mbSyncRegistry.Deliver(broadcast) // same mailbox because same action
 		mailboxName = "mbSyncRegistry"
+	case *keeper_registry_wrapper.KeeperRegistryPaused:
+		wasOverCapacity = rs.mailRoom.mbSyncRegistry.Deliver(broadcast) // same mailbox because same action
+		mailboxName = "mbSyncRegistry"
+	case *keeper_registry_wrapper.KeeperRegistryUnpaused:
+		wasOverCapacity = rs.mailRoom.mbSyncRegistry.Deliver(broadcast) // same mailbox because same action
+		mailboxName = "mbSyncRegistry"
 	case *keeper_registry_wrapper.KeeperRegistryUpkeepCanceled:
 		wasOverCapacity = rs.mailRoom.mbUpkeepCanceled.Deliver(broadcast)
 		mailboxName = "mbUpkeepCanceled"
@@ -191,6 +191,11 @@ func (rs *RegistrySynchronizer) handleUpkeepPerformed(broadcast log.Broadcast) {
 		logger.Error(err)
 		return
 	}
+	err = rs.orm.SetUpkeepPerformSuccess(db, broadcast.RawLog().TxHash, log.Success)
+	if err != nil {
+		logger.Error(err)
+		return
+	}
 	ctx, cancel = postgres.DefaultQueryCtx()
 	defer cancel()
 	err = rs.logBroadcaster.MarkConsumed(rs.orm.DB.WithContext(ctx), broadcast)
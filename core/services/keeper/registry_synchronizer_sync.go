@@ -6,8 +6,11 @@ import (
 	"math/big"
 	"sync"
 
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/pkg/errors"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/services/postgres"
 	"github.com/smartcontractkit/chainlink/core/utils"
@@ -140,6 +143,13 @@ func (rs *RegistrySynchronizer) deleteCanceledUpkeeps(reg Registry) error {
 func (rs *RegistrySynchronizer) newRegistryFromChain() (Registry, error) {
 	fromAddress := rs.job.KeeperSpec.FromAddress
 	contractAddress := rs.job.KeeperSpec.ContractAddress
+	version, err := rs.registryVersion()
+	if err != nil {
+		ctx, cancel := postgres.DefaultQueryCtx()
+		defer cancel()
+		rs.jrm.RecordError(ctx, rs.job.ID, err.Error())
+		return Registry{}, err
+	}
 	config, err := rs.contract.GetConfig(nil)
 	if err != nil {
 		ctx, cancel := postgres.DefaultQueryCtx()
@@ -147,6 +157,10 @@ func (rs *RegistrySynchronizer) newRegistryFromChain() (Registry, error) {
 		rs.jrm.RecordError(ctx, rs.job.ID, err.Error())
 		return Registry{}, err
 	}
+	paused, err := rs.contract.Paused(nil)
+	if err != nil {
+		return Registry{}, err
+	}
 	keeperAddresses, err := rs.contract.GetKeeperList(nil)
 	if err != nil {
 		return Registry{}, err
@@ -169,9 +183,34 @@ func (rs *RegistrySynchronizer) newRegistryFromChain() (Registry, error) {
 		JobID:             rs.job.ID,
 		KeeperIndex:       keeperIndex,
 		NumKeepers:        int32(len(keeperAddresses)),
+		Version:           version,
+		Paused:            paused,
 	}, nil
 }
 
+// registryVersion calls typeAndVersion on the registry to find out its
+// version. Registries that do not implement typeAndVersion are 1.0.
+func (rs *RegistrySynchronizer) registryVersion() (RegistryVersion, error) {
+	contractAddress := rs.contract.Address()
+	ctx, cancel := eth.DefaultQueryCtx()
+	defer cancel()
+	data, err := rs.ethClient.CallContract(ctx, ethereum.CallMsg{
+		To:   &contractAddress,
+		Data: TypeAndVersionABI.Methods[typeAndVersion].ID,
+	}, nil)
+	if err != nil && !eth.IsExecutionReverted(err) {
+		return "", errors.Wrap(err, "unable to get typeAndVersion of registry")
+	}
+	if err != nil || len(data) == 0 {
+		return RegistryVersion_1_0, nil
+	}
+	out, err := TypeAndVersionABI.Unpack(typeAndVersion, data)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to unpack typeAndVersion of registry")
+	}
+	return ParseRegistryVersion(*abi.ConvertType(out[0], new(string)).(*string))
+}
+
 // the positioning constant is fixed because upkeepID and registryAddress are immutable
 func CalcPositioningConstant(upkeepID int64, registryAddress ethkey.EIP55Address) (int32, error) {
 	upkeepBytes := make([]byte, binary.MaxVarintLen64)
@@ -56,7 +56,7 @@ func setupRegistrySync(t *testing.T) (
 	j := cltest.MustInsertKeeperJob(t, store, cltest.NewEIP55Address(), cltest.NewEIP55Address())
 	cfg, cleanup := cltest.NewConfig(t)
 	t.Cleanup(cleanup)
-	jpv2 := cltest.NewJobPipelineV2(t, cfg, store.DB, nil, nil, nil)
+	jpv2 := cltest.NewJobPipelineV2(t, cfg, store.DB, nil, nil)
 	contractAddress := j.KeeperSpec.ContractAddress.Address()
 	contract, err := keeper_registry_wrapper.NewKeeperRegistry(
 		contractAddress,
@@ -70,7 +70,7 @@ func setupRegistrySync(t *testing.T) (
 	lbMock.On("IsConnected").Return(true).Maybe()
 
 	orm := keeper.NewORM(store.DB, nil, store.Config, bulletprooftxmanager.SendEveryStrategy{})
-	synchronizer := keeper.NewRegistrySynchronizer(j, contract, orm, jpv2.Jrm, lbMock, syncInterval, 1)
+	synchronizer := keeper.NewRegistrySynchronizer(j, contract, ethClient, orm, jpv2.Jrm, lbMock, syncInterval, 1)
 	return store, synchronizer, ethClient, lbMock, j
 }
 
@@ -90,8 +90,11 @@ func Test_RegistrySynchronizer_Start(t *testing.T) {
 	fromAddress := job.KeeperSpec.FromAddress.Address()
 
 	registryMock := cltest.NewContractMockReceiver(t, ethMock, keeper.RegistryABI, contractAddress)
+	versionMock := cltest.NewContractMockReceiver(t, ethMock, keeper.TypeAndVersionABI, contractAddress)
 	canceledUpkeeps := []*big.Int{big.NewInt(1)}
 	registryMock.MockResponse("getConfig", registryConfig).Once()
+	registryMock.MockResponse("paused", false).Once()
+	versionMock.MockResponse("typeAndVersion").Once()
 	registryMock.MockResponse("getKeeperList", []common.Address{fromAddress}).Once()
 	registryMock.MockResponse("getCanceledUpkeepList", canceledUpkeeps).Once()
 	registryMock.MockResponse("getUpkeepCount", big.NewInt(0)).Once()
@@ -122,8 +125,11 @@ func Test_RegistrySynchronizer_FullSync(t *testing.T) {
 	fromAddress := job.KeeperSpec.FromAddress.Address()
 
 	registryMock := cltest.NewContractMockReceiver(t, ethMock, keeper.RegistryABI, contractAddress)
+	versionMock := cltest.NewContractMockReceiver(t, ethMock, keeper.TypeAndVersionABI, contractAddress)
 	canceledUpkeeps := []*big.Int{big.NewInt(1)}
 	registryMock.MockResponse("getConfig", registryConfig).Once()
+	registryMock.MockResponse("paused", false).Once()
+	versionMock.MockResponse("typeAndVersion").Once()
 	registryMock.MockResponse("getKeeperList", []common.Address{fromAddress}).Once()
 	registryMock.MockResponse("getCanceledUpkeepList", canceledUpkeeps).Once()
 	registryMock.MockResponse("getUpkeepCount", big.NewInt(3)).Once()
@@ -143,6 +149,8 @@ func Test_RegistrySynchronizer_FullSync(t *testing.T) {
 	require.Equal(t, int32(20), registry.BlockCountPerTurn)
 	require.Equal(t, int32(0), registry.KeeperIndex)
 	require.Equal(t, int32(1), registry.NumKeepers)
+	require.Equal(t, keeper.RegistryVersion_1_0, registry.Version)
+	require.False(t, registry.Paused)
 	require.Equal(t, upkeepConfig.CheckData, upkeepRegistration.CheckData)
 	require.Equal(t, uint64(upkeepConfig.ExecuteGas), upkeepRegistration.ExecuteGas)
 
@@ -152,6 +160,8 @@ func Test_RegistrySynchronizer_FullSync(t *testing.T) {
 	// 2nd sync
 	canceledUpkeeps = []*big.Int{big.NewInt(0), big.NewInt(1), big.NewInt(3)}
 	registryMock.MockResponse("getConfig", registryConfig).Once()
+	registryMock.MockResponse("paused", false).Once()
+	versionMock.MockResponse("typeAndVersion").Once()
 	registryMock.MockResponse("getKeeperList", []common.Address{fromAddress}).Once()
 	registryMock.MockResponse("getCanceledUpkeepList", canceledUpkeeps).Once()
 	registryMock.MockResponse("getUpkeepCount", big.NewInt(5)).Once()
@@ -165,6 +175,47 @@ func Test_RegistrySynchronizer_FullSync(t *testing.T) {
 	ethMock.AssertExpectations(t)
 }
 
+func Test_RegistrySynchronizer_FullSync_RegistryVersion(t *testing.T) {
+	t.Run("syncs the version and paused state of the registry", func(t *testing.T) {
+		store, synchronizer, ethMock, _, job := setupRegistrySync(t)
+
+		contractAddress := job.KeeperSpec.ContractAddress.Address()
+		fromAddress := job.KeeperSpec.FromAddress.Address()
+
+		registryMock := cltest.NewContractMockReceiver(t, ethMock, keeper.RegistryABI, contractAddress)
+		versionMock := cltest.NewContractMockReceiver(t, ethMock, keeper.TypeAndVersionABI, contractAddress)
+		versionMock.MockResponse("typeAndVersion", "KeeperRegistry 1.1.0").Once()
+		registryMock.MockResponse("getConfig", registryConfig).Once()
+		registryMock.MockResponse("paused", true).Once()
+		registryMock.MockResponse("getKeeperList", []common.Address{fromAddress}).Once()
+		registryMock.MockResponse("getCanceledUpkeepList", []*big.Int{}).Once()
+		registryMock.MockResponse("getUpkeepCount", big.NewInt(0)).Once()
+
+		synchronizer.ExportedFullSync()
+
+		var registry keeper.Registry
+		require.NoError(t, store.DB.First(&registry).Error)
+		require.Equal(t, keeper.RegistryVersion_1_1, registry.Version)
+		require.True(t, registry.Paused)
+		ethMock.AssertExpectations(t)
+	})
+
+	t.Run("does not sync unsupported versions of the registry", func(t *testing.T) {
+		store, synchronizer, ethMock, _, j := setupRegistrySync(t)
+
+		contractAddress := j.KeeperSpec.ContractAddress.Address()
+
+		versionMock := cltest.NewContractMockReceiver(t, ethMock, keeper.TypeAndVersionABI, contractAddress)
+		versionMock.MockResponse("typeAndVersion", "KeeperRegistry 9.0.0").Once()
+
+		synchronizer.ExportedFullSync()
+
+		cltest.AssertCount(t, store.DB, keeper.Registry{}, 0)
+		cltest.AssertCount(t, store.DB, job.SpecError{}, 1)
+		ethMock.AssertExpectations(t)
+	})
+}
+
 func Test_RegistrySynchronizer_ConfigSetLog(t *testing.T) {
 	store, synchronizer, ethMock, lb, job := setupRegistrySync(t)
 	db := store.DB
@@ -173,8 +224,11 @@ func Test_RegistrySynchronizer_ConfigSetLog(t *testing.T) {
 	fromAddress := job.KeeperSpec.FromAddress.Address()
 
 	registryMock := cltest.NewContractMockReceiver(t, ethMock, keeper.RegistryABI, contractAddress)
+	versionMock := cltest.NewContractMockReceiver(t, ethMock, keeper.TypeAndVersionABI, contractAddress)
 	registryMock.MockResponse("getKeeperList", []common.Address{fromAddress}).Once()
 	registryMock.MockResponse("getConfig", registryConfig).Once()
+	registryMock.MockResponse("paused", false).Once()
+	versionMock.MockResponse("typeAndVersion").Once()
 	registryMock.MockResponse("getCanceledUpkeepList", []*big.Int{}).Once()
 	registryMock.MockResponse("getUpkeepCount", big.NewInt(0)).Once()
 
@@ -187,6 +241,8 @@ func Test_RegistrySynchronizer_ConfigSetLog(t *testing.T) {
 	registryConfig.BlockCountPerTurn = big.NewInt(40) // change from default
 	registryMock.MockResponse("getKeeperList", []common.Address{fromAddress}).Once()
 	registryMock.MockResponse("getConfig", registryConfig).Once()
+	registryMock.MockResponse("paused", false).Once()
+	versionMock.MockResponse("typeAndVersion").Once()
 
 	head := cltest.MustInsertHead(t, store, 1)
 	rawLog := types.Log{BlockHash: head.Hash}
@@ -218,8 +274,11 @@ func Test_RegistrySynchronizer_KeepersUpdatedLog(t *testing.T) {
 	fromAddress := job.KeeperSpec.FromAddress.Address()
 
 	registryMock := cltest.NewContractMockReceiver(t, ethMock, keeper.RegistryABI, contractAddress)
+	versionMock := cltest.NewContractMockReceiver(t, ethMock, keeper.TypeAndVersionABI, contractAddress)
 	registryMock.MockResponse("getKeeperList", []common.Address{fromAddress}).Once()
 	registryMock.MockResponse("getConfig", registryConfig).Once()
+	registryMock.MockResponse("paused", false).Once()
+	versionMock.MockResponse("typeAndVersion").Once()
 	registryMock.MockResponse("getCanceledUpkeepList", []*big.Int{}).Once()
 	registryMock.MockResponse("getUpkeepCount", big.NewInt(0)).Once()
 
@@ -231,6 +290,8 @@ func Test_RegistrySynchronizer_KeepersUpdatedLog(t *testing.T) {
 
 	addresses := []common.Address{fromAddress, cltest.NewAddress()} // change from default
 	registryMock.MockResponse("getConfig", registryConfig).Once()
+	registryMock.MockResponse("paused", false).Once()
+	versionMock.MockResponse("typeAndVersion").Once()
 	registryMock.MockResponse("getKeeperList", addresses).Once()
 
 	head := cltest.MustInsertHead(t, store, 1)
@@ -262,7 +323,10 @@ func Test_RegistrySynchronizer_UpkeepCanceledLog(t *testing.T) {
 	fromAddress := job.KeeperSpec.FromAddress.Address()
 
 	registryMock := cltest.NewContractMockReceiver(t, ethMock, keeper.RegistryABI, contractAddress)
+	versionMock := cltest.NewContractMockReceiver(t, ethMock, keeper.TypeAndVersionABI, contractAddress)
 	registryMock.MockResponse("getConfig", registryConfig).Once()
+	registryMock.MockResponse("paused", false).Once()
+	versionMock.MockResponse("typeAndVersion").Once()
 	registryMock.MockResponse("getKeeperList", []common.Address{fromAddress}).Once()
 	registryMock.MockResponse("getCanceledUpkeepList", []*big.Int{}).Once()
 	registryMock.MockResponse("getUpkeepCount", big.NewInt(3)).Once()
@@ -299,7 +363,10 @@ func Test_RegistrySynchronizer_UpkeepRegisteredLog(t *testing.T) {
 	fromAddress := job.KeeperSpec.FromAddress.Address()
 
 	registryMock := cltest.NewContractMockReceiver(t, ethMock, keeper.RegistryABI, contractAddress)
+	versionMock := cltest.NewContractMockReceiver(t, ethMock, keeper.TypeAndVersionABI, contractAddress)
 	registryMock.MockResponse("getConfig", registryConfig).Once()
+	registryMock.MockResponse("paused", false).Once()
+	versionMock.MockResponse("typeAndVersion").Once()
 	registryMock.MockResponse("getKeeperList", []common.Address{fromAddress}).Once()
 	registryMock.MockResponse("getCanceledUpkeepList", []*big.Int{}).Once()
 	registryMock.MockResponse("getUpkeepCount", big.NewInt(0)).Once()
@@ -338,7 +405,10 @@ func Test_RegistrySynchronizer_UpkeepPerformedLog(t *testing.T) {
 	fromAddress := job.KeeperSpec.FromAddress.Address()
 
 	registryMock := cltest.NewContractMockReceiver(t, ethMock, keeper.RegistryABI, contractAddress)
+	versionMock := cltest.NewContractMockReceiver(t, ethMock, keeper.TypeAndVersionABI, contractAddress)
 	registryMock.MockResponse("getConfig", registryConfig).Once()
+	registryMock.MockResponse("paused", false).Once()
+	versionMock.MockResponse("typeAndVersion").Once()
 	registryMock.MockResponse("getKeeperList", []common.Address{fromAddress}).Once()
 	registryMock.MockResponse("getCanceledUpkeepList", []*big.Int{}).Once()
 	registryMock.MockResponse("getUpkeepCount", big.NewInt(1)).Once()
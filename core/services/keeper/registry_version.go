@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+)
+
+// RegistryVersion is the major and minor version of a KeeperRegistry contract,
+// as reported by its typeAndVersion
+type RegistryVersion string
+
+const (
+	// RegistryVersion_1_0 registries predate typeAndVersion
+	RegistryVersion_1_0 RegistryVersion = "1.0"
+	RegistryVersion_1_1 RegistryVersion = "1.1"
+)
+
+const typeAndVersion = "typeAndVersion"
+
+// TypeAndVersionABI is the ABI of the typeAndVersion function implemented by
+// KeeperRegistry 1.1 and later
+var TypeAndVersionABI = eth.MustGetABI(`[{"inputs":[],"name":"typeAndVersion","outputs":[{"internalType":"string","name":"","type":"string"}],"stateMutability":"pure","type":"function"}]`)
+
+// RegistryABI_1_1 is the part of the KeeperRegistry 1.1 ABI that the node
+// calls. Unlike 1.0, checkUpkeep returns the adjusted gas price and the
+// LINK/ETH price as uint256, and the gas price is named adjustedGasWei.
+var RegistryABI_1_1 = eth.MustGetABI(`[{"inputs":[{"internalType":"uint256","name":"id","type":"uint256"},{"internalType":"address","name":"from","type":"address"}],"name":"checkUpkeep","outputs":[{"internalType":"bytes","name":"performData","type":"bytes"},{"internalType":"uint256","name":"maxLinkPayment","type":"uint256"},{"internalType":"uint256","name":"gasLimit","type":"uint256"},{"internalType":"uint256","name":"adjustedGasWei","type":"uint256"},{"internalType":"uint256","name":"linkEth","type":"uint256"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"uint256","name":"id","type":"uint256"},{"internalType":"bytes","name":"performData","type":"bytes"}],"name":"performUpkeep","outputs":[{"internalType":"bool","name":"success","type":"bool"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[],"name":"typeAndVersion","outputs":[{"internalType":"string","name":"","type":"string"}],"stateMutability":"pure","type":"function"}]`)
+
+// registryABIs holds the ABI used to call each supported version of the
+// registry. 1.0 is the version of the generated keeper_registry_wrapper.
+var registryABIs = map[RegistryVersion]abi.ABI{
+	RegistryVersion_1_0: RegistryABI,
+	RegistryVersion_1_1: RegistryABI_1_1,
+}
+
+// ABI returns the ABI of this version of the registry. Registries saved before
+// versions were tracked are 1.0.
+func (v RegistryVersion) ABI() abi.ABI {
+	if registryABI, ok := registryABIs[v]; ok {
+		return registryABI
+	}
+	return RegistryABI
+}
+
+// CheckUpkeepReturn is the decoded return value of checkUpkeep. GasLimit is
+// the execute gas of the upkeep, which does not include the gas used by the
+// registry itself to perform it.
+type CheckUpkeepReturn struct {
+	PerformData    []byte
+	MaxLinkPayment *big.Int
+	GasLimit       *big.Int
+	AdjustedGasWei *big.Int
+	LinkEth        *big.Int
+}
+
+// UnpackCheckUpkeep decodes the return value of checkUpkeep on this version of
+// the registry
+func (v RegistryVersion) UnpackCheckUpkeep(data []byte) (CheckUpkeepReturn, error) {
+	values, err := v.ABI().Unpack(checkUpkeep, data)
+	if err != nil {
+		return CheckUpkeepReturn{}, errors.Wrapf(err, "unable to unpack checkUpkeep of KeeperRegistry %s", v)
+	}
+	if len(values) != 5 {
+		return CheckUpkeepReturn{}, errors.Errorf("checkUpkeep of KeeperRegistry %s returned %d values, expected 5", v, len(values))
+	}
+	var ret CheckUpkeepReturn
+	ret.PerformData = *abi.ConvertType(values[0], new([]byte)).(*[]byte)
+	ret.MaxLinkPayment = *abi.ConvertType(values[1], new(*big.Int)).(**big.Int)
+	ret.GasLimit = *abi.ConvertType(values[2], new(*big.Int)).(**big.Int)
+	ret.AdjustedGasWei = *abi.ConvertType(values[3], new(*big.Int)).(**big.Int)
+	ret.LinkEth = *abi.ConvertType(values[4], new(*big.Int)).(**big.Int)
+	return ret, nil
+}
+
+// ParseRegistryVersion parses the version out of the typeAndVersion of a
+// registry, e.g. "KeeperRegistry 1.1.0", and checks that it is supported
+func ParseRegistryVersion(typeAndVersion string) (RegistryVersion, error) {
+	parts := strings.Fields(typeAndVersion)
+	if len(parts) != 2 || parts[0] != "KeeperRegistry" {
+		return "", errors.Errorf("contract is not a KeeperRegistry: %q", typeAndVersion)
+	}
+	numbers := strings.Split(parts[1], ".")
+	if len(numbers) < 2 {
+		return "", errors.Errorf("invalid KeeperRegistry version %q", parts[1])
+	}
+	version := RegistryVersion(numbers[0] + "." + numbers[1])
+	if _, ok := registryABIs[version]; !ok {
+		return "", errors.Errorf("unsupported KeeperRegistry version %s", parts[1])
+	}
+	return version, nil
+}
@@ -0,0 +1,85 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/smartcontractkit/chainlink/core/services/keeper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseRegistryVersion(t *testing.T) {
+	t.Parallel()
+
+	version, err := keeper.ParseRegistryVersion("KeeperRegistry 1.1.0")
+	require.NoError(t, err)
+	assert.Equal(t, keeper.RegistryVersion_1_1, version)
+
+	version, err = keeper.ParseRegistryVersion("KeeperRegistry 1.0.3")
+	require.NoError(t, err)
+	assert.Equal(t, keeper.RegistryVersion_1_0, version)
+
+	_, err = keeper.ParseRegistryVersion("KeeperRegistry 9.0.0")
+	assert.EqualError(t, err, "unsupported KeeperRegistry version 9.0.0")
+
+	_, err = keeper.ParseRegistryVersion("OffchainAggregator 1.0.0")
+	assert.EqualError(t, err, `contract is not a KeeperRegistry: "OffchainAggregator 1.0.0"`)
+
+	_, err = keeper.ParseRegistryVersion("KeeperRegistry 1")
+	assert.EqualError(t, err, `invalid KeeperRegistry version "1"`)
+}
+
+func Test_RegistryVersion_UnpackCheckUpkeep(t *testing.T) {
+	t.Parallel()
+
+	performData := common.Hex2Bytes("1234")
+
+	t.Run("1.0", func(t *testing.T) {
+		data, err := keeper.RegistryABI.Methods["checkUpkeep"].Outputs.Pack(
+			performData, big.NewInt(1), big.NewInt(2_000_000), big.NewInt(-3), big.NewInt(4),
+		)
+		require.NoError(t, err)
+
+		checked, err := keeper.RegistryVersion_1_0.UnpackCheckUpkeep(data)
+		require.NoError(t, err)
+		assert.Equal(t, performData, checked.PerformData)
+		assert.Equal(t, big.NewInt(1), checked.MaxLinkPayment)
+		assert.Equal(t, big.NewInt(2_000_000), checked.GasLimit)
+		// 1.0 returns the gas price as an int256
+		assert.Equal(t, big.NewInt(-3), checked.AdjustedGasWei)
+		assert.Equal(t, big.NewInt(4), checked.LinkEth)
+	})
+
+	t.Run("1.1", func(t *testing.T) {
+		data, err := keeper.RegistryABI_1_1.Methods["checkUpkeep"].Outputs.Pack(
+			performData, big.NewInt(1), big.NewInt(2_000_000), big.NewInt(3), big.NewInt(4),
+		)
+		require.NoError(t, err)
+
+		checked, err := keeper.RegistryVersion_1_1.UnpackCheckUpkeep(data)
+		require.NoError(t, err)
+		assert.Equal(t, performData, checked.PerformData)
+		assert.Equal(t, big.NewInt(1), checked.MaxLinkPayment)
+		assert.Equal(t, big.NewInt(2_000_000), checked.GasLimit)
+		assert.Equal(t, big.NewInt(3), checked.AdjustedGasWei)
+		assert.Equal(t, big.NewInt(4), checked.LinkEth)
+
+		_, err = keeper.RegistryVersion_1_1.UnpackCheckUpkeep(data[:64])
+		assert.Error(t, err)
+	})
+
+	t.Run("registries without a version are 1.0", func(t *testing.T) {
+		assert.Equal(t, keeper.RegistryABI, keeper.RegistryVersion("").ABI())
+		assert.Equal(t, keeper.RegistryABI_1_1, keeper.RegistryVersion_1_1.ABI())
+	})
+}
+
+func Test_RegistryVersion_PerformUpkeepSelector(t *testing.T) {
+	t.Parallel()
+
+	// performUpkeep is encoded the same by every version
+	assert.Equal(t, keeper.RegistryABI.Methods["performUpkeep"].ID, keeper.RegistryABI_1_1.Methods["performUpkeep"].ID)
+	assert.NotEqual(t, keeper.RegistryABI.Methods["checkUpkeep"].Outputs[3].Type, keeper.RegistryABI_1_1.Methods["checkUpkeep"].Outputs[3].Type)
+}
@@ -145,22 +145,49 @@ func (executer *UpkeepExecuter) processActiveUpkeeps() {
 		return
 	}
 
+	batchSize := int(executer.config.KeeperCheckUpkeepBatchSize())
+	if batchSize < 1 {
+		batchSize = 1
+	}
+
 	wg := sync.WaitGroup{}
-	wg.Add(len(activeUpkeeps))
 	done := func() { <-executer.executionQueue; wg.Done() }
-	for _, reg := range activeUpkeeps {
+	for len(activeUpkeeps) > 0 {
+		n := batchSize
+		if n > len(activeUpkeeps) {
+			n = len(activeUpkeeps)
+		}
+		batch := activeUpkeeps[:n]
+		activeUpkeeps = activeUpkeeps[n:]
+
+		wg.Add(1)
 		executer.executionQueue <- struct{}{}
-		go executer.execute(reg, head.Number, done)
+		go executer.executeBatch(batch, head.Number, done)
 	}
 
 	wg.Wait()
 }
 
-// execute will call checkForUpkeep and, if it succeeds, trigger a job on the CL node
-// DEV: must perform contract call "manually" because abigen wrapper can only send tx
-func (executer *UpkeepExecuter) execute(upkeep UpkeepRegistration, headNumber int64, done func()) {
+// executeBatch checks a batch of upkeeps in a single request to the eth node
+// and performs the ones that are eligible
+func (executer *UpkeepExecuter) executeBatch(upkeeps []UpkeepRegistration, headNumber int64, done func()) {
 	defer done()
 	start := time.Now()
+
+	logger.Debugw("UpkeepExecuter: checking upkeeps", "jobID", executer.job.ID, "blockNum", headNumber, "numUpkeeps", len(upkeeps))
+
+	ctxService, cancel := utils.ContextFromChan(executer.chStop)
+	defer cancel()
+
+	results := executer.checkUpkeeps(ctxService, upkeeps)
+	for i, upkeep := range upkeeps {
+		executer.execute(upkeep, results[i], headNumber, start)
+	}
+}
+
+// execute will, if checkUpkeep succeeded for the upkeep, trigger a job on the CL node
+// DEV: must perform contract call "manually" because abigen wrapper can only send tx
+func (executer *UpkeepExecuter) execute(upkeep UpkeepRegistration, result checkUpkeepResult, headNumber int64, start time.Time) {
 	logArgs := []interface{}{
 		"jobID", executer.job.ID,
 		"blockNum", headNumber,
@@ -168,29 +195,13 @@ func (executer *UpkeepExecuter) execute(upkeep UpkeepRegistration, headNumber in
 		"upkeepID", upkeep.UpkeepID,
 	}
 
-	msg, err := executer.constructCheckUpkeepCallMsg(upkeep)
-	if err != nil {
-		logger.Error(err)
+	if result.err != nil {
+		logArgs = append(logArgs, "revertReason", result.revertReason)
+		logger.Debugw(fmt.Sprintf("UpkeepExecuter: checkUpkeep failed: %v", result.err), logArgs...)
 		return
 	}
 
-	logger.Debugw("UpkeepExecuter: checking upkeep", logArgs...)
-
-	ctxService, cancel := utils.ContextFromChan(executer.chStop)
-	defer cancel()
-
-	checkUpkeepResult, err := executer.ethClient.CallContract(ctxService, msg, nil)
-	if err != nil {
-		revertReason, err2 := eth.ExtractRevertReasonFromRPCError(err)
-		if err2 != nil {
-			revertReason = fmt.Sprintf("unknown revert reason: error during extraction: %v", err2)
-		}
-		logArgs = append(logArgs, "revertReason", revertReason)
-		logger.Debugw(fmt.Sprintf("UpkeepExecuter: checkUpkeep failed: %v", err), logArgs...)
-		return
-	}
-
-	performTxData, err := constructPerformUpkeepTxData(checkUpkeepResult, upkeep.UpkeepID)
+	performTxData, err := constructPerformUpkeepTxData(upkeep.Registry.Version, result.data, upkeep.UpkeepID)
 	if err != nil {
 		logger.Error(err)
 		return
@@ -224,6 +235,15 @@ func (executer *UpkeepExecuter) execute(upkeep UpkeepRegistration, headNumber in
 			return errors.Wrap(err, "failed to set last run height for upkeep")
 		}
 
+		err = executer.orm.InsertUpkeepPerform(dbtx, &UpkeepPerform{
+			UpkeepRegistrationID: upkeep.ID,
+			EthTxID:              etx.ID,
+			BlockNumber:          headNumber,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to record upkeep perform")
+		}
+
 		_, err = executer.pr.InsertFinishedRun(dbtx, pipeline.Run{
 			State:          pipeline.RunStatusCompleted,
 			PipelineSpecID: executer.job.PipelineSpecID,
@@ -262,7 +282,7 @@ func (executer *UpkeepExecuter) execute(upkeep UpkeepRegistration, headNumber in
 }
 
 func (executer *UpkeepExecuter) constructCheckUpkeepCallMsg(upkeep UpkeepRegistration) (ethereum.CallMsg, error) {
-	checkPayload, err := RegistryABI.Pack(
+	checkPayload, err := upkeep.Registry.Version.ABI().Pack(
 		checkUpkeep,
 		big.NewInt(int64(upkeep.UpkeepID)),
 		upkeep.Registry.FromAddress.Address(),
@@ -284,21 +304,19 @@ func (executer *UpkeepExecuter) constructCheckUpkeepCallMsg(upkeep UpkeepRegistr
 	return msg, nil
 }
 
-func constructPerformUpkeepTxData(checkUpkeepResult []byte, upkeepID int64) ([]byte, error) {
-	unpackedResult, err := RegistryABI.Unpack(checkUpkeep, checkUpkeepResult)
+// constructPerformUpkeepTxData decodes the result of checkUpkeep with the ABI
+// of the registry version and encodes the performUpkeep call with its
+// performData
+func constructPerformUpkeepTxData(version RegistryVersion, checkUpkeepResult []byte, upkeepID int64) ([]byte, error) {
+	checked, err := version.UnpackCheckUpkeep(checkUpkeepResult)
 	if err != nil {
 		return nil, err
 	}
 
-	performData, ok := unpackedResult[0].([]byte)
-	if !ok {
-		return nil, errors.New("checkupkeep payload not as expected")
-	}
-
-	performTxData, err := RegistryABI.Pack(
+	performTxData, err := version.ABI().Pack(
 		performUpkeep,
 		big.NewInt(upkeepID),
-		performData,
+		checked.PerformData,
 	)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,208 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+const (
+	tryAggregate = "tryAggregate"
+
+	checkMethodCall      = "call"
+	checkMethodBatch     = "batch"
+	checkMethodMulticall = "multicall"
+)
+
+var (
+	promCheckUpkeepBatchDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "keeper_check_upkeep_batch_duration_seconds",
+		Help:    "Time taken to check a batch of upkeeps, by the method used to send the checkUpkeep calls",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method"})
+	promCheckUpkeepBatchFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "keeper_check_upkeep_batch_failures",
+		Help: "Number of batches of checkUpkeep calls that failed as a whole, by the method used to send them",
+	}, []string{"method"})
+)
+
+// checkUpkeepResult is the outcome of checkUpkeep for a single upkeep. If err
+// is nil, data holds the ABI encoded return value of checkUpkeep.
+type checkUpkeepResult struct {
+	data         []byte
+	err          error
+	revertReason string
+}
+
+type multicallCall struct {
+	Target   common.Address
+	CallData []byte
+}
+
+type multicallResult struct {
+	Success    bool
+	ReturnData []byte
+}
+
+// checkUpkeeps calls checkUpkeep for all of the given upkeeps in a single
+// request to the eth node and returns the results in the same order. The
+// calls are aggregated by the Multicall2 contract at KeeperMulticallAddress if
+// it is set, and sent as a JSON-RPC batch otherwise.
+func (executer *UpkeepExecuter) checkUpkeeps(ctx context.Context, upkeeps []UpkeepRegistration) []checkUpkeepResult {
+	results := make([]checkUpkeepResult, len(upkeeps))
+	var msgs []ethereum.CallMsg
+	var indexes []int
+	for i, upkeep := range upkeeps {
+		msg, err := executer.constructCheckUpkeepCallMsg(upkeep)
+		if err != nil {
+			results[i].err = err
+			continue
+		}
+		msgs = append(msgs, msg)
+		indexes = append(indexes, i)
+	}
+	if len(msgs) == 0 {
+		return results
+	}
+
+	method := checkMethodBatch
+	multicallAddress := executer.config.KeeperMulticallAddress()
+	if len(msgs) == 1 {
+		method = checkMethodCall
+	} else if multicallAddress != "" {
+		method = checkMethodMulticall
+	}
+
+	start := time.Now()
+	var batchResults []checkUpkeepResult
+	var err error
+	switch method {
+	case checkMethodCall:
+		batchResults = []checkUpkeepResult{executer.callCheckUpkeep(ctx, msgs[0])}
+	case checkMethodBatch:
+		batchResults, err = executer.batchCallCheckUpkeeps(ctx, msgs)
+	case checkMethodMulticall:
+		batchResults, err = executer.multicallCheckUpkeeps(ctx, common.HexToAddress(multicallAddress), msgs)
+	}
+	promCheckUpkeepBatchDuration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+
+	if err != nil {
+		promCheckUpkeepBatchFailures.WithLabelValues(method).Inc()
+		err = errors.Wrapf(err, "failed to check batch of %d upkeeps", len(msgs))
+		for _, i := range indexes {
+			results[i].err = err
+		}
+		return results
+	}
+	for j, i := range indexes {
+		results[i] = batchResults[j]
+	}
+	return results
+}
+
+func (executer *UpkeepExecuter) callCheckUpkeep(ctx context.Context, msg ethereum.CallMsg) checkUpkeepResult {
+	data, err := executer.ethClient.CallContract(ctx, msg, nil)
+	if err != nil {
+		return checkUpkeepResult{err: err, revertReason: extractRevertReason(err)}
+	}
+	return checkUpkeepResult{data: data}
+}
+
+func (executer *UpkeepExecuter) batchCallCheckUpkeeps(ctx context.Context, msgs []ethereum.CallMsg) ([]checkUpkeepResult, error) {
+	reqs := make([]rpc.BatchElem, len(msgs))
+	for i, msg := range msgs {
+		reqs[i] = rpc.BatchElem{
+			Method: "eth_call",
+			Args: []interface{}{
+				map[string]interface{}{
+					"from": msg.From,
+					"to":   msg.To,
+					"gas":  hexutil.Uint64(msg.Gas),
+					"data": hexutil.Bytes(msg.Data),
+				},
+				"latest",
+			},
+			Result: &hexutil.Bytes{},
+		}
+	}
+
+	if err := executer.ethClient.BatchCallContext(ctx, reqs); err != nil {
+		return nil, err
+	}
+
+	results := make([]checkUpkeepResult, len(reqs))
+	for i, req := range reqs {
+		if req.Error != nil {
+			results[i] = checkUpkeepResult{err: req.Error, revertReason: extractRevertReason(req.Error)}
+			continue
+		}
+		results[i] = checkUpkeepResult{data: *req.Result.(*hexutil.Bytes)}
+	}
+	return results, nil
+}
+
+// multicallCheckUpkeeps aggregates the checkUpkeep calls with tryAggregate, so
+// that a reverting checkUpkeep does not fail the other calls. The gas limit of
+// the eth_call is the sum of the gas limits of the individual calls.
+func (executer *UpkeepExecuter) multicallCheckUpkeeps(ctx context.Context, multicallAddress common.Address, msgs []ethereum.CallMsg) ([]checkUpkeepResult, error) {
+	calls := make([]multicallCall, len(msgs))
+	var gasLimit uint64
+	for i, msg := range msgs {
+		calls[i] = multicallCall{Target: *msg.To, CallData: msg.Data}
+		gasLimit += msg.Gas
+	}
+	payload, err := multicallABI.Pack(tryAggregate, false, calls)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to pack tryAggregate call")
+	}
+
+	data, err := executer.ethClient.CallContract(ctx, ethereum.CallMsg{
+		From: utils.ZeroAddress,
+		To:   &multicallAddress,
+		Gas:  gasLimit,
+		Data: payload,
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var returned []multicallResult
+	if err = multicallABI.UnpackIntoInterface(&returned, tryAggregate, data); err != nil {
+		return nil, errors.Wrap(err, "failed to unpack tryAggregate result")
+	}
+	if len(returned) != len(msgs) {
+		return nil, errors.Errorf("expected %d results from tryAggregate, got %d", len(msgs), len(returned))
+	}
+
+	results := make([]checkUpkeepResult, len(returned))
+	for i, r := range returned {
+		if r.Success {
+			results[i] = checkUpkeepResult{data: r.ReturnData}
+			continue
+		}
+		revertReason, err := eth.DecodeRevertReason(r.ReturnData)
+		if err != nil {
+			revertReason = fmt.Sprintf("unknown revert reason: %s", hexutil.Encode(r.ReturnData))
+		}
+		results[i] = checkUpkeepResult{err: errors.Errorf("execution reverted: %s", revertReason), revertReason: revertReason}
+	}
+	return results, nil
+}
+
+func extractRevertReason(err error) string {
+	revertReason, err2 := eth.ExtractRevertReasonFromRPCError(err)
+	if err2 != nil {
+		return fmt.Sprintf("unknown revert reason: error during extraction: %v", err2)
+	}
+	return revertReason
+}
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"context"
+	"errors"
 	"math/big"
 	"testing"
 	"time"
@@ -11,7 +12,11 @@ import (
 	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/onsi/gomega"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/mocks"
@@ -45,7 +50,7 @@ func setup(t *testing.T) (
 	registry, job := cltest.MustInsertKeeperRegistry(t, store, ethKeyStore)
 	cfg, cleanup := cltest.NewConfig(t)
 	t.Cleanup(cleanup)
-	jpv2 := cltest.NewJobPipelineV2(t, cfg, store.DB, nil, nil, nil)
+	jpv2 := cltest.NewJobPipelineV2(t, cfg, store.DB, nil, nil)
 	headBroadcaster := headtracker.NewHeadBroadcaster()
 	txm := new(bptxmmocks.TxManager)
 	orm := keeper.NewORM(store.DB, txm, store.Config, bulletprooftxmanager.SendEveryStrategy{})
@@ -86,7 +91,7 @@ func Test_UpkeepExecuter_PerformsUpkeep_Happy(t *testing.T) {
 
 		gasLimit := upkeep.ExecuteGas + store.Config.KeeperRegistryPerformGasOverhead()
 		ethTxCreated := cltest.NewAwaiter()
-		txm.On("CreateEthTransaction", mock.Anything, mock.Anything, mock.Anything, mock.Anything, gasLimit, nil, mock.Anything).
+		txm.On("CreateEthTransaction", mock.Anything, mock.Anything, mock.Anything, mock.Anything, gasLimit, &models.EthTxMetaV2{JobID: job.ID}, mock.Anything).
 			Once().
 			Return(bulletprooftxmanager.EthTx{}, nil).
 			Run(func(mock.Arguments) { ethTxCreated.ItHappened() })
@@ -115,7 +120,7 @@ func Test_UpkeepExecuter_PerformsUpkeep_Happy(t *testing.T) {
 			cltest.NewAwaiter(),
 		}
 		gasLimit := upkeep.ExecuteGas + store.Config.KeeperRegistryPerformGasOverhead()
-		txm.On("CreateEthTransaction", mock.Anything, mock.Anything, mock.Anything, mock.Anything, gasLimit, nil, mock.Anything).
+		txm.On("CreateEthTransaction", mock.Anything, mock.Anything, mock.Anything, mock.Anything, gasLimit, &models.EthTxMetaV2{JobID: job.ID}, mock.Anything).
 			Once().
 			Return(bulletprooftxmanager.EthTx{}, nil).
 			Run(func(mock.Arguments) { etxs[0].ItHappened() })
@@ -144,7 +149,7 @@ func Test_UpkeepExecuter_PerformsUpkeep_Happy(t *testing.T) {
 		// head 40 triggers a new run
 		head = *cltest.Head(40)
 
-		txm.On("CreateEthTransaction", mock.Anything, mock.Anything, mock.Anything, mock.Anything, gasLimit, nil, mock.Anything).
+		txm.On("CreateEthTransaction", mock.Anything, mock.Anything, mock.Anything, mock.Anything, gasLimit, &models.EthTxMetaV2{JobID: job.ID}, mock.Anything).
 			Once().
 			Return(bulletprooftxmanager.EthTx{}, nil).
 			Run(func(mock.Arguments) { etxs[1].ItHappened() })
@@ -162,6 +167,118 @@ func Test_UpkeepExecuter_PerformsUpkeep_Happy(t *testing.T) {
 	})
 }
 
+func mustPackCheckUpkeepResponse(t *testing.T) []byte {
+	t.Helper()
+	data, err := keeper.RegistryABI.Methods["checkUpkeep"].Outputs.Pack(
+		checkUpkeepResponse.PerformData,
+		checkUpkeepResponse.MaxLinkPayment,
+		checkUpkeepResponse.GasLimit,
+		checkUpkeepResponse.GasWei,
+		checkUpkeepResponse.LinkEth,
+	)
+	require.NoError(t, err)
+	return data
+}
+
+// checkUpkeepID returns the ID of the upkeep checked by a checkUpkeep call
+func checkUpkeepID(data []byte) int64 {
+	return new(big.Int).SetBytes(data[4:36]).Int64()
+}
+
+func Test_UpkeepExecuter_PerformsUpkeep_Batched(t *testing.T) {
+	t.Parallel()
+
+	t.Run("checks upkeeps in a JSON-RPC batch", func(t *testing.T) {
+		store, ethMock, executer, registry, upkeep, job, jpv2, txm := setup(t)
+		upkeep2 := cltest.MustInsertUpkeepForRegistry(t, store, registry)
+
+		gasLimit := upkeep.ExecuteGas + store.Config.KeeperRegistryPerformGasOverhead()
+		ethTxCreated := cltest.NewAwaiter()
+		txm.On("CreateEthTransaction", mock.Anything, mock.Anything, mock.Anything, mock.Anything, gasLimit, &models.EthTxMetaV2{JobID: job.ID}, mock.Anything).
+			Once().
+			Return(bulletprooftxmanager.EthTx{}, nil).
+			Run(func(mock.Arguments) { ethTxCreated.ItHappened() })
+
+		ethMock.On("BatchCallContext", mock.Anything, mock.MatchedBy(func(b []rpc.BatchElem) bool {
+			return len(b) == 2 && b[0].Method == "eth_call" && b[1].Method == "eth_call"
+		})).Return(nil).Run(func(args mock.Arguments) {
+			for _, elem := range args.Get(1).([]rpc.BatchElem) {
+				callArgs := elem.Args[0].(map[string]interface{})
+				if checkUpkeepID(callArgs["data"].(hexutil.Bytes)) == upkeep.UpkeepID {
+					*elem.Result.(*hexutil.Bytes) = mustPackCheckUpkeepResponse(t)
+				} else {
+					elem.Error = errors.New("execution reverted: upkeep not needed")
+				}
+			}
+		}).Once()
+
+		head := models.NewHead(big.NewInt(20), utils.NewHash(), utils.NewHash(), 1000)
+		executer.OnNewLongestChain(context.Background(), head)
+		ethTxCreated.AwaitOrFail(t)
+		assertLastRunHeight(t, store, upkeep, 20)
+		assertLastRunHeight(t, store, upkeep2, 0)
+		runs := cltest.WaitForPipelineComplete(t, 0, job.ID, 1, 0, jpv2.Jrm, time.Second, 100*time.Millisecond)
+		require.Len(t, runs, 1)
+
+		ethMock.AssertExpectations(t)
+		txm.AssertExpectations(t)
+	})
+
+	t.Run("checks upkeeps with multicall", func(t *testing.T) {
+		store, ethMock, executer, registry, upkeep, job, jpv2, txm := setup(t)
+		upkeep2 := cltest.MustInsertUpkeepForRegistry(t, store, registry)
+		multicallAddress := cltest.NewAddress()
+		store.Config.Set("KEEPER_MULTICALL_ADDRESS", multicallAddress.Hex())
+
+		gasLimit := upkeep.ExecuteGas + store.Config.KeeperRegistryPerformGasOverhead()
+		ethTxCreated := cltest.NewAwaiter()
+		txm.On("CreateEthTransaction", mock.Anything, mock.Anything, mock.Anything, mock.Anything, gasLimit, &models.EthTxMetaV2{JobID: job.ID}, mock.Anything).
+			Once().
+			Return(bulletprooftxmanager.EthTx{}, nil).
+			Run(func(mock.Arguments) { ethTxCreated.ItHappened() })
+
+		type call struct {
+			Target   common.Address
+			CallData []byte
+		}
+		type result struct {
+			Success    bool
+			ReturnData []byte
+		}
+		aggregate := func(_ context.Context, msg ethereum.CallMsg, _ *big.Int) []byte {
+			method := keeper.MulticallABI.Methods["tryAggregate"]
+			inputs, err := method.Inputs.Unpack(msg.Data[4:])
+			require.NoError(t, err)
+			calls := *abi.ConvertType(inputs[1], new([]call)).(*[]call)
+			require.Len(t, calls, 2)
+			results := make([]result, len(calls))
+			for i, c := range calls {
+				assert.Equal(t, registry.ContractAddress.Address(), c.Target)
+				if checkUpkeepID(c.CallData) == upkeep2.UpkeepID {
+					results[i] = result{Success: true, ReturnData: mustPackCheckUpkeepResponse(t)}
+				}
+			}
+			data, err := method.Outputs.Pack(results)
+			require.NoError(t, err)
+			return data
+		}
+		ethMock.On("CallContract", mock.Anything, mock.MatchedBy(func(msg ethereum.CallMsg) bool {
+			return *msg.To == multicallAddress
+		}), mock.Anything).Return(aggregate, nil).Once()
+
+		head := models.NewHead(big.NewInt(20), utils.NewHash(), utils.NewHash(), 1000)
+		executer.OnNewLongestChain(context.Background(), head)
+		ethTxCreated.AwaitOrFail(t)
+		assertLastRunHeight(t, store, upkeep, 0)
+		assertLastRunHeight(t, store, upkeep2, 20)
+		runs := cltest.WaitForPipelineComplete(t, 0, job.ID, 1, 0, jpv2.Jrm, time.Second, 100*time.Millisecond)
+		require.Len(t, runs, 1)
+
+		ethMock.AssertExpectations(t)
+		txm.AssertExpectations(t)
+	})
+}
+
 func Test_UpkeepExecuter_PerformsUpkeep_Error(t *testing.T) {
 	t.Parallel()
 	g := gomega.NewGomegaWithT(t)
@@ -1,6 +1,7 @@
 package keystore
 
 import (
+	"context"
 	"crypto/ecdsa"
 	crand "crypto/rand"
 	"fmt"
@@ -28,6 +29,12 @@ import (
 // ErrKeyStoreLocked is returned if you call a method that requires unlocked keys before you unlocked the keystore
 var ErrKeyStoreLocked = errors.New("keystore is locked (HINT: did you forget to call keystore.Unlock?)")
 
+// ErrRemoteKey is returned when trying to export or remove a key held by the remote signer
+var ErrRemoteKey = errors.New("key is held by the remote signer and is read-only")
+
+// remoteSignerTimeout bounds each request to the remote signer
+const remoteSignerTimeout = 10 * time.Second
+
 // EthKeyStoreInterface is the external interface for EthKeyStore
 //go:generate mockery --name EthKeyStoreInterface --output mocks/ --case=underscore
 type EthKeyStoreInterface interface {
@@ -40,6 +47,7 @@ type EthKeyStoreInterface interface {
 	ExportKey(address common.Address, newPassword string) ([]byte, error)
 	AddKey(key *ethkey.Key) error
 	RemoveKey(address common.Address, hardDelete bool) (deletedKey ethkey.Key, err error)
+	SetTxLimits(address common.Address, limits ethkey.TxLimits) (ethkey.Key, error)
 	SubscribeToKeyChanges() (ch chan struct{}, unsub func())
 
 	SignTx(fromAddress common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
@@ -72,13 +80,29 @@ type Eth struct {
 	scryptParams utils.ScryptParams
 	keys         []combinedKey
 	mu           *sync.RWMutex
+	remoteSigner EthSigner
 
 	subscribers   [](chan struct{})
 	subscribersMu *sync.RWMutex
 }
 
 func newEthKeyStore(db *gorm.DB, scryptParams utils.ScryptParams) *Eth {
-	return &Eth{db, "", scryptParams, make([]combinedKey, 0), new(sync.RWMutex), make([](chan struct{}), 0), new(sync.RWMutex)}
+	return &Eth{db, "", scryptParams, make([]combinedKey, 0), new(sync.RWMutex), nil, make([](chan struct{}), 0), new(sync.RWMutex)}
+}
+
+// SetRemoteSigner makes the keys of signer available in the keystore. It must
+// be called before Unlock.
+func (ks *Eth) SetRemoteSigner(signer EthSigner) {
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+	ks.remoteSigner = signer
+}
+
+// HasRemoteSigner returns true if a remote signer is configured
+func (ks *Eth) HasRemoteSigner() bool {
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+	return ks.remoteSigner != nil
 }
 
 // Unlock loads keys from the database, and uses the given password to try to
@@ -113,10 +137,42 @@ func (ks *Eth) Unlock(password string) (merr error) {
 	if merr != nil {
 		return merr
 	}
+	if ks.remoteSigner != nil {
+		if err := ks.loadRemoteKeys(); err != nil {
+			ks.keys = nil
+			return err
+		}
+	}
 	ks.password = password
 	return nil
 }
 
+// loadRemoteKeys adds the accounts of the remote signer to the keystore. They
+// are saved to the database with remote=true so that nonces and foreign keys
+// work the same as for local keys.
+func (ks *Eth) loadRemoteKeys() error {
+	ctx, cancel := context.WithTimeout(context.Background(), remoteSignerTimeout)
+	defer cancel()
+	addresses, err := ks.remoteSigner.Accounts(ctx)
+	if err != nil {
+		return errors.Wrap(err, "EthKeyStore failed to load keys from remote signer")
+	}
+	for _, address := range addresses {
+		for _, cKey := range ks.keys {
+			if cKey.DecryptedKey.Address == address {
+				return errors.Errorf("account %s is held by both the remote signer and the database", address.Hex())
+			}
+		}
+		k, err := ks.upsertRemoteKey(address)
+		if err != nil {
+			return err
+		}
+		logger.Infow(fmt.Sprint("Loaded remote account ", address.Hex()), "address", address.Hex(), "type", k.Type())
+		ks.keys = append(ks.keys, combinedKey{k, keystore.Key{Address: address}, time.Time{}})
+	}
+	return nil
+}
+
 func (ks *Eth) isLocked() bool {
 	ks.mu.RLock()
 	defer ks.mu.RUnlock()
@@ -187,27 +243,34 @@ func (ks *Eth) getFundingKey() (*ethkey.Key, error) {
 	return nil, nil
 }
 
-// SignTx uses the unlocked account to sign the given transaction.
+// SignTx uses the unlocked account to sign the given transaction. Transactions
+// from remote keys are signed by the remote signer.
 func (ks *Eth) SignTx(fromAddress common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
 	if ks.isLocked() {
 		return nil, ErrKeyStoreLocked
 	}
-	signer := types.LatestSignerForChainID(chainID)
 
-	dKey := ks.getDecryptedKeyForAddress(fromAddress)
-	if dKey == nil {
+	cKey := ks.getCombinedKeyForAddress(fromAddress)
+	if cKey == nil {
 		return nil, newNoKeyError(fromAddress)
 	}
 
-	return types.SignTx(tx, signer, dKey.PrivateKey)
+	if cKey.DBKey.Remote {
+		ctx, cancel := context.WithTimeout(context.Background(), remoteSignerTimeout)
+		defer cancel()
+		return ks.remoteSigner.SignTx(ctx, fromAddress, tx, chainID)
+	}
+
+	signer := types.LatestSignerForChainID(chainID)
+	return types.SignTx(tx, signer, cKey.DecryptedKey.PrivateKey)
 }
 
-func (ks *Eth) getDecryptedKeyForAddress(addr common.Address) *keystore.Key {
+func (ks *Eth) getCombinedKeyForAddress(addr common.Address) *combinedKey {
 	ks.mu.RLock()
 	defer ks.mu.RUnlock()
 	for _, cKey := range ks.keys {
 		if cKey.DecryptedKey.Address == addr {
-			return &cKey.DecryptedKey
+			return &cKey
 		}
 	}
 	return nil
@@ -282,6 +345,9 @@ func (ks *Eth) ExportKey(address common.Address, newPassword string) ([]byte, er
 	defer ks.mu.RUnlock()
 	for _, k := range ks.keys {
 		if k.DecryptedKey.Address == address {
+			if k.DBKey.Remote {
+				return nil, ErrRemoteKey
+			}
 			dKey = k.DecryptedKey
 		}
 	}
@@ -322,6 +388,10 @@ func (ks *Eth) RemoveKey(address common.Address, hardDelete bool) (removedKey et
 	ks.mu.Lock()
 	for i, cKey := range ks.keys {
 		if cKey.DecryptedKey.Address == address {
+			if cKey.DBKey.Remote {
+				ks.mu.Unlock()
+				return removedKey, ErrRemoteKey
+			}
 			removedKey = cKey.DBKey
 			ks.keys = append(ks.keys[:i], ks.keys[i+1:]...)
 			ks.notify()
@@ -346,6 +416,31 @@ func (ks *Eth) RemoveKey(address common.Address, hardDelete bool) (removedKey et
 	return
 }
 
+// SetTxLimits replaces the transaction limits of the key, which override the
+// node's ETH_TX_LIMIT_* config for the transactions sent from it
+func (ks *Eth) SetTxLimits(address common.Address, limits ethkey.TxLimits) (ethkey.Key, error) {
+	if ks.isLocked() {
+		return ethkey.Key{}, ErrKeyStoreLocked
+	}
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+	for i, cKey := range ks.keys {
+		if cKey.DBKey.Address.Address() != address {
+			continue
+		}
+		err := postgres.DBWithDefaultContext(ks.db, func(db *gorm.DB) error {
+			return db.Exec(`UPDATE keys SET eth_tx_limit_max_txs = ?, eth_tx_limit_max_daily_spend_wei = ?, eth_tx_limit_max_value_per_tx_wei = ?, updated_at = NOW() WHERE address = ?`,
+				limits.MaxTxs, limits.MaxDailySpendWei, limits.MaxValuePerTxWei, address).Error
+		})
+		if err != nil {
+			return ethkey.Key{}, errors.Wrap(err, "failed to update transaction limits")
+		}
+		ks.keys[i].DBKey.TxLimits = limits
+		return ks.keys[i].DBKey, nil
+	}
+	return ethkey.Key{}, newNoKeyError(address)
+}
+
 // SubscribeToKeyChanges returns a channel that will fire if a key is added or removed
 // Consumers should call unsubscribe when they are done to close the channel
 func (ks *Eth) SubscribeToKeyChanges() (ch chan struct{}, unsubscribe func()) {
@@ -506,11 +601,28 @@ func (ks *Eth) ImportKeyFileToDB(keyPath string) (k ethkey.Key, err error) {
 // including the funding key.
 func (ks *Eth) loadDBKeys() (keys []ethkey.Key, err error) {
 	err = postgres.GormTransactionWithDefaultContext(ks.db, func(db *gorm.DB) error {
-		return db.Order("created_at ASC, address ASC").Where("deleted_at IS NULL").Find(&keys).Error
+		return db.Order("created_at ASC, address ASC").Where("deleted_at IS NULL AND NOT remote").Find(&keys).Error
 	})
 	return
 }
 
+// upsertRemoteKey inserts or restores the key of an account held by the remote
+// signer. It fails if the address belongs to a local key, even a deleted one.
+func (ks *Eth) upsertRemoteKey(address common.Address) (k ethkey.Key, err error) {
+	err = postgres.DBWithDefaultContext(ks.db, func(db *gorm.DB) error {
+		return db.Raw(`INSERT INTO keys (address, json, remote, next_nonce, created_at, updated_at) VALUES (?, '{}', TRUE, 0, NOW(), NOW())
+			ON CONFLICT (address) DO UPDATE SET deleted_at = NULL, updated_at = NOW() WHERE keys.remote
+			RETURNING *`, address).Scan(&k).Error
+	})
+	if err != nil {
+		return k, errors.Wrap(err, "upsertRemoteKey failed")
+	}
+	if k.ID == 0 {
+		return k, errors.Errorf("account %s of the remote signer is a local key in the database", address.Hex())
+	}
+	return k, nil
+}
+
 // insertKeyIfNotExists inserts a key if a key with that address doesn't exist already
 // If a key with this address exists, it does nothing
 func (ks *Eth) insertKeyIfNotExists(k *ethkey.Key) error {
@@ -0,0 +1,129 @@
+package keystore
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+)
+
+// EthSigner is a backend that holds ETH keys outside of the node and signs
+// transactions with them
+//go:generate mockery --name EthSigner --output mocks/ --case=underscore
+type EthSigner interface {
+	// Accounts returns the addresses the signer holds keys for
+	Accounts(ctx context.Context) ([]common.Address, error)
+	// SignTx signs tx with the key of fromAddress
+	SignTx(ctx context.Context, fromAddress common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+}
+
+// rpcMethodNotFound is the JSON-RPC error code for an unknown method
+const rpcMethodNotFound = -32601
+
+type remoteEthSigner struct {
+	client *rpc.Client
+}
+
+var _ EthSigner = &remoteEthSigner{}
+
+// NewRemoteEthSigner returns an EthSigner speaking the Web3Signer and Clef
+// compatible JSON-RPC API at rawURL
+func NewRemoteEthSigner(rawURL string) (EthSigner, error) {
+	client, err := rpc.Dial(rawURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to dial remote signer")
+	}
+	return &remoteEthSigner{client}, nil
+}
+
+// Accounts lists the accounts of the signer with account_list (Clef), falling
+// back to eth_accounts (Web3Signer) if the signer does not implement it
+func (s *remoteEthSigner) Accounts(ctx context.Context) (addresses []common.Address, err error) {
+	err = s.client.CallContext(ctx, &addresses, "account_list")
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) && rpcErr.ErrorCode() == rpcMethodNotFound {
+		addresses = nil
+		err = s.client.CallContext(ctx, &addresses, "eth_accounts")
+	}
+	return addresses, errors.Wrap(err, "remote signer failed to list accounts")
+}
+
+// signTransactionArgs are the params of eth_signTransaction
+type signTransactionArgs struct {
+	From                 common.Address    `json:"from"`
+	To                   *common.Address   `json:"to,omitempty"`
+	Gas                  hexutil.Uint64    `json:"gas"`
+	GasPrice             *hexutil.Big      `json:"gasPrice,omitempty"`
+	MaxFeePerGas         *hexutil.Big      `json:"maxFeePerGas,omitempty"`
+	MaxPriorityFeePerGas *hexutil.Big      `json:"maxPriorityFeePerGas,omitempty"`
+	Value                *hexutil.Big      `json:"value"`
+	Nonce                hexutil.Uint64    `json:"nonce"`
+	Data                 hexutil.Bytes     `json:"data"`
+	AccessList           *types.AccessList `json:"accessList,omitempty"`
+	ChainID              *hexutil.Big      `json:"chainId"`
+}
+
+func newSignTransactionArgs(fromAddress common.Address, tx *types.Transaction, chainID *big.Int) signTransactionArgs {
+	args := signTransactionArgs{
+		From:    fromAddress,
+		To:      tx.To(),
+		Gas:     hexutil.Uint64(tx.Gas()),
+		Value:   (*hexutil.Big)(tx.Value()),
+		Nonce:   hexutil.Uint64(tx.Nonce()),
+		Data:    tx.Data(),
+		ChainID: (*hexutil.Big)(chainID),
+	}
+	if tx.Type() == types.DynamicFeeTxType {
+		al := tx.AccessList()
+		args.MaxFeePerGas = (*hexutil.Big)(tx.GasFeeCap())
+		args.MaxPriorityFeePerGas = (*hexutil.Big)(tx.GasTipCap())
+		args.AccessList = &al
+	} else {
+		args.GasPrice = (*hexutil.Big)(tx.GasPrice())
+	}
+	return args
+}
+
+// SignTx signs tx with eth_signTransaction. Web3Signer returns the raw signed
+// transaction, Clef returns it in the raw field of an object. The signed
+// transaction is checked to be tx, signed by fromAddress.
+func (s *remoteEthSigner) SignTx(ctx context.Context, fromAddress common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	var result json.RawMessage
+	if err := s.client.CallContext(ctx, &result, "eth_signTransaction", newSignTransactionArgs(fromAddress, tx, chainID)); err != nil {
+		return nil, errors.Wrapf(err, "remote signer failed to sign transaction from %s", fromAddress.Hex())
+	}
+
+	var raw hexutil.Bytes
+	if err := json.Unmarshal(result, &raw); err != nil {
+		var clefResult struct {
+			Raw hexutil.Bytes `json:"raw"`
+		}
+		if err = json.Unmarshal(result, &clefResult); err != nil {
+			return nil, errors.Wrapf(err, "unexpected response from remote signer: %s", result)
+		}
+		raw = clefResult.Raw
+	}
+
+	signedTx := new(types.Transaction)
+	if err := signedTx.UnmarshalBinary(raw); err != nil {
+		return nil, errors.Wrap(err, "remote signer returned an invalid transaction")
+	}
+
+	signer := types.LatestSignerForChainID(chainID)
+	if signer.Hash(signedTx) != signer.Hash(tx) {
+		return nil, errors.Errorf("remote signer returned a different transaction than the one requested (got hash %s)", signedTx.Hash().Hex())
+	}
+	sender, err := types.Sender(signer, signedTx)
+	if err != nil {
+		return nil, errors.Wrap(err, "remote signer returned a transaction with an invalid signature")
+	}
+	if sender != fromAddress {
+		return nil, errors.Errorf("remote signer signed transaction with %s, expected %s", sender.Hex(), fromAddress.Hex())
+	}
+	return signedTx, nil
+}
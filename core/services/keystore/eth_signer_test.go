@@ -0,0 +1,263 @@
+package keystore_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"net/http/httptest"
+	"testing"
+
+	gethkeystore "github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// standInSigner is a local stand-in for Web3Signer (eth namespace only, raw
+// transaction result) or Clef (account_list, object result)
+type standInSigner struct {
+	keys []*ecdsa.PrivateKey
+	clef bool
+	// tamper makes the signer sign a different transaction than requested
+	tamper bool
+}
+
+type standInSignTxArgs struct {
+	From                 common.Address    `json:"from"`
+	To                   *common.Address   `json:"to"`
+	Gas                  hexutil.Uint64    `json:"gas"`
+	GasPrice             *hexutil.Big      `json:"gasPrice"`
+	MaxFeePerGas         *hexutil.Big      `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas *hexutil.Big      `json:"maxPriorityFeePerGas"`
+	Value                *hexutil.Big      `json:"value"`
+	Nonce                hexutil.Uint64    `json:"nonce"`
+	Data                 hexutil.Bytes     `json:"data"`
+	AccessList           *types.AccessList `json:"accessList"`
+	ChainID              *hexutil.Big      `json:"chainId"`
+}
+
+type standInAccountAPI struct{ s *standInSigner }
+
+func (a *standInAccountAPI) List() []common.Address { return a.s.accounts() }
+
+type standInEthAPI struct{ s *standInSigner }
+
+func (e *standInEthAPI) Accounts() []common.Address { return e.s.accounts() }
+
+func (e *standInEthAPI) SignTransaction(args standInSignTxArgs) (interface{}, error) {
+	var key *ecdsa.PrivateKey
+	for _, k := range e.s.keys {
+		if crypto.PubkeyToAddress(k.PublicKey) == args.From {
+			key = k
+		}
+	}
+	if key == nil {
+		return nil, errors.New("unknown account")
+	}
+	nonce := uint64(args.Nonce)
+	if e.s.tamper {
+		nonce++
+	}
+	chainID := args.ChainID.ToInt()
+	var tx *types.Transaction
+	if args.MaxFeePerGas != nil {
+		var al types.AccessList
+		if args.AccessList != nil {
+			al = *args.AccessList
+		}
+		tx = types.NewTx(&types.DynamicFeeTx{
+			ChainID:    chainID,
+			Nonce:      nonce,
+			GasTipCap:  args.MaxPriorityFeePerGas.ToInt(),
+			GasFeeCap:  args.MaxFeePerGas.ToInt(),
+			Gas:        uint64(args.Gas),
+			To:         args.To,
+			Value:      args.Value.ToInt(),
+			Data:       args.Data,
+			AccessList: al,
+		})
+	} else {
+		tx = types.NewTx(&types.LegacyTx{
+			Nonce:    nonce,
+			GasPrice: args.GasPrice.ToInt(),
+			Gas:      uint64(args.Gas),
+			To:       args.To,
+			Value:    args.Value.ToInt(),
+			Data:     args.Data,
+		})
+	}
+	signed, err := types.SignTx(tx, types.LatestSignerForChainID(chainID), key)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := signed.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	if e.s.clef {
+		return map[string]interface{}{"raw": hexutil.Bytes(raw), "tx": signed}, nil
+	}
+	return hexutil.Bytes(raw), nil
+}
+
+func (s *standInSigner) accounts() (addresses []common.Address) {
+	for _, k := range s.keys {
+		addresses = append(addresses, crypto.PubkeyToAddress(k.PublicKey))
+	}
+	return addresses
+}
+
+// url starts the stand-in signer and returns its JSON-RPC endpoint
+func (s *standInSigner) url(t *testing.T) string {
+	srv := rpc.NewServer()
+	require.NoError(t, srv.RegisterName("eth", &standInEthAPI{s}))
+	if s.clef {
+		require.NoError(t, srv.RegisterName("account", &standInAccountAPI{s}))
+	}
+	ts := httptest.NewServer(srv)
+	t.Cleanup(func() {
+		ts.Close()
+		srv.Stop()
+	})
+	return ts.URL
+}
+
+func newStandInSigner(t *testing.T, clef bool) (*standInSigner, common.Address) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	return &standInSigner{keys: []*ecdsa.PrivateKey{key}, clef: clef}, crypto.PubkeyToAddress(key.PublicKey)
+}
+
+func Test_RemoteEthSigner(t *testing.T) {
+	t.Parallel()
+
+	chainID := big.NewInt(1337)
+	to := cltest.NewAddress()
+	legacyTx := types.NewTransaction(3, to, big.NewInt(42), 21000, big.NewInt(1000000000), []byte{1, 2, 3})
+	dynamicFeeTx := types.NewTx(&types.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     4,
+		GasTipCap: big.NewInt(1000000000),
+		GasFeeCap: big.NewInt(2000000000),
+		Gas:       21000,
+		To:        &to,
+		Value:     big.NewInt(42),
+		Data:      []byte{1, 2, 3},
+	})
+
+	for _, clef := range []bool{false, true} {
+		name := "web3signer"
+		if clef {
+			name = "clef"
+		}
+		t.Run(name, func(t *testing.T) {
+			s, from := newStandInSigner(t, clef)
+			signer, err := keystore.NewRemoteEthSigner(s.url(t))
+			require.NoError(t, err)
+
+			accounts, err := signer.Accounts(context.Background())
+			require.NoError(t, err)
+			assert.Equal(t, []common.Address{from}, accounts)
+
+			for _, tx := range []*types.Transaction{legacyTx, dynamicFeeTx} {
+				signed, err := signer.SignTx(context.Background(), from, tx, chainID)
+				require.NoError(t, err)
+
+				ethSigner := types.LatestSignerForChainID(chainID)
+				assert.Equal(t, ethSigner.Hash(tx), ethSigner.Hash(signed))
+				sender, err := types.Sender(ethSigner, signed)
+				require.NoError(t, err)
+				assert.Equal(t, from, sender)
+			}
+
+			_, err = signer.SignTx(context.Background(), cltest.NewAddress(), legacyTx, chainID)
+			require.Error(t, err)
+		})
+	}
+
+	t.Run("rejects a transaction that differs from the one requested", func(t *testing.T) {
+		s, from := newStandInSigner(t, false)
+		s.tamper = true
+		signer, err := keystore.NewRemoteEthSigner(s.url(t))
+		require.NoError(t, err)
+
+		_, err = signer.SignTx(context.Background(), from, legacyTx, chainID)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "remote signer returned a different transaction than the one requested")
+	})
+}
+
+func Test_EthKeyStore_RemoteSigner(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	t.Cleanup(cleanup)
+	db := store.DB
+
+	s, remoteAddress := newStandInSigner(t, false)
+	signer, err := keystore.NewRemoteEthSigner(s.url(t))
+	require.NoError(t, err)
+
+	localKey := cltest.MustInsertRandomKey(t, db)
+
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	ethKeyStore.SetRemoteSigner(signer)
+	assert.True(t, ethKeyStore.HasRemoteSigner())
+	require.NoError(t, ethKeyStore.Unlock(cltest.Password))
+
+	keys, err := ethKeyStore.SendingKeys()
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+	assert.Equal(t, localKey.Address, keys[0].Address)
+	assert.False(t, keys[0].Remote)
+	assert.Equal(t, remoteAddress, keys[1].Address.Address())
+	assert.True(t, keys[1].Remote)
+
+	var dbKey ethkey.Key
+	require.NoError(t, db.Where("address = ?", remoteAddress).First(&dbKey).Error)
+	assert.True(t, dbKey.Remote)
+
+	chainID := big.NewInt(1337)
+	tx := types.NewTransaction(0, cltest.NewAddress(), big.NewInt(42), 21000, big.NewInt(1000000000), nil)
+	signed, err := ethKeyStore.SignTx(remoteAddress, tx, chainID)
+	require.NoError(t, err)
+	sender, err := types.Sender(types.LatestSignerForChainID(chainID), signed)
+	require.NoError(t, err)
+	assert.Equal(t, remoteAddress, sender)
+
+	_, err = ethKeyStore.ExportKey(remoteAddress, "password")
+	assert.Equal(t, keystore.ErrRemoteKey, err)
+	_, err = ethKeyStore.RemoveKey(remoteAddress, false)
+	assert.Equal(t, keystore.ErrRemoteKey, err)
+
+	// Remote keys are loaded from the signer again and not decrypted
+	ethKeyStore = cltest.NewKeyStore(t, db).Eth()
+	ethKeyStore.SetRemoteSigner(signer)
+	require.NoError(t, ethKeyStore.Unlock(cltest.Password))
+	keys, err = ethKeyStore.AllKeys()
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+	cltest.AssertCount(t, db, ethkey.Key{}, 2)
+
+	// Local keys may not also be held by the remote signer
+	s.keys = append(s.keys, mustDecryptedPrivateKey(t, localKey))
+	ethKeyStore = cltest.NewKeyStore(t, db).Eth()
+	ethKeyStore.SetRemoteSigner(signer)
+	err = ethKeyStore.Unlock(cltest.Password)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "is held by both the remote signer and the database")
+}
+
+func mustDecryptedPrivateKey(t *testing.T, k ethkey.Key) *ecdsa.PrivateKey {
+	dKey, err := gethkeystore.DecryptKey(k.JSON, cltest.Password)
+	require.NoError(t, err)
+	return dKey.PrivateKey
+}
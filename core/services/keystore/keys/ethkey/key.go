@@ -6,6 +6,8 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	clnull "github.com/smartcontractkit/chainlink/core/null"
+	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/tidwall/gjson"
 	"go.uber.org/multierr"
 	"gorm.io/datatypes"
@@ -33,6 +35,25 @@ type Key struct {
 	// IsFunding marks the address as being used for rescuing the  node and the pending transactions
 	// Only one key can be IsFunding=true at a time.
 	IsFunding bool
+	// Remote marks the key as being held by the remote signer. Its JSON is
+	// empty and it can neither be exported nor deleted from the node.
+	Remote bool
+	// TxLimits override the node's per key ETH_TX_LIMIT_* limits for the
+	// transactions sent from this key
+	TxLimits TxLimits `gorm:"embedded;embeddedPrefix:eth_tx_limit_"`
+}
+
+// TxLimits override the ETH_TX_LIMIT_* limits of the node for a key or a job.
+// An unset limit falls back to the config, a limit of 0 disables it.
+type TxLimits struct {
+	// MaxTxs is the most transactions that may be sent within
+	// ETH_TX_LIMIT_WINDOW
+	MaxTxs clnull.Uint32 `json:"maxTxs"`
+	// MaxDailySpendWei is the most wei that the transactions may cost over the
+	// last 24 hours
+	MaxDailySpendWei *utils.Big `json:"maxDailySpendWei"`
+	// MaxValuePerTxWei is the most wei that a single transaction may transfer
+	MaxValuePerTxWei *utils.Big `json:"maxValuePerTxWei"`
 }
 
 // Type returns type of key
@@ -7,6 +7,7 @@ import (
 
 func New(db *gorm.DB, scryptParams utils.ScryptParams) *Master {
 	return &Master{
+		db:  db,
 		eth: newEthKeyStore(db, scryptParams),
 		csa: newCSAKeyStore(db, scryptParams),
 		ocr: newOCRKeyStore(db, scryptParams),
@@ -15,6 +16,7 @@ func New(db *gorm.DB, scryptParams utils.ScryptParams) *Master {
 }
 
 type Master struct {
+	db  *gorm.DB
 	eth *Eth
 	csa *CSA
 	ocr *OCR
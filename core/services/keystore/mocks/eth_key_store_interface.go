@@ -328,6 +328,27 @@ func (_m *EthKeyStoreInterface) SendingKeys() ([]ethkey.Key, error) {
 	return r0, r1
 }
 
+// SetTxLimits provides a mock function with given fields: address, limits
+func (_m *EthKeyStoreInterface) SetTxLimits(address common.Address, limits ethkey.TxLimits) (ethkey.Key, error) {
+	ret := _m.Called(address, limits)
+
+	var r0 ethkey.Key
+	if rf, ok := ret.Get(0).(func(common.Address, ethkey.TxLimits) ethkey.Key); ok {
+		r0 = rf(address, limits)
+	} else {
+		r0 = ret.Get(0).(ethkey.Key)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(common.Address, ethkey.TxLimits) error); ok {
+		r1 = rf(address, limits)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SignTx provides a mock function with given fields: fromAddress, tx, chainID
 func (_m *EthKeyStoreInterface) SignTx(fromAddress common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
 	ret := _m.Called(fromAddress, tx, chainID)
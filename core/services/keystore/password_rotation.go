@@ -0,0 +1,322 @@
+package keystore
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	cryptop2p "github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/csakey"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ocrkey"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/p2pkey"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/vrfkey"
+	"github.com/smartcontractkit/chainlink/core/services/postgres"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/chainlink/core/utils/crypto"
+	"go.uber.org/multierr"
+	"gorm.io/gorm"
+)
+
+// ErrKeystoreUnlocked is returned when the password of a keystore that is in
+// use is rotated
+var ErrKeystoreUnlocked = errors.New("the password may not be rotated while the keystore is unlocked")
+
+// reencryptedKey is a key re-encrypted with the new password, to be written
+// to column of table in place of the old ciphertext
+type reencryptedKey struct {
+	name       string
+	table      string
+	idColumn   string
+	column     string
+	id         interface{}
+	ciphertext interface{}
+	// verify decrypts the ciphertext read back from the database with the new
+	// password and checks it holds the original key
+	verify func(tx *gorm.DB) error
+}
+
+// RotatePassword re-encrypts every key in the database, including soft
+// deleted ones, with newPassword and scryptParams. All keys are decrypted
+// with oldPassword first, and the new ciphertexts are read back and
+// decrypted before the transaction commits, so on any error the keys are
+// left untouched.
+//
+// The keystore must be locked, i.e. not in use by a running node, as the keys
+// held in memory would otherwise be re-encrypted with the old password.
+// Keys held by the remote signer are not stored by the node and are skipped.
+func (m *Master) RotatePassword(oldPassword, newPassword string, scryptParams utils.ScryptParams) error {
+	if !m.isLocked() {
+		return ErrKeystoreUnlocked
+	}
+	if newPassword == "" {
+		return errors.New("new password may not be empty")
+	}
+
+	var keys []reencryptedKey
+	var merr error
+	for _, reencrypt := range []func(*gorm.DB, string, string, utils.ScryptParams) ([]reencryptedKey, error){
+		reencryptEthKeys,
+		reencryptP2PKeys,
+		reencryptOCRKeys,
+		reencryptCSAKeys,
+		reencryptVRFKeys,
+	} {
+		ks, err := reencrypt(m.db, oldPassword, newPassword, scryptParams)
+		merr = multierr.Append(merr, err)
+		keys = append(keys, ks...)
+	}
+	if merr != nil {
+		return errors.Wrap(merr, "failed to re-encrypt keys, no keys were changed")
+	}
+
+	err := postgres.GormTransactionWithoutContext(m.db, func(tx *gorm.DB) error {
+		for _, k := range keys {
+			/* #nosec G201 */
+			result := tx.Exec(fmt.Sprintf(`UPDATE %s SET %s = ?, updated_at = NOW() WHERE %s = ?`, k.table, k.column, k.idColumn), k.ciphertext, k.id)
+			if result.Error != nil {
+				return errors.Wrapf(result.Error, "failed to update %s", k.name)
+			}
+			if result.RowsAffected != 1 {
+				return errors.Errorf("failed to update %s: key no longer exists", k.name)
+			}
+		}
+		for _, k := range keys {
+			if err := k.verify(tx); err != nil {
+				return errors.Wrapf(err, "failed to verify re-encrypted %s", k.name)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to rotate keystore password, no keys were changed")
+	}
+	logger.Infow("Rotated keystore password", "keys", len(keys))
+	return nil
+}
+
+func (m *Master) isLocked() bool {
+	if !m.eth.isLocked() {
+		return false
+	}
+	m.csa.mu.RLock()
+	defer m.csa.mu.RUnlock()
+	m.ocr.mu.RLock()
+	defer m.ocr.mu.RUnlock()
+	m.vrf.lock.RLock()
+	defer m.vrf.lock.RUnlock()
+	return m.csa.password == "" && m.ocr.password == "" && m.vrf.password == ""
+}
+
+func reencryptEthKeys(db *gorm.DB, oldPassword, newPassword string, scryptParams utils.ScryptParams) (keys []reencryptedKey, merr error) {
+	var rows []ethkey.Key
+	if err := db.Unscoped().Where("NOT remote").Order("id ASC").Find(&rows).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to load ETH keys")
+	}
+	for _, row := range rows {
+		name := fmt.Sprintf("ETH key %s", row.Address.Hex())
+		dKey, err := keystore.DecryptKey(row.JSON, oldPassword)
+		if err != nil {
+			merr = multierr.Append(merr, errors.Wrapf(err, "failed to decrypt %s", name))
+			continue
+		}
+		ciphertext, err := keystore.EncryptKey(dKey, newPassword, scryptParams.N, scryptParams.P)
+		if err != nil {
+			merr = multierr.Append(merr, errors.Wrapf(err, "failed to encrypt %s", name))
+			continue
+		}
+		id := row.ID
+		keys = append(keys, reencryptedKey{
+			name: name, table: "keys", idColumn: "id", column: "json",
+			id: id, ciphertext: ciphertext,
+			verify: func(tx *gorm.DB) error {
+				var k ethkey.Key
+				if err := tx.Unscoped().Where("id = ?", id).First(&k).Error; err != nil {
+					return err
+				}
+				newKey, err := keystore.DecryptKey(k.JSON, newPassword)
+				if err != nil {
+					return err
+				}
+				if newKey.Address != dKey.Address || newKey.PrivateKey.D.Cmp(dKey.PrivateKey.D) != 0 {
+					return errors.New("decrypted key does not match")
+				}
+				return nil
+			},
+		})
+	}
+	return keys, merr
+}
+
+func reencryptP2PKeys(db *gorm.DB, oldPassword, newPassword string, scryptParams utils.ScryptParams) (keys []reencryptedKey, merr error) {
+	var rows []p2pkey.EncryptedP2PKey
+	if err := db.Unscoped().Order("id ASC").Find(&rows).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to load P2P keys")
+	}
+	for _, row := range rows {
+		name := fmt.Sprintf("P2P key %s", row.PeerID.Raw())
+		k, err := row.Decrypt(oldPassword)
+		if err != nil {
+			merr = multierr.Append(merr, errors.Wrapf(err, "failed to decrypt %s", name))
+			continue
+		}
+		encrypted, err := k.ToEncryptedP2PKey(newPassword, scryptParams)
+		if err != nil {
+			merr = multierr.Append(merr, errors.Wrapf(err, "failed to encrypt %s", name))
+			continue
+		}
+		id := row.ID
+		keys = append(keys, reencryptedKey{
+			name: name, table: "encrypted_p2p_keys", idColumn: "id", column: "encrypted_priv_key",
+			id: id, ciphertext: encrypted.EncryptedPrivKey,
+			verify: func(tx *gorm.DB) error {
+				var ek p2pkey.EncryptedP2PKey
+				if err := tx.Unscoped().Where("id = ?", id).First(&ek).Error; err != nil {
+					return err
+				}
+				newKey, err := ek.Decrypt(newPassword)
+				if err != nil {
+					return err
+				}
+				if !cryptop2p.KeyEqual(newKey, k) {
+					return errors.New("decrypted key does not match")
+				}
+				return nil
+			},
+		})
+	}
+	return keys, merr
+}
+
+func reencryptOCRKeys(db *gorm.DB, oldPassword, newPassword string, scryptParams utils.ScryptParams) (keys []reencryptedKey, merr error) {
+	var rows []ocrkey.EncryptedKeyBundle
+	if err := db.Unscoped().Order("id ASC").Find(&rows).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to load OCR keys")
+	}
+	for i := range rows {
+		name := fmt.Sprintf("OCR key bundle %s", rows[i].ID)
+		kb, err := rows[i].Decrypt(oldPassword)
+		if err != nil {
+			merr = multierr.Append(merr, errors.Wrapf(err, "failed to decrypt %s", name))
+			continue
+		}
+		plaintext, err := json.Marshal(kb)
+		if err != nil {
+			merr = multierr.Append(merr, errors.Wrapf(err, "failed to marshal %s", name))
+			continue
+		}
+		encrypted, err := kb.Encrypt(newPassword, scryptParams)
+		if err != nil {
+			merr = multierr.Append(merr, errors.Wrapf(err, "failed to encrypt %s", name))
+			continue
+		}
+		id := rows[i].ID
+		keys = append(keys, reencryptedKey{
+			name: name, table: "encrypted_ocr_key_bundles", idColumn: "id", column: "encrypted_private_keys",
+			id: id, ciphertext: encrypted.EncryptedPrivateKeys,
+			verify: func(tx *gorm.DB) error {
+				var ekb ocrkey.EncryptedKeyBundle
+				if err := tx.Unscoped().Where("id = ?", id).First(&ekb).Error; err != nil {
+					return err
+				}
+				newKey, err := ekb.Decrypt(newPassword)
+				if err != nil {
+					return err
+				}
+				newPlaintext, err := json.Marshal(newKey)
+				if err != nil {
+					return err
+				}
+				if !bytes.Equal(newPlaintext, plaintext) {
+					return errors.New("decrypted key does not match")
+				}
+				return nil
+			},
+		})
+	}
+	return keys, merr
+}
+
+func reencryptCSAKeys(db *gorm.DB, oldPassword, newPassword string, scryptParams utils.ScryptParams) (keys []reencryptedKey, merr error) {
+	var rows []csakey.Key
+	if err := db.Order("id ASC").Find(&rows).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to load CSA keys")
+	}
+	for _, row := range rows {
+		name := fmt.Sprintf("CSA key %s", row.PublicKey.String())
+		privkey, err := row.EncryptedPrivateKey.Decrypt(oldPassword)
+		if err != nil {
+			merr = multierr.Append(merr, errors.Wrapf(err, "failed to decrypt %s", name))
+			continue
+		}
+		encrypted, err := crypto.NewEncryptedPrivateKey(privkey, newPassword, scryptParams)
+		if err != nil {
+			merr = multierr.Append(merr, errors.Wrapf(err, "failed to encrypt %s", name))
+			continue
+		}
+		id := row.ID
+		keys = append(keys, reencryptedKey{
+			name: name, table: "csa_keys", idColumn: "id", column: "encrypted_private_key",
+			id: id, ciphertext: *encrypted,
+			verify: func(tx *gorm.DB) error {
+				var k csakey.Key
+				if err := tx.Where("id = ?", id).First(&k).Error; err != nil {
+					return err
+				}
+				newPrivkey, err := k.EncryptedPrivateKey.Decrypt(newPassword)
+				if err != nil {
+					return err
+				}
+				if !bytes.Equal(newPrivkey, privkey) {
+					return errors.New("decrypted key does not match")
+				}
+				return nil
+			},
+		})
+	}
+	return keys, merr
+}
+
+func reencryptVRFKeys(db *gorm.DB, oldPassword, newPassword string, scryptParams utils.ScryptParams) (keys []reencryptedKey, merr error) {
+	var rows []vrfkey.EncryptedVRFKey
+	if err := db.Unscoped().Order("created_at ASC").Find(&rows).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to load VRF keys")
+	}
+	for i := range rows {
+		name := fmt.Sprintf("VRF key %s", rows[i].PublicKey.String())
+		k, err := vrfkey.Decrypt(&rows[i], oldPassword)
+		if err != nil {
+			merr = multierr.Append(merr, errors.Wrapf(err, "failed to decrypt %s", name))
+			continue
+		}
+		// Encrypt checks the new ciphertext decrypts to k
+		encrypted, err := k.Encrypt(newPassword, scryptParams)
+		if err != nil {
+			merr = multierr.Append(merr, errors.Wrapf(err, "failed to encrypt %s", name))
+			continue
+		}
+		publicKey := rows[i].PublicKey
+		keys = append(keys, reencryptedKey{
+			name: name, table: "encrypted_vrf_keys", idColumn: "public_key", column: "vrf_key",
+			id: publicKey, ciphertext: encrypted.VRFKey,
+			verify: func(tx *gorm.DB) error {
+				var ek vrfkey.EncryptedVRFKey
+				if err := tx.Unscoped().Where("public_key = ?", publicKey).First(&ek).Error; err != nil {
+					return err
+				}
+				newKey, err := vrfkey.Decrypt(&ek, newPassword)
+				if err != nil {
+					return err
+				}
+				if newKey.PublicKey != publicKey {
+					return errors.New("decrypted key does not match")
+				}
+				return nil
+			},
+		})
+	}
+	return keys, merr
+}
@@ -0,0 +1,126 @@
+package keystore_test
+
+import (
+	"testing"
+
+	gethkeystore "github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/csakey"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ocrkey"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/p2pkey"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/vrfkey"
+	"github.com/smartcontractkit/chainlink/core/services/signatures/secp256k1"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+const newPassword = "rotated-p4ssw0rd"
+
+func Test_Master_RotatePassword(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	t.Cleanup(cleanup)
+	db := store.DB
+
+	ks := cltest.NewKeyStore(t, db)
+	require.NoError(t, ks.Eth().Unlock(cltest.Password))
+	require.NoError(t, ks.CSA().Unlock(cltest.Password))
+	require.NoError(t, ks.OCR().Unlock(cltest.Password))
+	_, err := ks.VRF().Unlock(cltest.Password)
+	require.NoError(t, err)
+
+	ethKey, err := ks.Eth().CreateNewKey()
+	require.NoError(t, err)
+	p2pKey, encP2PKey, err := ks.OCR().GenerateEncryptedP2PKey()
+	require.NoError(t, err)
+	ocrKey, _, err := ks.OCR().GenerateEncryptedOCRKeyBundle()
+	require.NoError(t, err)
+	_, err = ks.CSA().CreateCSAKey()
+	require.NoError(t, err)
+	vrfPublicKey, err := ks.VRF().CreateKey()
+	require.NoError(t, err)
+	require.NoError(t, ks.OCR().ArchiveEncryptedP2PKey(&encP2PKey))
+
+	t.Run("refuses to rotate the password of an unlocked keystore", func(t *testing.T) {
+		err := ks.RotatePassword(cltest.Password, newPassword, utils.FastScryptParams)
+		assert.Equal(t, keystore.ErrKeystoreUnlocked, err)
+	})
+
+	t.Run("does not change any keys with the wrong password", func(t *testing.T) {
+		err := cltest.NewKeyStore(t, db).RotatePassword("wrong password", newPassword, utils.FastScryptParams)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "no keys were changed")
+
+		assertKeysDecrypt(t, db, cltest.Password)
+	})
+
+	t.Run("re-encrypts every key with the new password", func(t *testing.T) {
+		require.NoError(t, cltest.NewKeyStore(t, db).RotatePassword(cltest.Password, newPassword, utils.FastScryptParams))
+
+		assertKeysDecrypt(t, db, newPassword)
+
+		rotated := cltest.NewKeyStore(t, db)
+		require.Error(t, rotated.Eth().Unlock(cltest.Password))
+		rotated = cltest.NewKeyStore(t, db)
+		require.NoError(t, rotated.Eth().Unlock(newPassword))
+		keys, err := rotated.Eth().AllKeys()
+		require.NoError(t, err)
+		require.Len(t, keys, 1)
+		assert.Equal(t, ethKey.Address, keys[0].Address)
+
+		require.NoError(t, rotated.OCR().Unlock(newPassword))
+		decryptedOCRKey, exists := rotated.OCR().DecryptedOCRKey(ocrKey.ID)
+		require.True(t, exists)
+		assert.Equal(t, ocrKey.ID, decryptedOCRKey.ID)
+
+		// Soft deleted keys are re-encrypted too
+		var ek p2pkey.EncryptedP2PKey
+		require.NoError(t, db.Unscoped().Where("id = ?", encP2PKey.ID).First(&ek).Error)
+		decryptedP2PKey, err := ek.Decrypt(newPassword)
+		require.NoError(t, err)
+		assert.Equal(t, p2pKey.MustGetPeerID(), decryptedP2PKey.MustGetPeerID())
+
+		unlocked, err := rotated.VRF().Unlock(newPassword)
+		require.NoError(t, err)
+		assert.Equal(t, []secp256k1.PublicKey{vrfPublicKey}, unlocked)
+	})
+}
+
+// assertKeysDecrypt checks every key in the database decrypts with password
+func assertKeysDecrypt(t *testing.T, db *gorm.DB, password string) {
+	t.Helper()
+
+	var ethKeys []ethkey.Key
+	require.NoError(t, db.Find(&ethKeys).Error)
+	require.Len(t, ethKeys, 1)
+	_, err := gethkeystore.DecryptKey(ethKeys[0].JSON, password)
+	assert.NoError(t, err)
+
+	var p2pKeys []p2pkey.EncryptedP2PKey
+	require.NoError(t, db.Unscoped().Find(&p2pKeys).Error)
+	require.Len(t, p2pKeys, 1)
+	_, err = p2pKeys[0].Decrypt(password)
+	assert.NoError(t, err)
+
+	var ocrKeys []ocrkey.EncryptedKeyBundle
+	require.NoError(t, db.Find(&ocrKeys).Error)
+	require.Len(t, ocrKeys, 1)
+	_, err = ocrKeys[0].Decrypt(password)
+	assert.NoError(t, err)
+
+	var csaKeys []csakey.Key
+	require.NoError(t, db.Find(&csaKeys).Error)
+	require.Len(t, csaKeys, 1)
+	assert.NoError(t, csaKeys[0].Unlock(password))
+
+	var vrfKeys []vrfkey.EncryptedVRFKey
+	require.NoError(t, db.Find(&vrfKeys).Error)
+	require.Len(t, vrfKeys, 1)
+	_, err = vrfkey.Decrypt(&vrfKeys[0], password)
+	assert.NoError(t, err)
+}
@@ -45,6 +45,7 @@ type DelegateConfig interface {
 	OCRKeyBundleID(*models.Sha256Hash) (models.Sha256Hash, error)
 	OCRObservationGracePeriod() time.Duration
 	OCRObservationTimeout(time.Duration) time.Duration
+	OCRSimulateTransactions() bool
 	OCRTraceLogging() bool
 	OCRTransmitterAddress(*ethkey.EIP55Address) (ethkey.EIP55Address, error)
 	P2PBootstrapPeers([]string) ([]string, error)
@@ -230,13 +231,13 @@ func (d Delegate) ServicesForSpec(jobSpec job.Job) (services []job.Service, err
 			return nil, err
 		}
 
-		strategy := bulletprooftxmanager.NewQueueingTxStrategy(jobSpec.ExternalJobID, d.config.OCRDefaultTransactionQueueDepth())
+		strategy := bulletprooftxmanager.NewQueueingTxStrategy(jobSpec.ExternalJobID, d.config.OCRDefaultTransactionQueueDepth(), d.config.OCRSimulateTransactions())
 
 		contractTransmitter := NewOCRContractTransmitter(
 			concreteSpec.ContractAddress.Address(),
 			contractCaller,
 			contractABI,
-			NewTransmitter(d.txm, d.db, ta.Address(), d.config.EthGasLimitDefault(), strategy),
+			NewTransmitter(d.txm, d.db, ta.Address(), d.config.EthGasLimitDefault(), strategy, jobSpec.ID),
 			d.logBroadcaster,
 			tracker,
 			d.config.ChainID(),
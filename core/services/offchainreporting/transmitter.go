@@ -6,6 +6,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+	"github.com/smartcontractkit/chainlink/core/store/models"
 	"gorm.io/gorm"
 )
 
@@ -19,22 +20,24 @@ type transmitter struct {
 	fromAddress common.Address
 	gasLimit    uint64
 	strategy    bulletprooftxmanager.TxStrategy
+	jobID       int32
 }
 
 // NewTransmitter creates a new eth transmitter
-func NewTransmitter(txm txManager, db *gorm.DB, fromAddress common.Address, gasLimit uint64, strategy bulletprooftxmanager.TxStrategy) Transmitter {
+func NewTransmitter(txm txManager, db *gorm.DB, fromAddress common.Address, gasLimit uint64, strategy bulletprooftxmanager.TxStrategy, jobID int32) Transmitter {
 	return &transmitter{
 		txm:         txm,
 		db:          db,
 		fromAddress: fromAddress,
 		gasLimit:    gasLimit,
 		strategy:    strategy,
+		jobID:       jobID,
 	}
 }
 
 func (t *transmitter) CreateEthTransaction(ctx context.Context, toAddress common.Address, payload []byte) error {
 	db := t.db.WithContext(ctx)
-	_, err := t.txm.CreateEthTransaction(db, t.fromAddress, toAddress, payload, t.gasLimit, nil, t.strategy)
+	_, err := t.txm.CreateEthTransaction(db, t.fromAddress, toAddress, payload, t.gasLimit, &models.EthTxMetaV2{JobID: t.jobID}, t.strategy)
 	return errors.Wrap(err, "Skipped OCR transmission")
 }
 
@@ -8,6 +8,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
 	bptxmmocks "github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager/mocks"
 	"github.com/smartcontractkit/chainlink/core/services/offchainreporting"
+	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -25,9 +26,9 @@ func Test_Transmitter_CreateEthTransaction(t *testing.T) {
 	txm := new(bptxmmocks.TxManager)
 	strategy := new(bptxmmocks.TxStrategy)
 
-	transmitter := offchainreporting.NewTransmitter(txm, store.DB, fromAddress, gasLimit, strategy)
+	transmitter := offchainreporting.NewTransmitter(txm, store.DB, fromAddress, gasLimit, strategy, 42)
 
-	txm.On("CreateEthTransaction", mock.Anything, fromAddress, toAddress, payload, gasLimit, nil, strategy).Return(bulletprooftxmanager.EthTx{}, nil).Once()
+	txm.On("CreateEthTransaction", mock.Anything, fromAddress, toAddress, payload, gasLimit, &models.EthTxMetaV2{JobID: 42}, strategy).Return(bulletprooftxmanager.EthTx{}, nil).Once()
 	require.NoError(t, transmitter.CreateEthTransaction(context.Background(), toAddress, payload))
 
 	txm.AssertExpectations(t)
@@ -0,0 +1,424 @@
+package periodicbackup
+
+import (
+	"archive/zip"
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/chainlink/core/store/config"
+	"github.com/smartcontractkit/chainlink/core/store/migrations"
+	"gopkg.in/guregu/null.v4"
+)
+
+// An archive is a zip file holding the data of every table in the public
+// schema in COPY text format, one entry per table, followed by a manifest
+// describing the snapshot. The schema itself is not archived, it is
+// recreated by running the migrations the snapshot was taken at.
+const (
+	// ArchiveFormatVersion is bumped whenever the layout of the archive
+	// changes in a way older nodes cannot restore
+	ArchiveFormatVersion = 1
+
+	manifestName    = "manifest.json"
+	migrationsTable = "migrations"
+)
+
+type (
+	// Manifest describes the snapshot held by an archive
+	Manifest struct {
+		FormatVersion int                       `json:"formatVersion"`
+		NodeVersion   string                    `json:"nodeVersion"`
+		Migration     string                    `json:"migration"`
+		Mode          config.DatabaseBackupMode `json:"mode"`
+		CreatedAt     time.Time                 `json:"createdAt"`
+		// Tables are ordered so that referenced tables come first
+		Tables    []TableManifest    `json:"tables"`
+		Sequences []SequenceManifest `json:"sequences"`
+	}
+
+	// TableManifest describes the data of a table in the archive
+	TableManifest struct {
+		Name    string   `json:"name"`
+		Columns []string `json:"columns"`
+		// DataExcluded is set for tables whose data is left out of lite
+		// backups. They are emptied on restore.
+		DataExcluded bool `json:"dataExcluded,omitempty"`
+		// NulledColumns reference tables whose data is excluded, and are
+		// set to NULL in the archive
+		NulledColumns []string `json:"nulledColumns,omitempty"`
+		Rows          int64    `json:"rows"`
+		SHA256        string   `json:"sha256"`
+	}
+
+	// SequenceManifest holds the value of a sequence at the time of the
+	// snapshot
+	SequenceManifest struct {
+		Name string `json:"name"`
+		// LastValue is null for a sequence that was never used
+		LastValue null.Int `json:"lastValue"`
+	}
+
+	foreignKey struct {
+		table      string
+		column     string
+		referenced string
+		notNull    bool
+	}
+)
+
+func tableEntryName(table string) string {
+	return "tables/" + table + ".copy"
+}
+
+// ExportArchive writes a consistent snapshot of the database to w as an
+// archive
+func ExportArchive(ctx context.Context, databaseURL string, mode config.DatabaseBackupMode, nodeVersion string, w io.Writer) (*Manifest, error) {
+	conn, err := pgconn.Connect(ctx, databaseURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to connect to database")
+	}
+	defer conn.Close(ctx)
+
+	// All tables are read from the same snapshot
+	if _, err = conn.Exec(ctx, "BEGIN ISOLATION LEVEL REPEATABLE READ READ ONLY").ReadAll(); err != nil {
+		return nil, errors.Wrap(err, "failed to begin transaction")
+	}
+	defer func() {
+		_, _ = conn.Exec(ctx, "ROLLBACK").ReadAll()
+	}()
+
+	m := &Manifest{
+		FormatVersion: ArchiveFormatVersion,
+		NodeVersion:   nodeVersion,
+		Mode:          mode,
+		CreatedAt:     time.Now().UTC(),
+	}
+	if m.Migration, err = currentMigration(ctx, conn); err != nil {
+		return nil, err
+	}
+	if m.Tables, err = tablesToExport(ctx, conn, mode); err != nil {
+		return nil, err
+	}
+	if m.Sequences, err = sequences(ctx, conn); err != nil {
+		return nil, err
+	}
+
+	zw := zip.NewWriter(w)
+	for i, table := range m.Tables {
+		if table.DataExcluded {
+			continue
+		}
+		entry, err := zw.CreateHeader(&zip.FileHeader{Name: tableEntryName(table.Name), Method: zip.Deflate, Modified: m.CreatedAt})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to add table %s to archive", table.Name)
+		}
+		hash := sha256.New()
+		tag, err := conn.CopyTo(ctx, io.MultiWriter(entry, hash), copyToSQL(table))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to export table %s", table.Name)
+		}
+		m.Tables[i].Rows = tag.RowsAffected()
+		m.Tables[i].SHA256 = hex.EncodeToString(hash.Sum(nil))
+	}
+
+	entry, err := zw.CreateHeader(&zip.FileHeader{Name: manifestName, Method: zip.Deflate, Modified: m.CreatedAt})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to add manifest to archive")
+	}
+	enc := json.NewEncoder(entry)
+	enc.SetIndent("", "  ")
+	if err = enc.Encode(m); err != nil {
+		return nil, errors.Wrap(err, "failed to write manifest")
+	}
+	return m, errors.Wrap(zw.Close(), "failed to write archive")
+}
+
+func copyToSQL(table TableManifest) string {
+	nulled := make(map[string]bool)
+	for _, c := range table.NulledColumns {
+		nulled[c] = true
+	}
+	columns := make([]string, len(table.Columns))
+	for i, c := range table.Columns {
+		if nulled[c] {
+			columns[i] = "NULL"
+		} else {
+			columns[i] = pgx.Identifier{c}.Sanitize()
+		}
+	}
+	return fmt.Sprintf("COPY (SELECT %s FROM %s) TO STDOUT", strings.Join(columns, ", "), pgx.Identifier{table.Name}.Sanitize())
+}
+
+// query runs sql and returns its rows as strings, with NULL values as ""
+func query(ctx context.Context, conn *pgconn.PgConn, sql string, args ...string) ([][]string, error) {
+	params := make([][]byte, len(args))
+	for i, a := range args {
+		params[i] = []byte(a)
+	}
+	result := conn.ExecParams(ctx, sql, params, nil, nil, nil).Read()
+	if result.Err != nil {
+		return nil, result.Err
+	}
+	rows := make([][]string, len(result.Rows))
+	for i, row := range result.Rows {
+		rows[i] = make([]string, len(row))
+		for j, v := range row {
+			rows[i][j] = string(v)
+		}
+	}
+	return rows, nil
+}
+
+// currentMigration is the latest migration of this node that has been run on
+// the database
+func currentMigration(ctx context.Context, conn *pgconn.PgConn) (string, error) {
+	rows, err := query(ctx, conn, `SELECT id FROM migrations`)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to load migrations")
+	}
+	ran := make(map[string]bool)
+	for _, row := range rows {
+		ran[row[0]] = true
+	}
+	for i := len(migrations.Migrations) - 1; i >= 0; i-- {
+		if ran[migrations.Migrations[i].ID] {
+			return migrations.Migrations[i].ID, nil
+		}
+	}
+	return "", errors.New("database has not been migrated")
+}
+
+// tablesToExport lists the tables of the public schema, ordered so that
+// referenced tables come before the tables referencing them. In lite mode,
+// the data of excludedDataFromTables is left out, along with the data of
+// tables that cannot exist without it.
+func tablesToExport(ctx context.Context, conn *pgconn.PgConn, mode config.DatabaseBackupMode) ([]TableManifest, error) {
+	rows, err := query(ctx, conn, `SELECT tablename FROM pg_tables WHERE schemaname = 'public' AND tablename <> $1 ORDER BY tablename`, migrationsTable)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list tables")
+	}
+	tables := make(map[string]*TableManifest)
+	var names []string
+	for _, row := range rows {
+		name := row[0]
+		columns, err := query(ctx, conn, `SELECT attname FROM pg_attribute WHERE attrelid = $1::regclass AND attnum > 0 AND NOT attisdropped ORDER BY attnum`, pgx.Identifier{"public", name}.Sanitize())
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to list columns of table %s", name)
+		}
+		t := &TableManifest{Name: name}
+		for _, c := range columns {
+			t.Columns = append(t.Columns, c[0])
+		}
+		tables[name] = t
+		names = append(names, name)
+	}
+
+	rows, err = query(ctx, conn, `
+SELECT cl.relname, a.attname, rt.relname, a.attnotnull
+FROM pg_constraint c
+JOIN pg_class cl ON cl.oid = c.conrelid
+JOIN pg_class rt ON rt.oid = c.confrelid
+JOIN pg_namespace n ON n.oid = cl.relnamespace
+JOIN pg_attribute a ON a.attrelid = c.conrelid AND a.attnum = ANY(c.conkey)
+WHERE c.contype = 'f' AND n.nspname = 'public'
+ORDER BY cl.relname, a.attname`)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list foreign keys")
+	}
+	var fks []foreignKey
+	for _, row := range rows {
+		fks = append(fks, foreignKey{row[0], row[1], row[2], row[3] == "t"})
+	}
+
+	if mode == config.DatabaseBackupModeLite {
+		excludeData(tables, fks)
+	}
+	return sortByDependencies(tables, names, fks)
+}
+
+// excludeData marks excludedDataFromTables as excluded. Columns referencing
+// them are nulled, and tables with a NOT NULL reference are excluded too.
+func excludeData(tables map[string]*TableManifest, fks []foreignKey) {
+	for _, name := range excludedDataFromTables {
+		if t, exists := tables[name]; exists {
+			t.DataExcluded = true
+		}
+	}
+	for changed := true; changed; {
+		changed = false
+		for _, fk := range fks {
+			t, referenced := tables[fk.table], tables[fk.referenced]
+			if t == nil || referenced == nil || t.DataExcluded || !referenced.DataExcluded {
+				continue
+			}
+			if fk.notNull {
+				t.DataExcluded = true
+				t.NulledColumns = nil
+				changed = true
+			} else if !containsString(t.NulledColumns, fk.column) {
+				t.NulledColumns = append(t.NulledColumns, fk.column)
+			}
+		}
+	}
+}
+
+func sortByDependencies(tables map[string]*TableManifest, names []string, fks []foreignKey) ([]TableManifest, error) {
+	dependencies := make(map[string][]string)
+	for _, fk := range fks {
+		if fk.table != fk.referenced {
+			dependencies[fk.table] = append(dependencies[fk.table], fk.referenced)
+		}
+	}
+	var sorted []TableManifest
+	visited := make(map[string]bool)
+	visiting := make(map[string]bool)
+	var visit func(name string) error
+	visit = func(name string) error {
+		if visited[name] {
+			return nil
+		}
+		if visiting[name] {
+			return errors.Errorf("tables referencing each other are not supported (cycle through %s)", name)
+		}
+		visiting[name] = true
+		deps := dependencies[name]
+		sort.Strings(deps)
+		for _, dep := range deps {
+			if err := visit(dep); err != nil {
+				return err
+			}
+		}
+		visiting[name] = false
+		visited[name] = true
+		if t, exists := tables[name]; exists {
+			sorted = append(sorted, *t)
+		}
+		return nil
+	}
+	for _, name := range names {
+		if err := visit(name); err != nil {
+			return nil, err
+		}
+	}
+	return sorted, nil
+}
+
+func sequences(ctx context.Context, conn *pgconn.PgConn) ([]SequenceManifest, error) {
+	rows, err := query(ctx, conn, `SELECT sequencename, last_value IS NULL, COALESCE(last_value, 0) FROM pg_sequences WHERE schemaname = 'public' ORDER BY sequencename`)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list sequences")
+	}
+	var seqs []SequenceManifest
+	for _, row := range rows {
+		seq := SequenceManifest{Name: row[0]}
+		if row[1] != "t" {
+			var v int64
+			if _, err := fmt.Sscan(row[2], &v); err != nil {
+				return nil, errors.Wrapf(err, "invalid value for sequence %s", row[0])
+			}
+			seq.LastValue = null.IntFrom(v)
+		}
+		seqs = append(seqs, seq)
+	}
+	return seqs, nil
+}
+
+// VerifyArchive checks the archive at path is complete and that it can be
+// restored by this node, and returns its manifest
+func VerifyArchive(path string) (*Manifest, error) {
+	zr, err := zip.OpenReader(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open archive")
+	}
+	defer zr.Close()
+
+	entries := make(map[string]*zip.File)
+	for _, f := range zr.File {
+		entries[f.Name] = f
+	}
+	manifestEntry, exists := entries[manifestName]
+	if !exists {
+		return nil, errors.New("archive has no manifest")
+	}
+	r, err := manifestEntry.Open()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open manifest")
+	}
+	defer r.Close()
+	var m Manifest
+	if err = json.NewDecoder(r).Decode(&m); err != nil {
+		return nil, errors.Wrap(err, "invalid manifest")
+	}
+	if m.FormatVersion < 1 || m.FormatVersion > ArchiveFormatVersion {
+		return nil, errors.Errorf("unsupported archive format version %d, this node supports up to version %d", m.FormatVersion, ArchiveFormatVersion)
+	}
+
+	for _, table := range m.Tables {
+		entry, exists := entries[tableEntryName(table.Name)]
+		if table.DataExcluded {
+			if exists {
+				return nil, errors.Errorf("archive holds data of excluded table %s", table.Name)
+			}
+			continue
+		}
+		if !exists {
+			return nil, errors.Errorf("archive has no data for table %s", table.Name)
+		}
+		if err = verifyTable(entry, table); err != nil {
+			return nil, errors.Wrapf(err, "invalid data for table %s", table.Name)
+		}
+	}
+	return &m, nil
+}
+
+func verifyTable(entry *zip.File, table TableManifest) error {
+	r, err := entry.Open()
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	// zip checks the CRC of the entry once it is read to the end
+	hash := sha256.New()
+	br := bufio.NewReader(io.TeeReader(r, hash))
+	var rows int64
+	for {
+		_, err = br.ReadSlice('\n')
+		if err == bufio.ErrBufferFull {
+			continue
+		} else if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+		// COPY escapes newlines within values, so there is one row per line
+		rows++
+	}
+	if sum := hex.EncodeToString(hash.Sum(nil)); sum != table.SHA256 {
+		return errors.Errorf("checksum mismatch, expected %s, got %s", table.SHA256, sum)
+	}
+	if rows != table.Rows {
+		return errors.Errorf("expected %d rows, got %d", table.Rows, rows)
+	}
+	return nil
+}
+
+func containsString(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
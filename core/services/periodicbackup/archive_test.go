@@ -0,0 +1,224 @@
+package periodicbackup
+
+import (
+	"archive/zip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/smartcontractkit/chainlink/core/store/config"
+	"github.com/smartcontractkit/chainlink/core/store/migrations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func Test_excludeData(t *testing.T) {
+	tables := map[string]*TableManifest{
+		"pipeline_runs":               {Name: "pipeline_runs"},
+		"pipeline_task_runs":          {Name: "pipeline_task_runs"},
+		"flux_monitor_round_stats_v2": {Name: "flux_monitor_round_stats_v2"},
+		"run_details":                 {Name: "run_details"},
+		"run_details_notes":           {Name: "run_details_notes"},
+		"jobs":                        {Name: "jobs"},
+	}
+	fks := []foreignKey{
+		{"pipeline_task_runs", "pipeline_run_id", "pipeline_runs", true},
+		{"flux_monitor_round_stats_v2", "pipeline_run_id", "pipeline_runs", false},
+		{"run_details", "pipeline_task_run_id", "pipeline_task_runs", true},
+		{"run_details_notes", "run_details_id", "run_details", false},
+		{"run_details_notes", "job_id", "jobs", true},
+	}
+
+	excludeData(tables, fks)
+
+	for name, excluded := range map[string]bool{
+		"pipeline_runs":               true,
+		"pipeline_task_runs":          true,
+		"flux_monitor_round_stats_v2": false,
+		"run_details":                 true,
+		"run_details_notes":           false,
+		"jobs":                        false,
+	} {
+		assert.Equal(t, excluded, tables[name].DataExcluded, name)
+	}
+	assert.Equal(t, []string{"pipeline_run_id"}, tables["flux_monitor_round_stats_v2"].NulledColumns)
+	assert.Equal(t, []string{"run_details_id"}, tables["run_details_notes"].NulledColumns)
+	assert.Empty(t, tables["jobs"].NulledColumns)
+}
+
+func Test_sortByDependencies(t *testing.T) {
+	names := []string{"a", "b", "c", "d"}
+	tables := make(map[string]*TableManifest)
+	for _, name := range names {
+		tables[name] = &TableManifest{Name: name}
+	}
+
+	sorted, err := sortByDependencies(tables, names, []foreignKey{
+		{table: "a", referenced: "c"},
+		{table: "c", referenced: "d"},
+		{table: "b", referenced: "b"},
+	})
+	require.NoError(t, err)
+	var sortedNames []string
+	for _, table := range sorted {
+		sortedNames = append(sortedNames, table.Name)
+	}
+	assert.Equal(t, []string{"d", "c", "a", "b"}, sortedNames)
+
+	_, err = sortByDependencies(tables, names, []foreignKey{
+		{table: "a", referenced: "c"},
+		{table: "c", referenced: "a"},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cycle")
+}
+
+// writeTestArchive writes an archive holding a single table with data
+func writeTestArchive(t *testing.T, m Manifest, data string) string {
+	path := filepath.Join(t.TempDir(), "archive.zip")
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	defer f.Close()
+	zw := zip.NewWriter(f)
+	w, err := zw.Create(tableEntryName(m.Tables[0].Name))
+	require.NoError(t, err)
+	_, err = w.Write([]byte(data))
+	require.NoError(t, err)
+	w, err = zw.Create(manifestName)
+	require.NoError(t, err)
+	require.NoError(t, json.NewEncoder(w).Encode(m))
+	require.NoError(t, zw.Close())
+	return path
+}
+
+func Test_VerifyArchive(t *testing.T) {
+	data := "1\tfoo\n2\tline\\nbreak\n"
+	sum := sha256.Sum256([]byte(data))
+	newManifest := func() Manifest {
+		return Manifest{
+			FormatVersion: ArchiveFormatVersion,
+			Migration:     "0001_initial",
+			Mode:          config.DatabaseBackupModeFull,
+			CreatedAt:     time.Now(),
+			Tables:        []TableManifest{{Name: "foos", Columns: []string{"id", "name"}, Rows: 2, SHA256: hex.EncodeToString(sum[:])}},
+		}
+	}
+
+	m, err := VerifyArchive(writeTestArchive(t, newManifest(), data))
+	require.NoError(t, err)
+	assert.Equal(t, "0001_initial", m.Migration)
+
+	for name, tc := range map[string]struct {
+		modify func(m *Manifest)
+		err    string
+	}{
+		"newer format":        {func(m *Manifest) { m.FormatVersion = ArchiveFormatVersion + 1 }, "unsupported archive format version"},
+		"wrong checksum":      {func(m *Manifest) { m.Tables[0].SHA256 = hex.EncodeToString(make([]byte, 32)) }, "checksum mismatch"},
+		"wrong row count":     {func(m *Manifest) { m.Tables[0].Rows = 3 }, "expected 3 rows, got 2"},
+		"missing table data":  {func(m *Manifest) { m.Tables = append(m.Tables, TableManifest{Name: "bars"}) }, "archive has no data for table bars"},
+		"excluded table data": {func(m *Manifest) { m.Tables[0].DataExcluded = true }, "archive holds data of excluded table foos"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			m := newManifest()
+			tc.modify(&m)
+			_, err := VerifyArchive(writeTestArchive(t, m, data))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.err)
+		})
+	}
+
+	_, err = VerifyArchive(filepath.Join(t.TempDir(), "missing.zip"))
+	require.Error(t, err)
+}
+
+func TestArchive_ExportAndRestore(t *testing.T) {
+	ctx := context.Background()
+	databaseURL := config.NewConfig().DatabaseURL()
+
+	for _, mode := range []config.DatabaseBackupMode{config.DatabaseBackupModeFull, config.DatabaseBackupModeLite} {
+		t.Run(string(mode), func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "archive.zip")
+			f, err := os.Create(path)
+			require.NoError(t, err)
+			exported, err := ExportArchive(ctx, databaseURL.String(), mode, "0.9.9", f)
+			require.NoError(t, err)
+			require.NoError(t, f.Close())
+			assert.Equal(t, migrations.Migrations[len(migrations.Migrations)-1].ID, exported.Migration)
+
+			restoreURL := newMigratedDatabase(t, databaseURL)
+			restored, err := RestoreArchive(ctx, restoreURL, path)
+			require.NoError(t, err)
+			assert.Equal(t, exported.Tables, restored.Tables)
+
+			conn, err := pgconn.Connect(ctx, restoreURL)
+			require.NoError(t, err)
+			defer conn.Close(ctx)
+			for _, table := range exported.Tables {
+				rows, err := query(ctx, conn, fmt.Sprintf(`SELECT count(*) FROM %s`, table.Name))
+				require.NoError(t, err)
+				assert.Equal(t, fmt.Sprint(table.Rows), rows[0][0], table.Name)
+			}
+		})
+	}
+
+	t.Run("refuses to restore into a database at another migration", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "archive.zip")
+		f, err := os.Create(path)
+		require.NoError(t, err)
+		_, err = ExportArchive(ctx, databaseURL.String(), config.DatabaseBackupModeFull, "0.9.9", f)
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+
+		restoreURL := newMigratedDatabase(t, databaseURL)
+		db, err := gorm.Open(postgres.Open(restoreURL), &gorm.Config{})
+		require.NoError(t, err)
+		require.NoError(t, migrations.Rollback(db, migrations.Migrations[len(migrations.Migrations)-1]))
+		sqlDB, err := db.DB()
+		require.NoError(t, err)
+		require.NoError(t, sqlDB.Close())
+
+		_, err = RestoreArchive(ctx, restoreURL, path)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "but the database is at migration")
+	})
+}
+
+// newMigratedDatabase creates a new, fully migrated database on the server of
+// databaseURL and returns its URL
+func newMigratedDatabase(t *testing.T, databaseURL url.URL) string {
+	ctx := context.Background()
+	conn, err := pgconn.Connect(ctx, databaseURL.String())
+	require.NoError(t, err)
+	defer conn.Close(ctx)
+
+	name := fmt.Sprintf("chainlink_test_restore_%d", time.Now().UnixNano())
+	_, err = conn.Exec(ctx, "CREATE DATABASE "+name).ReadAll()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		conn, err := pgconn.Connect(ctx, databaseURL.String())
+		require.NoError(t, err)
+		defer conn.Close(ctx)
+		_, err = conn.Exec(ctx, "DROP DATABASE IF EXISTS "+name).ReadAll()
+		assert.NoError(t, err)
+	})
+
+	u := databaseURL
+	u.Path = "/" + name
+	db, err := gorm.Open(postgres.Open(u.String()), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, migrations.Migrate(db))
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	require.NoError(t, sqlDB.Close())
+	return u.String()
+}
@@ -1,12 +1,14 @@
 package periodicbackup
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	"net/url"
 	"os"
-	"os/exec"
 	"path/filepath"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -15,10 +17,13 @@ import (
 	"github.com/smartcontractkit/chainlink/core/static"
 	"github.com/smartcontractkit/chainlink/core/store/config"
 	"github.com/smartcontractkit/chainlink/core/utils"
+	"go.uber.org/multierr"
 )
 
 var (
-	filePattern        = "cl_backup_%s.dump"
+	archivePrefix      = "cl_backup_"
+	archiveSuffix      = ".zip"
+	filePattern        = archivePrefix + "%s_%s" + archiveSuffix
 	minBackupFrequency = time.Minute
 
 	excludedDataFromTables = []string{
@@ -28,10 +33,9 @@ var (
 )
 
 type backupResult struct {
-	size            int64
-	path            string
-	maskedArguments []string
-	pgDumpArguments []string
+	size     int64
+	path     string
+	manifest *Manifest
 }
 
 type (
@@ -46,6 +50,9 @@ type (
 		mode            config.DatabaseBackupMode
 		frequency       time.Duration
 		outputParentDir string
+		destination     Destination
+		retentionCount  uint32
+		retentionPeriod time.Duration
 		done            chan bool
 		utils.StartStopOnce
 	}
@@ -55,25 +62,25 @@ type (
 		DatabaseBackupFrequency() time.Duration
 		DatabaseBackupURL() *url.URL
 		DatabaseBackupDir() string
+		DatabaseBackupRetentionCount() uint32
+		DatabaseBackupRetentionPeriod() time.Duration
+		DatabaseBackupS3URL() (*url.URL, error)
 		DatabaseURL() url.URL
 		RootDir() string
 	}
 )
 
-func NewDatabaseBackup(config Config, logger *logger.Logger) DatabaseBackup {
+func NewDatabaseBackup(config Config, logger *logger.Logger) (DatabaseBackup, error) {
 	dbUrl := config.DatabaseURL()
 	dbBackupUrl := config.DatabaseBackupURL()
 	if dbBackupUrl != nil {
 		dbUrl = *dbBackupUrl
 	}
 
-	outputParentDir := filepath.Join(config.RootDir(), "backup")
-	if config.DatabaseBackupDir() != "" {
-		dir, err := filepath.Abs(config.DatabaseBackupDir())
-		if err != nil {
-			logger.Errorf("Invalid path for DATABASE_BACKUP_DIR (%s) - please set it to a valid directory path", config.DatabaseBackupDir())
-		}
-		outputParentDir = dir
+	outputParentDir := OutputDir(config)
+	destination, err := NewDestination(config)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid DATABASE_BACKUP_S3_URL")
 	}
 
 	return &databaseBackup{
@@ -82,9 +89,38 @@ func NewDatabaseBackup(config Config, logger *logger.Logger) DatabaseBackup {
 		config.DatabaseBackupMode(),
 		config.DatabaseBackupFrequency(),
 		outputParentDir,
+		destination,
+		config.DatabaseBackupRetentionCount(),
+		config.DatabaseBackupRetentionPeriod(),
 		make(chan bool),
 		utils.StartStopOnce{},
+	}, nil
+}
+
+// OutputDir is the directory backups are written to, and kept in unless they
+// are uploaded to S3
+func OutputDir(config Config) string {
+	outputParentDir := filepath.Join(config.RootDir(), "backup")
+	if config.DatabaseBackupDir() != "" {
+		dir, err := filepath.Abs(config.DatabaseBackupDir())
+		if err != nil {
+			logger.Errorf("Invalid path for DATABASE_BACKUP_DIR (%s) - please set it to a valid directory path", config.DatabaseBackupDir())
+		}
+		outputParentDir = dir
+	}
+	return outputParentDir
+}
+
+// NewDestination returns the Destination backups are kept in
+func NewDestination(config Config) (Destination, error) {
+	s3URL, err := config.DatabaseBackupS3URL()
+	if err != nil {
+		return nil, err
+	}
+	if s3URL != nil {
+		return NewS3Destination(s3URL)
 	}
+	return NewLocalDestination(OutputDir(config)), nil
 }
 
 func (backup *databaseBackup) Start() error {
@@ -123,18 +159,23 @@ func (backup *databaseBackup) frequencyIsTooSmall() bool {
 }
 
 func (backup *databaseBackup) RunBackupGracefully(version string) {
-	backup.logger.Debugw("DatabaseBackup: Starting database backup...", "mode", backup.mode, "url", backup.databaseURL.String(), "directory", backup.outputParentDir)
+	backup.logger.Debugw("DatabaseBackup: Starting database backup...", "mode", backup.mode, "url", backup.databaseURL.Redacted(), "directory", backup.outputParentDir)
 	startAt := time.Now()
 	result, err := backup.runBackup(version)
 	duration := time.Since(startAt)
 	if err != nil {
 		backup.logger.Errorw("DatabaseBackup: Failed", "duration", duration, "error", err)
-	} else {
-		backup.logger.Infow("DatabaseBackup: Database backup finished successfully.", "duration", duration, "fileSize", result.size, "filePath", result.path)
+		return
+	}
+	backup.logger.Infow("DatabaseBackup: Database backup finished successfully.", "duration", duration, "fileSize", result.size, "filePath", result.path)
+
+	if err = backup.applyRetention(); err != nil {
+		backup.logger.Errorw("DatabaseBackup: Failed to delete old backups", "error", err)
 	}
 }
 
 func (backup *databaseBackup) runBackup(version string) (*backupResult, error) {
+	ctx := context.Background()
 
 	err := os.MkdirAll(backup.outputParentDir, os.ModePerm)
 	if err != nil {
@@ -144,72 +185,68 @@ func (backup *databaseBackup) runBackup(version string) (*backupResult, error) {
 	if err != nil {
 		return nil, errors.Wrap(err, "DatabaseBackup: Failed to create a tmp file")
 	}
-	err = os.Remove(tmpFile.Name())
-	if err != nil {
-		return nil, errors.Wrap(err, "Failed to remove the tmp file before running backup")
-	}
+	defer func() {
+		_ = tmpFile.Close()
+		_ = os.Remove(tmpFile.Name())
+	}()
 
-	args := []string{
-		backup.databaseURL.String(),
-		"-f", tmpFile.Name(),
-		"-F", "c", // format: custom (zipped)
+	if version == "" {
+		version = "unknown"
 	}
-
-	if backup.mode == config.DatabaseBackupModeLite {
-		for _, table := range excludedDataFromTables {
-			args = append(args, fmt.Sprintf("--exclude-table-data=%s", table))
-		}
+	_, err = ExportArchive(ctx, backup.databaseURL.String(), backup.mode, version, tmpFile)
+	if err != nil {
+		// The error may quote the database URL, including its password
+		return nil, errors.New(strings.ReplaceAll(err.Error(), backup.databaseURL.String(), backup.databaseURL.Redacted()))
 	}
-
-	maskArgs := func(args []string) []string {
-		masked := make([]string, len(args))
-		copy(masked, args)
-		masked[0] = backup.databaseURL.Redacted()
-		return masked
+	if err = tmpFile.Close(); err != nil {
+		return nil, errors.Wrap(err, "Failed to write the backup file")
 	}
-
-	maskedArgs := maskArgs(args)
-	backup.logger.Debugf("DatabaseBackup: Running pg_dump with: %v", maskedArgs)
-
-	cmd := exec.Command(
-		"pg_dump", args...,
-	)
-
-	_, err = cmd.Output()
-
+	manifest, err := VerifyArchive(tmpFile.Name())
 	if err != nil {
-		partialResult := &backupResult{
-			size:            0,
-			path:            "",
-			maskedArguments: maskedArgs,
-			pgDumpArguments: args,
-		}
-		if ee, ok := err.(*exec.ExitError); ok {
-			return partialResult, errors.Wrap(err, fmt.Sprintf("pg_dump failed with output: %s", string(ee.Stderr)))
-		}
-		return partialResult, errors.Wrap(err, "pg_dump failed")
+		return nil, errors.Wrap(err, "Backup file failed verification")
 	}
-
-	if version == "" {
-		version = "unknown"
-	}
-	finalFilePath := filepath.Join(backup.outputParentDir, fmt.Sprintf(filePattern, version))
-	_ = os.Remove(finalFilePath)
-	err = os.Rename(tmpFile.Name(), finalFilePath)
+	file, err := os.Stat(tmpFile.Name())
 	if err != nil {
-		_ = os.Remove(tmpFile.Name())
-		return nil, errors.Wrap(err, "Failed to rename the temp file to the final backup file")
+		return nil, errors.Wrap(err, "Failed to access the backup file")
 	}
 
-	file, err := os.Stat(finalFilePath)
-	if err != nil {
-		return nil, errors.Wrap(err, "Failed to access the final backup file")
+	name := fmt.Sprintf(filePattern, version, manifest.CreatedAt.Format("20060102T150405Z"))
+	if err = backup.destination.Put(ctx, name, tmpFile.Name()); err != nil {
+		return nil, errors.Wrap(err, "Failed to store the backup file")
 	}
 
 	return &backupResult{
-		size:            file.Size(),
-		path:            finalFilePath,
-		maskedArguments: maskedArgs,
-		pgDumpArguments: args,
+		size:     file.Size(),
+		path:     backup.destination.Location(name),
+		manifest: manifest,
 	}, nil
 }
+
+// applyRetention deletes the backups that are not among the retentionCount
+// most recent ones, or older than retentionPeriod. The most recent backup is
+// always kept.
+func (backup *databaseBackup) applyRetention() error {
+	ctx := context.Background()
+	archives, err := backup.destination.List(ctx)
+	if err != nil {
+		return err
+	}
+	sort.Slice(archives, func(i, j int) bool {
+		return archives[i].ModTime.After(archives[j].ModTime)
+	})
+
+	var merr error
+	for i, archive := range archives {
+		if i == 0 {
+			continue
+		}
+		tooMany := backup.retentionCount > 0 && i >= int(backup.retentionCount)
+		tooOld := backup.retentionPeriod > 0 && time.Since(archive.ModTime) > backup.retentionPeriod
+		if !tooMany && !tooOld {
+			continue
+		}
+		backup.logger.Infow("DatabaseBackup: Deleting old backup", "filePath", backup.destination.Location(archive.Name), "modTime", archive.ModTime)
+		merr = multierr.Append(merr, backup.destination.Delete(ctx, archive.Name))
+	}
+	return merr
+}
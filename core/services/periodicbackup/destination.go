@@ -0,0 +1,87 @@
+package periodicbackup
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+type (
+	// Destination stores backup archives
+	Destination interface {
+		// Put stores the archive at path as name. The file at path may be
+		// moved by Put.
+		Put(ctx context.Context, name string, path string) error
+		// Open returns the contents of the archive name
+		Open(ctx context.Context, name string) (io.ReadCloser, error)
+		// List returns the backup archives held by the destination
+		List(ctx context.Context) ([]ArchiveInfo, error)
+		Delete(ctx context.Context, name string) error
+		// Location describes where name is stored, for logging
+		Location(name string) string
+	}
+
+	// ArchiveInfo describes a backup archive held by a Destination
+	ArchiveInfo struct {
+		Name    string
+		Size    int64
+		ModTime time.Time
+	}
+
+	localDestination struct {
+		dir string
+	}
+)
+
+var _ Destination = &localDestination{}
+
+// NewLocalDestination returns a Destination keeping archives in dir
+func NewLocalDestination(dir string) Destination {
+	return &localDestination{dir}
+}
+
+// isArchiveName matches the files written by the periodic backup
+func isArchiveName(name string) bool {
+	return strings.HasPrefix(name, archivePrefix) && strings.HasSuffix(name, archiveSuffix)
+}
+
+func (d *localDestination) Put(_ context.Context, name string, path string) error {
+	if err := os.MkdirAll(d.dir, os.ModePerm); err != nil {
+		return errors.Wrapf(err, "failed to create directory %s", d.dir)
+	}
+	return errors.Wrap(os.Rename(path, d.Location(name)), "failed to move archive")
+}
+
+func (d *localDestination) Open(_ context.Context, name string) (io.ReadCloser, error) {
+	return os.Open(d.Location(name))
+}
+
+func (d *localDestination) List(context.Context) ([]ArchiveInfo, error) {
+	files, err := ioutil.ReadDir(d.dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, errors.Wrapf(err, "failed to list directory %s", d.dir)
+	}
+	var archives []ArchiveInfo
+	for _, f := range files {
+		if f.Mode().IsRegular() && isArchiveName(f.Name()) {
+			archives = append(archives, ArchiveInfo{f.Name(), f.Size(), f.ModTime()})
+		}
+	}
+	return archives, nil
+}
+
+func (d *localDestination) Delete(_ context.Context, name string) error {
+	return os.Remove(d.Location(name))
+}
+
+func (d *localDestination) Location(name string) string {
+	return filepath.Join(d.dir, filepath.Base(name))
+}
@@ -0,0 +1,117 @@
+package periodicbackup
+
+import (
+	"archive/zip"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+// RestoreArchive replaces the data of the database with the snapshot in the
+// archive at path, in a single transaction. The database must have been
+// migrated to the migration the snapshot was taken at, and no node may be
+// using it.
+func RestoreArchive(ctx context.Context, databaseURL string, path string) (*Manifest, error) {
+	m, err := VerifyArchive(path)
+	if err != nil {
+		return nil, err
+	}
+
+	zr, err := zip.OpenReader(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open archive")
+	}
+	defer zr.Close()
+	entries := make(map[string]*zip.File)
+	for _, f := range zr.File {
+		entries[f.Name] = f
+	}
+
+	conn, err := pgconn.Connect(ctx, databaseURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to connect to database")
+	}
+	defer conn.Close(ctx)
+
+	if _, err = conn.Exec(ctx, "BEGIN").ReadAll(); err != nil {
+		return nil, errors.Wrap(err, "failed to begin transaction")
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			_, _ = conn.Exec(ctx, "ROLLBACK").ReadAll()
+		}
+	}()
+
+	migration, err := currentMigration(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+	if migration != m.Migration {
+		return nil, errors.Errorf("backup was taken at migration %s but the database is at migration %s", m.Migration, migration)
+	}
+
+	tables := make([]string, len(m.Tables))
+	for i, table := range m.Tables {
+		tables[i] = pgx.Identifier{table.Name}.Sanitize()
+	}
+	if len(tables) > 0 {
+		if _, err = conn.Exec(ctx, fmt.Sprintf("TRUNCATE %s CASCADE", strings.Join(tables, ", "))).ReadAll(); err != nil {
+			return nil, errors.Wrap(err, "failed to empty tables")
+		}
+	}
+
+	for _, table := range m.Tables {
+		if table.DataExcluded {
+			continue
+		}
+		if err = restoreTable(ctx, conn, entries[tableEntryName(table.Name)], table); err != nil {
+			return nil, errors.Wrapf(err, "failed to restore table %s", table.Name)
+		}
+	}
+
+	for _, seq := range m.Sequences {
+		name := pgx.Identifier{seq.Name}.Sanitize()
+		sql := fmt.Sprintf("ALTER SEQUENCE %s RESTART", name)
+		if seq.LastValue.Valid {
+			sql = fmt.Sprintf("SELECT setval('%s', %s, true)", strings.ReplaceAll(name, "'", "''"), strconv.FormatInt(seq.LastValue.Int64, 10))
+		}
+		if _, err = conn.Exec(ctx, sql).ReadAll(); err != nil {
+			return nil, errors.Wrapf(err, "failed to restore sequence %s", seq.Name)
+		}
+	}
+
+	if _, err = conn.Exec(ctx, "COMMIT").ReadAll(); err != nil {
+		return nil, errors.Wrap(err, "failed to commit restore")
+	}
+	committed = true
+	logger.Infow("DatabaseBackup: Restored database", "migration", m.Migration, "createdAt", m.CreatedAt, "tables", len(m.Tables))
+	return m, nil
+}
+
+func restoreTable(ctx context.Context, conn *pgconn.PgConn, entry *zip.File, table TableManifest) error {
+	r, err := entry.Open()
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	columns := make([]string, len(table.Columns))
+	for i, c := range table.Columns {
+		columns[i] = pgx.Identifier{c}.Sanitize()
+	}
+	tag, err := conn.CopyFrom(ctx, r, fmt.Sprintf("COPY %s (%s) FROM STDIN", pgx.Identifier{table.Name}.Sanitize(), strings.Join(columns, ", ")))
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() != table.Rows {
+		return errors.Errorf("expected %d rows, restored %d", table.Rows, tag.RowsAffected())
+	}
+	return nil
+}
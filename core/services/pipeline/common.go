@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql/driver"
 	"encoding/json"
+	"math/big"
 	"net/url"
 	"reflect"
 	"sort"
@@ -14,6 +15,7 @@ import (
 	"github.com/mitchellh/mapstructure"
 	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils"
@@ -49,6 +51,8 @@ type (
 		JobPipelineMaxRunDuration() time.Duration
 		JobPipelineReaperInterval() time.Duration
 		JobPipelineReaperThreshold() time.Duration
+		JobPipelineResponseCacheMaxStaleness() time.Duration
+		JobPipelineResponseCacheSize() uint64
 	}
 )
 
@@ -121,6 +125,21 @@ type TaskRunResult struct {
 	Result     Result
 	CreatedAt  time.Time
 	FinishedAt null.Time
+	// Skipped is true if the task was not run because it is downstream of a
+	// conditional task that evaluated to false
+	Skipped bool
+	// Attempts are all executions of a task with retries, including the last
+	// one whose result this is
+	Attempts TaskRunAttempts
+}
+
+// attempt returns the execution of the task that produced result
+func (result TaskRunResult) attempt() TaskRunAttempt {
+	return TaskRunAttempt{
+		CreatedAt:  result.CreatedAt,
+		FinishedAt: result.FinishedAt.Time,
+		Error:      result.Result.ErrorDB(),
+	}
 }
 
 func (result *TaskRunResult) IsPending() bool {
@@ -228,11 +247,13 @@ const (
 	TaskTypeCBORParse       TaskType = "cborparse"
 	TaskTypeAny             TaskType = "any"
 	TaskTypeVRF             TaskType = "vrf"
+	TaskTypeVRFV2           TaskType = "vrfv2"
 	TaskTypeETHCall         TaskType = "ethcall"
 	TaskTypeETHTx           TaskType = "ethtx"
 	TaskTypeETHABIEncode    TaskType = "ethabiencode"
 	TaskTypeETHABIDecode    TaskType = "ethabidecode"
 	TaskTypeETHABIDecodeLog TaskType = "ethabidecodelog"
+	TaskTypeConditional     TaskType = "conditional"
 
 	// Testing only.
 	TaskTypePanic TaskType = "panic"
@@ -243,6 +264,7 @@ var (
 	bytesType   = reflect.TypeOf([]byte(nil))
 	bytes20Type = reflect.TypeOf([20]byte{})
 	int32Type   = reflect.TypeOf(int32(0))
+	uint32Type  = reflect.TypeOf(uint32(0))
 )
 
 func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID string) (_ Task, err error) {
@@ -282,6 +304,8 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 		task = &DivideTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeVRF:
 		task = &VRFTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeVRFV2:
+		task = &VRFTaskV2{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeETHCall:
 		task = &ETHCallTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeETHTx:
@@ -294,6 +318,8 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 		task = &ETHABIDecodeLogTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeCBORParse:
 		task = &CBORParseTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeConditional:
+		task = &ConditionalTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	default:
 		return nil, errors.Errorf(`unknown task type: "%v"`, taskType)
 	}
@@ -309,6 +335,9 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 					case int32Type:
 						i, err2 := strconv.ParseInt(data.(string), 10, 32)
 						return int32(i), err2
+					case uint32Type:
+						i, err2 := strconv.ParseUint(data.(string), 10, 32)
+						return uint32(i), err2
 					}
 				}
 				return data, nil
@@ -323,6 +352,9 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 	if err != nil {
 		return nil, err
 	}
+	if err = task.Base().validateRetries(); err != nil {
+		return nil, err
+	}
 	return task, nil
 }
 
@@ -346,3 +378,16 @@ func CheckInputs(inputs []Result, minLen, maxLen, maxErrors int) ([]interface{},
 	}
 	return vals, nil
 }
+
+// getChainByString returns the chain with the given ID, or the default chain
+// if str is empty
+func getChainByString(chainSet evm.ChainSet, str string) (evm.Chain, error) {
+	if str == "" {
+		return chainSet.Default(), nil
+	}
+	id, ok := new(big.Int).SetString(str, 10)
+	if !ok {
+		return nil, errors.Errorf("invalid EVM chain ID: %s", str)
+	}
+	return chainSet.Get(id)
+}
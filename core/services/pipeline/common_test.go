@@ -70,3 +70,21 @@ func Test_UnmarshalTaskFromMap(t *testing.T) {
 		require.Contains(t, err.Error(), "UnmarshalTaskFromMap: UnmarshalTaskFromMap only accepts a map[string]interface{} or a map[string]string")
 	})
 }
+
+func Test_TaskRetriesUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	p, err := pipeline.Parse(`ds1 [type=http url="https://chain.link" retries=3 minBackoff="1s" maxBackoff="1m"];`)
+	require.NoError(t, err)
+	require.Len(t, p.Tasks, 1)
+	base := p.Tasks[0].Base()
+	assert.Equal(t, uint32(3), base.Retries)
+	assert.Equal(t, time.Second, base.MinBackoff)
+	assert.Equal(t, time.Minute, base.MaxBackoff)
+
+	_, err = pipeline.Parse(`ds1 [type=http url="https://chain.link" retries=3 minBackoff="1m" maxBackoff="1s"];`)
+	assert.EqualError(t, err, "UnmarshalTaskFromMap: minBackoff (1m0s) must not be greater than maxBackoff (1s)")
+
+	_, err = pipeline.Parse(`ds1 [type=http url="https://chain.link" retries=-1];`)
+	assert.Error(t, err)
+}
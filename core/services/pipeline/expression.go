@@ -0,0 +1,315 @@
+package pipeline
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+var (
+	ErrBadExpression = errors.New("bad expression")
+
+	exprVariableRegexp = regexp.MustCompile(`^\$\(\s*([a-zA-Z0-9_\.]+)\s*\)`)
+	exprNumberRegexp   = regexp.MustCompile(`^-?[0-9]+(\.[0-9]+)?([eE][-+]?[0-9]+)?`)
+)
+
+// EvaluateExpression evaluates a boolean expression against the given vars.
+//
+// Supported syntax:
+//    literals:    123, -4.5, "a string", 'a string', true, false
+//    variables:   $(task_name), $(task_name.key)
+//    comparison:  ==, !=, <, <=, >, >=
+//    logical:     &&, ||, !
+//    grouping:    ( ... )
+//
+// Numeric values (including numeric strings) are compared as decimals. The
+// ordering operators require both sides to be numeric. The expression must
+// evaluate to a boolean.
+func EvaluateExpression(expr string, vars Vars) (bool, error) {
+	p := &exprParser{input: expr}
+	node, err := p.parse()
+	if err != nil {
+		return false, err
+	}
+	return evalBool(node, vars)
+}
+
+type exprNode interface {
+	eval(vars Vars) (interface{}, error)
+}
+
+type (
+	exprLiteral  struct{ value interface{} }
+	exprVariable struct{ keypath string }
+	exprNot      struct{ operand exprNode }
+	exprBinary   struct {
+		op          string
+		left, right exprNode
+	}
+)
+
+func (n exprLiteral) eval(Vars) (interface{}, error) {
+	return n.value, nil
+}
+
+func (n exprVariable) eval(vars Vars) (interface{}, error) {
+	val, err := vars.Get(n.keypath)
+	if err != nil {
+		return nil, err
+	} else if as, is := val.(error); is {
+		return nil, errors.Wrapf(ErrTooManyErrors, "expression variable %v: %v", n.keypath, as)
+	}
+	return val, nil
+}
+
+func (n exprNot) eval(vars Vars) (interface{}, error) {
+	b, err := evalBool(n.operand, vars)
+	if err != nil {
+		return nil, err
+	}
+	return !b, nil
+}
+
+func (n exprBinary) eval(vars Vars) (interface{}, error) {
+	switch n.op {
+	case "&&", "||":
+		left, err := evalBool(n.left, vars)
+		if err != nil {
+			return nil, err
+		}
+		// Short circuit, so that the right hand side may refer to variables
+		// that only exist when the left hand side allows it
+		if (n.op == "&&" && !left) || (n.op == "||" && left) {
+			return left, nil
+		}
+		return evalBool(n.right, vars)
+	}
+
+	left, err := n.left.eval(vars)
+	if err != nil {
+		return nil, err
+	}
+	right, err := n.right.eval(vars)
+	if err != nil {
+		return nil, err
+	}
+	return compare(n.op, left, right)
+}
+
+func evalBool(node exprNode, vars Vars) (bool, error) {
+	val, err := node.eval(vars)
+	if err != nil {
+		return false, err
+	}
+	var b BoolParam
+	if err := b.UnmarshalPipelineParam(val); err != nil {
+		return false, errors.Wrapf(ErrBadExpression, "expected a boolean, got %T (%v)", val, val)
+	}
+	return bool(b), nil
+}
+
+func compare(op string, left, right interface{}) (bool, error) {
+	l, lErr := toExprDecimal(left)
+	r, rErr := toExprDecimal(right)
+	numeric := lErr == nil && rErr == nil
+
+	switch op {
+	case "==", "!=":
+		var equal bool
+		if numeric {
+			equal = l.Equal(r)
+		} else {
+			equal = fmt.Sprintf("%v", left) == fmt.Sprintf("%v", right)
+		}
+		return equal == (op == "=="), nil
+	}
+
+	if !numeric {
+		return false, errors.Wrapf(ErrBadExpression, "operator %v requires numeric operands, got %T (%v) and %T (%v)", op, left, left, right, right)
+	}
+	switch op {
+	case "<":
+		return l.LessThan(r), nil
+	case "<=":
+		return l.LessThanOrEqual(r), nil
+	case ">":
+		return l.GreaterThan(r), nil
+	case ">=":
+		return l.GreaterThanOrEqual(r), nil
+	default:
+		return false, errors.Wrapf(ErrBadExpression, "unknown operator %v", op)
+	}
+}
+
+func toExprDecimal(val interface{}) (decimal.Decimal, error) {
+	switch v := val.(type) {
+	case bool, nil:
+		return decimal.Decimal{}, errors.Errorf("%T is not numeric", v)
+	case []byte:
+		return utils.ToDecimal(string(v))
+	}
+	return utils.ToDecimal(val)
+}
+
+// exprParser is a simple recursive descent parser for the grammar:
+//    or    := and ( "||" and )*
+//    and   := unary ( "&&" unary )*
+//    unary := "!" unary | cmp
+//    cmp   := term ( ( "==" | "!=" | "<=" | ">=" | "<" | ">" ) term )?
+//    term  := "(" or ")" | literal | variable
+type exprParser struct {
+	input string
+	pos   int
+}
+
+func (p *exprParser) parse() (exprNode, error) {
+	node, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpace()
+	if p.pos != len(p.input) {
+		return nil, p.errorf("unexpected %q", p.input[p.pos:])
+	}
+	return node, nil
+}
+
+func (p *exprParser) parseOr() (exprNode, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.consume("||") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = exprBinary{op: "||", left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *exprParser) parseAnd() (exprNode, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.consume("&&") {
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = exprBinary{op: "&&", left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *exprParser) parseUnary() (exprNode, error) {
+	p.skipSpace()
+	if !strings.HasPrefix(p.input[p.pos:], "!=") && p.consume("!") {
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return exprNot{operand: operand}, nil
+	}
+	return p.parseComparison()
+}
+
+func (p *exprParser) parseComparison() (exprNode, error) {
+	left, err := p.parseTerm()
+	if err != nil {
+		return nil, err
+	}
+	// Two character operators must be checked first
+	for _, op := range []string{"==", "!=", "<=", ">=", "<", ">"} {
+		if p.consume(op) {
+			right, err := p.parseTerm()
+			if err != nil {
+				return nil, err
+			}
+			return exprBinary{op: op, left: left, right: right}, nil
+		}
+	}
+	return left, nil
+}
+
+func (p *exprParser) parseTerm() (exprNode, error) {
+	p.skipSpace()
+	rest := p.input[p.pos:]
+	switch {
+	case rest == "":
+		return nil, p.errorf("unexpected end of expression")
+
+	case p.consume("("):
+		node, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if !p.consume(")") {
+			return nil, p.errorf("missing closing parenthesis")
+		}
+		return node, nil
+
+	case strings.HasPrefix(rest, "$("):
+		m := exprVariableRegexp.FindStringSubmatch(rest)
+		if m == nil {
+			return nil, p.errorf("invalid variable %q", rest)
+		}
+		p.pos += len(m[0])
+		return exprVariable{keypath: m[1]}, nil
+
+	case rest[0] == '"' || rest[0] == '\'':
+		end := strings.IndexByte(rest[1:], rest[0])
+		if end == -1 {
+			return nil, p.errorf("unterminated string")
+		}
+		p.pos += end + 2
+		return exprLiteral{value: rest[1 : end+1]}, nil
+
+	case exprNumberRegexp.MatchString(rest):
+		m := exprNumberRegexp.FindString(rest)
+		p.pos += len(m)
+		d, err := decimal.NewFromString(m)
+		if err != nil {
+			return nil, p.errorf("invalid number %q", m)
+		}
+		return exprLiteral{value: d}, nil
+	}
+
+	for _, kw := range []string{"true", "false"} {
+		if strings.HasPrefix(rest, kw) && (len(rest) == len(kw) || !isIdentChar(rune(rest[len(kw)]))) {
+			p.pos += len(kw)
+			return exprLiteral{value: kw == "true"}, nil
+		}
+	}
+	return nil, p.errorf("unexpected %q", rest)
+}
+
+func (p *exprParser) consume(token string) bool {
+	p.skipSpace()
+	if strings.HasPrefix(p.input[p.pos:], token) {
+		p.pos += len(token)
+		return true
+	}
+	return false
+}
+
+func (p *exprParser) skipSpace() {
+	for p.pos < len(p.input) && unicode.IsSpace(rune(p.input[p.pos])) {
+		p.pos++
+	}
+}
+
+func (p *exprParser) errorf(format string, args ...interface{}) error {
+	return errors.Wrapf(ErrBadExpression, "at position %d: %s", p.pos, fmt.Sprintf(format, args...))
+}
+
+func isIdentChar(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
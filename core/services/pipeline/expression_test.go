@@ -0,0 +1,95 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func TestEvaluateExpression(t *testing.T) {
+	t.Parallel()
+
+	vars := pipeline.NewVarsFrom(map[string]interface{}{
+		"answer":    *mustDecimal(t, "1.5"),
+		"previous":  float64(1),
+		"threshold": "0.5",
+		"flag":      true,
+		"name":      "chainlink",
+		"result":    map[string]interface{}{"value": "42", "ok": "true"},
+	})
+
+	tests := []struct {
+		name string
+		expr string
+		want bool
+	}{
+		{"literal true", "true", true},
+		{"literal false", "false", false},
+		{"bool var", "$(flag)", true},
+		{"bool string var", "$(result.ok)", true},
+		{"not", "!$(flag)", false},
+		{"decimal greater than number", "$(answer) > 1", true},
+		{"decimal less than or equal", "$(answer) <= 1.5", true},
+		{"numeric string comparison", "$(result.value) == 42", true},
+		{"numeric string greater than", "$(threshold) >= 0.6", false},
+		{"string equality", `$(name) == "chainlink"`, true},
+		{"string inequality", `$(name) != 'chainlink'`, false},
+		{"and", "$(answer) > 1 && $(previous) < 1", false},
+		{"or", "$(answer) > 1 || $(previous) < 1", true},
+		{"grouping", "!($(answer) > 1 && $(flag)) || $(previous) == 1", true},
+		{"short circuit skips missing variable", "false && $(missing) > 1", false},
+		{"negative numbers", "-1 < $(previous)", true},
+		{"scientific notation", "1e18 > $(answer)", true},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := pipeline.EvaluateExpression(test.expr, vars)
+			require.NoError(t, err)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestEvaluateExpression_Errors(t *testing.T) {
+	t.Parallel()
+
+	vars := pipeline.NewVarsFrom(map[string]interface{}{
+		"name":   "chainlink",
+		"answer": float64(2),
+		"failed": errors.New("oh no"),
+	})
+
+	tests := []struct {
+		name    string
+		expr    string
+		wantErr error
+	}{
+		{"empty", "", pipeline.ErrBadExpression},
+		{"not a boolean", "$(answer)", pipeline.ErrBadExpression},
+		{"ordering non-numeric", `$(name) > 1`, pipeline.ErrBadExpression},
+		{"missing parenthesis", "($(answer) > 1", pipeline.ErrBadExpression},
+		{"trailing garbage", "$(answer) > 1 foo", pipeline.ErrBadExpression},
+		{"unterminated string", `$(name) == "chainlink`, pipeline.ErrBadExpression},
+		{"missing variable", "$(missing) > 1", pipeline.ErrKeypathNotFound},
+		{"errored variable", "$(failed) == 1", pipeline.ErrTooManyErrors},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := pipeline.EvaluateExpression(test.expr, vars)
+			require.Error(t, err)
+			assert.Equal(t, test.wantErr, errors.Cause(err))
+		})
+	}
+}
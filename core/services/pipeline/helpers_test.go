@@ -4,11 +4,12 @@ import (
 	uuid "github.com/satori/go.uuid"
 	"gorm.io/gorm"
 
-	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
 )
 
 var (
 	NewKeypathFromString = newKeypathFromString
+	NewResponseCache     = newResponseCache
 )
 
 const (
@@ -41,13 +42,24 @@ func (t *HTTPTask) HelperSetDependencies(config Config) {
 	t.config = config
 }
 
-func (t *ETHCallTask) HelperSetDependencies(client eth.Client) {
-	t.ethClient = client
+func (t *HTTPTask) HelperSetResponseCache(cache *responseCache) {
+	t.cache = cache
 }
 
-func (t *ETHTxTask) HelperSetDependencies(db *gorm.DB, config Config, keyStore ETHKeyStore, txManager TxManager) {
+func (t *BridgeTask) HelperSetResponseCache(cache *responseCache) {
+	t.cache = cache
+}
+
+func (t *ETHCallTask) HelperSetDependencies(chainSet evm.ChainSet) {
+	t.chainSet = chainSet
+}
+
+func (t *ETHTxTask) HelperSetDependencies(db *gorm.DB, keyStore ETHKeyStore, chainSet evm.ChainSet) {
 	t.db = db
-	t.config = config
 	t.keyStore = keyStore
-	t.txManager = txManager
+	t.chainSet = chainSet
+}
+
+func (t *VRFTaskV2) HelperSetDependencies(keyStore VRFKeyStore) {
+	t.keyStore = keyStore
 }
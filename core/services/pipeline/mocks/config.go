@@ -186,6 +186,34 @@ func (_m *Config) JobPipelineReaperThreshold() time.Duration {
 	return r0
 }
 
+// JobPipelineResponseCacheMaxStaleness provides a mock function with given fields:
+func (_m *Config) JobPipelineResponseCacheMaxStaleness() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// JobPipelineResponseCacheSize provides a mock function with given fields:
+func (_m *Config) JobPipelineResponseCacheSize() uint64 {
+	ret := _m.Called()
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	return r0
+}
+
 // TriggerFallbackDBPollInterval provides a mock function with given fields:
 func (_m *Config) TriggerFallbackDBPollInterval() time.Duration {
 	ret := _m.Called()
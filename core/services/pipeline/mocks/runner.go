@@ -161,6 +161,45 @@ func (_m *Runner) Run(ctx context.Context, run *pipeline.Run, l logger.Logger, s
 	return r0, r1
 }
 
+// SimulateRun provides a mock function with given fields: ctx, spec, vars, l
+func (_m *Runner) SimulateRun(ctx context.Context, spec pipeline.Spec, vars pipeline.Vars, l logger.Logger) (pipeline.Run, pipeline.TaskRunResults, *pipeline.Simulation, error) {
+	ret := _m.Called(ctx, spec, vars, l)
+
+	var r0 pipeline.Run
+	if rf, ok := ret.Get(0).(func(context.Context, pipeline.Spec, pipeline.Vars, logger.Logger) pipeline.Run); ok {
+		r0 = rf(ctx, spec, vars, l)
+	} else {
+		r0 = ret.Get(0).(pipeline.Run)
+	}
+
+	var r1 pipeline.TaskRunResults
+	if rf, ok := ret.Get(1).(func(context.Context, pipeline.Spec, pipeline.Vars, logger.Logger) pipeline.TaskRunResults); ok {
+		r1 = rf(ctx, spec, vars, l)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(pipeline.TaskRunResults)
+		}
+	}
+
+	var r2 *pipeline.Simulation
+	if rf, ok := ret.Get(2).(func(context.Context, pipeline.Spec, pipeline.Vars, logger.Logger) *pipeline.Simulation); ok {
+		r2 = rf(ctx, spec, vars, l)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(*pipeline.Simulation)
+		}
+	}
+
+	var r3 error
+	if rf, ok := ret.Get(3).(func(context.Context, pipeline.Spec, pipeline.Vars, logger.Logger) error); ok {
+		r3 = rf(ctx, spec, vars, l)
+	} else {
+		r3 = ret.Error(3)
+	}
+
+	return r0, r1, r2, r3
+}
+
 // Start provides a mock function with given fields:
 func (_m *Runner) Start() error {
 	ret := _m.Called()
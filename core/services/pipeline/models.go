@@ -141,6 +141,8 @@ type TaskRun struct {
 	FinishedAt    null.Time         `json:"finishedAt"`
 	Index         int32             `json:"index"`
 	DotID         string            `json:"dotId"`
+	Skipped       bool              `json:"skipped"`
+	Attempts      TaskRunAttempts   `json:"attempts" gorm:"type:jsonb"`
 
 	// Used internally for sorting completed results
 	task Task
@@ -181,6 +183,65 @@ func (tr *TaskRun) IsPending() bool {
 	return !tr.FinishedAt.Valid && tr.Output.Empty() && tr.Error.IsZero()
 }
 
+// Status determines the status of the task run.
+func (tr *TaskRun) Status() TaskRunStatus {
+	if tr.Skipped {
+		return TaskRunStatusSkipped
+	} else if tr.IsPending() {
+		return TaskRunStatusPending
+	} else if !tr.Error.IsZero() {
+		return TaskRunStatusErrored
+	}
+	return TaskRunStatusCompleted
+}
+
+// TaskRunAttempt is one execution of a task run
+type TaskRunAttempt struct {
+	CreatedAt  time.Time   `json:"createdAt"`
+	FinishedAt time.Time   `json:"finishedAt"`
+	Error      null.String `json:"error"`
+}
+
+// TaskRunAttempts are all executions of a task run, oldest first. They are
+// only recorded for tasks with retries.
+type TaskRunAttempts []TaskRunAttempt
+
+// Scan reads the database value and returns an instance.
+func (a *TaskRunAttempts) Scan(value interface{}) error {
+	if value == nil {
+		*a = nil
+		return nil
+	}
+	bytes, ok := value.([]byte)
+	if !ok {
+		return errors.Errorf("TaskRunAttempts#Scan received a value of type %T", value)
+	}
+	return json.Unmarshal(bytes, a)
+}
+
+// Value returns this instance serialized for database storage.
+func (a TaskRunAttempts) Value() (driver.Value, error) {
+	if a == nil {
+		return nil, nil
+	}
+	return json.Marshal(a)
+}
+
+// TaskRunStatus represents the status of a task run
+type TaskRunStatus string
+
+const (
+	// TaskRunStatusPending is used for a task run that is awaiting a result.
+	TaskRunStatusPending TaskRunStatus = "pending"
+	// TaskRunStatusErrored is used for a task run that finished with an error.
+	TaskRunStatusErrored TaskRunStatus = "errored"
+	// TaskRunStatusCompleted is used for a task run that finished successfully.
+	TaskRunStatusCompleted TaskRunStatus = "completed"
+	// TaskRunStatusSkipped is used for a task that was not run because it is
+	// downstream of a conditional that evaluated to false.
+	TaskRunStatusSkipped TaskRunStatus = "skipped"
+)
+
 // RunStatus represents the status of a run
 type RunStatus string
 
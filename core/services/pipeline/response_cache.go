@@ -0,0 +1,206 @@
+package pipeline
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+var promResponseCacheRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "pipeline_task_response_cache_requests",
+	Help: "Number of requests of http and bridge tasks with a cacheTTL by result: hit, miss or stale (an expired response used because the request failed)",
+},
+	[]string{"task_type", "name", "result"},
+)
+
+const (
+	cacheResultHit   = "hit"
+	cacheResultMiss  = "miss"
+	cacheResultStale = "stale"
+)
+
+// responseCache keeps the responses of the http and bridge tasks that have a
+// cacheTTL. It is shared by all jobs of the node, so that jobs making the
+// same request within the TTL make it only once. The total size of the
+// responses is bounded, the least recently used ones are evicted first.
+type responseCache struct {
+	maxSize      uint64
+	maxStaleness time.Duration
+	// requestTimeout bounds the requests shared by concurrent misses
+	requestTimeout time.Duration
+
+	mu      sync.Mutex
+	size    uint64
+	entries map[string]*list.Element
+	// lru holds the entries, most recently used first
+	lru *list.List
+
+	// group shares the request of concurrent misses of the same key
+	group singleflight.Group
+}
+
+type responseCacheEntry struct {
+	key       string
+	response  []byte
+	expiresAt time.Time
+}
+
+// newResponseCache returns a cache of responses with a total size of at
+// most maxSize bytes, whose expired responses are used for up to
+// maxStaleness when requests fail. Requests made to fill the cache time out
+// after requestTimeout. The cache is disabled if maxSize is 0.
+func newResponseCache(maxSize uint64, maxStaleness, requestTimeout time.Duration) *responseCache {
+	return &responseCache{
+		maxSize:        maxSize,
+		maxStaleness:   maxStaleness,
+		requestTimeout: requestTimeout,
+		entries:        make(map[string]*list.Element),
+		lru:            list.New(),
+	}
+}
+
+// responseCacheKey identifies the requests with the given method, URL and
+// body
+func responseCacheKey(method, url string, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(method))
+	h.Write([]byte{0})
+	h.Write([]byte(url))
+	h.Write([]byte{0})
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// fetch returns the cached response for key if it has not expired, or else
+// the response returned by request, which is cached for ttl. If request
+// fails, a response for key that expired less than maxStaleness ago is
+// returned instead of the error. The request is made without the cache if
+// the cache is nil or disabled, or if ttl is 0. taskType and name label the
+// metrics of the cache.
+//
+// Concurrent misses of the same key share a single request. It is made with
+// a context of its own rather than the one of the caller that started it, so
+// that it is not cancelled with that caller, and each caller stops waiting
+// for it when ctx is done.
+func (c *responseCache) fetch(ctx context.Context, key string, ttl time.Duration, taskType TaskType, name string, request func(ctx context.Context) ([]byte, error)) ([]byte, error) {
+	if c == nil || c.maxSize == 0 || ttl <= 0 {
+		return request(ctx)
+	}
+
+	if response, fresh := c.get(key); fresh {
+		promResponseCacheRequests.WithLabelValues(string(taskType), name, cacheResultHit).Inc()
+		return response, nil
+	}
+
+	shared := c.group.DoChan(key, func() (interface{}, error) {
+		requestCtx := context.Background()
+		if c.requestTimeout > 0 {
+			var cancel context.CancelFunc
+			requestCtx, cancel = context.WithTimeout(requestCtx, c.requestTimeout)
+			defer cancel()
+		}
+		response, err := request(requestCtx)
+		if err != nil {
+			return nil, err
+		}
+		c.set(key, response, ttl)
+		return response, nil
+	})
+	var err error
+	select {
+	case result := <-shared:
+		if result.Err == nil {
+			promResponseCacheRequests.WithLabelValues(string(taskType), name, cacheResultMiss).Inc()
+			return result.Val.([]byte), nil
+		}
+		err = result.Err
+	case <-ctx.Done():
+		err = errors.Wrap(ctx.Err(), "gave up waiting for the shared request")
+	}
+
+	if response, ok := c.getStale(key); ok {
+		promResponseCacheRequests.WithLabelValues(string(taskType), name, cacheResultStale).Inc()
+		logger.Warnw("Pipeline task request failed, using an expired cached response",
+			"taskType", taskType,
+			"name", name,
+			"error", err,
+		)
+		return response, nil
+	}
+	promResponseCacheRequests.WithLabelValues(string(taskType), name, cacheResultMiss).Inc()
+	return nil, err
+}
+
+// get returns the response cached for key and whether it has not expired
+func (c *responseCache) get(key string) (response []byte, fresh bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	elem, exists := c.entries[key]
+	if !exists {
+		return nil, false
+	}
+	entry := elem.Value.(*responseCacheEntry)
+	if time.Now().After(entry.expiresAt) {
+		return nil, false
+	}
+	c.lru.MoveToFront(elem)
+	return entry.response, true
+}
+
+// getStale returns the response cached for key if it expired less than
+// maxStaleness ago
+func (c *responseCache) getStale(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	elem, exists := c.entries[key]
+	if !exists {
+		return nil, false
+	}
+	entry := elem.Value.(*responseCacheEntry)
+	if time.Since(entry.expiresAt) > c.maxStaleness {
+		return nil, false
+	}
+	return entry.response, true
+}
+
+func (c *responseCache) set(key string, response []byte, ttl time.Duration) {
+	size := entrySize(key, response)
+	if size > c.maxSize {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if elem, exists := c.entries[key]; exists {
+		c.remove(elem)
+	}
+	c.entries[key] = c.lru.PushFront(&responseCacheEntry{
+		key:       key,
+		response:  response,
+		expiresAt: time.Now().Add(ttl),
+	})
+	c.size += size
+	for c.size > c.maxSize {
+		c.remove(c.lru.Back())
+	}
+}
+
+func (c *responseCache) remove(elem *list.Element) {
+	entry := c.lru.Remove(elem).(*responseCacheEntry)
+	delete(c.entries, entry.key)
+	c.size -= entrySize(entry.key, entry.response)
+}
+
+func entrySize(key string, response []byte) uint64 {
+	return uint64(len(key) + len(response))
+}
@@ -0,0 +1,261 @@
+package pipeline_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+// newCountingServer returns a server that responds with the number of
+// requests it received, or with an error while failing is set
+func newCountingServer(t *testing.T, failing *int32) (*httptest.Server, *int32) {
+	var requests int32
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&requests, 1)
+		if failing != nil && atomic.LoadInt32(failing) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		_, err := fmt.Fprintf(w, `{"request": %d}`, n)
+		require.NoError(t, err)
+	}))
+	t.Cleanup(s.Close)
+	return s, &requests
+}
+
+func TestResponseCacheAttributes(t *testing.T) {
+	t.Parallel()
+
+	p, err := pipeline.Parse(`
+ds1 [type=http url="https://chain.link" cacheTTL="30s"];
+ds2 [type=bridge name="foo" cacheTTL="1m"];
+`)
+	require.NoError(t, err)
+	require.Len(t, p.Tasks, 2)
+	assert.Equal(t, 30*time.Second, p.Tasks[0].(*pipeline.HTTPTask).CacheTTL)
+	assert.Equal(t, time.Minute, p.Tasks[1].(*pipeline.BridgeTask).CacheTTL)
+}
+
+func TestHTTPTask_ResponseCache(t *testing.T) {
+	t.Parallel()
+
+	config, cleanup := cltest.NewConfig(t)
+	defer cleanup()
+
+	newTask := func(url, requestData string, cacheTTL time.Duration) pipeline.HTTPTask {
+		task := pipeline.HTTPTask{
+			BaseTask:    pipeline.NewBaseTask(0, "http", nil, nil, 0),
+			Method:      "POST",
+			URL:         url,
+			RequestData: requestData,
+			CacheTTL:    cacheTTL,
+		}
+		task.HelperSetDependencies(config)
+		return task
+	}
+	run := func(t *testing.T, task pipeline.HTTPTask) pipeline.Result {
+		return task.Run(context.Background(), pipeline.NewVarsFrom(nil), nil)
+	}
+
+	t.Run("shares responses of identical requests", func(t *testing.T) {
+		cache := pipeline.NewResponseCache(1024*1024, time.Hour, time.Minute)
+		s, requests := newCountingServer(t, nil)
+
+		// Two jobs requesting the same pair
+		task1 := newTask(s.URL, `{"from": "ETH", "to": "USD"}`, time.Minute)
+		task1.HelperSetResponseCache(cache)
+		task2 := newTask(s.URL, `{"to": "USD", "from": "ETH"}`, time.Minute)
+		task2.HelperSetResponseCache(cache)
+
+		result := run(t, task1)
+		require.NoError(t, result.Error)
+		assert.Equal(t, `{"request": 1}`, result.Value)
+		result = run(t, task2)
+		require.NoError(t, result.Error)
+		assert.Equal(t, `{"request": 1}`, result.Value)
+		assert.Equal(t, int32(1), atomic.LoadInt32(requests))
+
+		// A different request body
+		task3 := newTask(s.URL, `{"from": "BTC", "to": "USD"}`, time.Minute)
+		task3.HelperSetResponseCache(cache)
+		result = run(t, task3)
+		require.NoError(t, result.Error)
+		assert.Equal(t, `{"request": 2}`, result.Value)
+
+		// A task without cacheTTL
+		task4 := newTask(s.URL, `{"from": "ETH", "to": "USD"}`, 0)
+		task4.HelperSetResponseCache(cache)
+		result = run(t, task4)
+		require.NoError(t, result.Error)
+		assert.Equal(t, `{"request": 3}`, result.Value)
+	})
+
+	t.Run("shares the request of concurrent misses", func(t *testing.T) {
+		cache := pipeline.NewResponseCache(1024*1024, time.Hour, time.Minute)
+		var requests int32
+		release := make(chan struct{})
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			n := atomic.AddInt32(&requests, 1)
+			<-release
+			_, err := fmt.Fprintf(w, `{"request": %d}`, n)
+			require.NoError(t, err)
+		}))
+		t.Cleanup(s.Close)
+
+		task1 := newTask(s.URL, ``, time.Minute)
+		task1.HelperSetResponseCache(cache)
+		task2 := newTask(s.URL, ``, time.Minute)
+		task2.HelperSetResponseCache(cache)
+
+		// The first task starts the request and gives up waiting for it
+		ctx1, cancel1 := context.WithCancel(context.Background())
+		results1 := make(chan pipeline.Result)
+		go func() { results1 <- task1.Run(ctx1, pipeline.NewVarsFrom(nil), nil) }()
+		require.Eventually(t, func() bool { return atomic.LoadInt32(&requests) == 1 }, 5*time.Second, 10*time.Millisecond)
+
+		results2 := make(chan pipeline.Result)
+		go func() { results2 <- run(t, task2) }()
+
+		cancel1()
+		result := <-results1
+		require.Error(t, result.Error)
+		assert.Contains(t, result.Error.Error(), "context canceled")
+
+		// The request is not cancelled with it, and the second task gets
+		// its response
+		close(release)
+		result = <-results2
+		require.NoError(t, result.Error)
+		assert.Equal(t, `{"request": 1}`, result.Value)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+	})
+
+	t.Run("requests again after the TTL", func(t *testing.T) {
+		cache := pipeline.NewResponseCache(1024*1024, time.Hour, time.Minute)
+		s, requests := newCountingServer(t, nil)
+
+		task := newTask(s.URL, ``, 10*time.Millisecond)
+		task.HelperSetResponseCache(cache)
+		require.NoError(t, run(t, task).Error)
+		time.Sleep(20 * time.Millisecond)
+		result := run(t, task)
+		require.NoError(t, result.Error)
+		assert.Equal(t, `{"request": 2}`, result.Value)
+		assert.Equal(t, int32(2), atomic.LoadInt32(requests))
+	})
+
+	t.Run("uses an expired response when the request fails", func(t *testing.T) {
+		var failing int32
+		s, _ := newCountingServer(t, &failing)
+
+		cache := pipeline.NewResponseCache(1024*1024, time.Hour, time.Minute)
+		task := newTask(s.URL, ``, time.Millisecond)
+		task.HelperSetResponseCache(cache)
+		require.NoError(t, run(t, task).Error)
+		time.Sleep(5 * time.Millisecond)
+
+		atomic.StoreInt32(&failing, 1)
+		result := run(t, task)
+		require.NoError(t, result.Error)
+		assert.Equal(t, `{"request": 1}`, result.Value)
+
+		// Unless it expired more than the max staleness ago
+		cache = pipeline.NewResponseCache(1024*1024, time.Millisecond, time.Minute)
+		task.HelperSetResponseCache(cache)
+		atomic.StoreInt32(&failing, 0)
+		require.NoError(t, run(t, task).Error)
+		time.Sleep(5 * time.Millisecond)
+
+		atomic.StoreInt32(&failing, 1)
+		result = run(t, task)
+		require.Error(t, result.Error)
+		assert.Contains(t, result.Error.Error(), "remote server error: 502")
+	})
+
+	t.Run("evicts the least recently used responses", func(t *testing.T) {
+		// Room for one entry of a 64 character key and a short response
+		cache := pipeline.NewResponseCache(100, time.Hour, time.Minute)
+		s, requests := newCountingServer(t, nil)
+
+		task1 := newTask(s.URL, `{"pair": 1}`, time.Minute)
+		task1.HelperSetResponseCache(cache)
+		task2 := newTask(s.URL, `{"pair": 2}`, time.Minute)
+		task2.HelperSetResponseCache(cache)
+
+		require.NoError(t, run(t, task1).Error)
+		require.NoError(t, run(t, task2).Error)
+		result := run(t, task1)
+		require.NoError(t, result.Error)
+		assert.Equal(t, `{"request": 3}`, result.Value)
+		assert.Equal(t, int32(3), atomic.LoadInt32(requests))
+	})
+
+	t.Run("is disabled with a size of 0", func(t *testing.T) {
+		cache := pipeline.NewResponseCache(0, time.Hour, time.Minute)
+		s, requests := newCountingServer(t, nil)
+
+		task := newTask(s.URL, ``, time.Minute)
+		task.HelperSetResponseCache(cache)
+		require.NoError(t, run(t, task).Error)
+		require.NoError(t, run(t, task).Error)
+		assert.Equal(t, int32(2), atomic.LoadInt32(requests))
+	})
+}
+
+func TestBridgeTask_ResponseCache(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	s, requests := newCountingServer(t, nil)
+	bridgeURL, err := url.ParseRequestURI(s.URL)
+	require.NoError(t, err)
+	_, bridge := cltest.NewBridgeType(t, "cached_bridge")
+	bridge.URL = models.WebURL(*bridgeURL)
+	require.NoError(t, store.ORM.DB.Create(&bridge).Error)
+
+	cache := pipeline.NewResponseCache(1024*1024, time.Hour, time.Minute)
+	newTask := func(async string) *pipeline.BridgeTask {
+		task := &pipeline.BridgeTask{
+			BaseTask:    pipeline.NewBaseTask(0, "bridge", nil, nil, 0),
+			Name:        "cached_bridge",
+			RequestData: `{"data": {"from": "ETH", "to": "USD"}}`,
+			Async:       async,
+			CacheTTL:    time.Minute,
+		}
+		task.HelperSetDependencies(store.Config, store.DB, uuid.NewV4())
+		task.HelperSetResponseCache(cache)
+		return task
+	}
+
+	// Jobs with different run meta share the response
+	for i := 0; i < 3; i++ {
+		vars := pipeline.NewVarsFrom(map[string]interface{}{
+			"jobRun": map[string]interface{}{"meta": map[string]interface{}{"job": i}},
+		})
+		result := newTask("").Run(context.Background(), vars, nil)
+		require.NoError(t, result.Error)
+		assert.Equal(t, `{"request": 1}`, result.Value)
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(requests))
+
+	result := newTask("true").Run(context.Background(), pipeline.NewVarsFrom(nil), nil)
+	require.Error(t, result.Error)
+	assert.True(t, strings.Contains(result.Error.Error(), "cacheTTL cannot be used with async bridges"))
+	assert.Equal(t, int32(1), atomic.LoadInt32(requests))
+}
@@ -18,8 +18,8 @@ import (
 	"gopkg.in/guregu/null.v4"
 	"gorm.io/gorm"
 
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
 	"github.com/smartcontractkit/chainlink/core/service"
-	"github.com/smartcontractkit/chainlink/core/services/eth"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 )
 
@@ -36,6 +36,11 @@ type Runner interface {
 	// We expect spec.JobID and spec.JobName to be set for logging/prometheus.
 	// ExecuteRun executes a new run in-memory according to a spec and returns the results.
 	ExecuteRun(ctx context.Context, spec Spec, vars Vars, l logger.Logger) (run Run, trrs TaskRunResults, err error)
+	// SimulateRun executes a new run in-memory like ExecuteRun, without side
+	// effects: ethtx and async bridge tasks record what they would have done
+	// in the returned Simulation instead, async runs are not saved and no
+	// metrics are reported.
+	SimulateRun(ctx context.Context, spec Spec, vars Vars, l logger.Logger) (run Run, trrs TaskRunResults, sim *Simulation, err error)
 	// InsertFinishedRun saves the run results in the database.
 	InsertFinishedRun(db *gorm.DB, run Run, trrs TaskRunResults, saveSuccessfulTaskRuns bool) (int64, error)
 
@@ -51,11 +56,12 @@ type Runner interface {
 type runner struct {
 	orm             ORM
 	config          Config
-	ethClient       eth.Client
+	chainSet        evm.ChainSet
 	ethKeyStore     ETHKeyStore
 	vrfKeyStore     VRFKeyStore
-	txManager       TxManager
 	runReaperWorker utils.SleeperTask
+	// responseCache is shared by the http and bridge tasks of all runs
+	responseCache *responseCache
 
 	utils.StartStopOnce
 	chStop chan struct{}
@@ -92,16 +98,20 @@ var (
 	)
 )
 
-func NewRunner(orm ORM, config Config, ethClient eth.Client, ethks ETHKeyStore, vrfks VRFKeyStore, txManager TxManager) *runner {
+func NewRunner(orm ORM, config Config, chainSet evm.ChainSet, ethks ETHKeyStore, vrfks VRFKeyStore) *runner {
 	r := &runner{
 		orm:         orm,
 		config:      config,
-		ethClient:   ethClient,
+		chainSet:    chainSet,
 		ethKeyStore: ethks,
 		vrfKeyStore: vrfks,
-		txManager:   txManager,
 		chStop:      make(chan struct{}),
 		wgDone:      sync.WaitGroup{},
+		responseCache: newResponseCache(
+			config.JobPipelineResponseCacheSize(),
+			config.JobPipelineResponseCacheMaxStaleness(),
+			config.JobPipelineMaxRunDuration(),
+		),
 	}
 	r.runReaperWorker = utils.NewSleeperTask(
 		utils.SleeperTaskFuncWorker(r.runReaper),
@@ -153,6 +163,8 @@ type memoryTaskRun struct {
 	task   Task
 	inputs []Result // sorted by input index
 	vars   Vars
+	// attempts are the previous failed attempts if this is a retry
+	attempts TaskRunAttempts
 }
 
 // When a task panics, we catch the panic and wrap it in an error for reporting to the scheduler.
@@ -181,11 +193,32 @@ func (r *runner) ExecuteRun(
 	vars Vars,
 	l logger.Logger,
 ) (Run, TaskRunResults, error) {
-	l.Debugw("Initiating tasks for pipeline run of spec", "job ID", spec.JobID, "job name", spec.JobName)
+	return r.executeRun(ctx, spec, vars, l, nil)
+}
+
+func (r *runner) SimulateRun(
+	ctx context.Context,
+	spec Spec,
+	vars Vars,
+	l logger.Logger,
+) (Run, TaskRunResults, *Simulation, error) {
+	sim := NewSimulation()
+	run, trrs, err := r.executeRun(ctx, spec, vars, l, sim)
+	return run, trrs, sim, err
+}
+
+func (r *runner) executeRun(
+	ctx context.Context,
+	spec Spec,
+	vars Vars,
+	l logger.Logger,
+	sim *Simulation,
+) (Run, TaskRunResults, error) {
+	l.Debugw("Initiating tasks for pipeline run of spec", "job ID", spec.JobID, "job name", spec.JobName, "simulated", sim != nil)
 
 	run := NewRun(spec, vars)
 
-	taskRunResults, err := r.run(ctx, &run, vars, l)
+	taskRunResults, err := r.run(ctx, &run, vars, l, sim)
 	if err != nil {
 		return run, nil, err
 	}
@@ -200,7 +233,7 @@ func (r *runner) ExecuteRun(
 	}
 
 	finalResult := taskRunResults.FinalResult()
-	if finalResult.HasErrors() {
+	if finalResult.HasErrors() && sim == nil {
 		PromPipelineRunErrors.WithLabelValues(fmt.Sprintf("%d", spec.JobID), spec.JobName).Inc()
 	}
 
@@ -212,6 +245,7 @@ func (r *runner) run(
 	run *Run,
 	vars Vars,
 	l logger.Logger,
+	sim *Simulation,
 ) (TaskRunResults, error) {
 	l.Debugw("Initiating tasks for pipeline run of spec", "job ID", run.PipelineSpec.JobID, "job name", run.PipelineSpec.JobName)
 
@@ -225,25 +259,31 @@ func (r *runner) run(
 		switch task.Type() {
 		case TaskTypeHTTP:
 			task.(*HTTPTask).config = r.config
+			task.(*HTTPTask).cache = r.responseCache
 		case TaskTypeBridge:
 			task.(*BridgeTask).config = r.config
+			task.(*BridgeTask).cache = r.responseCache
 			task.(*BridgeTask).db = r.orm.DB()
 			task.(*BridgeTask).id = uuid.NewV4()
+			task.(*BridgeTask).simulation = sim
 		case TaskTypeETHCall:
-			task.(*ETHCallTask).ethClient = r.ethClient
+			task.(*ETHCallTask).chainSet = r.chainSet
 		case TaskTypeVRF:
 			task.(*VRFTask).keyStore = r.vrfKeyStore
+		case TaskTypeVRFV2:
+			task.(*VRFTaskV2).keyStore = r.vrfKeyStore
 		case TaskTypeETHTx:
 			task.(*ETHTxTask).db = r.orm.DB()
-			task.(*ETHTxTask).config = r.config
 			task.(*ETHTxTask).keyStore = r.ethKeyStore
-			task.(*ETHTxTask).txManager = r.txManager
+			task.(*ETHTxTask).chainSet = r.chainSet
+			task.(*ETHTxTask).jobID = run.PipelineSpec.JobID
+			task.(*ETHTxTask).simulation = sim
 		default:
 		}
 	}
 
 	// avoid an extra db write if there is no async tasks present or if this is a resumed run
-	if pipeline.HasAsync() {
+	if pipeline.HasAsync() && sim == nil {
 		run.Async = true
 		if run.ID == 0 {
 			if err = r.orm.CreateRun(r.orm.DB(), run); err != nil {
@@ -253,7 +293,7 @@ func (r *runner) run(
 	}
 
 	todo := context.TODO()
-	scheduler := newScheduler(todo, pipeline, run, vars)
+	scheduler := newScheduler(todo, pipeline, run, vars, newRetryLimits(ctx, run, r.config.JobPipelineMaxRunDuration()))
 	go scheduler.Run()
 
 	for taskRun := range scheduler.taskCh {
@@ -275,7 +315,9 @@ func (r *runner) run(
 			}()
 			result := r.executeTaskRun(ctx, run.PipelineSpec, taskRun, l)
 
-			logTaskRunToPrometheus(result, run.PipelineSpec)
+			if sim == nil {
+				logTaskRunToPrometheus(result, run.PipelineSpec)
+			}
 
 			scheduler.report(todo, result)
 		}(taskRun)
@@ -292,7 +334,9 @@ func (r *runner) run(
 		// NOTE: runTime can be very long now because it'll include suspend
 		runTime := run.FinishedAt.Time.Sub(run.CreatedAt)
 		l.Debugw("Finished all tasks for pipeline run", "specID", run.PipelineSpecID, "runTime", runTime)
-		PromPipelineRunTotalTimeToCompletion.WithLabelValues(fmt.Sprintf("%d", run.PipelineSpec.JobID), run.PipelineSpec.JobName).Set(float64(runTime))
+		if sim == nil {
+			PromPipelineRunTotalTimeToCompletion.WithLabelValues(fmt.Sprintf("%d", run.PipelineSpec.JobID), run.PipelineSpec.JobName).Set(float64(runTime))
+		}
 	}
 
 	// Update run results
@@ -309,6 +353,8 @@ func (r *runner) run(
 			DotID:         result.Task.DotID(),
 			CreatedAt:     result.CreatedAt,
 			FinishedAt:    result.FinishedAt,
+			Skipped:       result.Skipped,
+			Attempts:      result.Attempts,
 			task:          result.Task,
 		})
 
@@ -359,14 +405,13 @@ func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryT
 	// - Specific task timeout (task.TaskTimeout)
 	// - Job level task timeout (spec.MaxTaskDuration)
 	// - Passed in context
-	taskTimeout, isSet := taskRun.task.TaskTimeout()
-	if isSet {
-		var cancel context.CancelFunc
-		ctx, cancel = utils.CombinedContext(r.chStop, taskTimeout)
-		defer cancel()
-	} else if spec.MaxTaskDuration != models.Interval(time.Duration(0)) {
+	// The timeout applies to all attempts of a task with retries together.
+	if timeout, isSet := taskTimeout(taskRun.task, spec); isSet {
+		if len(taskRun.attempts) > 0 {
+			timeout -= time.Since(taskRun.attempts[0].CreatedAt)
+		}
 		var cancel context.CancelFunc
-		ctx, cancel = utils.CombinedContext(r.chStop, time.Duration(spec.MaxTaskDuration))
+		ctx, cancel = utils.CombinedContext(r.chStop, timeout)
 		defer cancel()
 	}
 
@@ -399,6 +444,18 @@ func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryT
 	}
 }
 
+// taskTimeout returns the timeout of the task if it has one, or else the
+// MaxTaskDuration of the spec if set
+func taskTimeout(task Task, spec Spec) (time.Duration, bool) {
+	if timeout, isSet := task.TaskTimeout(); isSet {
+		return timeout, true
+	}
+	if spec.MaxTaskDuration != models.Interval(time.Duration(0)) {
+		return time.Duration(spec.MaxTaskDuration), true
+	}
+	return time.Duration(0), false
+}
+
 func logTaskRunToPrometheus(trr TaskRunResult, spec Spec) {
 	elapsed := trr.FinishedAt.Time.Sub(trr.CreatedAt)
 
@@ -435,7 +492,7 @@ func (r *runner) ExecuteAndInsertFinishedRun(ctx context.Context, spec Spec, var
 
 func (r *runner) Run(ctx context.Context, run *Run, l logger.Logger, saveSuccessfulTaskRuns bool) (incomplete bool, err error) {
 	for {
-		trrs, err := r.run(ctx, run, NewVarsFrom(run.Inputs.Val.(map[string]interface{})), l)
+		trrs, err := r.run(ctx, run, NewVarsFrom(run.Inputs.Val.(map[string]interface{})), l, nil)
 		if err != nil {
 			return false, errors.Wrapf(err, "failed to run for spec ID %v", run.PipelineSpec.ID)
 		}
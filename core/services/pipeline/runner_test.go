@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -56,7 +57,7 @@ func Test_PipelineRunner_ExecuteTaskRuns(t *testing.T) {
 	orm := new(mocks.ORM)
 	orm.On("DB").Return(store.DB)
 
-	r := pipeline.NewRunner(orm, store.Config, nil, nil, nil, nil)
+	r := pipeline.NewRunner(orm, store.Config, nil, nil, nil)
 
 	s := fmt.Sprintf(`
 ds1 [type=bridge name="example-bridge" timeout=0 requestData=<{"data": {"coin": "BTC", "market": "USD"}}>]
@@ -233,7 +234,7 @@ func Test_PipelineRunner_ExecuteTaskRunsWithVars(t *testing.T) {
 			orm := new(mocks.ORM)
 			orm.On("DB").Return(store.DB)
 
-			runner := pipeline.NewRunner(orm, store.Config, nil, nil, nil, nil)
+			runner := pipeline.NewRunner(orm, store.Config, nil, nil, nil)
 			specStr := fmt.Sprintf(specTemplate, ds2.URL, ds4.URL, test.includeInputAtKey)
 			p, err := pipeline.Parse(specStr)
 			require.NoError(t, err)
@@ -309,7 +310,7 @@ ds2 -> ds2_parse -> ds2_multiply -> answer1;
 answer1 [type=median                      index=0];
 `, m1.URL, m2.URL)
 
-	r := pipeline.NewRunner(orm, store.Config, nil, nil, nil, nil)
+	r := pipeline.NewRunner(orm, store.Config, nil, nil, nil)
 
 	// If we cancel before an API is finished, we should still get a median.
 	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
@@ -332,7 +333,7 @@ func Test_PipelineRunner_MultipleOutputs(t *testing.T) {
 	defer cleanup()
 	orm := new(mocks.ORM)
 	orm.On("DB").Return(store.DB)
-	r := pipeline.NewRunner(orm, store.Config, nil, nil, nil, nil)
+	r := pipeline.NewRunner(orm, store.Config, nil, nil, nil)
 	input := map[string]interface{}{"val": 2}
 	_, trrs, err := r.ExecuteRun(context.Background(), pipeline.Spec{
 		DotDagSource: `
@@ -361,7 +362,7 @@ func Test_PipelineRunner_MultipleTerminatingOutputs(t *testing.T) {
 	defer cleanup()
 	orm := new(mocks.ORM)
 	orm.On("DB").Return(store.DB)
-	r := pipeline.NewRunner(orm, store.Config, nil, nil, nil, nil)
+	r := pipeline.NewRunner(orm, store.Config, nil, nil, nil)
 	input := map[string]interface{}{"val": 2}
 	_, trrs, err := r.ExecuteRun(context.Background(), pipeline.Spec{
 		DotDagSource: `
@@ -380,6 +381,62 @@ a->b2;`,
 	assert.Equal(t, mustDecimal(t, "12").String(), result.Values[1].(decimal.Decimal).String())
 }
 
+func Test_PipelineRunner_ConditionalTask(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	orm := new(mocks.ORM)
+	orm.On("DB").Return(store.DB)
+	r := pipeline.NewRunner(orm, store.Config, nil, nil, nil)
+	spec := pipeline.Spec{
+		DotDagSource: `
+a [type=multiply input="$(val)" times=2]
+check [type=conditional expression="$(a) > 5"]
+b [type=multiply input="$(a)" times=3]
+c [type=multiply input="$(b)" times=4 index=0]
+d [type=multiply input="$(a)" times=10 index=1]
+a->check->b->c;
+a->d;`,
+	}
+
+	t.Run("runs the branch when the condition is true", func(t *testing.T) {
+		run, trrs, err := r.ExecuteRun(context.Background(), spec, pipeline.NewVarsFrom(map[string]interface{}{"val": 3}), *logger.Default)
+		require.NoError(t, err)
+		require.Len(t, trrs, 5)
+		for _, trr := range trrs {
+			assert.False(t, trr.Skipped)
+		}
+		result := trrs.FinalResult()
+		assert.False(t, result.HasErrors())
+		assert.Equal(t, mustDecimal(t, "72").String(), result.Values[0].(decimal.Decimal).String())
+		assert.Equal(t, mustDecimal(t, "60").String(), result.Values[1].(decimal.Decimal).String())
+		assert.Equal(t, pipeline.RunStatusCompleted, run.State)
+	})
+
+	t.Run("skips the branch when the condition is false", func(t *testing.T) {
+		run, trrs, err := r.ExecuteRun(context.Background(), spec, pipeline.NewVarsFrom(map[string]interface{}{"val": 2}), *logger.Default)
+		require.NoError(t, err)
+		require.Len(t, trrs, 5)
+
+		statuses := make(map[string]pipeline.TaskRunStatus)
+		for _, tr := range run.PipelineTaskRuns {
+			statuses[tr.DotID] = tr.Status()
+		}
+		assert.Equal(t, map[string]pipeline.TaskRunStatus{
+			"a":     pipeline.TaskRunStatusCompleted,
+			"check": pipeline.TaskRunStatusCompleted,
+			"b":     pipeline.TaskRunStatusSkipped,
+			"c":     pipeline.TaskRunStatusSkipped,
+			"d":     pipeline.TaskRunStatusCompleted,
+		}, statuses)
+
+		result := trrs.FinalResult()
+		assert.False(t, result.HasErrors())
+		assert.Nil(t, result.Values[0])
+		assert.Equal(t, mustDecimal(t, "40").String(), result.Values[1].(decimal.Decimal).String())
+		assert.Equal(t, pipeline.RunStatusCompleted, run.State)
+	})
+}
+
 func Test_PipelineRunner_PanicTask_Run(t *testing.T) {
 	store, cleanup := cltest.NewStore(t)
 	defer cleanup()
@@ -389,7 +446,7 @@ func Test_PipelineRunner_PanicTask_Run(t *testing.T) {
 		res.WriteHeader(http.StatusOK)
 		res.Write([]byte(`{"result":10}`))
 	}))
-	r := pipeline.NewRunner(orm, store.Config, nil, nil, nil, nil)
+	r := pipeline.NewRunner(orm, store.Config, nil, nil, nil)
 	spec := pipeline.Spec{
 		DotDagSource: fmt.Sprintf(`
 ds1 [type=http url="%s"]
@@ -453,7 +510,7 @@ func Test_PipelineRunner_AsyncJob_Basic(t *testing.T) {
 	orm := new(mocks.ORM)
 	orm.On("DB").Return(store.DB)
 
-	r := pipeline.NewRunner(orm, store.Config, nil, nil, nil, nil)
+	r := pipeline.NewRunner(orm, store.Config, nil, nil, nil)
 
 	s := fmt.Sprintf(`
 ds1 [type=bridge async=true name="example-bridge" timeout=0 requestData=<{"data": {"coin": "BTC", "market": "USD"}}>]
@@ -582,7 +639,7 @@ func Test_PipelineRunner_AsyncJob_InstantRestart(t *testing.T) {
 	orm := new(mocks.ORM)
 	orm.On("DB").Return(store.DB)
 
-	r := pipeline.NewRunner(orm, store.Config, nil, nil, nil, nil)
+	r := pipeline.NewRunner(orm, store.Config, nil, nil, nil)
 
 	s := fmt.Sprintf(`
 ds1 [type=bridge async=true name="example-bridge" timeout=0 requestData=<{"data": {"coin": "BTC", "market": "USD"}}>]
@@ -663,7 +720,7 @@ func Test_PipelineRunner_FailEarly(t *testing.T) {
 	s := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
 		require.Fail(t, "ds1 shouldn't have been called")
 	}))
-	r := pipeline.NewRunner(orm, store.Config, nil, nil, nil, nil)
+	r := pipeline.NewRunner(orm, store.Config, nil, nil, nil)
 	spec := pipeline.Spec{
 		DotDagSource: fmt.Sprintf(`
 ds_panic [type=panic msg="oh no" failEarly=true]
@@ -680,3 +737,183 @@ ds_panic->ds1->ds_parse->ds_multiply;`, s.URL),
 	require.Equal(t, 1, len(trrs))
 	assert.IsType(t, pipeline.ErrRunPanicked{}, trrs[0].Result.Error)
 }
+
+func Test_PipelineRunner_SimulateRun(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	s1 := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		require.Fail(t, "the async bridge shouldn't have been called")
+	}))
+	defer s1.Close()
+	bridgeURL, err := url.ParseRequestURI(s1.URL)
+	require.NoError(t, err)
+	_, bridge := cltest.NewBridgeType(t, "simulated-bridge")
+	bridge.URL = models.WebURL(*bridgeURL)
+	require.NoError(t, store.ORM.DB.Create(&bridge).Error)
+
+	s2 := httptest.NewServer(fakeStringResponder(t, "9600"))
+	defer s2.Close()
+
+	// No CreateRun expected: simulated async runs are not saved
+	orm := new(mocks.ORM)
+	orm.On("DB").Return(store.DB)
+	r := pipeline.NewRunner(orm, store.Config, nil, nil, nil)
+
+	spec := pipeline.Spec{
+		DotDagSource: fmt.Sprintf(`
+ds1 [type=http method="GET" url="%s"]
+ds1_multiply [type=multiply input="$(ds1)" times="$(jobRun.times)"]
+ds2 [type=bridge async=true name="simulated-bridge" requestData=<{"data": {"value": $(ds1_multiply)}}>]
+ds1->ds1_multiply->ds2;`, s2.URL),
+	}
+	vars := pipeline.NewVarsFrom(map[string]interface{}{
+		"jobRun": map[string]interface{}{"times": 2},
+	})
+
+	run, trrs, sim, err := r.SimulateRun(context.Background(), spec, vars, *logger.Default)
+	require.NoError(t, err)
+	require.Len(t, trrs, 3)
+	assert.False(t, run.Async)
+	assert.Equal(t, int64(0), run.ID)
+	orm.AssertExpectations(t)
+
+	for _, trr := range trrs {
+		require.NoError(t, trr.Result.Error, trr.Task.DotID())
+		switch trr.Task.DotID() {
+		case "ds1_multiply":
+			assert.Equal(t, "19200", trr.Result.Value.(decimal.Decimal).String())
+			assert.Nil(t, sim.Action("ds1_multiply"))
+		case "ds2":
+			action := sim.Action("ds2")
+			require.NotNil(t, action)
+			assert.Equal(t, "simulated-bridge", action["name"])
+			assert.Equal(t, s1.URL, action["url"])
+			requestData, err := json.Marshal(action["requestData"])
+			require.NoError(t, err)
+			assert.Contains(t, string(requestData), `"value":"19200"`)
+		}
+	}
+}
+
+func Test_PipelineRunner_RetriesFailedTasks(t *testing.T) {
+	cfg := cltest.NewTestConfig(t)
+	// Only retry in the runner, not in the http task itself
+	cfg.Set("MAX_HTTP_ATTEMPTS", 1)
+	r := pipeline.NewRunner(new(mocks.ORM), cfg, nil, nil, nil)
+
+	// newServer returns a server that fails the given number of requests
+	// before it succeeds
+	newServer := func(t *testing.T, failures int32) (*httptest.Server, *int32) {
+		var requests int32
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			if atomic.AddInt32(&requests, 1) <= failures {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			_, err := w.Write([]byte(`{"result": 42}`))
+			require.NoError(t, err)
+		}))
+		t.Cleanup(s.Close)
+		return s, &requests
+	}
+
+	findTaskRun := func(t *testing.T, run pipeline.Run, dotID string) pipeline.TaskRun {
+		for _, tr := range run.PipelineTaskRuns {
+			if tr.DotID == dotID {
+				return tr
+			}
+		}
+		require.Failf(t, "task run not found", dotID)
+		return pipeline.TaskRun{}
+	}
+
+	t.Run("succeeds after failed attempts", func(t *testing.T) {
+		s, requests := newServer(t, 2)
+		spec := pipeline.Spec{DotDagSource: fmt.Sprintf(`
+ds1       [type=http url="%s" retries=3 minBackoff="1ms" maxBackoff="5ms"]
+ds1_parse [type=jsonparse path="result"]
+ds1->ds1_parse;`, s.URL)}
+
+		run, _, err := r.ExecuteRun(context.Background(), spec, pipeline.NewVarsFrom(nil), *logger.Default)
+		require.NoError(t, err)
+		require.False(t, run.HasErrors())
+		assert.Equal(t, int32(3), atomic.LoadInt32(requests))
+
+		tr := findTaskRun(t, run, "ds1")
+		require.Len(t, tr.Attempts, 3)
+		assert.Contains(t, tr.Attempts[0].Error.String, "remote server error: 503")
+		assert.Contains(t, tr.Attempts[1].Error.String, "remote server error: 503")
+		assert.False(t, tr.Attempts[2].Error.Valid)
+		assert.Equal(t, tr.Attempts[0].CreatedAt, tr.CreatedAt)
+		assert.True(t, tr.Attempts[1].CreatedAt.After(tr.Attempts[0].FinishedAt))
+		assert.Equal(t, tr.Attempts[2].FinishedAt, tr.FinishedAt.Time)
+		assert.False(t, tr.Error.Valid)
+
+		assert.Nil(t, findTaskRun(t, run, "ds1_parse").Attempts)
+	})
+
+	t.Run("fails when out of retries", func(t *testing.T) {
+		s, requests := newServer(t, 10)
+		spec := pipeline.Spec{DotDagSource: fmt.Sprintf(`ds1 [type=http url="%s" retries=2 minBackoff="1ms"];`, s.URL)}
+
+		run, _, err := r.ExecuteRun(context.Background(), spec, pipeline.NewVarsFrom(nil), *logger.Default)
+		require.NoError(t, err)
+		require.True(t, run.HasErrors())
+		assert.Equal(t, int32(3), atomic.LoadInt32(requests))
+
+		tr := findTaskRun(t, run, "ds1")
+		require.Len(t, tr.Attempts, 3)
+		assert.Contains(t, tr.Error.String, "remote server error: 503")
+	})
+
+	t.Run("does not retry tasks without retries", func(t *testing.T) {
+		s, requests := newServer(t, 1)
+		spec := pipeline.Spec{DotDagSource: fmt.Sprintf(`ds1 [type=http url="%s"];`, s.URL)}
+
+		run, _, err := r.ExecuteRun(context.Background(), spec, pipeline.NewVarsFrom(nil), *logger.Default)
+		require.NoError(t, err)
+		require.True(t, run.HasErrors())
+		assert.Equal(t, int32(1), atomic.LoadInt32(requests))
+		assert.Nil(t, findTaskRun(t, run, "ds1").Attempts)
+	})
+
+	t.Run("does not retry after the task timeout", func(t *testing.T) {
+		s, requests := newServer(t, 10)
+		spec := pipeline.Spec{DotDagSource: fmt.Sprintf(`ds1 [type=http url="%s" timeout="1s" retries=3 minBackoff="2s"];`, s.URL)}
+
+		run, _, err := r.ExecuteRun(context.Background(), spec, pipeline.NewVarsFrom(nil), *logger.Default)
+		require.NoError(t, err)
+		require.True(t, run.HasErrors())
+		assert.Equal(t, int32(1), atomic.LoadInt32(requests))
+		assert.Len(t, findTaskRun(t, run, "ds1").Attempts, 1)
+	})
+
+	t.Run("does not retry after the deadline of the run", func(t *testing.T) {
+		s, requests := newServer(t, 10)
+		spec := pipeline.Spec{DotDagSource: fmt.Sprintf(`ds1 [type=http url="%s" retries=3 minBackoff="2s"];`, s.URL)}
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		run, _, err := r.ExecuteRun(ctx, spec, pipeline.NewVarsFrom(nil), *logger.Default)
+		require.NoError(t, err)
+		require.True(t, run.HasErrors())
+		assert.Equal(t, int32(1), atomic.LoadInt32(requests))
+	})
+
+	t.Run("stops waiting to retry when the run is cancelled", func(t *testing.T) {
+		s, requests := newServer(t, 10)
+		spec := pipeline.Spec{DotDagSource: fmt.Sprintf(`ds1 [type=http url="%s" retries=3 minBackoff="1m"];`, s.URL)}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(100*time.Millisecond, cancel)
+		run, _, err := r.ExecuteRun(ctx, spec, pipeline.NewVarsFrom(nil), *logger.Default)
+		require.NoError(t, err)
+		require.True(t, run.HasErrors())
+		assert.Equal(t, int32(1), atomic.LoadInt32(requests))
+
+		tr := findTaskRun(t, run, "ds1")
+		require.Len(t, tr.Attempts, 1)
+		assert.Contains(t, tr.Error.String, "remote server error: 503")
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"gopkg.in/guregu/null.v4"
 )
@@ -38,6 +39,29 @@ func (s *scheduler) newMemoryTaskRun(task Task) *memoryTaskRun {
 	return run
 }
 
+// retryLimits bound when the failed tasks of a run can be retried
+type retryLimits struct {
+	// ctx is the context of the run, pending retries are abandoned when it is
+	// done
+	ctx context.Context
+	// deadline is the time by which the run must finish, no retry starts
+	// after it. It is zero if the run has no deadline.
+	deadline time.Time
+}
+
+// newRetryLimits returns the limits of a run that must finish by the
+// deadline of ctx and within maxRunDuration of its creation
+func newRetryLimits(ctx context.Context, run *Run, maxRunDuration time.Duration) retryLimits {
+	limits := retryLimits{ctx: ctx}
+	if maxRunDuration > 0 && !run.CreatedAt.IsZero() {
+		limits.deadline = run.CreatedAt.Add(maxRunDuration)
+	}
+	if deadline, ok := ctx.Deadline(); ok && (limits.deadline.IsZero() || deadline.Before(limits.deadline)) {
+		limits.deadline = deadline
+	}
+	return limits
+}
+
 type scheduler struct {
 	ctx          context.Context
 	pipeline     *Pipeline
@@ -46,15 +70,22 @@ type scheduler struct {
 	waiting      uint
 	results      map[int]TaskRunResult
 	vars         Vars
+	// skipped is the set of tasks that have at least one input that was
+	// skipped, or that was a conditional that evaluated to false
+	skipped map[int]bool
+	// attempts are the failed attempts of the tasks that are being retried
+	attempts    map[int]TaskRunAttempts
+	retryLimits retryLimits
 
 	pending bool
 	exiting bool
 
 	taskCh   chan *memoryTaskRun
 	resultCh chan TaskRunResult
+	retryCh  chan *memoryTaskRun
 }
 
-func newScheduler(ctx context.Context, p *Pipeline, run *Run, vars Vars) *scheduler {
+func newScheduler(ctx context.Context, p *Pipeline, run *Run, vars Vars, retryLimits retryLimits) *scheduler {
 	dependencies := make(map[int]uint, len(p.Tasks))
 
 	for id, task := range p.Tasks {
@@ -69,10 +100,14 @@ func newScheduler(ctx context.Context, p *Pipeline, run *Run, vars Vars) *schedu
 		dependencies: dependencies,
 		results:      make(map[int]TaskRunResult, len(p.Tasks)),
 		vars:         vars,
+		skipped:      make(map[int]bool),
+		attempts:     make(map[int]TaskRunAttempts),
+		retryLimits:  retryLimits,
 
 		// taskCh should never block
 		taskCh:   make(chan *memoryTaskRun, len(dependencies)),
 		resultCh: make(chan TaskRunResult),
+		retryCh:  make(chan *memoryTaskRun),
 	}
 
 	// if there's results already present on Run, then this is a resumption. Loop over them and fill results table
@@ -127,19 +162,27 @@ func (s *scheduler) reconstructResults() {
 			Result:     result,
 			CreatedAt:  r.CreatedAt,
 			FinishedAt: r.FinishedAt,
+			Skipped:    r.Skipped,
+			Attempts:   r.Attempts,
 		}
 
-		// store the result in vars
-		if result.Error != nil {
-			s.vars.Set(task.DotID(), result.Error)
-		} else {
-			s.vars.Set(task.DotID(), result.Value)
+		// store the result in vars, skipped tasks have no result
+		if !r.Skipped {
+			if result.Error != nil {
+				s.vars.Set(task.DotID(), result.Error)
+			} else {
+				s.vars.Set(task.DotID(), result.Value)
+			}
 		}
 
 		// mark all outputs as complete
+		skip := skipsOutputs(s.results[task.ID()])
 		for _, output := range task.Outputs() {
 			id := output.ID()
 			s.dependencies[id]--
+			if skip {
+				s.skipped[id] = true
+			}
 		}
 	}
 }
@@ -153,6 +196,11 @@ Loop:
 		var result TaskRunResult
 		select {
 		case result = <-s.resultCh:
+		case taskRun := <-s.retryCh:
+			// the retry is still counted as waiting
+			s.attempts[taskRun.task.ID()] = taskRun.attempts
+			s.taskCh <- taskRun
+			continue
 		case <-s.ctx.Done():
 			now := time.Now()
 			// mark remaining jobs as timeout
@@ -163,6 +211,7 @@ Loop:
 						Result:     Result{Error: ErrTimeout},
 						CreatedAt:  now, // TODO: more accurate start time
 						FinishedAt: null.TimeFrom(now),
+						Attempts:   s.attempts[task.ID()],
 					}
 				}
 			}
@@ -178,6 +227,13 @@ Loop:
 			result.FinishedAt = null.Time{} // not finished
 		}
 
+		if result.Result.Error != nil && s.retry(result) {
+			continue
+		}
+		if result.Task.Base().Retries > 0 && !result.IsPending() {
+			result = s.withAttempts(result)
+		}
+
 		// store task run
 		s.results[result.Task.ID()] = result
 
@@ -207,23 +263,103 @@ Loop:
 			continue
 		}
 
-		for _, output := range result.Task.Outputs() {
-			id := output.ID()
-			s.dependencies[id]--
+		s.scheduleOutputs(result)
+	}
 
-			// if all dependencies are done, schedule task run
-			if s.dependencies[id] == 0 {
-				task := s.pipeline.Tasks[id]
-				run := s.newMemoryTaskRun(task)
+	close(s.taskCh)
+}
 
-				s.taskCh <- run
-				s.waiting++
+// scheduleOutputs marks the result's task as complete for each of its
+// outputs, scheduling any output whose dependencies are all done. Outputs
+// that must be skipped are completed immediately without being run.
+func (s *scheduler) scheduleOutputs(result TaskRunResult) {
+	skip := skipsOutputs(result)
+	for _, output := range result.Task.Outputs() {
+		id := output.ID()
+		s.dependencies[id]--
+		if skip {
+			s.skipped[id] = true
+		}
+
+		// if all dependencies are done, schedule task run
+		if s.dependencies[id] != 0 {
+			continue
+		}
+		task := s.pipeline.Tasks[id]
+		if s.skipped[id] {
+			now := time.Now()
+			skippedResult := TaskRunResult{
+				ID:         uuid.NewV4(),
+				Task:       task,
+				CreatedAt:  now,
+				FinishedAt: null.TimeFrom(now),
+				Skipped:    true,
 			}
+			s.results[id] = skippedResult
+			s.scheduleOutputs(skippedResult)
+			continue
 		}
 
+		run := s.newMemoryTaskRun(task)
+
+		s.taskCh <- run
+		s.waiting++
+	}
+}
+
+// retry schedules another attempt of the task of a failed result after its
+// backoff, if it has retries left that can start within the timeout of the
+// task and the deadline of the run
+func (s *scheduler) retry(result TaskRunResult) bool {
+	task := result.Task
+	failures := s.attempts[task.ID()]
+	if uint32(len(failures)) >= task.Base().Retries || s.retryLimits.ctx.Err() != nil {
+		return false
 	}
 
-	close(s.taskCh)
+	backoff := task.Base().retryBackoff(len(failures) + 1)
+	start := time.Now().Add(backoff)
+	if !s.retryLimits.deadline.IsZero() && start.After(s.retryLimits.deadline) {
+		return false
+	}
+	if timeout, isSet := taskTimeout(task, s.run.PipelineSpec); isSet {
+		firstStart := result.CreatedAt
+		if len(failures) > 0 {
+			firstStart = failures[0].CreatedAt
+		}
+		if start.After(firstStart.Add(timeout)) {
+			return false
+		}
+	}
+
+	taskRun := s.newMemoryTaskRun(task)
+	taskRun.attempts = append(append(TaskRunAttempts{}, failures...), result.attempt())
+	s.waiting++
+
+	go func() {
+		timer := time.NewTimer(backoff)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+			select {
+			case s.retryCh <- taskRun:
+			case <-s.ctx.Done():
+			}
+		case <-s.retryLimits.ctx.Done():
+			// the run is over, so the failed attempt is the final result
+			s.report(s.ctx, result)
+		}
+	}()
+	return true
+}
+
+// withAttempts records the failed attempts of the task of result before it
+// on result, which then starts with the first attempt
+func (s *scheduler) withAttempts(result TaskRunResult) TaskRunResult {
+	attempts := append(append(TaskRunAttempts{}, s.attempts[result.Task.ID()]...), result.attempt())
+	result.Attempts = attempts
+	result.CreatedAt = attempts[0].CreatedAt
+	return result
 }
 
 func (s *scheduler) report(ctx context.Context, result TaskRunResult) {
@@ -0,0 +1,32 @@
+package pipeline
+
+import (
+	"sync"
+)
+
+// Simulation records the side effects that the tasks of a run executed by
+// Runner.SimulateRun would have had. Tasks with side effects, i.e. ethtx and
+// async bridge tasks, record what they would have done instead of doing it.
+type Simulation struct {
+	mu      sync.Mutex
+	actions map[string]map[string]interface{}
+}
+
+// NewSimulation returns an empty Simulation
+func NewSimulation() *Simulation {
+	return &Simulation{actions: make(map[string]map[string]interface{})}
+}
+
+func (s *Simulation) record(dotID string, action map[string]interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.actions[dotID] = action
+}
+
+// Action returns what the task with the given DOT ID would have done, or nil
+// if it has no side effects or did not reach them
+func (s *Simulation) Action(dotID string) map[string]interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.actions[dotID]
+}
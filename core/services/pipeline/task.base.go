@@ -1,6 +1,17 @@
 package pipeline
 
-import "time"
+import (
+	"time"
+
+	"github.com/jpillora/backoff"
+	"github.com/pkg/errors"
+)
+
+// Default backoffs between the attempts of tasks with retries
+const (
+	DefaultTaskMinBackoff = 100 * time.Millisecond
+	DefaultTaskMaxBackoff = 10 * time.Second
+)
 
 type BaseTask struct {
 	outputs []Task
@@ -11,6 +22,12 @@ type BaseTask struct {
 	Index     int32         `mapstructure:"index" json:"-" `
 	Timeout   time.Duration `mapstructure:"timeout"`
 	FailEarly string        `mapstructure:"failEarly"`
+
+	// Retries is the number of times the task is run again after it fails,
+	// waiting an exponential backoff between MinBackoff and MaxBackoff
+	Retries    uint32        `mapstructure:"retries"`
+	MinBackoff time.Duration `mapstructure:"minBackoff"`
+	MaxBackoff time.Duration `mapstructure:"maxBackoff"`
 }
 
 func NewBaseTask(id int, dotID string, inputs, outputs []Task, index int32) BaseTask {
@@ -47,3 +64,34 @@ func (t BaseTask) TaskTimeout() (time.Duration, bool) {
 	}
 	return t.Timeout, true
 }
+
+// retryBackoff returns how long to wait before the next attempt of the task
+// after it failed the given number of times
+func (t BaseTask) retryBackoff(failures int) time.Duration {
+	b := backoff.Backoff{
+		Min:    DefaultTaskMinBackoff,
+		Max:    DefaultTaskMaxBackoff,
+		Factor: 2,
+		Jitter: true,
+	}
+	if t.MinBackoff != 0 {
+		b.Min = t.MinBackoff
+	}
+	if t.MaxBackoff != 0 {
+		b.Max = t.MaxBackoff
+	}
+	if b.Max < b.Min {
+		b.Max = b.Min
+	}
+	return b.ForAttempt(float64(failures - 1))
+}
+
+func (t BaseTask) validateRetries() error {
+	if t.MinBackoff < 0 || t.MaxBackoff < 0 {
+		return errors.New("minBackoff and maxBackoff must not be negative")
+	}
+	if t.MinBackoff != 0 && t.MaxBackoff != 0 && t.MinBackoff > t.MaxBackoff {
+		return errors.Errorf("minBackoff (%v) must not be greater than maxBackoff (%v)", t.MinBackoff, t.MaxBackoff)
+	}
+	return nil
+}
@@ -3,8 +3,10 @@ package pipeline
 import (
 	"context"
 	"encoding/json"
+	"net/http"
 	"net/url"
 	"path"
+	"time"
 
 	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
@@ -26,10 +28,17 @@ type BridgeTask struct {
 	RequestData       string `json:"requestData"`
 	IncludeInputAtKey string `json:"includeInputAtKey"`
 	Async             string `json:"async"`
+	// CacheTTL is how long the response is cached for identical requests of
+	// all jobs, see JOB_PIPELINE_RESPONSE_CACHE_SIZE. It is not cached if 0.
+	// Responses of async bridges are never cached.
+	CacheTTL time.Duration `json:"cacheTTL"`
 
 	db     *gorm.DB
 	config Config
+	cache  *responseCache
 	id     uuid.UUID
+	// simulation is set for runs without side effects
+	simulation *Simulation
 }
 
 var _ Task = (*BridgeTask)(nil)
@@ -61,6 +70,9 @@ func (t *BridgeTask) Run(ctx context.Context, vars Vars, inputs []Result) Result
 	if err != nil {
 		return Result{Error: err}
 	}
+	if t.Async == "true" && t.CacheTTL != 0 {
+		return Result{Error: errors.New("cacheTTL cannot be used with async bridges")}
+	}
 
 	url, err := t.getBridgeURLFromName(name)
 	if err != nil {
@@ -96,6 +108,17 @@ func (t *BridgeTask) Run(ctx context.Context, vars Vars, inputs []Result) Result
 		requestData["responseURL"] = responseURL.String()
 	}
 
+	if t.Async == "true" && t.simulation != nil {
+		// The external adapter would resume the run later, so the request is
+		// recorded instead of sent
+		t.simulation.record(t.DotID(), map[string]interface{}{
+			"name":        string(name),
+			"url":         url.String(),
+			"requestData": requestData,
+		})
+		return Result{Value: nil}
+	}
+
 	// URL is "safe" because it comes from the node's own database
 	// Some node operators may run external adapters on their own hardware
 	allowUnrestrictedNetworkAccess := BoolParam(true)
@@ -109,7 +132,34 @@ func (t *BridgeTask) Run(ctx context.Context, vars Vars, inputs []Result) Result
 		"url", url.String(),
 	)
 
-	responseBytes, headers, elapsed, err := makeHTTPRequest(ctx, "POST", URLParam(url), requestData, allowUnrestrictedNetworkAccess, t.config)
+	var headers http.Header
+	request := func(ctx context.Context) ([]byte, error) {
+		var (
+			responseBytes []byte
+			elapsed       time.Duration
+			err           error
+		)
+		responseBytes, headers, elapsed, err = makeHTTPRequest(ctx, "POST", URLParam(url), requestData, allowUnrestrictedNetworkAccess, t.config)
+		if err != nil {
+			return nil, err
+		}
+		promHTTPFetchTime.WithLabelValues(t.DotID()).Set(float64(elapsed))
+		promHTTPResponseBodySize.WithLabelValues(t.DotID()).Set(float64(len(responseBytes)))
+		return responseBytes, nil
+	}
+
+	var responseBytes []byte
+	if t.Async == "true" {
+		responseBytes, err = request(ctx)
+	} else {
+		// The meta of the run differs between jobs that request the same
+		// data, so it is not part of the key
+		keyJSON, jerr := json.Marshal(withoutMeta(requestData))
+		if jerr != nil {
+			return Result{Error: jerr}
+		}
+		responseBytes, err = t.cache.fetch(ctx, responseCacheKey("POST", url.String(), keyJSON), t.CacheTTL, t.Type(), string(name), request)
+	}
 	if err != nil {
 		return Result{Error: err}
 	}
@@ -134,9 +184,6 @@ func (t *BridgeTask) Run(ctx context.Context, vars Vars, inputs []Result) Result
 	// value instead.
 	result := Result{Value: string(responseBytes)}
 
-	promHTTPFetchTime.WithLabelValues(t.DotID()).Set(float64(elapsed))
-	promHTTPResponseBodySize.WithLabelValues(t.DotID()).Set(float64(len(responseBytes)))
-
 	logger.Debugw("Bridge task: fetched answer",
 		"answer", result.Value,
 		"url", url.String(),
@@ -164,3 +211,13 @@ func withMeta(request MapParam, meta MapParam) MapParam {
 	}
 	return output
 }
+
+func withoutMeta(request MapParam) MapParam {
+	output := make(MapParam)
+	for k, v := range request {
+		if k != "meta" {
+			output[k] = v
+		}
+	}
+	return output
+}
@@ -0,0 +1,66 @@
+package pipeline
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+//
+// Return types:
+//    bool
+//
+// ConditionalTask evaluates a boolean expression. If the result is false, all
+// tasks downstream of it are skipped rather than run.
+//
+// If the task has a single input, its value is available to the expression
+// as $(input). With no expression, the input itself must be a boolean.
+//
+type ConditionalTask struct {
+	BaseTask   `mapstructure:",squash"`
+	Expression string `json:"expression"`
+}
+
+var _ Task = (*ConditionalTask)(nil)
+
+func (t *ConditionalTask) Type() TaskType {
+	return TaskTypeConditional
+}
+
+func (t *ConditionalTask) Run(_ context.Context, vars Vars, inputs []Result) (result Result) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}
+	}
+
+	if strings.TrimSpace(t.Expression) == "" {
+		var condition BoolParam
+		if err = ResolveParam(&condition, From(Input(inputs, 0))); err != nil {
+			return Result{Error: errors.Wrap(err, "input")}
+		}
+		return Result{Value: bool(condition)}
+	}
+
+	if len(inputs) == 1 {
+		vars.Set(InputTaskKey, inputs[0].Value)
+	}
+	condition, err := EvaluateExpression(t.Expression, vars)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "expression")}
+	}
+	return Result{Value: condition}
+}
+
+// skipsOutputs returns true if the task is a conditional that evaluated to
+// false, meaning that everything downstream of it should be skipped
+func skipsOutputs(result TaskRunResult) bool {
+	if result.Skipped {
+		return true
+	}
+	if result.Task.Type() != TaskTypeConditional || result.Result.Error != nil {
+		return false
+	}
+	condition, is := result.Result.Value.(bool)
+	return is && !condition
+}
@@ -0,0 +1,55 @@
+package pipeline_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func TestConditionalTask(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		expression string
+		vars       pipeline.Vars
+		inputs     []pipeline.Result
+		want       pipeline.Result
+		wantErr    error
+	}{
+		{"expression true", "$(answer) > 1", pipeline.NewVarsFrom(map[string]interface{}{"answer": "2"}), nil, pipeline.Result{Value: true}, nil},
+		{"expression false", "$(answer) > 1", pipeline.NewVarsFrom(map[string]interface{}{"answer": "0.5"}), nil, pipeline.Result{Value: false}, nil},
+		{"expression using input", "$(input) == 3", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: float64(3)}}, pipeline.Result{Value: true}, nil},
+		{"no expression, bool input", "", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: false}}, pipeline.Result{Value: false}, nil},
+		{"no expression, string input", "", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: "true"}}, pipeline.Result{Value: true}, nil},
+		{"no expression, no input", "", pipeline.NewVarsFrom(nil), nil, pipeline.Result{}, pipeline.ErrParameterEmpty},
+		{"no expression, non-bool input", "", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: "foo"}}, pipeline.Result{}, pipeline.ErrBadInput},
+		{"errored input", "true", pipeline.NewVarsFrom(nil), []pipeline.Result{{Error: errors.New("foo")}}, pipeline.Result{}, pipeline.ErrTooManyErrors},
+		{"bad expression", "$(answer) >", pipeline.NewVarsFrom(map[string]interface{}{"answer": "2"}), nil, pipeline.Result{}, pipeline.ErrBadExpression},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			task := pipeline.ConditionalTask{
+				BaseTask:   pipeline.NewBaseTask(0, "cond", nil, nil, 0),
+				Expression: test.expression,
+			}
+			result := task.Run(context.Background(), test.vars, test.inputs)
+			if test.wantErr != nil {
+				require.Error(t, result.Error)
+				assert.Equal(t, test.wantErr, errors.Cause(result.Error))
+			} else {
+				require.NoError(t, result.Error)
+				assert.Equal(t, test.want, result)
+			}
+		})
+	}
+}
@@ -9,19 +9,19 @@ import (
 	"github.com/pkg/errors"
 	"go.uber.org/multierr"
 
-	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
 )
 
-//
 // Return types:
-//     []byte
 //
+//	[]byte
 type ETHCallTask struct {
-	BaseTask `mapstructure:",squash"`
-	Contract string `json:"contract"`
-	Data     string `json:"data"`
+	BaseTask   `mapstructure:",squash"`
+	Contract   string `json:"contract"`
+	Data       string `json:"data"`
+	EVMChainID string `json:"evmChainID"`
 
-	ethClient eth.Client
+	chainSet evm.ChainSet
 }
 
 var _ Task = (*ETHCallTask)(nil)
@@ -36,6 +36,11 @@ func (t *ETHCallTask) Run(ctx context.Context, vars Vars, inputs []Result) (resu
 		return Result{Error: errors.Wrap(err, "task inputs")}
 	}
 
+	chain, err := getChainByString(t.chainSet, t.EVMChainID)
+	if err != nil {
+		return Result{Error: errors.Wrapf(ErrBadInput, "evmChainID: %v", err)}
+	}
+
 	var (
 		contractAddr AddressParam
 		data         BytesParam
@@ -55,7 +60,7 @@ func (t *ETHCallTask) Run(ctx context.Context, vars Vars, inputs []Result) (resu
 		Data: []byte(data),
 	}
 
-	resp, err := t.ethClient.CallContract(ctx, call, nil)
+	resp, err := chain.Client().CallContract(ctx, call, nil)
 	if err != nil {
 		return Result{Error: err}
 	}
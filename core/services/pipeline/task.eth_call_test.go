@@ -11,6 +11,8 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	evmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/mocks"
 	ethmocks "github.com/smartcontractkit/chainlink/core/services/eth/mocks"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 )
@@ -20,6 +22,7 @@ func TestETHCallTask(t *testing.T) {
 		name                  string
 		contract              string
 		data                  string
+		evmChainID            string
 		vars                  pipeline.Vars
 		inputs                []pipeline.Result
 		setupClientMock       func(ethClient *ethmocks.Client)
@@ -31,6 +34,7 @@ func TestETHCallTask(t *testing.T) {
 			"happy",
 			"0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF",
 			"$(foo)",
+			"",
 			pipeline.NewVarsFrom(map[string]interface{}{
 				"foo": []byte("foo bar"),
 			}),
@@ -43,10 +47,40 @@ func TestETHCallTask(t *testing.T) {
 			},
 			[]byte("baz quux"), nil, "",
 		},
+		{
+			"happy with evmChainID",
+			"0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF",
+			"$(foo)",
+			"42",
+			pipeline.NewVarsFrom(map[string]interface{}{
+				"foo": []byte("foo bar"),
+			}),
+			nil,
+			func(ethClient *ethmocks.Client) {
+				contractAddr := common.HexToAddress("0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF")
+				ethClient.
+					On("CallContract", mock.Anything, ethereum.CallMsg{To: &contractAddr, Data: []byte("foo bar")}, (*big.Int)(nil)).
+					Return([]byte("baz quux"), nil)
+			},
+			[]byte("baz quux"), nil, "",
+		},
+		{
+			"unknown evmChainID",
+			"0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF",
+			"$(foo)",
+			"1337",
+			pipeline.NewVarsFrom(map[string]interface{}{
+				"foo": []byte("foo bar"),
+			}),
+			nil,
+			func(ethClient *ethmocks.Client) {},
+			nil, pipeline.ErrBadInput, "evmChainID",
+		},
 		{
 			"bad contract address",
 			"0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbee",
 			"$(foo)",
+			"",
 			pipeline.NewVarsFrom(map[string]interface{}{
 				"foo": []byte("foo bar"),
 			}),
@@ -58,6 +92,7 @@ func TestETHCallTask(t *testing.T) {
 			"missing data var",
 			"0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF",
 			"$(foo)",
+			"",
 			pipeline.NewVarsFrom(map[string]interface{}{
 				"zork": []byte("foo bar"),
 			}),
@@ -69,6 +104,7 @@ func TestETHCallTask(t *testing.T) {
 			"no data",
 			"0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF",
 			"$(foo)",
+			"",
 			pipeline.NewVarsFrom(map[string]interface{}{
 				"foo": []byte(nil),
 			}),
@@ -80,6 +116,7 @@ func TestETHCallTask(t *testing.T) {
 			"errored input",
 			"0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF",
 			"$(foo)",
+			"",
 			pipeline.NewVarsFrom(map[string]interface{}{
 				"foo": []byte("foo bar"),
 			}),
@@ -93,14 +130,21 @@ func TestETHCallTask(t *testing.T) {
 		test := test
 		t.Run(test.name, func(t *testing.T) {
 			task := pipeline.ETHCallTask{
-				BaseTask: pipeline.NewBaseTask(0, "ethcall", nil, nil, 0),
-				Contract: test.contract,
-				Data:     test.data,
+				BaseTask:   pipeline.NewBaseTask(0, "ethcall", nil, nil, 0),
+				Contract:   test.contract,
+				Data:       test.data,
+				EVMChainID: test.evmChainID,
 			}
 
 			ethClient := new(ethmocks.Client)
 			test.setupClientMock(ethClient)
-			task.HelperSetDependencies(ethClient)
+			chain := new(evmmocks.Chain)
+			chain.On("Client").Return(ethClient)
+			chainSet := new(evmmocks.ChainSet)
+			chainSet.On("Default").Return(chain).Maybe()
+			chainSet.On("Get", big.NewInt(42)).Return(chain, nil).Maybe()
+			chainSet.On("Get", big.NewInt(1337)).Return(nil, evm.ErrChainNotFound).Maybe()
+			task.HelperSetDependencies(chainSet)
 
 			result := task.Run(context.Background(), test.vars, test.inputs)
 
@@ -2,49 +2,51 @@ package pipeline
 
 import (
 	"context"
+	"math/big"
 	"reflect"
 	"strconv"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/mitchellh/mapstructure"
 	"github.com/pkg/errors"
 	"go.uber.org/multierr"
 	"gorm.io/gorm"
 
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+	"github.com/smartcontractkit/chainlink/core/services/postgres"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 )
 
-//
 // Return types:
-//     nil
 //
+//	nil
 type ETHTxTask struct {
-	BaseTask `mapstructure:",squash"`
-	From     string `json:"from"`
-	To       string `json:"to"`
-	Data     string `json:"data"`
-	GasLimit string `json:"gasLimit"`
-	TxMeta   string `json:"txMeta"`
-
-	db        *gorm.DB
-	config    Config
-	keyStore  ETHKeyStore
-	txManager TxManager
+	BaseTask   `mapstructure:",squash"`
+	From       string `json:"from"`
+	To         string `json:"to"`
+	Data       string `json:"data"`
+	GasLimit   string `json:"gasLimit"`
+	TxMeta     string `json:"txMeta"`
+	EVMChainID string `json:"evmChainID"`
+	Simulate   string `json:"simulate"`
+
+	db       *gorm.DB
+	keyStore ETHKeyStore
+	chainSet evm.ChainSet
+	jobID    int32
+	// simulation is set for runs without side effects
+	simulation *Simulation
 }
 
 //go:generate mockery --name ETHKeyStore --output ./mocks/ --case=underscore
-//go:generate mockery --name TxManager --output ./mocks/ --case=underscore
 
 type ETHKeyStore interface {
 	GetRoundRobinAddress(addrs ...common.Address) (common.Address, error)
 }
 
-type TxManager interface {
-	CreateEthTransaction(db *gorm.DB, fromAddress, toAddress common.Address, payload []byte, gasLimit uint64, meta interface{}, strategy bulletprooftxmanager.TxStrategy) (etx bulletprooftxmanager.EthTx, err error)
-}
-
 var _ Task = (*ETHTxTask)(nil)
 
 func (t *ETHTxTask) Type() TaskType {
@@ -57,19 +59,26 @@ func (t *ETHTxTask) Run(_ context.Context, vars Vars, inputs []Result) (result R
 		return Result{Error: errors.Wrap(err, "task inputs")}
 	}
 
+	chain, err := getChainByString(t.chainSet, t.EVMChainID)
+	if err != nil {
+		return Result{Error: errors.Wrapf(ErrBadInput, "evmChainID: %v", err)}
+	}
+
 	var (
 		fromAddrs AddressSliceParam
 		toAddr    AddressParam
 		data      BytesParam
 		gasLimit  Uint64Param
 		txMetaMap MapParam
+		simulate  BoolParam
 	)
 	err = multierr.Combine(
 		errors.Wrap(ResolveParam(&fromAddrs, From(VarExpr(t.From, vars), JSONWithVarExprs(t.From, vars, false), NonemptyString(t.From), nil)), "from"),
 		errors.Wrap(ResolveParam(&toAddr, From(VarExpr(t.To, vars), NonemptyString(t.To))), "to"),
 		errors.Wrap(ResolveParam(&data, From(VarExpr(t.Data, vars), NonemptyString(t.Data))), "data"),
-		errors.Wrap(ResolveParam(&gasLimit, From(VarExpr(t.GasLimit, vars), NonemptyString(t.GasLimit), t.config.EthGasLimitDefault())), "gasLimit"),
+		errors.Wrap(ResolveParam(&gasLimit, From(VarExpr(t.GasLimit, vars), NonemptyString(t.GasLimit), chain.Config().EthGasLimitDefault())), "gasLimit"),
 		errors.Wrap(ResolveParam(&txMetaMap, From(VarExpr(t.TxMeta, vars), JSONWithVarExprs(t.TxMeta, vars, false), MapParam{})), "txMeta"),
+		errors.Wrap(ResolveParam(&simulate, From(NonemptyString(t.Simulate), false)), "simulate"),
 	)
 	if err != nil {
 		return Result{Error: err}
@@ -103,6 +112,10 @@ func (t *ETHTxTask) Run(_ context.Context, vars Vars, inputs []Result) (result R
 		return Result{Error: errors.Wrapf(ErrBadInput, "txMeta: %v", err)}
 	}
 
+	if txMeta.JobID == 0 {
+		txMeta.JobID = t.jobID
+	}
+
 	fromAddr, err := t.keyStore.GetRoundRobinAddress(fromAddrs...)
 	if err != nil {
 		err = errors.Wrap(err, "ETHTxTask failed to get fromAddress")
@@ -110,14 +123,53 @@ func (t *ETHTxTask) Run(_ context.Context, vars Vars, inputs []Result) (result R
 		return Result{Error: errors.Wrapf(ErrTaskRunFailed, "while querying keystore: %v", err)}
 	}
 
-	// NOTE: This can be easily adjusted later to allow job specs to specify the details of which strategy they would like
-	strategy := bulletprooftxmanager.SendEveryStrategy{}
+	// ethtx transactions transfer no ether, so only their gas counts towards
+	// the limits
+	value := big.NewInt(0)
+
+	if t.simulation != nil {
+		if err = checkTxLimits(t.db, chain, txMeta.JobID, fromAddr, value, []byte(data), uint64(gasLimit)); err != nil {
+			return Result{Error: err}
+		}
+		t.simulation.record(t.DotID(), map[string]interface{}{
+			"from":       fromAddr,
+			"to":         common.Address(toAddr),
+			"data":       hexutil.Bytes(data),
+			"gasLimit":   uint64(gasLimit),
+			"txMeta":     txMeta,
+			"evmChainID": chain.ID().String(),
+			"simulate":   bool(simulate),
+		})
+		return Result{Value: nil}
+	}
 
-	_, err = t.txManager.CreateEthTransaction(t.db, fromAddr, common.Address(toAddr), []byte(data), uint64(gasLimit), &txMeta, strategy)
+	// NOTE: This can be easily adjusted later to allow job specs to specify the details of which strategy they would like
+	strategy := bulletprooftxmanager.NewSendEveryStrategy(bool(simulate))
+
+	// The limits are checked in the transaction that creates the eth_tx, so
+	// that concurrent runs cannot exceed them together
+	err = postgres.GormTransactionWithDefaultContext(t.db, func(tx *gorm.DB) error {
+		if err = checkTxLimits(tx, chain, txMeta.JobID, fromAddr, value, []byte(data), uint64(gasLimit)); err != nil {
+			return err
+		}
+		_, err = chain.TxManager().CreateEthTransaction(tx, fromAddr, common.Address(toAddr), []byte(data), uint64(gasLimit), &txMeta, strategy)
+		if err != nil {
+			return errors.Wrapf(ErrTaskRunFailed, "while creating transaction: %v", err)
+		}
+		return nil
+	})
 	if err != nil {
-		return Result{Error: errors.Wrapf(ErrTaskRunFailed, "while creating transaction: %v", err)}
+		return Result{Error: err}
 	}
 	// TODO(spook): once @archseer's "async jobs" work is merged, return the tx hash of
 	// the successful EthTxAttempt
 	return Result{Value: nil}
 }
+
+func checkTxLimits(db *gorm.DB, chain evm.Chain, jobID int32, fromAddr common.Address, value *big.Int, data []byte, gasLimit uint64) error {
+	err := bulletprooftxmanager.CheckTxLimits(db, chain.Config(), chain.TxManager().GetGasEstimator(), jobID, fromAddr, value, data, gasLimit)
+	if err != nil && !errors.Is(err, bulletprooftxmanager.ErrTxLimitExceeded) {
+		return errors.Wrapf(ErrTaskRunFailed, "while checking transaction limits: %v", err)
+	}
+	return err
+}
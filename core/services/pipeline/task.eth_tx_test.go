@@ -9,8 +9,10 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	evmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/mocks"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+	bptxmmocks "github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager/mocks"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	pipelinemocks "github.com/smartcontractkit/chainlink/core/services/pipeline/mocks"
 	"github.com/smartcontractkit/chainlink/core/store/models"
@@ -26,7 +28,7 @@ func TestETHTxTask(t *testing.T) {
 		txMeta                string
 		vars                  pipeline.Vars
 		inputs                []pipeline.Result
-		setupClientMocks      func(config *pipelinemocks.Config, keyStore *pipelinemocks.KeyStore, txManager *pipelinemocks.TxManager)
+		setupClientMocks      func(keyStore *pipelinemocks.KeyStore, txManager *bptxmmocks.TxManager)
 		expected              interface{}
 		expectedErrorCause    error
 		expectedErrorContains string
@@ -40,8 +42,7 @@ func TestETHTxTask(t *testing.T) {
 			`{ "jobID": 321, "requestID": "0x5198616554d738d9485d1a7cf53b2f33e09c3bbc8fe9ac0020bd672cd2bc15d2", "requestTxHash": "0xc524fafafcaec40652b1f84fca09c231185437d008d195fccf2f51e64b7062f8" }`,
 			pipeline.NewVarsFrom(nil),
 			nil,
-			func(config *pipelinemocks.Config, keyStore *pipelinemocks.KeyStore, txManager *pipelinemocks.TxManager) {
-				config.On("EthGasLimitDefault").Return(uint64(999))
+			func(keyStore *pipelinemocks.KeyStore, txManager *bptxmmocks.TxManager) {
 				from := common.HexToAddress("0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c")
 				to := common.HexToAddress("0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF")
 				data := []byte("foobar")
@@ -69,8 +70,7 @@ func TestETHTxTask(t *testing.T) {
 				"requestTxHash": common.HexToHash("0xc524fafafcaec40652b1f84fca09c231185437d008d195fccf2f51e64b7062f8"),
 			}),
 			nil,
-			func(config *pipelinemocks.Config, keyStore *pipelinemocks.KeyStore, txManager *pipelinemocks.TxManager) {
-				config.On("EthGasLimitDefault").Return(uint64(999))
+			func(keyStore *pipelinemocks.KeyStore, txManager *bptxmmocks.TxManager) {
 				from := common.HexToAddress("0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c")
 				to := common.HexToAddress("0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF")
 				data := []byte("foobar")
@@ -100,8 +100,7 @@ func TestETHTxTask(t *testing.T) {
 				},
 			}),
 			nil,
-			func(config *pipelinemocks.Config, keyStore *pipelinemocks.KeyStore, txManager *pipelinemocks.TxManager) {
-				config.On("EthGasLimitDefault").Return(uint64(999))
+			func(keyStore *pipelinemocks.KeyStore, txManager *bptxmmocks.TxManager) {
 				from := common.HexToAddress("0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c")
 				to := common.HexToAddress("0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF")
 				data := []byte("foobar")
@@ -131,8 +130,7 @@ func TestETHTxTask(t *testing.T) {
 				},
 			}),
 			nil,
-			func(config *pipelinemocks.Config, keyStore *pipelinemocks.KeyStore, txManager *pipelinemocks.TxManager) {
-				config.On("EthGasLimitDefault").Return(uint64(999))
+			func(keyStore *pipelinemocks.KeyStore, txManager *bptxmmocks.TxManager) {
 				from := common.HexToAddress("0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c")
 				to := common.HexToAddress("0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF")
 				data := []byte("foobar")
@@ -152,8 +150,7 @@ func TestETHTxTask(t *testing.T) {
 			`{}`,
 			pipeline.NewVarsFrom(nil),
 			nil,
-			func(config *pipelinemocks.Config, keyStore *pipelinemocks.KeyStore, txManager *pipelinemocks.TxManager) {
-				config.On("EthGasLimitDefault").Return(uint64(999))
+			func(keyStore *pipelinemocks.KeyStore, txManager *bptxmmocks.TxManager) {
 				from := common.HexToAddress("0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c")
 				to := common.HexToAddress("0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF")
 				data := []byte("foobar")
@@ -173,8 +170,7 @@ func TestETHTxTask(t *testing.T) {
 			`{ "jobID": 321, "requestID": "0x5198616554d738d9485d1a7cf53b2f33e09c3bbc8fe9ac0020bd672cd2bc15d2", "requestTxHash": "0xc524fafafcaec40652b1f84fca09c231185437d008d195fccf2f51e64b7062f8" }`,
 			pipeline.NewVarsFrom(nil),
 			nil,
-			func(config *pipelinemocks.Config, keyStore *pipelinemocks.KeyStore, txManager *pipelinemocks.TxManager) {
-				config.On("EthGasLimitDefault").Return(uint64(999))
+			func(keyStore *pipelinemocks.KeyStore, txManager *bptxmmocks.TxManager) {
 				from := common.HexToAddress("0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c")
 				to := common.HexToAddress("0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF")
 				data := []byte("foobar")
@@ -204,8 +200,7 @@ func TestETHTxTask(t *testing.T) {
 				},
 			}),
 			nil,
-			func(config *pipelinemocks.Config, keyStore *pipelinemocks.KeyStore, txManager *pipelinemocks.TxManager) {
-				config.On("EthGasLimitDefault").Return(uint64(999))
+			func(keyStore *pipelinemocks.KeyStore, txManager *bptxmmocks.TxManager) {
 				keyStore.On("GetRoundRobinAddress").Return(nil, errors.New("uh oh"))
 			},
 			nil, pipeline.ErrTaskRunFailed, "while querying keystore",
@@ -219,8 +214,7 @@ func TestETHTxTask(t *testing.T) {
 			`{ "jobID": 321, "requestID": "0x5198616554d738d9485d1a7cf53b2f33e09c3bbc8fe9ac0020bd672cd2bc15d2", "requestTxHash": "0xc524fafafcaec40652b1f84fca09c231185437d008d195fccf2f51e64b7062f8" }`,
 			pipeline.NewVarsFrom(nil),
 			nil,
-			func(config *pipelinemocks.Config, keyStore *pipelinemocks.KeyStore, txManager *pipelinemocks.TxManager) {
-				config.On("EthGasLimitDefault").Return(uint64(999))
+			func(keyStore *pipelinemocks.KeyStore, txManager *bptxmmocks.TxManager) {
 				from := common.HexToAddress("0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c")
 				to := common.HexToAddress("0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF")
 				data := []byte("foobar")
@@ -240,8 +234,7 @@ func TestETHTxTask(t *testing.T) {
 			`{ "jobID": 321, "requestID": "0x5198616554d738d9485d1a7cf53b2f33e09c3bbc8fe9ac0020bd672cd2bc15d2", "requestTxHash": "0xc524fafafcaec40652b1f84fca09c231185437d008d195fccf2f51e64b7062f8", "foo": "bar" }`,
 			pipeline.NewVarsFrom(nil),
 			nil,
-			func(config *pipelinemocks.Config, keyStore *pipelinemocks.KeyStore, txManager *pipelinemocks.TxManager) {
-				config.On("EthGasLimitDefault").Return(uint64(999))
+			func(keyStore *pipelinemocks.KeyStore, txManager *bptxmmocks.TxManager) {
 			},
 			nil, pipeline.ErrBadInput, "txMeta",
 		},
@@ -254,8 +247,7 @@ func TestETHTxTask(t *testing.T) {
 			`{ "jobID": "asdf", "requestID": 123, "requestTxHash": true }`,
 			pipeline.NewVarsFrom(nil),
 			nil,
-			func(config *pipelinemocks.Config, keyStore *pipelinemocks.KeyStore, txManager *pipelinemocks.TxManager) {
-				config.On("EthGasLimitDefault").Return(uint64(999))
+			func(keyStore *pipelinemocks.KeyStore, txManager *bptxmmocks.TxManager) {
 			},
 			nil, pipeline.ErrBadInput, "txMeta",
 		},
@@ -268,8 +260,7 @@ func TestETHTxTask(t *testing.T) {
 			`{ "jobID": 321, "requestID": "0x5198616554d738d9485d1a7cf53b2f33e09c3bbc8fe9ac0020bd672cd2bc15d2", "requestTxHash": "0xc524fafafcaec40652b1f84fca09c231185437d008d195fccf2f51e64b7062f8" }`,
 			pipeline.NewVarsFrom(nil),
 			nil,
-			func(config *pipelinemocks.Config, keyStore *pipelinemocks.KeyStore, txManager *pipelinemocks.TxManager) {
-				config.On("EthGasLimitDefault").Return(uint64(999))
+			func(keyStore *pipelinemocks.KeyStore, txManager *bptxmmocks.TxManager) {
 			},
 			nil, pipeline.ErrParameterEmpty, "to",
 		},
@@ -282,7 +273,7 @@ func TestETHTxTask(t *testing.T) {
 			`{ "jobID": 321, "requestID": "0x5198616554d738d9485d1a7cf53b2f33e09c3bbc8fe9ac0020bd672cd2bc15d2", "requestTxHash": "0xc524fafafcaec40652b1f84fca09c231185437d008d195fccf2f51e64b7062f8" }`,
 			pipeline.NewVarsFrom(nil),
 			[]pipeline.Result{{Error: errors.New("uh oh")}},
-			func(config *pipelinemocks.Config, keyStore *pipelinemocks.KeyStore, txManager *pipelinemocks.TxManager) {
+			func(keyStore *pipelinemocks.KeyStore, txManager *bptxmmocks.TxManager) {
 			},
 			nil, pipeline.ErrTooManyErrors, "task inputs",
 		},
@@ -302,14 +293,22 @@ func TestETHTxTask(t *testing.T) {
 				TxMeta:   test.txMeta,
 			}
 
-			config := new(pipelinemocks.Config)
 			keyStore := new(pipelinemocks.KeyStore)
-			txManager := new(pipelinemocks.TxManager)
+			txManager := new(bptxmmocks.TxManager)
 			store, cleanup := cltest.NewStore(t)
 			defer cleanup()
+			store.Config.Set("ETH_GAS_LIMIT_DEFAULT", 999)
 
-			test.setupClientMocks(config, keyStore, txManager)
-			task.HelperSetDependencies(store.DB, config, keyStore, txManager)
+			chain := new(evmmocks.Chain)
+			chain.On("Config").Return(store.Config)
+			chain.On("TxManager").Return(txManager)
+			// With no estimator the transaction limits use ETH_GAS_PRICE_DEFAULT
+			txManager.On("GetGasEstimator").Return(nil).Maybe()
+			chainSet := new(evmmocks.ChainSet)
+			chainSet.On("Default").Return(chain)
+
+			test.setupClientMocks(keyStore, txManager)
+			task.HelperSetDependencies(store.DB, keyStore, chainSet)
 
 			result := task.Run(context.Background(), test.vars, test.inputs)
 
@@ -324,7 +323,6 @@ func TestETHTxTask(t *testing.T) {
 				require.Equal(t, test.expected, result.Value)
 			}
 
-			config.AssertExpectations(t)
 			keyStore.AssertExpectations(t)
 			txManager.AssertExpectations(t)
 		})
@@ -3,6 +3,7 @@ package pipeline
 import (
 	"context"
 	"encoding/json"
+	"time"
 
 	"go.uber.org/multierr"
 
@@ -22,8 +23,12 @@ type HTTPTask struct {
 	URL                            string
 	RequestData                    string `json:"requestData"`
 	AllowUnrestrictedNetworkAccess string
+	// CacheTTL is how long the response is cached for identical requests of
+	// all jobs, see JOB_PIPELINE_RESPONSE_CACHE_SIZE. It is not cached if 0.
+	CacheTTL time.Duration
 
 	config Config
+	cache  *responseCache
 }
 
 var _ Task = (*HTTPTask)(nil)
@@ -80,7 +85,16 @@ func (t *HTTPTask) Run(ctx context.Context, vars Vars, inputs []Result) Result {
 		"allowUnrestrictedNetworkAccess", allowUnrestrictedNetworkAccess,
 	)
 
-	responseBytes, _, elapsed, err := makeHTTPRequest(ctx, method, url, requestData, allowUnrestrictedNetworkAccess, t.config)
+	cacheKey := responseCacheKey(string(method), url.String(), requestDataJSON)
+	responseBytes, err := t.cache.fetch(ctx, cacheKey, t.CacheTTL, t.Type(), url.Host, func(ctx context.Context) ([]byte, error) {
+		responseBytes, _, elapsed, err := makeHTTPRequest(ctx, method, url, requestData, allowUnrestrictedNetworkAccess, t.config)
+		if err != nil {
+			return nil, err
+		}
+		promHTTPFetchTime.WithLabelValues(t.DotID()).Set(float64(elapsed))
+		promHTTPResponseBodySize.WithLabelValues(t.DotID()).Set(float64(len(responseBytes)))
+		return responseBytes, nil
+	})
 	if err != nil {
 		return Result{Error: err}
 	}
@@ -91,9 +105,6 @@ func (t *HTTPTask) Run(ctx context.Context, vars Vars, inputs []Result) Result {
 		"dotID", t.DotID(),
 	)
 
-	promHTTPFetchTime.WithLabelValues(t.DotID()).Set(float64(elapsed))
-	promHTTPResponseBodySize.WithLabelValues(t.DotID()).Set(float64(len(responseBytes)))
-
 	// NOTE: We always stringify the response since this is required for all current jobs.
 	// If a binary response is required we might consider adding an adapter
 	// flag such as  "BinaryMode: true" which passes through raw binary as the
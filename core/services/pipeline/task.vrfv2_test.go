@@ -0,0 +1,153 @@
+package pipeline_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/vrf_coordinator_v2"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/vrfkey"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/services/signatures/secp256k1"
+	"github.com/smartcontractkit/chainlink/core/services/vrf/proof"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// singleKeyVRFKeyStore generates proofs with a single in-memory key
+type singleKeyVRFKeyStore struct {
+	key *vrfkey.PrivateKey
+}
+
+func (ks singleKeyVRFKeyStore) GenerateProof(_ secp256k1.PublicKey, seed *big.Int) (vrfkey.Proof, error) {
+	return ks.key.GenerateProof(seed)
+}
+
+func TestVRFTaskV2(t *testing.T) {
+	key := vrfkey.CreateKey()
+	keyHash := key.PublicKey.MustHash()
+	blockHash := utils.NewHash()
+	sender := common.HexToAddress("0x8bE8A1bA7a23fC2D8a0a21F2f1B3c2E4C4E22c11")
+
+	newVars := func() pipeline.Vars {
+		return pipeline.NewVarsFrom(map[string]interface{}{
+			"jobSpec": map[string]interface{}{
+				"publicKey": key.PublicKey[:],
+			},
+			"jobRun": map[string]interface{}{
+				"logBlockHash":   blockHash[:],
+				"logBlockNumber": uint64(10),
+				"logTopics":      []common.Hash{{}, keyHash, common.BigToHash(big.NewInt(7)), sender.Hash()},
+			},
+		})
+	}
+	newLogValues := func() map[string]interface{} {
+		return map[string]interface{}{
+			"keyHash":          [32]byte(keyHash),
+			"requestId":        big.NewInt(1),
+			"preSeed":          big.NewInt(42),
+			"subId":            uint64(7),
+			"callbackGasLimit": uint32(100000),
+			"numWords":         uint32(3),
+			"sender":           sender,
+		}
+	}
+	newTask := func() *pipeline.VRFTaskV2 {
+		task := &pipeline.VRFTaskV2{
+			BaseTask:           pipeline.NewBaseTask(0, "vrf", nil, nil, 0),
+			PublicKey:          "$(jobSpec.publicKey)",
+			RequestBlockHash:   "$(jobRun.logBlockHash)",
+			RequestBlockNumber: "$(jobRun.logBlockNumber)",
+			Topics:             "$(jobRun.logTopics)",
+		}
+		task.HelperSetDependencies(singleKeyVRFKeyStore{key})
+		return task
+	}
+
+	t.Run("generates the proof and request commitment", func(t *testing.T) {
+		result := newTask().Run(context.Background(), newVars(), []pipeline.Result{{Value: newLogValues()}})
+		require.NoError(t, result.Error)
+		values := result.Value.(map[string]interface{})
+
+		rc := values["requestCommitment"].(vrf_coordinator_v2.VRFCoordinatorV2RequestCommitment)
+		assert.Equal(t, vrf_coordinator_v2.VRFCoordinatorV2RequestCommitment{
+			BlockNum:         10,
+			SubId:            7,
+			CallbackGasLimit: 100000,
+			NumWords:         3,
+			Sender:           sender,
+		}, rc)
+
+		onChainProof := values["proof"].(vrf_coordinator_v2.VRFProof)
+		assert.Equal(t, big.NewInt(42), onChainProof.Seed)
+		preSeed, err := proof.BigToSeed(big.NewInt(42))
+		require.NoError(t, err)
+		p, err := key.GenerateProof(proof.FinalSeedV2(proof.PreSeedDataV2{PreSeed: preSeed, BlockHash: blockHash, BlockNum: 10}))
+		require.NoError(t, err)
+		gammaX, gammaY := secp256k1.Coordinates(p.Gamma)
+		assert.Equal(t, [2]*big.Int{gammaX, gammaY}, onChainProof.Gamma)
+
+		calldata, err := hexutil.Decode(values["output"].(string))
+		require.NoError(t, err)
+		coordinatorABI := eth.MustGetABI(vrf_coordinator_v2.VRFCoordinatorV2ABI)
+		assert.Equal(t, coordinatorABI.Methods["fulfillRandomWords"].ID, calldata[:4])
+	})
+
+	t.Run("accepts the output of ethabidecodelog", func(t *testing.T) {
+		coordinatorABI := eth.MustGetABI(vrf_coordinator_v2.VRFCoordinatorV2ABI)
+		event := coordinatorABI.Events["RandomWordsRequested"]
+		data, err := event.Inputs.NonIndexed().Pack(big.NewInt(1), big.NewInt(42), uint16(3), uint32(100000), uint32(3))
+		require.NoError(t, err)
+		vars := newVars()
+		topics, err := vars.Get("jobRun.logTopics")
+		require.NoError(t, err)
+		topics.([]common.Hash)[0] = event.ID
+		vars.Set("jobRun", map[string]interface{}{
+			"logBlockHash":   blockHash[:],
+			"logBlockNumber": uint64(10),
+			"logTopics":      topics,
+			"logData":        data,
+		})
+
+		decodeTask := pipeline.ETHABIDecodeLogTask{
+			BaseTask: pipeline.NewBaseTask(0, "decode_log", nil, nil, 0),
+			ABI:      "RandomWordsRequested(bytes32 indexed keyHash,uint256 requestId,uint256 preSeed,uint64 indexed subId,uint16 minimumRequestConfirmations,uint32 callbackGasLimit,uint32 numWords,address indexed sender)",
+			Data:     "$(jobRun.logData)",
+			Topics:   "$(jobRun.logTopics)",
+		}
+		decoded := decodeTask.Run(context.Background(), vars, nil)
+		require.NoError(t, decoded.Error)
+
+		result := newTask().Run(context.Background(), vars, []pipeline.Result{decoded})
+		require.NoError(t, result.Error)
+		rc := result.Value.(map[string]interface{})["requestCommitment"].(vrf_coordinator_v2.VRFCoordinatorV2RequestCommitment)
+		assert.Equal(t, uint64(7), rc.SubId)
+		assert.Equal(t, sender, rc.Sender)
+	})
+
+	t.Run("rejects requests for another key", func(t *testing.T) {
+		logValues := newLogValues()
+		logValues["keyHash"] = [32]byte(utils.NewHash())
+		result := newTask().Run(context.Background(), newVars(), []pipeline.Result{{Value: logValues}})
+		require.Error(t, result.Error)
+		assert.Contains(t, result.Error.Error(), "invalid key hash")
+	})
+
+	t.Run("rejects logs without the request fields", func(t *testing.T) {
+		logValues := newLogValues()
+		delete(logValues, "subId")
+		result := newTask().Run(context.Background(), newVars(), []pipeline.Result{{Value: logValues}})
+		require.Error(t, result.Error)
+		assert.Contains(t, result.Error.Error(), "invalid subId")
+	})
+
+	t.Run("errors if the input errored", func(t *testing.T) {
+		result := newTask().Run(context.Background(), newVars(), []pipeline.Result{{Error: pipeline.ErrBadInput}})
+		assert.Equal(t, pipeline.ErrInputTaskErrored, result.Error)
+	})
+}
@@ -0,0 +1,212 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Schemes of secret references. A config value or a password flag of the
+// form <scheme>://<location> is replaced by the secret it references:
+//
+//	file:///run/secrets/password   contents of the file, without surrounding whitespace
+//	env://KEYSTORE_PASSWORD        value of the environment variable
+//	vault://secret/data/node#key   field key of the secret at secret/data/node in Vault
+const (
+	SchemeFile  = "file"
+	SchemeEnv   = "env"
+	SchemeVault = "vault"
+)
+
+// DefaultVaultKey is the field of a Vault secret used by references without
+// a fragment
+const DefaultVaultKey = "value"
+
+const maxVaultRequestTime = 10 * time.Second
+
+// Provider returns the secrets referenced by URLs of one scheme
+type Provider interface {
+	Secret(ctx context.Context, ref *url.URL) (string, error)
+}
+
+// IsReference returns true if value is a secret reference, i.e. a URL with
+// one of the secret schemes
+func IsReference(value string) bool {
+	i := strings.Index(value, "://")
+	if i <= 0 {
+		return false
+	}
+	switch value[:i] {
+	case SchemeFile, SchemeEnv, SchemeVault:
+		return true
+	}
+	return false
+}
+
+// Resolver resolves secret references with the provider of their scheme
+type Resolver struct {
+	providers map[string]Provider
+}
+
+// NewResolver returns a Resolver for file and env references, and for vault
+// references if vault is not nil
+func NewResolver(vault Provider) *Resolver {
+	r := &Resolver{providers: map[string]Provider{
+		SchemeFile: FileProvider{},
+		SchemeEnv:  EnvProvider{},
+	}}
+	if vault != nil {
+		r.providers[SchemeVault] = vault
+	}
+	return r
+}
+
+// Resolve returns the secret referenced by value. Values that are not secret
+// references are returned unchanged.
+func (r *Resolver) Resolve(ctx context.Context, value string) (string, error) {
+	if !IsReference(value) {
+		return value, nil
+	}
+	ref, err := url.Parse(value)
+	if err != nil {
+		// The reference itself may contain a secret, so it is not included
+		return "", errors.Errorf("invalid secret reference with scheme %s", value[:strings.Index(value, "://")])
+	}
+	provider, exists := r.providers[ref.Scheme]
+	if !exists {
+		return "", errors.Errorf("cannot resolve %s:// secrets, VAULT_ADDR is not set", ref.Scheme)
+	}
+	secret, err := provider.Secret(ctx, ref)
+	return secret, errors.Wrapf(err, "failed to resolve secret %s", Location(ref))
+}
+
+// Location returns the location of a secret reference, for use in errors
+// and logs. It never contains the secret itself.
+func Location(ref *url.URL) string {
+	return ref.Scheme + "://" + ref.Host + ref.Path
+}
+
+// FileProvider reads secrets from files referenced as file:///path or
+// file://relative/path
+type FileProvider struct{}
+
+// Secret returns the contents of the file without surrounding whitespace
+func (FileProvider) Secret(_ context.Context, ref *url.URL) (string, error) {
+	name := ref.Host + ref.Path
+	if name == "" {
+		return "", errors.New("missing file path")
+	}
+	b, err := ioutil.ReadFile(name)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(b)), nil
+}
+
+// EnvProvider reads secrets from environment variables referenced as
+// env://NAME
+type EnvProvider struct{}
+
+// Secret returns the value of the environment variable, which must be set
+func (EnvProvider) Secret(_ context.Context, ref *url.URL) (string, error) {
+	name := ref.Host
+	if name == "" {
+		return "", errors.New("missing environment variable name")
+	}
+	value, exists := os.LookupEnv(name)
+	if !exists {
+		return "", errors.Errorf("environment variable %s is not set", name)
+	}
+	return value, nil
+}
+
+// VaultProvider reads secrets from the HTTP API of a Vault server, or any
+// server compatible with its key/value secrets engines. References have the
+// form vault://<path>#<key>, e.g. vault://secret/data/chainlink#password
+// reads the field password of the secret at secret/data/chainlink. Both
+// versions of the key/value engine are supported.
+type VaultProvider struct {
+	URL    *url.URL
+	Token  string
+	Client *http.Client
+}
+
+// NewVaultProvider returns a provider for the Vault server at u, which
+// authenticates with token
+func NewVaultProvider(u *url.URL, token string) *VaultProvider {
+	return &VaultProvider{
+		URL:    u,
+		Token:  token,
+		Client: &http.Client{Timeout: maxVaultRequestTime},
+	}
+}
+
+type vaultResponse struct {
+	Data   map[string]interface{} `json:"data"`
+	Errors []string               `json:"errors"`
+}
+
+// Secret fetches the secret from Vault
+func (v *VaultProvider) Secret(ctx context.Context, ref *url.URL) (string, error) {
+	secretPath := strings.Trim(ref.Host+ref.Path, "/")
+	if secretPath == "" {
+		return "", errors.New("missing secret path")
+	}
+	key := ref.Fragment
+	if key == "" {
+		key = DefaultVaultKey
+	}
+
+	u := *v.URL
+	u.Path = path.Join("/", u.Path, "v1", secretPath)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("X-Vault-Token", v.Token)
+	res, err := v.Client.Do(req)
+	if err != nil {
+		return "", errors.Wrap(err, "vault request failed")
+	}
+	defer res.Body.Close()
+
+	var body vaultResponse
+	if err = json.NewDecoder(res.Body).Decode(&body); err != nil && res.StatusCode == http.StatusOK {
+		return "", errors.Wrap(err, "failed to decode vault response")
+	}
+	if res.StatusCode != http.StatusOK {
+		if len(body.Errors) > 0 {
+			return "", errors.Errorf("vault returned status %d: %s", res.StatusCode, strings.Join(body.Errors, "; "))
+		}
+		return "", errors.Errorf("vault returned status %d", res.StatusCode)
+	}
+
+	data := body.Data
+	// Version 2 of the key/value engine nests the fields of the secret
+	if nested, ok := data["data"].(map[string]interface{}); ok {
+		if _, hasMetadata := data["metadata"]; hasMetadata {
+			data = nested
+		}
+	}
+	value, exists := data[key]
+	if !exists {
+		return "", errors.Errorf("secret has no field %s", key)
+	}
+	switch t := value.(type) {
+	case string:
+		return t, nil
+	case nil:
+		return "", errors.Errorf("field %s of secret is null", key)
+	default:
+		return fmt.Sprint(t), nil
+	}
+}
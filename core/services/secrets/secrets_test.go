@@ -0,0 +1,110 @@
+package secrets_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/services/secrets"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsReference(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, secrets.IsReference("file:///run/secrets/password"))
+	assert.True(t, secrets.IsReference("env://KEYSTORE_PASSWORD"))
+	assert.True(t, secrets.IsReference("vault://secret/data/chainlink#password"))
+	assert.False(t, secrets.IsReference("postgresql://localhost:5432/chainlink"))
+	assert.False(t, secrets.IsReference("/run/secrets/password"))
+	assert.False(t, secrets.IsReference("hunter2"))
+	assert.False(t, secrets.IsReference(""))
+}
+
+// newVaultStandIn returns a server answering like the key/value secrets
+// engines of Vault, with version 2 mounted at secret/ and version 1 at kv/
+func newVaultStandIn(t *testing.T, token string) *url.URL {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != token {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"errors":["permission denied"]}`))
+			return
+		}
+		switch r.URL.Path {
+		case "/v1/secret/data/chainlink":
+			_, _ = w.Write([]byte(`{"data":{"data":{"password":"v2-password","value":"v2-default"},"metadata":{"version":3}}}`))
+		case "/v1/kv/chainlink":
+			_, _ = w.Write([]byte(`{"data":{"password":"v1-password","port":5432}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors":[]}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+	u, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	return u
+}
+
+func TestResolver_Resolve(t *testing.T) {
+	ctx := context.Background()
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "password")
+	require.NoError(t, ioutil.WriteFile(path, []byte("  file-password\n"), 0600))
+
+	require.NoError(t, os.Setenv("SECRETS_TEST_PASSWORD", "env-password"))
+	defer os.Unsetenv("SECRETS_TEST_PASSWORD")
+
+	vaultURL := newVaultStandIn(t, "s.token")
+	r := secrets.NewResolver(secrets.NewVaultProvider(vaultURL, "s.token"))
+
+	tests := []struct {
+		name   string
+		value  string
+		secret string
+		err    string
+	}{
+		{"literal", "hunter2", "hunter2", ""},
+		{"file", "file://" + path, "file-password", ""},
+		{"missing file", "file://" + filepath.Join(dir, "missing"), "", "failed to resolve secret file://" + filepath.Join(dir, "missing")},
+		{"env", "env://SECRETS_TEST_PASSWORD", "env-password", ""},
+		{"unset env", "env://SECRETS_TEST_UNSET", "", "environment variable SECRETS_TEST_UNSET is not set"},
+		{"vault kv v2", "vault://secret/data/chainlink#password", "v2-password", ""},
+		{"vault default key", "vault://secret/data/chainlink", "v2-default", ""},
+		{"vault kv v1", "vault://kv/chainlink#password", "v1-password", ""},
+		{"vault non-string field", "vault://kv/chainlink#port", "5432", ""},
+		{"vault missing field", "vault://kv/chainlink#user", "", "secret has no field user"},
+		{"vault missing secret", "vault://kv/other", "", "vault returned status 404"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			secret, err := r.Resolve(ctx, test.value)
+			if test.err != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), test.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.secret, secret)
+		})
+	}
+
+	t.Run("vault with wrong token", func(t *testing.T) {
+		r := secrets.NewResolver(secrets.NewVaultProvider(vaultURL, "s.wrong"))
+		_, err := r.Resolve(ctx, "vault://secret/data/chainlink#password")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "vault returned status 403: permission denied")
+	})
+
+	t.Run("vault not configured", func(t *testing.T) {
+		_, err := secrets.NewResolver(nil).Resolve(ctx, "vault://secret/data/chainlink#password")
+		assert.EqualError(t, err, "cannot resolve vault:// secrets, VAULT_ADDR is not set")
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"math/big"
 	"strings"
 	"sync"
 	"time"
@@ -23,6 +24,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/smartcontractkit/chainlink/core/gracefulpanic"
 	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/solidity_vrf_coordinator_interface"
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/vrf_coordinator_v2"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/eth"
 	"github.com/smartcontractkit/chainlink/core/services/job"
@@ -56,6 +58,8 @@ type GethKeyStore interface {
 type Config interface {
 	MinIncomingConfirmations() uint32
 	EthGasLimitDefault() uint64
+	EthMaxGasPriceWei() *big.Int
+	EthGasPriceDefault() *big.Int
 }
 
 func NewDelegate(
@@ -92,6 +96,9 @@ func (d *Delegate) ServicesForSpec(jb job.Job) ([]job.Service, error) {
 	if jb.VRFSpec == nil {
 		return nil, errors.Errorf("vrf.Delegate expects a *job.VRFSpec to be present, got %+v", jb)
 	}
+	if jb.VRFSpec.CoordinatorVersion == job.VRFCoordinatorV2 {
+		return d.servicesForSpecV2(jb)
+	}
 	coordinator, err := solidity_vrf_coordinator_interface.NewVRFCoordinator(jb.VRFSpec.CoordinatorAddress.Address(), d.ec)
 	if err != nil {
 		return nil, err
@@ -131,6 +138,20 @@ func (d *Delegate) ServicesForSpec(jb job.Job) ([]job.Service, error) {
 	return []job.Service{logListener}, nil
 }
 
+func (d *Delegate) servicesForSpecV2(jb job.Job) ([]job.Service, error) {
+	coordinator, err := vrf_coordinator_v2.NewVRFCoordinatorV2(jb.VRFSpec.CoordinatorAddress.Address(), d.ec)
+	if err != nil {
+		return nil, err
+	}
+	l := logger.CreateLogger(logger.Default.SugaredLogger.With(
+		"jobID", jb.ID,
+		"externalJobID", jb.ExternalJobID,
+		"coordinatorAddress", jb.VRFSpec.CoordinatorAddress,
+		"coordinatorVersion", jb.VRFSpec.CoordinatorVersion,
+	))
+	return []job.Service{newListenerV2(d.cfg, *l, d.ec, d.lb, d.hb, coordinator, d.pr, d.ks.Eth(), d.txm, d.db, jb)}, nil
+}
+
 func getStartingResponseCounts(db *gorm.DB, l *logger.Logger) map[[32]byte]uint64 {
 	respCounts := make(map[[32]byte]uint64)
 	var counts []struct {
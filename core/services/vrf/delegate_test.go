@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"gopkg.in/guregu/null.v4"
 
@@ -74,7 +75,8 @@ func buildVrfUni(t *testing.T, db *gorm.DB, cfg *config.Config) vrfUniverse {
 	ks := keystore.New(db, utils.FastScryptParams)
 	txm := new(bptxmmocks.TxManager)
 	t.Cleanup(func() { txm.AssertExpectations(t) })
-	pr := pipeline.NewRunner(prm, cfg, ec, ks.Eth(), ks.VRF(), txm)
+	cs := evm.NewChainSet(evm.NewDefaultChain(cfg, ec, hb, nil, lb, txm), evm.ChainSetOpts{Config: cfg, DB: db})
+	pr := pipeline.NewRunner(prm, cfg, cs, ks.Eth(), ks.VRF())
 	require.NoError(t, ks.Eth().Unlock("blah"))
 	_, err = ks.Eth().CreateNewKey()
 	require.NoError(t, err)
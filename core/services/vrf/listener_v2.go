@@ -0,0 +1,861 @@
+package vrf
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/theodesp/go-heaps/pairing"
+	"gopkg.in/guregu/null.v4"
+	"gorm.io/gorm"
+
+	"github.com/smartcontractkit/chainlink/core/gracefulpanic"
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/batch_vrf_coordinator_v2"
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/vrf_coordinator_v2"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	httypes "github.com/smartcontractkit/chainlink/core/services/headtracker/types"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/log"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/services/postgres"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+const (
+	// GasProofVerification is an upper bound on the gas the coordinator spends
+	// verifying a proof and charging the subscription, on top of the callback
+	GasProofVerification uint64 = 200_000
+	// DefaultBatchFulfillmentGasLimit caps the gas of a batch of fulfillments
+	// when the job does not set batchFulfillmentGasLimit
+	DefaultBatchFulfillmentGasLimit uint64 = 2_500_000
+	// requestTimeoutBlocks is how long a request that can't be fulfilled, for
+	// instance because its subscription is underfunded, is kept in memory. The
+	// log is not consumed, so the request is retried after a restart.
+	requestTimeoutBlocks = 256
+)
+
+var (
+	coordinatorV2ABI      = eth.MustGetABI(vrf_coordinator_v2.VRFCoordinatorV2ABI)
+	batchCoordinatorV2ABI = eth.MustGetABI(batch_vrf_coordinator_v2.BatchVRFCoordinatorV2ABI)
+)
+
+var (
+	_ log.Listener = &listenerV2{}
+	_ job.Service  = &listenerV2{}
+)
+
+type pendingRequestV2 struct {
+	confirmedAtBlock uint64
+	req              *vrf_coordinator_v2.VRFCoordinatorV2RandomWordsRequested
+	lb               log.Broadcast
+	// Set once the subscription was found too low to pay for the simulated
+	// fulfillment, so the proof isn't generated again until it is funded
+	minBalance *big.Int
+}
+
+// fulfillmentV2 is a request whose pipeline run succeeded and whose
+// subscription can pay for the fulfillment
+type fulfillmentV2 struct {
+	pendingRequestV2
+	run      pipeline.Run
+	trrs     pipeline.TaskRunResults
+	proof    vrf_coordinator_v2.VRFProof
+	rc       vrf_coordinator_v2.VRFCoordinatorV2RequestCommitment
+	payload  []byte
+	gasLimit uint64
+	maxLink  *big.Int
+}
+
+// listenerV2 fulfills the requests of a VRFCoordinatorV2. Fulfillments are
+// paid from the subscription of the requester, so the listener only fulfills
+// requests the subscription can pay for, and optionally batches the
+// fulfillments of a block through a BatchVRFCoordinatorV2.
+type listenerV2 struct {
+	utils.StartStopOnce
+
+	cfg             Config
+	l               logger.Logger
+	ethClient       eth.Client
+	logBroadcaster  log.Broadcaster
+	coordinator     *vrf_coordinator_v2.VRFCoordinatorV2
+	pipelineRunner  pipeline.Runner
+	job             job.Job
+	db              *gorm.DB
+	headBroadcaster httypes.HeadBroadcasterRegistry
+	txm             bulletprooftxmanager.TxManager
+	gethks          GethKeyStore
+	reqLogs         *utils.Mailbox
+	chStop          chan struct{}
+	waitOnStop      chan struct{}
+	newHead         chan struct{}
+	latestHead      uint64
+	latestHeadMu    sync.RWMutex
+	// Requests are only consumed once their fulfillment is queued, so on a
+	// restart the log broadcaster resends the ones we had in memory.
+	reqsMu   sync.Mutex // Both goroutines write to reqs
+	reqs     []pendingRequestV2
+	reqAdded func() // A simple debug helper
+
+	// Data structures for reorg attack protection, see listener
+	respCountMu        sync.Mutex
+	respCount          map[[32]byte]uint64
+	blockNumberToReqID *pairing.PairHeap
+}
+
+func newListenerV2(
+	cfg Config,
+	l logger.Logger,
+	ethClient eth.Client,
+	logBroadcaster log.Broadcaster,
+	headBroadcaster httypes.HeadBroadcasterRegistry,
+	coordinator *vrf_coordinator_v2.VRFCoordinatorV2,
+	pipelineRunner pipeline.Runner,
+	gethks GethKeyStore,
+	txm bulletprooftxmanager.TxManager,
+	db *gorm.DB,
+	jb job.Job,
+) *listenerV2 {
+	return &listenerV2{
+		cfg:                cfg,
+		l:                  l,
+		ethClient:          ethClient,
+		logBroadcaster:     logBroadcaster,
+		headBroadcaster:    headBroadcaster,
+		coordinator:        coordinator,
+		pipelineRunner:     pipelineRunner,
+		gethks:             gethks,
+		txm:                txm,
+		db:                 db,
+		job:                jb,
+		reqLogs:            utils.NewMailbox(1000),
+		chStop:             make(chan struct{}),
+		waitOnStop:         make(chan struct{}),
+		newHead:            make(chan struct{}, 1),
+		respCount:          getStartingResponseCountsV2(db, &l),
+		blockNumberToReqID: pairing.New(),
+		reqAdded:           func() {},
+	}
+}
+
+// getStartingResponseCountsV2 counts the fulfillments queued for each v2
+// request, whether sent on their own or as part of a batch
+func getStartingResponseCountsV2(db *gorm.DB, l *logger.Logger) map[[32]byte]uint64 {
+	respCounts := make(map[[32]byte]uint64)
+	var counts []struct {
+		RequestID string
+		Count     int
+	}
+	// Allow any state, not just confirmed, on purpose.
+	// We assume once a ethtx is queued it will go through.
+	err := db.Raw(`SELECT request_id, count(*) AS count FROM (
+			SELECT meta->>'RequestID' AS request_id FROM eth_txes WHERE meta->'SubID' IS NOT NULL
+			UNION ALL
+			SELECT f->>'RequestID' FROM eth_txes, jsonb_array_elements(meta->'Fulfillments') f
+		) fulfillments
+		GROUP BY request_id`).Scan(&counts).Error
+	if err != nil {
+		// Continue with an empty map, do not block job on this.
+		l.Errorw("VRFListenerV2: unable to read previous fulfillments", "err", err)
+		return respCounts
+	}
+	for _, c := range counts {
+		b, err := hexutil.Decode(c.RequestID)
+		if err != nil {
+			l.Errorw("VRFListenerV2: unable to read fulfillment", "err", err, "reqID", c.RequestID)
+			continue
+		}
+		respCounts[common.BytesToHash(b)] = uint64(c.Count)
+	}
+	return respCounts
+}
+
+// Note that we have 2 seconds to do this processing
+func (lsn *listenerV2) OnNewLongestChain(_ context.Context, head models.Head) {
+	lsn.setLatestHead(head)
+	select {
+	case lsn.newHead <- struct{}{}:
+	default:
+	}
+}
+
+func (lsn *listenerV2) setLatestHead(h models.Head) {
+	lsn.latestHeadMu.Lock()
+	defer lsn.latestHeadMu.Unlock()
+	num := uint64(h.Number)
+	if num > lsn.latestHead {
+		lsn.latestHead = num
+	}
+}
+
+func (lsn *listenerV2) getLatestHead() uint64 {
+	lsn.latestHeadMu.RLock()
+	defer lsn.latestHeadMu.RUnlock()
+	return lsn.latestHead
+}
+
+// minConfirmations is the larger of the global and the job's minimum
+// incoming confirmations
+func (lsn *listenerV2) minConfirmations() uint32 {
+	minConfs := lsn.cfg.MinIncomingConfirmations()
+	if lsn.job.VRFSpec.Confirmations > minConfs {
+		minConfs = lsn.job.VRFSpec.Confirmations
+	}
+	return minConfs
+}
+
+// Start complies with job.Service
+func (lsn *listenerV2) Start() error {
+	return lsn.StartOnce("VRFListenerV2", func() error {
+		// Note that runtime changes to incoming confirmations require a job delete/add
+		// because we need to resubscribe to the lb with the new min.
+		minConfs := lsn.minConfirmations()
+		unsubscribeLogs := lsn.logBroadcaster.Register(lsn, log.ListenerOpts{
+			Contract: lsn.coordinator.Address(),
+			ParseLog: lsn.coordinator.ParseLog,
+			LogsWithTopics: map[common.Hash][][]log.Topic{
+				vrf_coordinator_v2.VRFCoordinatorV2RandomWordsRequested{}.Topic(): {
+					{
+						log.Topic(lsn.job.VRFSpec.PublicKey.MustHash()),
+					},
+				},
+				vrf_coordinator_v2.VRFCoordinatorV2RandomWordsFulfilled{}.Topic(): {},
+			},
+			// Listen one block early, see listener.Start
+			NumConfirmations: uint64(minConfs - 1),
+		})
+		latestHead, unsubscribeHeadBroadcaster := lsn.headBroadcaster.Subscribe(lsn)
+		if latestHead != nil {
+			lsn.setLatestHead(*latestHead)
+		}
+		go gracefulpanic.WrapRecover(func() {
+			lsn.runLogListener([]func(){unsubscribeLogs}, minConfs)
+		})
+		go gracefulpanic.WrapRecover(func() {
+			lsn.runHeadListener(unsubscribeHeadBroadcaster)
+		})
+		return nil
+	})
+}
+
+// Listen for new heads
+func (lsn *listenerV2) runHeadListener(unsubscribe func()) {
+	for {
+		select {
+		case <-lsn.chStop:
+			unsubscribe()
+			lsn.waitOnStop <- struct{}{}
+			return
+		case <-lsn.newHead:
+			lsn.processPendingRequests()
+			lsn.pruneConfirmedRequestCounts()
+		}
+	}
+}
+
+func (lsn *listenerV2) runLogListener(unsubscribes []func(), minConfs uint32) {
+	lsn.l.Infow("VRFListenerV2: listening for run requests",
+		"minConfs", minConfs,
+		"batchCoordinatorAddress", lsn.job.VRFSpec.BatchCoordinatorAddress)
+	for {
+		select {
+		case <-lsn.chStop:
+			for _, f := range unsubscribes {
+				f()
+			}
+			lsn.waitOnStop <- struct{}{}
+			return
+		case <-lsn.reqLogs.Notify():
+			// Process all the logs in the queue if one is added
+			for {
+				i, exists := lsn.reqLogs.Retrieve()
+				if !exists {
+					break
+				}
+				lb, ok := i.(log.Broadcast)
+				if !ok {
+					panic(fmt.Sprintf("VRFListenerV2: invariant violated, expected log.Broadcast got %T", i))
+				}
+				lsn.handleLog(lb, minConfs)
+			}
+		}
+	}
+}
+
+func (lsn *listenerV2) handleLog(lb log.Broadcast, minConfs uint32) {
+	if v, ok := lb.DecodedLog().(*vrf_coordinator_v2.VRFCoordinatorV2RandomWordsFulfilled); ok {
+		if !lsn.shouldProcessLog(lb) {
+			return
+		}
+		reqID := common.BigToHash(v.RequestId)
+		lsn.respCountMu.Lock()
+		lsn.respCount[reqID]++
+		lsn.respCountMu.Unlock()
+		lsn.blockNumberToReqID.Insert(fulfilledReq{
+			blockNumber: v.Raw.BlockNumber,
+			reqID:       reqID,
+		})
+		lsn.markLogAsConsumed(lb)
+		return
+	}
+
+	req, err := lsn.coordinator.ParseRandomWordsRequested(lb.RawLog())
+	if err != nil {
+		lsn.l.Errorw("VRFListenerV2: failed to parse log", "err", err, "txHash", lb.RawLog().TxHash)
+		if !lsn.shouldProcessLog(lb) {
+			return
+		}
+		lsn.markLogAsConsumed(lb)
+		return
+	}
+
+	lsn.reqsMu.Lock()
+	lsn.reqs = append(lsn.reqs, pendingRequestV2{
+		confirmedAtBlock: lsn.getConfirmedAt(req, minConfs),
+		req:              req,
+		lb:               lb,
+	})
+	lsn.reqAdded()
+	lsn.reqsMu.Unlock()
+}
+
+// getConfirmedAt returns the block a request is confirmed at: the larger of
+// the confirmations the job and the requester ask for, doubled for each
+// fulfillment of the request we already saw.
+func (lsn *listenerV2) getConfirmedAt(req *vrf_coordinator_v2.VRFCoordinatorV2RandomWordsRequested, minConfs uint32) uint64 {
+	lsn.respCountMu.Lock()
+	defer lsn.respCountMu.Unlock()
+	confs := uint64(minConfs)
+	if uint64(req.MinimumRequestConfirmations) > confs {
+		confs = uint64(req.MinimumRequestConfirmations)
+	}
+	respCount := lsn.respCount[common.BigToHash(req.RequestId)]
+	newConfs := confs * (1 << respCount)
+	// Capped for the same reason as in listener.getConfirmedAt
+	if newConfs > 200 {
+		newConfs = 200
+	}
+	if respCount > 0 {
+		lsn.l.Warnw("VRFListenerV2: duplicate request found after fulfillment, doubling incoming confirmations",
+			"txHash", req.Raw.TxHash,
+			"blockNumber", req.Raw.BlockNumber,
+			"blockHash", req.Raw.BlockHash,
+			"reqID", req.RequestId.String(),
+			"newConfs", newConfs)
+	}
+	return req.Raw.BlockNumber + newConfs
+}
+
+// Removes and returns all the confirmed requests from the pending queue, and
+// drops the ones that timed out.
+func (lsn *listenerV2) extractConfirmedRequests() []pendingRequestV2 {
+	lsn.reqsMu.Lock()
+	defer lsn.reqsMu.Unlock()
+	latestHead := lsn.getLatestHead()
+	var toProcess, toKeep []pendingRequestV2
+	for _, r := range lsn.reqs {
+		switch {
+		case r.req.Raw.BlockNumber+requestTimeoutBlocks < latestHead:
+			lsn.l.Warnw("VRFListenerV2: dropping request that could not be fulfilled in time",
+				"reqID", r.req.RequestId.String(),
+				"subID", r.req.SubId,
+				"txHash", r.req.Raw.TxHash)
+		case r.confirmedAtBlock <= latestHead:
+			toProcess = append(toProcess, r)
+		default:
+			toKeep = append(toKeep, r)
+		}
+	}
+	lsn.reqs = toKeep
+	return toProcess
+}
+
+// requeue puts back requests which could not be fulfilled yet, to retry
+// them on the next head
+func (lsn *listenerV2) requeue(reqs []pendingRequestV2) {
+	if len(reqs) == 0 {
+		return
+	}
+	lsn.reqsMu.Lock()
+	defer lsn.reqsMu.Unlock()
+	lsn.reqs = append(lsn.reqs, reqs...)
+}
+
+// Remove all entries 10000 blocks or older
+// to avoid a memory leak.
+func (lsn *listenerV2) pruneConfirmedRequestCounts() {
+	lsn.respCountMu.Lock()
+	defer lsn.respCountMu.Unlock()
+	min := lsn.blockNumberToReqID.FindMin()
+	for min != nil {
+		m := min.(fulfilledReq)
+		if m.blockNumber > (lsn.getLatestHead() - 10000) {
+			break
+		}
+		delete(lsn.respCount, m.reqID)
+		lsn.blockNumberToReqID.DeleteMin()
+		min = lsn.blockNumberToReqID.FindMin()
+	}
+}
+
+// processPendingRequests fulfills the confirmed requests the subscriptions
+// can pay for, and requeues the others
+func (lsn *listenerV2) processPendingRequests() {
+	confirmed := lsn.extractConfirmedRequests()
+	if len(confirmed) == 0 {
+		return
+	}
+	fromAddress, err := lsn.fromAddress()
+	if err != nil {
+		lsn.l.Errorw("VRFListenerV2: unable to pick a key to send fulfillments from", "err", err)
+		lsn.requeue(confirmed)
+		return
+	}
+
+	// Group the requests by subscription so each balance is read once
+	var subIDs []uint64
+	bySub := make(map[uint64][]pendingRequestV2)
+	for _, r := range confirmed {
+		if _, exists := bySub[r.req.SubId]; !exists {
+			subIDs = append(subIDs, r.req.SubId)
+		}
+		bySub[r.req.SubId] = append(bySub[r.req.SubId], r)
+	}
+	var fulfillments []fulfillmentV2
+	for _, subID := range subIDs {
+		fulfillments = append(fulfillments, lsn.processRequestsForSub(fromAddress, subID, bySub[subID])...)
+	}
+
+	if lsn.job.VRFSpec.BatchCoordinatorAddress == nil {
+		for _, f := range fulfillments {
+			lsn.sendFulfillment(fromAddress, f)
+		}
+		return
+	}
+	for _, batch := range batchFulfillments(fulfillments, lsn.batchGasLimit()) {
+		if len(batch) == 1 {
+			lsn.sendFulfillment(fromAddress, batch[0])
+		} else {
+			lsn.sendBatch(fromAddress, batch)
+		}
+	}
+}
+
+// processRequestsForSub runs the pipeline of the requests of a subscription
+// and returns the fulfillments its balance can pay for. The other requests
+// are requeued, and skipped without running their pipeline until the balance
+// covers their last simulated payment.
+func (lsn *listenerV2) processRequestsForSub(fromAddress common.Address, subID uint64, reqs []pendingRequestV2) []fulfillmentV2 {
+	sub, err := lsn.coordinator.GetSubscription(nil, subID)
+	if err != nil {
+		lsn.l.Errorw("VRFListenerV2: unable to read subscription", "err", err, "subID", subID)
+		lsn.requeue(reqs)
+		return nil
+	}
+	pendingLink, err := lsn.pendingLink(subID)
+	if err != nil {
+		lsn.l.Errorw("VRFListenerV2: unable to read the pending fulfillments of subscription", "err", err, "subID", subID)
+		lsn.requeue(reqs)
+		return nil
+	}
+	// The balance left once the fulfillments already queued are mined
+	balance := new(big.Int).Sub(sub.Balance, pendingLink)
+
+	var fulfillments []fulfillmentV2
+	var unfulfilled []pendingRequestV2
+	for _, r := range reqs {
+		// This check to see if the log was consumed needs to be in the same
+		// goroutine as the mark consumed to avoid processing duplicates.
+		if !lsn.shouldProcessLog(r.lb) {
+			continue
+		}
+		if balance.Sign() <= 0 || (r.minBalance != nil && r.minBalance.Cmp(balance) > 0) {
+			lsn.l.Debugw("VRFListenerV2: subscription balance still too low to fulfill request, retrying on the next head",
+				"reqID", r.req.RequestId.String(),
+				"subID", subID,
+				"balance", balance,
+				"minBalance", r.minBalance)
+			unfulfilled = append(unfulfilled, r)
+			continue
+		}
+		// Check if the request was already fulfilled, after the log was
+		// confirmed for the same reason as in listener.ProcessRequest
+		commitment, err := lsn.coordinator.GetCommitment(nil, r.req.RequestId)
+		if err != nil {
+			lsn.l.Errorw("VRFListenerV2: unable to check if already fulfilled, processing anyways", "err", err, "txHash", r.req.Raw.TxHash)
+		} else if utils.IsEmpty(commitment[:]) {
+			lsn.l.Infow("VRFListenerV2: request already fulfilled", "txHash", r.req.Raw.TxHash, "reqID", r.req.RequestId.String())
+			lsn.markLogAsConsumed(r.lb)
+			continue
+		}
+
+		f, err := lsn.runPipeline(r)
+		if err != nil {
+			// The proof failed, there is nothing to retry
+			lsn.l.Errorw("VRFListenerV2: pipeline run failed", "err", err, "reqID", r.req.RequestId.String())
+			lsn.saveFailedRun(f)
+			continue
+		}
+		maxLink, err := lsn.simulateFulfillment(fromAddress, f.payload, f.gasLimit)
+		if err != nil {
+			lsn.l.Warnw("VRFListenerV2: fulfillment simulation failed, retrying on the next head", "err", err, "reqID", r.req.RequestId.String(), "subID", subID)
+			unfulfilled = append(unfulfilled, r)
+			continue
+		}
+		if maxLink.Cmp(balance) > 0 {
+			lsn.l.Warnw("VRFListenerV2: subscription balance too low to fulfill request, retrying on the next head",
+				"reqID", r.req.RequestId.String(),
+				"subID", subID,
+				"balance", balance,
+				"maxLink", maxLink)
+			r.minBalance = maxLink
+			unfulfilled = append(unfulfilled, r)
+			continue
+		}
+		balance.Sub(balance, maxLink)
+		f.maxLink = maxLink
+		fulfillments = append(fulfillments, f)
+	}
+	lsn.requeue(unfulfilled)
+	return fulfillments
+}
+
+// pendingLink sums the most juels the fulfillments queued for a subscription,
+// and not yet mined, can charge it
+func (lsn *listenerV2) pendingLink(subID uint64) (*big.Int, error) {
+	ctx, cancel := postgres.DefaultQueryCtx()
+	defer cancel()
+	var pending string
+	sub := strconv.FormatUint(subID, 10)
+	err := lsn.db.WithContext(ctx).Raw(`SELECT coalesce(sum(max_link), 0)::text FROM (
+			SELECT (meta->>'MaxLink')::numeric AS max_link FROM eth_txes
+			WHERE state IN ('unstarted', 'in_progress', 'unconfirmed') AND meta->>'SubID' = ?
+			UNION ALL
+			SELECT (f->>'MaxLink')::numeric FROM eth_txes, jsonb_array_elements(meta->'Fulfillments') f
+			WHERE state IN ('unstarted', 'in_progress', 'unconfirmed') AND f->>'SubID' = ?
+		) pending`, sub, sub).Scan(&pending).Error
+	if err != nil {
+		return nil, err
+	}
+	amount, ok := new(big.Int).SetString(pending, 10)
+	if !ok {
+		return nil, errors.Errorf("invalid pending link amount %s", pending)
+	}
+	return amount, nil
+}
+
+// runPipeline generates the proof of a request. On error the returned
+// fulfillment holds the failed run.
+func (lsn *listenerV2) runPipeline(r pendingRequestV2) (fulfillmentV2, error) {
+	f := fulfillmentV2{pendingRequestV2: r}
+	lsn.l.Infow("VRFListenerV2: received log request",
+		"log", r.lb.String(),
+		"reqID", r.req.RequestId.String(),
+		"keyHash", hexutil.Encode(r.req.KeyHash[:]),
+		"subID", r.req.SubId,
+		"callbackGasLimit", r.req.CallbackGasLimit,
+		"numWords", r.req.NumWords,
+		"txHash", r.req.Raw.TxHash,
+		"blockNumber", r.req.Raw.BlockNumber,
+		"blockHash", r.req.Raw.BlockHash)
+
+	vars := pipeline.NewVarsFrom(map[string]interface{}{
+		"jobSpec": map[string]interface{}{
+			"databaseID":    lsn.job.ID,
+			"externalJobID": lsn.job.ExternalJobID,
+			"name":          lsn.job.Name.ValueOrZero(),
+			"publicKey":     lsn.job.VRFSpec.PublicKey[:],
+		},
+		"jobRun": map[string]interface{}{
+			"logBlockHash":   r.req.Raw.BlockHash[:],
+			"logBlockNumber": r.req.Raw.BlockNumber,
+			"logTxHash":      r.req.Raw.TxHash,
+			"logTopics":      r.req.Raw.Topics,
+			"logData":        r.req.Raw.Data,
+		},
+	})
+	start := time.Now()
+	run, trrs, err := lsn.pipelineRunner.ExecuteRun(context.Background(), *lsn.job.PipelineSpec, vars, lsn.l)
+	run.CreatedAt = start
+	run.FinishedAt = null.TimeFrom(time.Now())
+	f.run, f.trrs = run, trrs
+	if err != nil {
+		return f, errors.Wrap(err, "failed executing run")
+	}
+	if run.HasErrors() {
+		return f, errors.Errorf("run errored: %v", run.Errors)
+	}
+	for _, trr := range trrs {
+		if trr.Task.Type() != pipeline.TaskTypeVRFV2 {
+			continue
+		}
+		values, ok := trr.Result.Value.(map[string]interface{})
+		if !ok {
+			return f, errors.Errorf("unexpected %s task output %T", pipeline.TaskTypeVRFV2, trr.Result.Value)
+		}
+		f.proof, _ = values["proof"].(vrf_coordinator_v2.VRFProof)
+		f.rc, _ = values["requestCommitment"].(vrf_coordinator_v2.VRFCoordinatorV2RequestCommitment)
+		output, _ := values["output"].(string)
+		f.payload, err = hexutil.Decode(output)
+		if err != nil {
+			return f, errors.Wrapf(err, "invalid %s task output", pipeline.TaskTypeVRFV2)
+		}
+		f.gasLimit = uint64(r.req.CallbackGasLimit) + GasProofVerification
+		return f, nil
+	}
+	return f, errors.Errorf("pipeline has no %s task", pipeline.TaskTypeVRFV2)
+}
+
+// simulateFulfillment returns the most a fulfillment can cost the
+// subscription, which is the payment the coordinator would charge at the
+// maximum gas price. The key sending the fulfillment may not be able to
+// afford the maximum gas price, so the coordinator is called without a gas
+// price to get the flat fee, and at the current gas price to get the gas
+// part of the payment, which is then scaled up to the maximum gas price.
+func (lsn *listenerV2) simulateFulfillment(fromAddress common.Address, payload []byte, gasLimit uint64) (*big.Int, error) {
+	fee, err := lsn.callFulfillment(fromAddress, payload, gasLimit, nil)
+	if err != nil {
+		return nil, err
+	}
+	gasPrice := lsn.currentGasPrice(payload, gasLimit)
+	if gasPrice == nil || gasPrice.Sign() <= 0 {
+		return nil, errors.New("no gas price to simulate the fulfillment at")
+	}
+	payment, err := lsn.callFulfillment(fromAddress, payload, gasLimit, gasPrice)
+	if err != nil {
+		return nil, err
+	}
+	// The gas part of the payment is proportional to the gas price, round it
+	// up so the result is never below what the coordinator charges
+	gasPayment := new(big.Int).Sub(payment, fee)
+	gasPayment.Mul(gasPayment, lsn.cfg.EthMaxGasPriceWei())
+	gasPayment.Add(gasPayment, new(big.Int).Sub(gasPrice, big.NewInt(1)))
+	gasPayment.Div(gasPayment, gasPrice)
+	return gasPayment.Add(gasPayment, fee), nil
+}
+
+// currentGasPrice returns the gas price the fulfillment would be sent at,
+// falling back to ETH_GAS_PRICE_DEFAULT
+func (lsn *listenerV2) currentGasPrice(payload []byte, gasLimit uint64) *big.Int {
+	if estimator := lsn.txm.GetGasEstimator(); estimator != nil {
+		gasPrice, _, err := estimator.EstimateGas(payload, gasLimit)
+		if err == nil {
+			return gasPrice
+		}
+		lsn.l.Warnw("VRFListenerV2: unable to estimate gas price, simulating fulfillment at the default gas price", "err", err)
+	}
+	return lsn.cfg.EthGasPriceDefault()
+}
+
+// callFulfillment calls fulfillRandomWords at gasPrice and returns the payment
+// the coordinator would charge
+func (lsn *listenerV2) callFulfillment(fromAddress common.Address, payload []byte, gasLimit uint64, gasPrice *big.Int) (*big.Int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	to := lsn.coordinator.Address()
+	b, err := lsn.ethClient.CallContract(ctx, ethereum.CallMsg{
+		From:     fromAddress,
+		To:       &to,
+		Gas:      gasLimit,
+		GasPrice: gasPrice,
+		Data:     payload,
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	results, err := coordinatorV2ABI.Unpack("fulfillRandomWords", b)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to unpack fulfillRandomWords result")
+	}
+	if len(results) != 1 {
+		return nil, errors.Errorf("expected 1 fulfillRandomWords result, got %d", len(results))
+	}
+	payment, ok := results[0].(*big.Int)
+	if !ok {
+		return nil, errors.Errorf("unexpected fulfillRandomWords result %T", results[0])
+	}
+	return payment, nil
+}
+
+// sendFulfillment queues the transaction fulfilling a single request, in the
+// same database transaction as the run and the consumption of the log
+func (lsn *listenerV2) sendFulfillment(fromAddress common.Address, f fulfillmentV2) {
+	subID := f.req.SubId
+	maxLink := f.maxLink.String()
+	err := postgres.GormTransactionWithDefaultContext(lsn.db, func(tx *gorm.DB) error {
+		if err := lsn.saveRun(tx, f); err != nil {
+			return err
+		}
+		_, err := lsn.txm.CreateEthTransaction(tx, fromAddress, lsn.coordinator.Address(), f.payload, f.gasLimit, &models.EthTxMetaV2{
+			JobID:         lsn.job.ID,
+			RequestID:     common.BigToHash(f.req.RequestId),
+			RequestTxHash: f.req.Raw.TxHash,
+			SubID:         &subID,
+			MaxLink:       &maxLink,
+		}, bulletprooftxmanager.NewSendEveryStrategy(false))
+		return errors.Wrap(err, "VRFListenerV2: failed to create fulfillment transaction")
+	})
+	if err != nil {
+		lsn.l.Errorw("VRFListenerV2: failed to queue fulfillment", "err", err, "reqID", f.req.RequestId.String())
+		return
+	}
+	lsn.l.Infow("VRFListenerV2: queued fulfillment", "reqID", f.req.RequestId.String(), "subID", subID, "maxLink", maxLink)
+}
+
+// sendBatch queues a single transaction to the batch coordinator fulfilling
+// all the requests of the batch
+func (lsn *listenerV2) sendBatch(fromAddress common.Address, batch []fulfillmentV2) {
+	var (
+		proofs   []vrf_coordinator_v2.VRFProof
+		rcs      []vrf_coordinator_v2.VRFCoordinatorV2RequestCommitment
+		gasLimit uint64
+		meta     = models.EthTxMetaV2{JobID: lsn.job.ID}
+	)
+	for _, f := range batch {
+		proofs = append(proofs, f.proof)
+		rcs = append(rcs, f.rc)
+		gasLimit += f.gasLimit
+		meta.Fulfillments = append(meta.Fulfillments, models.VRFFulfillmentMeta{
+			RequestID: common.BigToHash(f.req.RequestId),
+			SubID:     f.req.SubId,
+			MaxLink:   f.maxLink.String(),
+		})
+	}
+	payload, err := batchCoordinatorV2ABI.Pack("fulfillRandomWords", proofs, rcs)
+	if err != nil {
+		lsn.l.Errorw("VRFListenerV2: unable to pack batch of fulfillments, sending them one by one", "err", err)
+		for _, f := range batch {
+			lsn.sendFulfillment(fromAddress, f)
+		}
+		return
+	}
+	err = postgres.GormTransactionWithDefaultContext(lsn.db, func(tx *gorm.DB) error {
+		for _, f := range batch {
+			if err = lsn.saveRun(tx, f); err != nil {
+				return err
+			}
+		}
+		_, err = lsn.txm.CreateEthTransaction(tx, fromAddress, lsn.job.VRFSpec.BatchCoordinatorAddress.Address(), payload, gasLimit, &meta, bulletprooftxmanager.NewSendEveryStrategy(false))
+		return errors.Wrap(err, "VRFListenerV2: failed to create batch fulfillment transaction")
+	})
+	if err != nil {
+		lsn.l.Errorw("VRFListenerV2: failed to queue batch of fulfillments", "err", err, "fulfillments", len(batch))
+		return
+	}
+	lsn.l.Infow("VRFListenerV2: queued batch of fulfillments", "fulfillments", len(batch), "gasLimit", gasLimit)
+}
+
+// batchFulfillments splits fulfillments into batches whose summed gas limit
+// is at most gasLimit. A fulfillment exceeding gasLimit on its own gets a
+// batch of its own.
+func batchFulfillments(fulfillments []fulfillmentV2, gasLimit uint64) [][]fulfillmentV2 {
+	var batches [][]fulfillmentV2
+	var batch []fulfillmentV2
+	var batchGas uint64
+	for _, f := range fulfillments {
+		if len(batch) > 0 && batchGas+f.gasLimit > gasLimit {
+			batches = append(batches, batch)
+			batch, batchGas = nil, 0
+		}
+		batch = append(batch, f)
+		batchGas += f.gasLimit
+	}
+	if len(batch) > 0 {
+		batches = append(batches, batch)
+	}
+	return batches
+}
+
+func (lsn *listenerV2) batchGasLimit() uint64 {
+	if lsn.job.VRFSpec.BatchFulfillmentGasLimit != 0 {
+		return lsn.job.VRFSpec.BatchFulfillmentGasLimit
+	}
+	return DefaultBatchFulfillmentGasLimit
+}
+
+func (lsn *listenerV2) fromAddress() (common.Address, error) {
+	var addresses []common.Address
+	if lsn.job.VRFSpec.FromAddress != nil {
+		addresses = append(addresses, lsn.job.VRFSpec.FromAddress.Address())
+	}
+	return lsn.gethks.GetRoundRobinAddress(addresses...)
+}
+
+// saveRun saves the run of a fulfillment and marks its log consumed
+func (lsn *listenerV2) saveRun(tx *gorm.DB, f fulfillmentV2) error {
+	_, err := lsn.pipelineRunner.InsertFinishedRun(tx, pipeline.Run{
+		State:          pipeline.RunStatusCompleted,
+		PipelineSpecID: f.run.PipelineSpecID,
+		Errors:         f.run.Errors,
+		Outputs:        f.run.Outputs,
+		Meta:           f.run.Meta,
+		CreatedAt:      f.run.CreatedAt,
+		FinishedAt:     f.run.FinishedAt,
+	}, f.trrs, true)
+	if err != nil {
+		return errors.Wrap(err, "VRFListenerV2: failed to insert finished run")
+	}
+	return lsn.logBroadcaster.MarkConsumed(tx, f.lb)
+}
+
+// saveFailedRun saves the run of a request whose proof failed, and consumes
+// its log as there is nothing to retry
+func (lsn *listenerV2) saveFailedRun(f fulfillmentV2) {
+	err := postgres.GormTransactionWithDefaultContext(lsn.db, func(tx *gorm.DB) error {
+		return lsn.saveRun(tx, f)
+	})
+	if err != nil {
+		lsn.l.Errorw("VRFListenerV2: failed to save run", "err", err)
+	}
+}
+
+func (lsn *listenerV2) shouldProcessLog(lb log.Broadcast) bool {
+	ctx, cancel := postgres.DefaultQueryCtx()
+	defer cancel()
+	consumed, err := lsn.logBroadcaster.WasAlreadyConsumed(lsn.db.WithContext(ctx), lb)
+	if err != nil {
+		lsn.l.Errorw("VRFListenerV2: could not determine if log was already consumed", "error", err, "txHash", lb.RawLog().TxHash)
+		// Do not process, let lb resend it as a retry mechanism.
+		return false
+	}
+	return !consumed
+}
+
+func (lsn *listenerV2) markLogAsConsumed(lb log.Broadcast) {
+	ctx, cancel := postgres.DefaultQueryCtx()
+	defer cancel()
+
+	err := lsn.logBroadcaster.MarkConsumed(lsn.db.WithContext(ctx), lb)
+	lsn.l.ErrorIf(errors.Wrapf(err, "VRFListenerV2: unable to mark log %v as consumed", lb.String()))
+}
+
+// Close complies with job.Service
+func (lsn *listenerV2) Close() error {
+	return lsn.StopOnce("VRFListenerV2", func() error {
+		close(lsn.chStop)
+		<-lsn.waitOnStop // Log listener
+		<-lsn.waitOnStop // Head listener
+		return nil
+	})
+}
+
+func (lsn *listenerV2) HandleLog(lb log.Broadcast) {
+	wasOverCapacity := lsn.reqLogs.Deliver(lb)
+	if wasOverCapacity {
+		logger.Error("VRFListenerV2: log mailbox is over capacity - dropped the oldest log")
+	}
+}
+
+// JobID complies with log.Listener
+func (lsn *listenerV2) JobID() int32 {
+	return lsn.job.ID
+}
@@ -0,0 +1,380 @@
+package vrf
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"math/big"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/datatypes"
+
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/vrf_coordinator_v2"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+	bptxmmocks "github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager/mocks"
+	eth_mocks "github.com/smartcontractkit/chainlink/core/services/eth/mocks"
+	gas_mocks "github.com/smartcontractkit/chainlink/core/services/gas/mocks"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/vrfkey"
+	"github.com/smartcontractkit/chainlink/core/services/log"
+	"github.com/smartcontractkit/chainlink/core/services/vrf/proof"
+	"github.com/smartcontractkit/chainlink/core/store/config"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/testdata/testspecs"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+func setupV2(t *testing.T) (vrfUniverse, *listenerV2, job.Job) {
+	db := pgtest.NewGormDB(t)
+	c := config.NewConfig()
+	vuni := buildVrfUni(t, db, c)
+	estimator := new(gas_mocks.Estimator)
+	estimator.On("EstimateGas", mock.Anything, mock.Anything).Return(big.NewInt(20e9), uint64(0), nil).Maybe()
+	vuni.txm.On("GetGasEstimator").Return(estimator).Maybe()
+
+	vd := NewDelegate(
+		db,
+		vuni.txm,
+		vuni.ks,
+		vuni.pr,
+		vuni.prm,
+		vuni.lb,
+		vuni.hb,
+		vuni.ec,
+		c)
+	vs := testspecs.GenerateVRFSpec(testspecs.VRFSpecParams{PublicKey: vuni.vrfkey.String(), V2: true})
+	jb, err := ValidatedVRFSpec(vs.Toml())
+	require.NoError(t, err)
+	jb, err = vuni.jrm.CreateJob(context.Background(), &jb, jb.Pipeline)
+	require.NoError(t, err)
+	vl, err := vd.ServicesForSpec(jb)
+	require.NoError(t, err)
+	require.Len(t, vl, 1)
+	listener := vl[0].(*listenerV2)
+	go func() {
+		listener.runLogListener([]func(){}, 6)
+	}()
+	go func() {
+		listener.runHeadListener(func() {})
+	}()
+	t.Cleanup(func() {
+		listener.chStop <- struct{}{}
+		waitForChannel(t, listener.waitOnStop, time.Second, "did not clean up properly")
+	})
+	return vuni, listener, jb
+}
+
+// coordinatorV2Call matches the eth_calls of a method of the coordinator
+func coordinatorV2Call(method string) interface{} {
+	return mock.MatchedBy(func(msg ethereum.CallMsg) bool {
+		return len(msg.Data) >= 4 && bytes.Equal(msg.Data[:4], coordinatorV2ABI.Methods[method].ID)
+	})
+}
+
+func packCoordinatorV2Outputs(t *testing.T, method string, values ...interface{}) []byte {
+	b, err := coordinatorV2ABI.Methods[method].Outputs.Pack(values...)
+	require.NoError(t, err)
+	return b
+}
+
+func randomWordsRequestedLog(t *testing.T, keyHash common.Hash, reqID *big.Int, subID uint64, minConfs uint16) types.Log {
+	event := coordinatorV2ABI.Events["RandomWordsRequested"]
+	data, err := event.Inputs.NonIndexed().Pack(reqID, big.NewInt(42), minConfs, uint32(100000), uint32(2))
+	require.NoError(t, err)
+	return types.Log{
+		Data: data,
+		Topics: []common.Hash{
+			event.ID,
+			keyHash,
+			common.BigToHash(new(big.Int).SetUint64(subID)),
+			common.BytesToHash(utils.NewHash().Bytes()[:20]), // sender
+		},
+		TxHash:      utils.NewHash(),
+		BlockNumber: 10,
+		BlockHash:   utils.NewHash(),
+	}
+}
+
+func TestListenerV2_FulfillsFundedRequests(t *testing.T) {
+	vuni, listener, jb := setupV2(t)
+	added := make(chan struct{})
+	listener.reqAdded = func() {
+		added <- struct{}{}
+	}
+	reqID := big.NewInt(7)
+	payment := big.NewInt(1e17)
+
+	vuni.lb.On("WasAlreadyConsumed", mock.Anything, mock.Anything).Return(false, nil)
+	vuni.lb.On("MarkConsumed", mock.Anything, mock.Anything).Return(nil).Once()
+	vuni.ec.On("CallContract", mock.Anything, coordinatorV2Call("getSubscription"), mock.Anything).
+		Return(packCoordinatorV2Outputs(t, "getSubscription", big.NewInt(1e18), uint64(1), common.Address{}, []common.Address{}), nil)
+	vuni.ec.On("CallContract", mock.Anything, coordinatorV2Call("getCommitment"), mock.Anything).
+		Return(packCoordinatorV2Outputs(t, "getCommitment", utils.NewHash()), nil)
+	vuni.ec.On("CallContract", mock.Anything, coordinatorV2Call("fulfillRandomWords"), mock.Anything).
+		Return(packCoordinatorV2Outputs(t, "fulfillRandomWords", payment), nil)
+	queued := make(chan struct{})
+	vuni.txm.On("CreateEthTransaction", mock.AnythingOfType("*gorm.DB"), vuni.submitter, jb.VRFSpec.CoordinatorAddress.Address(), mock.Anything, uint64(100000)+GasProofVerification, mock.MatchedBy(func(meta *models.EthTxMetaV2) bool {
+		return meta.JobID == jb.ID && meta.RequestID == common.BigToHash(reqID) && *meta.SubID == 1 && *meta.MaxLink == payment.String()
+	}), bulletprooftxmanager.SendEveryStrategy{}).Once().Run(func(mock.Arguments) {
+		queued <- struct{}{}
+	}).Return(bulletprooftxmanager.EthTx{}, nil)
+
+	listener.HandleLog(log.NewLogBroadcast(randomWordsRequestedLog(t, jb.VRFSpec.PublicKey.MustHash(), reqID, 1, 3), nil))
+	waitForChannel(t, added, time.Second, "request not added to the queue")
+	// The job asks for more confirmations than the request
+	assert.Equal(t, uint64(16), listener.reqs[0].confirmedAtBlock)
+	listener.OnNewLongestChain(context.Background(), models.Head{Number: 16})
+	waitForChannel(t, queued, 2*time.Second, "fulfillment not queued")
+
+	runs, err := vuni.prm.GetAllRuns()
+	require.NoError(t, err)
+	require.Len(t, runs, 1)
+	assert.False(t, runs[0].Errors.HasError())
+	vuni.Assert(t)
+}
+
+func TestListenerV2_RequeuesUnderfundedRequests(t *testing.T) {
+	vuni, listener, jb := setupV2(t)
+	added := make(chan struct{})
+	listener.reqAdded = func() {
+		added <- struct{}{}
+	}
+
+	vuni.lb.On("WasAlreadyConsumed", mock.Anything, mock.Anything).Return(false, nil)
+	subscriptionRead := make(chan struct{})
+	vuni.ec.On("CallContract", mock.Anything, coordinatorV2Call("getSubscription"), mock.Anything).Run(func(mock.Arguments) {
+		subscriptionRead <- struct{}{}
+	}).Return(packCoordinatorV2Outputs(t, "getSubscription", big.NewInt(1e16), uint64(1), common.Address{}, []common.Address{}), nil)
+	vuni.ec.On("CallContract", mock.Anything, coordinatorV2Call("getCommitment"), mock.Anything).
+		Return(packCoordinatorV2Outputs(t, "getCommitment", utils.NewHash()), nil)
+	var simulations int32
+	vuni.ec.On("CallContract", mock.Anything, coordinatorV2Call("fulfillRandomWords"), mock.Anything).Run(func(args mock.Arguments) {
+		if args.Get(1).(ethereum.CallMsg).GasPrice != nil {
+			atomic.AddInt32(&simulations, 1)
+		}
+	}).Return(packCoordinatorV2Outputs(t, "fulfillRandomWords", big.NewInt(1e17)), nil)
+
+	requeued := func() bool {
+		listener.reqsMu.Lock()
+		defer listener.reqsMu.Unlock()
+		return len(listener.reqs) == 1
+	}
+	listener.HandleLog(log.NewLogBroadcast(randomWordsRequestedLog(t, jb.VRFSpec.PublicKey.MustHash(), big.NewInt(7), 1, 3), nil))
+	waitForChannel(t, added, time.Second, "request not added to the queue")
+	listener.OnNewLongestChain(context.Background(), models.Head{Number: 16})
+	waitForChannel(t, subscriptionRead, 2*time.Second, "subscription not read")
+	require.Eventually(t, requeued, time.Second, 10*time.Millisecond, "request not requeued")
+	assert.Equal(t, int32(1), atomic.LoadInt32(&simulations))
+
+	// The balance did not change, so the proof isn't generated again
+	listener.OnNewLongestChain(context.Background(), models.Head{Number: 17})
+	waitForChannel(t, subscriptionRead, 2*time.Second, "subscription not read")
+	require.Eventually(t, requeued, time.Second, 10*time.Millisecond, "request not requeued")
+	assert.Equal(t, int32(1), atomic.LoadInt32(&simulations))
+	// Nothing is saved until the subscription can pay
+	runs, err := vuni.prm.GetAllRuns()
+	require.NoError(t, err)
+	assert.Len(t, runs, 0)
+	vuni.lb.AssertNotCalled(t, "MarkConsumed", mock.Anything, mock.Anything)
+}
+
+func TestListenerV2_SimulateFulfillment(t *testing.T) {
+	c := config.NewConfig()
+	c.Set("ETH_MAX_GAS_PRICE_WEI", 500e9)
+	ec := new(eth_mocks.Client)
+	txm := new(bptxmmocks.TxManager)
+	estimator := new(gas_mocks.Estimator)
+	coordinator, err := vrf_coordinator_v2.NewVRFCoordinatorV2(common.HexToAddress("0x01"), ec)
+	require.NoError(t, err)
+	lsn := &listenerV2{cfg: c, l: *logger.Default, ethClient: ec, coordinator: coordinator, txm: txm}
+	from := common.HexToAddress("0x02")
+	payload := []byte{1, 2, 3}
+
+	// The key can't afford the maximum gas price, so it is never simulated at
+	noGasPrice := mock.MatchedBy(func(msg ethereum.CallMsg) bool { return msg.GasPrice == nil })
+	currentGasPrice := mock.MatchedBy(func(msg ethereum.CallMsg) bool {
+		return msg.GasPrice != nil && msg.GasPrice.Cmp(big.NewInt(20e9)) == 0 && msg.From == from
+	})
+	ec.On("CallContract", mock.Anything, noGasPrice, mock.Anything).
+		Return(packCoordinatorV2Outputs(t, "fulfillRandomWords", big.NewInt(1e15)), nil)
+	ec.On("CallContract", mock.Anything, currentGasPrice, mock.Anything).
+		Return(packCoordinatorV2Outputs(t, "fulfillRandomWords", big.NewInt(1e15+1e16+1)), nil)
+
+	t.Run("scales the gas payment to the maximum gas price", func(t *testing.T) {
+		estimator.On("EstimateGas", payload, uint64(300000)).Return(big.NewInt(20e9), uint64(300000), nil).Once()
+		txm.On("GetGasEstimator").Return(estimator).Once()
+
+		maxLink, err := lsn.simulateFulfillment(from, payload, 300000)
+		require.NoError(t, err)
+		// 1e16+1 at 20 gwei is 25e16+25 at 500 gwei, plus the flat fee
+		assert.Equal(t, big.NewInt(1e15+25e16+25).String(), maxLink.String())
+	})
+
+	t.Run("falls back to the default gas price", func(t *testing.T) {
+		c.Set("ETH_GAS_PRICE_DEFAULT", 20e9)
+		txm.On("GetGasEstimator").Return(nil).Once()
+
+		maxLink, err := lsn.simulateFulfillment(from, payload, 300000)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(1e15+25e16+25).String(), maxLink.String())
+	})
+	ec.AssertExpectations(t)
+	estimator.AssertExpectations(t)
+}
+
+func TestStartingCountsV2(t *testing.T) {
+	db := pgtest.NewGormDB(t)
+	counts := getStartingResponseCountsV2(db, logger.Default)
+	assert.Equal(t, 0, len(counts))
+
+	ks := keystore.New(db, utils.FastScryptParams)
+	require.NoError(t, ks.Eth().Unlock("blah"))
+	k, err := ks.Eth().CreateNewKey()
+	require.NoError(t, err)
+	subID, maxLink := uint64(1), "100"
+	single, err := json.Marshal(&models.EthTxMetaV2{
+		RequestID: utils.PadByteToHash(0x10),
+		SubID:     &subID,
+		MaxLink:   &maxLink,
+	})
+	require.NoError(t, err)
+	batch, err := json.Marshal(&models.EthTxMetaV2{
+		Fulfillments: []models.VRFFulfillmentMeta{
+			{RequestID: utils.PadByteToHash(0x10), SubID: subID, MaxLink: maxLink},
+			{RequestID: utils.PadByteToHash(0x11), SubID: subID, MaxLink: maxLink},
+		},
+	})
+	require.NoError(t, err)
+	v1, err := json.Marshal(&models.EthTxMetaV2{
+		RequestID: utils.PadByteToHash(0x12),
+	})
+	require.NoError(t, err)
+	b := time.Now()
+	var txes []bulletprooftxmanager.EthTx
+	for i, meta := range [][]byte{single, batch, v1} {
+		nonce := int64(i)
+		txes = append(txes, bulletprooftxmanager.EthTx{
+			Nonce:          &nonce,
+			FromAddress:    k.Address.Address(),
+			BroadcastAt:    &b,
+			CreatedAt:      b,
+			State:          bulletprooftxmanager.EthTxUnconfirmed,
+			Meta:           datatypes.JSON(meta),
+			EncodedPayload: []byte{},
+		})
+	}
+	require.NoError(t, db.Create(&txes).Error)
+
+	counts = getStartingResponseCountsV2(db, logger.Default)
+	assert.Equal(t, 2, len(counts))
+	assert.Equal(t, uint64(2), counts[utils.PadByteToHash(0x10)])
+	assert.Equal(t, uint64(1), counts[utils.PadByteToHash(0x11)])
+
+	// Both transactions are still pending, so they count against the subscription
+	lsn := listenerV2{db: db}
+	pending, err := lsn.pendingLink(subID)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(300), pending)
+	pending, err = lsn.pendingLink(subID + 1)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(0), pending)
+}
+
+func TestListenerV2_GetConfirmedAt(t *testing.T) {
+	lsn := listenerV2{l: *logger.Default, respCount: make(map[[32]byte]uint64)}
+	req := &vrf_coordinator_v2.VRFCoordinatorV2RandomWordsRequested{
+		RequestId:                   big.NewInt(1),
+		MinimumRequestConfirmations: 3,
+		Raw:                         types.Log{BlockNumber: 10},
+	}
+	// The larger of the job's and the request's confirmations
+	assert.Equal(t, uint64(16), lsn.getConfirmedAt(req, 6))
+	req.MinimumRequestConfirmations = 20
+	assert.Equal(t, uint64(30), lsn.getConfirmedAt(req, 6))
+	// Doubled for each fulfillment already seen, up to 200
+	lsn.respCount[common.BigToHash(req.RequestId)] = 2
+	assert.Equal(t, uint64(90), lsn.getConfirmedAt(req, 6))
+	lsn.respCount[common.BigToHash(req.RequestId)] = 4
+	assert.Equal(t, uint64(210), lsn.getConfirmedAt(req, 6))
+}
+
+func TestListenerV2_ExtractConfirmedRequests(t *testing.T) {
+	lsn := listenerV2{l: *logger.Default}
+	newReq := func(blockNumber, confirmedAt uint64) pendingRequestV2 {
+		return pendingRequestV2{
+			confirmedAtBlock: confirmedAt,
+			req: &vrf_coordinator_v2.VRFCoordinatorV2RandomWordsRequested{
+				RequestId: big.NewInt(int64(confirmedAt)),
+				Raw:       types.Log{BlockNumber: blockNumber},
+			},
+		}
+	}
+	lsn.reqs = []pendingRequestV2{newReq(1, 2), newReq(1, 1), newReq(1, 3)}
+	lsn.latestHead = 0
+	assert.Len(t, lsn.extractConfirmedRequests(), 0)
+	assert.Len(t, lsn.reqs, 3)
+	lsn.latestHead = 2
+	assert.Len(t, lsn.extractConfirmedRequests(), 2)
+	require.Len(t, lsn.reqs, 1)
+	assert.Equal(t, uint64(3), lsn.reqs[0].confirmedAtBlock)
+
+	// Requeued requests are dropped once they time out
+	lsn.requeue([]pendingRequestV2{newReq(1, 2)})
+	lsn.latestHead = 1 + requestTimeoutBlocks + 1
+	assert.Len(t, lsn.extractConfirmedRequests(), 0)
+	assert.Len(t, lsn.reqs, 0)
+}
+
+func Test_batchFulfillments(t *testing.T) {
+	newFulfillment := func(gasLimit uint64) fulfillmentV2 {
+		return fulfillmentV2{gasLimit: gasLimit}
+	}
+	gasLimits := func(batches [][]fulfillmentV2) [][]uint64 {
+		var limits [][]uint64
+		for _, batch := range batches {
+			var l []uint64
+			for _, f := range batch {
+				l = append(l, f.gasLimit)
+			}
+			limits = append(limits, l)
+		}
+		return limits
+	}
+
+	assert.Empty(t, batchFulfillments(nil, 1000))
+	assert.Equal(t, [][]uint64{{400, 500}, {300}},
+		gasLimits(batchFulfillments([]fulfillmentV2{newFulfillment(400), newFulfillment(500), newFulfillment(300)}, 1000)))
+	// A fulfillment over the limit on its own still gets sent
+	assert.Equal(t, [][]uint64{{200}, {1500}, {100}},
+		gasLimits(batchFulfillments([]fulfillmentV2{newFulfillment(200), newFulfillment(1500), newFulfillment(100)}, 1000)))
+}
+
+func TestBatchCoordinatorV2Payload(t *testing.T) {
+	sk := vrfkey.CreateKey()
+	preSeed, err := proof.BigToSeed(big.NewInt(42))
+	require.NoError(t, err)
+	s := proof.PreSeedDataV2{PreSeed: preSeed, BlockHash: utils.NewHash(), BlockNum: 10, SubId: 1, CallbackGasLimit: 100000, NumWords: 2}
+	p, err := sk.GenerateProof(proof.FinalSeedV2(s))
+	require.NoError(t, err)
+	onChainProof, rc, err := proof.GenerateProofResponseFromProofV2(p, s)
+	require.NoError(t, err)
+
+	payload, err := batchCoordinatorV2ABI.Pack("fulfillRandomWords",
+		[]vrf_coordinator_v2.VRFProof{onChainProof, onChainProof},
+		[]vrf_coordinator_v2.VRFCoordinatorV2RequestCommitment{rc, rc})
+	require.NoError(t, err)
+	args, err := batchCoordinatorV2ABI.Methods["fulfillRandomWords"].Inputs.Unpack(payload[4:])
+	require.NoError(t, err)
+	require.Len(t, args, 2)
+}
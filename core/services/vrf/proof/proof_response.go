@@ -4,8 +4,11 @@ package proof
 // block in which a VRF request appeared
 
 import (
+	"math/big"
+
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/vrf_coordinator_v2"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/vrfkey"
 	"github.com/smartcontractkit/chainlink/core/services/signatures/secp256k1"
@@ -105,3 +108,39 @@ func GenerateProofResponse(keystore *keystore.VRF, key secp256k1.PublicKey, s Pr
 	}
 	return GenerateProofResponseFromProof(proof, s)
 }
+
+// GenerateProofResponseFromProofV2 returns the proof and request commitment
+// which are sent to a VRFCoordinatorV2 to fulfill the request described by s.
+// As for the VRFCoordinator, the seed of the proof is replaced with the
+// pre-seed, from which the coordinator computes the final seed itself.
+func GenerateProofResponseFromProofV2(p vrfkey.Proof, s PreSeedDataV2) (
+	vrf_coordinator_v2.VRFProof, vrf_coordinator_v2.VRFCoordinatorV2RequestCommitment, error) {
+	solidityProof, err := SolidityPrecalculations(&p)
+	if err != nil {
+		return vrf_coordinator_v2.VRFProof{}, vrf_coordinator_v2.VRFCoordinatorV2RequestCommitment{},
+			errors.Wrap(err, "while marshaling proof for VRFCoordinatorV2")
+	}
+	pkX, pkY := secp256k1.Coordinates(solidityProof.P.PublicKey)
+	gammaX, gammaY := secp256k1.Coordinates(solidityProof.P.Gamma)
+	cGammaX, cGammaY := secp256k1.Coordinates(solidityProof.CGammaWitness)
+	sHashX, sHashY := secp256k1.Coordinates(solidityProof.SHashWitness)
+	proof := vrf_coordinator_v2.VRFProof{
+		Pk:            [2]*big.Int{pkX, pkY},
+		Gamma:         [2]*big.Int{gammaX, gammaY},
+		C:             solidityProof.P.C,
+		S:             solidityProof.P.S,
+		Seed:          s.PreSeed.Big(),
+		UWitness:      solidityProof.UWitness,
+		CGammaWitness: [2]*big.Int{cGammaX, cGammaY},
+		SHashWitness:  [2]*big.Int{sHashX, sHashY},
+		ZInv:          solidityProof.ZInv,
+	}
+	rc := vrf_coordinator_v2.VRFCoordinatorV2RequestCommitment{
+		BlockNum:         s.BlockNum,
+		SubId:            s.SubId,
+		CallbackGasLimit: s.CallbackGasLimit,
+		NumWords:         s.NumWords,
+		Sender:           s.Sender,
+	}
+	return proof, rc, nil
+}
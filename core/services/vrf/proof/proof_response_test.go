@@ -13,13 +13,28 @@ import (
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/eth/ethconfig"
-	"github.com/pkg/errors"
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/solidity_vrf_verifier_wrapper"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/vrfkey"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
+func deployVRFTestHelper(t *testing.T) *solidity_vrf_verifier_wrapper.VRFTestHelper {
+	ethereumKey, _ := crypto.GenerateKey()
+	auth, err := bind.NewKeyedTransactorWithChainID(ethereumKey, big.NewInt(1337))
+	require.NoError(t, err)
+	genesisData := core.GenesisAlloc{auth.From: {Balance: assets.Ether(100)}}
+	gasLimit := ethconfig.Defaults.Miner.GasCeil
+	backend := backends.NewSimulatedBackend(genesisData, gasLimit)
+	_, _, verifier, err := solidity_vrf_verifier_wrapper.DeployVRFTestHelper(auth, backend)
+	require.NoError(t, err)
+	backend.Commit()
+	return verifier
+}
+
 func TestMarshaledProof(t *testing.T) {
 	store, cleanup := cltest.NewStore(t)
 	defer cleanup()
@@ -41,17 +56,49 @@ func TestMarshaledProof(t *testing.T) {
 	require.NoError(t, err)
 	// NB: For changes to the VRF solidity code to be reflected here, "go generate"
 	// must be run in core/services/vrf.
-	ethereumKey, _ := crypto.GenerateKey()
-	auth, err := bind.NewKeyedTransactorWithChainID(ethereumKey, big.NewInt(1337))
+	verifier := deployVRFTestHelper(t)
+	_, err = verifier.RandomValueFromVRFProof(nil, proof[:])
 	require.NoError(t, err)
-	genesisData := core.GenesisAlloc{auth.From: {Balance: assets.Ether(100)}}
-	gasLimit := ethconfig.Defaults.Miner.GasCeil
-	backend := backends.NewSimulatedBackend(genesisData, gasLimit)
-	_, _, verifier, err := solidity_vrf_verifier_wrapper.DeployVRFTestHelper(auth, backend)
-	if err != nil {
-		panic(errors.Wrapf(err, "while initializing EVM contract wrapper"))
+}
+
+func TestGenerateProofResponseFromProofV2(t *testing.T) {
+	key := vrfkey.CreateKey()
+	preSeed, err := proof2.BigToSeed(big.NewInt(42))
+	require.NoError(t, err)
+	s := proof2.PreSeedDataV2{
+		PreSeed:          preSeed,
+		BlockHash:        utils.NewHash(),
+		BlockNum:         10,
+		SubId:            7,
+		CallbackGasLimit: 100000,
+		NumWords:         3,
+		Sender:           cltest.NewAddress(),
 	}
-	backend.Commit()
-	_, err = verifier.RandomValueFromVRFProof(nil, proof[:])
+	p, err := key.GenerateProof(proof2.FinalSeedV2(s))
+	require.NoError(t, err)
+
+	onChainProof, rc, err := proof2.GenerateProofResponseFromProofV2(p, s)
+	require.NoError(t, err)
+	assert.Equal(t, preSeed.Big(), onChainProof.Seed)
+	assert.Equal(t, uint64(10), rc.BlockNum)
+	assert.Equal(t, uint64(7), rc.SubId)
+	assert.Equal(t, uint32(100000), rc.CallbackGasLimit)
+	assert.Equal(t, uint32(3), rc.NumWords)
+	assert.Equal(t, s.Sender, rc.Sender)
+
+	// The coordinator mixes the pre-seed with the block hash before verifying
+	// the proof, so the proof with the final seed must verify
+	var marshaled []byte
+	for _, x := range []*big.Int{
+		onChainProof.Pk[0], onChainProof.Pk[1], onChainProof.Gamma[0], onChainProof.Gamma[1],
+		onChainProof.C, onChainProof.S, proof2.FinalSeedV2(s), onChainProof.UWitness.Hash().Big(),
+		onChainProof.CGammaWitness[0], onChainProof.CGammaWitness[1],
+		onChainProof.SHashWitness[0], onChainProof.SHashWitness[1], onChainProof.ZInv,
+	} {
+		marshaled = append(marshaled, utils.Uint256ToBytes32(x)...)
+	}
+	require.Len(t, marshaled, proof2.ProofLength)
+	output, err := deployVRFTestHelper(t).RandomValueFromVRFProof(nil, marshaled)
 	require.NoError(t, err)
+	assert.Equal(t, p.Output, output)
 }
@@ -65,3 +65,21 @@ func TestXXXSeedData(t *testing.T, preSeed *big.Int, blockHash common.Hash,
 		BlockHash: blockHash,
 	}
 }
+
+// PreSeedDataV2 contains the data the VRF provider needs to compute the final
+// VRF output and the request commitment for a VRFCoordinatorV2 request.
+type PreSeedDataV2 struct {
+	PreSeed          Seed           // Seed to be mixed with hash of containing block
+	BlockHash        common.Hash    // Hash of block containing VRF request
+	BlockNum         uint64         // Cardinal number of block containing VRF request
+	SubId            uint64         // Subscription the request is charged to
+	CallbackGasLimit uint32         // Gas limit of the consumer callback
+	NumWords         uint32         // Number of random words requested
+	Sender           common.Address // Consumer which made the request
+}
+
+// FinalSeedV2 is the seed which is actually passed to the VRF proof generator
+// for a VRFCoordinatorV2 request. It is computed in the same way as FinalSeed.
+func FinalSeedV2(s PreSeedDataV2) (finalSeed *big.Int) {
+	return FinalSeed(PreSeedData{PreSeed: s.PreSeed, BlockHash: s.BlockHash, BlockNum: s.BlockNum})
+}
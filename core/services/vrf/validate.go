@@ -48,6 +48,20 @@ func ValidatedVRFSpec(tomlString string) (job.Job, error) {
 	if spec.CoordinatorAddress.String() == "" {
 		return jb, errors.Wrap(ErrKeyNotSet, "coordinatorAddress")
 	}
+	switch spec.CoordinatorVersion {
+	case "":
+		spec.CoordinatorVersion = job.VRFCoordinatorV1
+	case job.VRFCoordinatorV1, job.VRFCoordinatorV2:
+	default:
+		return jb, errors.Errorf("unsupported coordinatorVersion %s, expected %s or %s", spec.CoordinatorVersion, job.VRFCoordinatorV1, job.VRFCoordinatorV2)
+	}
+	if spec.CoordinatorVersion != job.VRFCoordinatorV2 {
+		if spec.FromAddress != nil || spec.BatchCoordinatorAddress != nil || spec.BatchFulfillmentGasLimit != 0 {
+			return jb, errors.Errorf("fromAddress, batchCoordinatorAddress and batchFulfillmentGasLimit are only supported by coordinatorVersion %s", job.VRFCoordinatorV2)
+		}
+	} else if spec.BatchFulfillmentGasLimit != 0 && spec.BatchCoordinatorAddress == nil {
+		return jb, errors.Wrap(ErrKeyNotSet, "batchCoordinatorAddress")
+	}
 
 	jb.VRFSpec = &spec
 
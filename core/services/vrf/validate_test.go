@@ -31,6 +31,75 @@ coordinatorAddress = "0xB3b7874F13387D44a3398D298B075B7A3505D8d4"
 				assert.Equal(t, uint32(10), s.VRFSpec.Confirmations)
 				assert.Equal(t, "0xB3b7874F13387D44a3398D298B075B7A3505D8d4", s.VRFSpec.CoordinatorAddress.String())
 				assert.Equal(t, "0x79be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f8179800", s.VRFSpec.PublicKey.String())
+				assert.Equal(t, job.VRFCoordinatorV1, s.VRFSpec.CoordinatorVersion)
+			},
+		},
+		{
+			name: "valid v2 spec with batching",
+			toml: `
+type            = "vrf"
+schemaVersion   = 1
+confirmations = 10
+publicKey = "0x79BE667EF9DCBBAC55A06295CE870B07029BFCDB2DCE28D959F2815B16F8179800"
+coordinatorAddress = "0xB3b7874F13387D44a3398D298B075B7A3505D8d4"
+coordinatorVersion = "v2"
+fromAddress = "0x9E40733cC9df84636505f4e6Db28DCa0dC5D1bba"
+batchCoordinatorAddress = "0xa8037A20989AFcBC51798de9762b351D63ff462e"
+batchFulfillmentGasLimit = 3000000
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, s.VRFSpec)
+				assert.Equal(t, job.VRFCoordinatorV2, s.VRFSpec.CoordinatorVersion)
+				require.NotNil(t, s.VRFSpec.FromAddress)
+				require.NotNil(t, s.VRFSpec.BatchCoordinatorAddress)
+				assert.Equal(t, uint64(3000000), s.VRFSpec.BatchFulfillmentGasLimit)
+			},
+		},
+		{
+			name: "unsupported coordinator version",
+			toml: `
+type            = "vrf"
+schemaVersion   = 1
+confirmations = 10
+publicKey = "0x79BE667EF9DCBBAC55A06295CE870B07029BFCDB2DCE28D959F2815B16F8179800"
+coordinatorAddress = "0xB3b7874F13387D44a3398D298B075B7A3505D8d4"
+coordinatorVersion = "v3"
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "unsupported coordinatorVersion")
+			},
+		},
+		{
+			name: "batching with a v1 coordinator",
+			toml: `
+type            = "vrf"
+schemaVersion   = 1
+confirmations = 10
+publicKey = "0x79BE667EF9DCBBAC55A06295CE870B07029BFCDB2DCE28D959F2815B16F8179800"
+coordinatorAddress = "0xB3b7874F13387D44a3398D298B075B7A3505D8d4"
+batchCoordinatorAddress = "0xa8037A20989AFcBC51798de9762b351D63ff462e"
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "only supported by coordinatorVersion v2")
+			},
+		},
+		{
+			name: "batch gas limit without a batch coordinator",
+			toml: `
+type            = "vrf"
+schemaVersion   = 1
+confirmations = 10
+publicKey = "0x79BE667EF9DCBBAC55A06295CE870B07029BFCDB2DCE28D959F2815B16F8179800"
+coordinatorAddress = "0xB3b7874F13387D44a3398D298B075B7A3505D8d4"
+coordinatorVersion = "v2"
+batchFulfillmentGasLimit = 3000000
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.Error(t, err)
+				require.True(t, ErrKeyNotSet == errors.Cause(err))
 			},
 		},
 		{
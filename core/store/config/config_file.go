@@ -0,0 +1,366 @@
+package config
+
+import (
+	"encoding"
+	"fmt"
+	"math/big"
+	"net"
+	"net/url"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pelletier/go-toml"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// ReloadableFields are the config file fields that are applied when the
+// config file is reloaded, without restarting the node. They are saved in the
+// runtime store, the same way as when they are changed through the API.
+var ReloadableFields = []string{"EthGasPriceDefault", "LogLevel", "LogSQLStatements"}
+
+// configFile is a TOML config file loaded with LoadConfigFile
+type configFile struct {
+	path string
+
+	mu sync.Mutex
+	// values are keyed by ConfigSchema field name
+	values map[string]interface{}
+	// loaded are the values the config was loaded with
+	loaded map[string]interface{}
+
+	reloadedMu sync.RWMutex
+	// reloaded are the values of ReloadableFields applied by ReloadConfigFile
+	reloaded map[string]string
+}
+
+// ReadConfigFile reads the TOML config file at path and returns its values
+// keyed by ConfigSchema field name. The keys of the file are the names of the
+// ConfigSchema fields, e.g. EthGasBumpPercent for ETH_GAS_BUMP_PERCENT. All
+// unknown keys and invalid values are reported in a single error.
+func ReadConfigFile(path string) (map[string]interface{}, error) {
+	tree, err := toml.LoadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse config file %s", path)
+	}
+
+	schemaT := reflect.TypeOf(ConfigSchema{})
+	envNames := map[string]string{}
+	for index := 0; index < schemaT.NumField(); index++ {
+		item := schemaT.Field(index)
+		envNames[item.Tag.Get("env")] = item.Name
+	}
+
+	values := map[string]interface{}{}
+	keys := tree.Keys()
+	sort.Slice(keys, func(i, j int) bool {
+		return tree.GetPosition(keys[i]).Line < tree.GetPosition(keys[j]).Line
+	})
+	for _, key := range keys {
+		pos := tree.GetPosition(key)
+		item, ok := schemaT.FieldByName(key)
+		if !ok {
+			if name, isEnv := envNames[key]; isEnv {
+				err = multierr.Append(err, errors.Errorf("line %d: unknown key %s, use %s instead", pos.Line, key, name))
+			} else {
+				err = multierr.Append(err, errors.Errorf("line %d: unknown key %s", pos.Line, key))
+			}
+			continue
+		}
+		value, verr := configFileValue(item, tree.Get(key))
+		if verr != nil {
+			err = multierr.Append(err, errors.Wrapf(verr, "line %d: invalid value for %s", pos.Line, key))
+			continue
+		}
+		values[key] = value
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid config file %s", path)
+	}
+	return values, nil
+}
+
+// configFileValue converts a TOML value to the raw value of a config field,
+// which is []string for list fields and a string otherwise
+func configFileValue(item reflect.StructField, v interface{}) (interface{}, error) {
+	if item.Type == reflect.TypeOf([]string{}) {
+		list, ok := v.([]interface{})
+		if !ok {
+			return nil, errors.Errorf("expected an array of strings, got %T", v)
+		}
+		strs := make([]string, len(list))
+		for i, e := range list {
+			s, ok := e.(string)
+			if !ok {
+				return nil, errors.Errorf("expected an array of strings, got an element of type %T", e)
+			}
+			strs[i] = s
+		}
+		return strs, nil
+	}
+
+	var raw string
+	switch t := v.(type) {
+	case string:
+		raw = t
+	case int64:
+		raw = strconv.FormatInt(t, 10)
+	case float64:
+		raw = strconv.FormatFloat(t, 'f', -1, 64)
+	case bool:
+		raw = strconv.FormatBool(t)
+	default:
+		return nil, errors.Errorf("expected a string, number or boolean, got %T", v)
+	}
+	if err := checkFieldValue(item, raw); err != nil {
+		return nil, err
+	}
+	return raw, nil
+}
+
+var (
+	durationType      = reflect.TypeOf(time.Duration(0))
+	modelDurationType = reflect.TypeOf(models.Duration{})
+	bigIntType        = reflect.TypeOf(big.Int{})
+	urlType           = reflect.TypeOf(url.URL{})
+	ipType            = reflect.TypeOf(net.IP{})
+	addressType       = reflect.TypeOf(common.Address{})
+	textUnmarshaler   = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// checkFieldValue returns an error if raw cannot be parsed as the type of the
+// given ConfigSchema field. Empty values are treated as unset.
+func checkFieldValue(item reflect.StructField, raw string) (err error) {
+	if raw == "" {
+		return nil
+	}
+	t := item.Type
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t {
+	case durationType, modelDurationType:
+		_, err = parseDuration(raw)
+		return err
+	case bigIntType:
+		_, err = parseBigInt(raw)
+		return err
+	case urlType:
+		_, err = parseURL(raw)
+		return err
+	case addressType:
+		_, err = parseAddress(raw)
+		return err
+	case ipType:
+		if net.ParseIP(raw) == nil {
+			return errors.Errorf("unable to parse %s into an IP address", raw)
+		}
+		return nil
+	}
+	if reflect.PtrTo(t).Implements(textUnmarshaler) {
+		return reflect.New(t).Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(raw))
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		_, err = strconv.ParseBool(raw)
+	case reflect.Int, reflect.Int64:
+		_, err = strconv.ParseInt(raw, 10, t.Bits())
+	case reflect.Uint, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		_, err = strconv.ParseUint(raw, 10, t.Bits())
+	case reflect.Float32:
+		_, err = strconv.ParseFloat(raw, 32)
+	}
+	return err
+}
+
+// LoadConfigFile reads the TOML config file at path and uses its values for
+// the fields that are not set by environment variables. It must be called
+// before the config is used.
+func (c *Config) LoadConfigFile(path string) error {
+	values, err := ReadConfigFile(path)
+	if err != nil {
+		return err
+	}
+	if err = c.viper.MergeConfigMap(envNameKeys(values)); err != nil {
+		return errors.Wrapf(err, "failed to load config file %s", path)
+	}
+	c.configFile = &configFile{path: path, values: values, loaded: values, reloaded: make(map[string]string)}
+
+	if err := utils.EnsureDirAndMaxPerms(c.RootDir(), os.FileMode(0700)); err != nil {
+		return errors.Wrapf(err, "error creating root directory %s", c.RootDir())
+	}
+	return nil
+}
+
+func envNameKeys(values map[string]interface{}) map[string]interface{} {
+	m := make(map[string]interface{}, len(values))
+	for name, v := range values {
+		m[EnvVarName(name)] = v
+	}
+	return m
+}
+
+// ConfigFilePath returns the path of the config file loaded with
+// LoadConfigFile, or an empty string if none was loaded
+func (c Config) ConfigFilePath() string {
+	if c.configFile == nil {
+		return ""
+	}
+	return c.configFile.path
+}
+
+// ReloadConfigFile reads the config file loaded with LoadConfigFile again and
+// applies the changes of ReloadableFields. Fields overridden by environment
+// variables are left alone, and changes to all other fields are ignored with
+// a warning, since they only take effect after a restart. It returns the
+// fields that were applied.
+func (c Config) ReloadConfigFile() (reloaded []string, err error) {
+	if c.configFile == nil {
+		return nil, errors.New("no config file was loaded")
+	}
+	c.configFile.mu.Lock()
+	defer c.configFile.mu.Unlock()
+
+	values, err := ReadConfigFile(c.configFile.path)
+	if err != nil {
+		return nil, err
+	}
+
+	var changed []string
+	for name := range values {
+		if _, exists := c.configFile.values[name]; !exists {
+			changed = append(changed, name)
+		}
+	}
+	for name, old := range c.configFile.values {
+		if !reflect.DeepEqual(old, values[name]) {
+			changed = append(changed, name)
+		}
+	}
+	sort.Strings(changed)
+
+	for _, name := range changed {
+		if env := EnvVarName(name); os.Getenv(env) != "" {
+			logger.Warnw(fmt.Sprintf("Config file change of %s is overridden by environment variable %s", name, env), "path", c.configFile.path)
+			continue
+		}
+		if !isReloadable(name) {
+			logger.Warnw(fmt.Sprintf("Config file change of %s requires a restart to take effect", name), "path", c.configFile.path)
+			continue
+		}
+		raw, _ := values[name].(string)
+		if aerr := c.applyReloadedValue(name, raw); aerr != nil {
+			err = multierr.Append(err, errors.Wrapf(aerr, "failed to reload %s", name))
+			// Keep the old value so that the next reload retries the change
+			if old, exists := c.configFile.values[name]; exists {
+				values[name] = old
+			} else {
+				delete(values, name)
+			}
+			continue
+		}
+		reloaded = append(reloaded, name)
+	}
+	c.configFile.values = values
+	return reloaded, err
+}
+
+func isReloadable(name string) bool {
+	for _, f := range ReloadableFields {
+		if f == name {
+			return true
+		}
+	}
+	return false
+}
+
+// applyReloadedValue checks the reloaded value of a reloadable field and uses
+// it in place of the value the config was loaded with. It is only kept in
+// memory, so environment variables and runtime values set through the API
+// still take precedence. An empty raw value means the field was removed from
+// the config file, which resets it to its default.
+func (c Config) applyReloadedValue(name string, raw string) error {
+	if !isReloadable(name) {
+		return errors.Errorf("%s cannot be reloaded", name)
+	}
+	if raw != "" {
+		switch name {
+		case "EthGasPriceDefault":
+			n, err := parseBigInt(raw)
+			if err != nil {
+				return err
+			}
+			value := n.(*big.Int)
+			if min := c.EthMinGasPriceWei(); value.Cmp(min) < 0 {
+				return errors.Errorf("cannot set default gas price to %s, it is below the minimum allowed value of %s", value.String(), min.String())
+			}
+			if max := c.EthMaxGasPriceWei(); value.Cmp(max) > 0 {
+				return errors.Errorf("cannot set default gas price to %s, it is above the maximum allowed value of %s", value.String(), max.String())
+			}
+		case "LogLevel":
+			var ll LogLevel
+			if err := ll.Set(raw); err != nil {
+				return err
+			}
+		case "LogSQLStatements":
+			if _, err := strconv.ParseBool(raw); err != nil {
+				return err
+			}
+		}
+	}
+	c.configFile.reloadedMu.Lock()
+	defer c.configFile.reloadedMu.Unlock()
+	c.configFile.reloaded[name] = raw
+	return nil
+}
+
+// reloadableString returns the raw value of a field. For ReloadableFields
+// that is the value last reloaded from the config file, unless the field is
+// overridden by an environment variable or Set.
+func (c Config) reloadableString(name string) string {
+	str := c.viper.GetString(EnvVarName(name))
+	if c.configFile == nil || !isReloadable(name) || os.Getenv(EnvVarName(name)) != "" {
+		return str
+	}
+	loaded, inFile := c.configFile.loaded[name].(string)
+	if !inFile {
+		loaded, _ = defaultValue(name)
+	}
+	if str != loaded {
+		return str
+	}
+	c.configFile.reloadedMu.RLock()
+	defer c.configFile.reloadedMu.RUnlock()
+	if raw, reloaded := c.configFile.reloaded[name]; reloaded {
+		return raw
+	}
+	return str
+}
+
+// ValidateValues checks that the values of all fields, whether they are set
+// by environment variables or the config file, can be parsed as their type.
+// Invalid values are otherwise replaced by their defaults when they are read.
+// All invalid values are reported in a single error.
+func (c Config) ValidateValues() (err error) {
+	schemaT := reflect.TypeOf(ConfigSchema{})
+	for index := 0; index < schemaT.NumField(); index++ {
+		item := schemaT.Field(index)
+		if item.Type == reflect.TypeOf([]string{}) {
+			continue
+		}
+		env := item.Tag.Get("env")
+		if verr := checkFieldValue(item, c.viper.GetString(env)); verr != nil {
+			err = multierr.Append(err, errors.Wrapf(verr, "invalid value for %s (%s)", item.Name, env))
+		}
+	}
+	return err
+}
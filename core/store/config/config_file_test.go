@@ -0,0 +1,213 @@
+package config_test
+
+import (
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/store/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/multierr"
+	"go.uber.org/zap/zapcore"
+)
+
+func writeConfigFile(t *testing.T, contents string) string {
+	path := filepath.Join(t.TempDir(), "node.toml")
+	require.NoError(t, ioutil.WriteFile(path, []byte(contents), 0600))
+	return path
+}
+
+func TestReadConfigFile(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid file", func(t *testing.T) {
+		path := writeConfigFile(t, `
+EthGasBumpPercent = 20
+EthGasLimitMultiplier = 1.5
+EthGasPriceDefault = "30000000000"
+EthereumDisabled = true
+LogLevel = "debug"
+DatabaseTimeout = "5s"
+P2PV2ListenAddresses = ["0.0.0.0:6690", "127.0.0.1:6691"]
+`)
+		values, err := config.ReadConfigFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{
+			"EthGasBumpPercent":     "20",
+			"EthGasLimitMultiplier": "1.5",
+			"EthGasPriceDefault":    "30000000000",
+			"EthereumDisabled":      "true",
+			"LogLevel":              "debug",
+			"DatabaseTimeout":       "5s",
+			"P2PV2ListenAddresses":  []string{"0.0.0.0:6690", "127.0.0.1:6691"},
+		}, values)
+	})
+
+	t.Run("reports all errors", func(t *testing.T) {
+		path := writeConfigFile(t, `
+EthGasBumpPercent = -1
+ETH_CHAIN_ID = 4
+Foo = "bar"
+LogLevel = "loud"
+P2PV2ListenAddresses = "0.0.0.0:6690"
+
+[Eth]
+ChainID = 4
+`)
+		_, err := config.ReadConfigFile(path)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "line 6: invalid value for P2PV2ListenAddresses: expected an array of strings")
+		assert.Contains(t, err.Error(), "line 2: invalid value for EthGasBumpPercent")
+		assert.Contains(t, err.Error(), "line 3: unknown key ETH_CHAIN_ID, use ChainID instead")
+		assert.Contains(t, err.Error(), "line 4: unknown key Foo")
+		assert.Contains(t, err.Error(), "line 5: invalid value for LogLevel")
+		assert.Contains(t, err.Error(), "line 8: unknown key Eth")
+	})
+
+	t.Run("invalid TOML", func(t *testing.T) {
+		path := writeConfigFile(t, `LogLevel = `)
+		_, err := config.ReadConfigFile(path)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to parse config file")
+	})
+}
+
+func TestConfig_LoadConfigFile(t *testing.T) {
+	path := writeConfigFile(t, `
+EthGasBumpPercent = 25
+EthGasBumpTxDepth = 5
+P2PV2ListenAddresses = ["0.0.0.0:6690"]
+`)
+
+	require.NoError(t, os.Setenv("ETH_GAS_BUMP_TX_DEPTH", "7"))
+	defer os.Unsetenv("ETH_GAS_BUMP_TX_DEPTH")
+
+	cfg := config.NewConfig()
+	require.NoError(t, cfg.LoadConfigFile(path))
+
+	assert.Equal(t, path, cfg.ConfigFilePath())
+	assert.Equal(t, uint16(25), cfg.EthGasBumpPercent())
+	// Environment variables override the config file
+	assert.Equal(t, uint16(7), cfg.EthGasBumpTxDepth())
+	assert.Equal(t, []string{"0.0.0.0:6690"}, cfg.P2PV2ListenAddresses())
+	// Fields missing from the config file keep their defaults
+	assert.Equal(t, uint32(16), cfg.EthMaxInFlightTransactions())
+
+	invalid := writeConfigFile(t, `EthGasBumpPercent = "many"`)
+	assert.Error(t, config.NewConfig().LoadConfigFile(invalid))
+}
+
+func TestConfig_ReloadConfigFile(t *testing.T) {
+	path := writeConfigFile(t, `
+EthGasPriceDefault = "30000000000"
+LogLevel = "info"
+EthGasBumpPercent = 20
+`)
+
+	cfg := config.NewConfig()
+	require.NoError(t, cfg.LoadConfigFile(path))
+
+	_, err := config.NewConfig().ReloadConfigFile()
+	assert.EqualError(t, err, "no config file was loaded")
+
+	require.NoError(t, ioutil.WriteFile(path, []byte(`
+EthGasPriceDefault = "40000000000"
+LogLevel = "debug"
+LogSQLStatements = true
+EthGasBumpPercent = 30
+`), 0600))
+	reloaded, err := cfg.ReloadConfigFile()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"EthGasPriceDefault", "LogLevel", "LogSQLStatements"}, reloaded)
+	assert.Equal(t, big.NewInt(40000000000), cfg.EthGasPriceDefault())
+	assert.Equal(t, zapcore.DebugLevel, cfg.LogLevel().Level)
+	assert.True(t, cfg.LogSQLStatements())
+	// Other fields require a restart
+	assert.Equal(t, uint16(20), cfg.EthGasBumpPercent())
+
+	// An invalid file leaves the config unchanged
+	require.NoError(t, ioutil.WriteFile(path, []byte(`LogLevel = "loud"`), 0600))
+	_, err = cfg.ReloadConfigFile()
+	require.Error(t, err)
+	assert.Equal(t, zapcore.DebugLevel, cfg.LogLevel().Level)
+
+	// Removing a reloadable field resets it to its default
+	require.NoError(t, ioutil.WriteFile(path, []byte(`
+EthGasPriceDefault = "40000000000"
+LogLevel = "debug"
+`), 0600))
+	reloaded, err = cfg.ReloadConfigFile()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"LogSQLStatements"}, reloaded)
+	assert.False(t, cfg.LogSQLStatements())
+
+	// Later reloads are applied too
+	require.NoError(t, ioutil.WriteFile(path, []byte(`
+EthGasPriceDefault = "50000000000"
+`), 0600))
+	reloaded, err = cfg.ReloadConfigFile()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"EthGasPriceDefault", "LogLevel"}, reloaded)
+	assert.Equal(t, big.NewInt(50000000000), cfg.EthGasPriceDefault())
+	assert.Equal(t, zapcore.InfoLevel, cfg.LogLevel().Level)
+}
+
+func TestConfig_ReloadConfigFile_EnvOverride(t *testing.T) {
+	path := writeConfigFile(t, `LogLevel = "info"`)
+	cfg := config.NewConfig()
+	require.NoError(t, cfg.LoadConfigFile(path))
+
+	require.NoError(t, ioutil.WriteFile(path, []byte(`LogLevel = "debug"`), 0600))
+	_, err := cfg.ReloadConfigFile()
+	require.NoError(t, err)
+	assert.Equal(t, zapcore.DebugLevel, cfg.LogLevel().Level)
+
+	// An environment variable set after a reload still wins
+	defer os.Unsetenv("LOG_LEVEL")
+	require.NoError(t, os.Setenv("LOG_LEVEL", "warn"))
+	assert.Equal(t, zapcore.WarnLevel, cfg.LogLevel().Level)
+
+	require.NoError(t, ioutil.WriteFile(path, []byte(`LogLevel = "error"`), 0600))
+	reloaded, err := cfg.ReloadConfigFile()
+	require.NoError(t, err)
+	assert.Empty(t, reloaded)
+	assert.Equal(t, zapcore.WarnLevel, cfg.LogLevel().Level)
+
+	// As does Set
+	require.NoError(t, os.Unsetenv("LOG_LEVEL"))
+	cfg.Set("LOG_LEVEL", "panic")
+	assert.Equal(t, zapcore.PanicLevel, cfg.LogLevel().Level)
+}
+
+func TestConfig_ValidateValues(t *testing.T) {
+	cfg := config.NewConfig()
+	assert.NoError(t, cfg.ValidateValues())
+
+	cfg.Set("ETH_GAS_BUMP_PERCENT", "-5")
+	cfg.Set("DATABASE_TIMEOUT", "soon")
+	cfg.Set("P2P_ANNOUNCE_IP", "localhost")
+	errs := multierr.Errors(cfg.ValidateValues())
+	require.Len(t, errs, 3)
+	assert.Contains(t, errs[0].Error(), "invalid value for DatabaseTimeout (DATABASE_TIMEOUT)")
+	assert.Contains(t, errs[1].Error(), "invalid value for EthGasBumpPercent (ETH_GAS_BUMP_PERCENT)")
+	assert.Contains(t, errs[2].Error(), "invalid value for P2PAnnounceIP (P2P_ANNOUNCE_IP)")
+}
+
+func TestConfigFileDocumentation(t *testing.T) {
+	t.Parallel()
+
+	doc, err := ioutil.ReadFile("../../../docs/core/CONFIG_FILE.md")
+	require.NoError(t, err)
+
+	schemaT := reflect.TypeOf(config.ConfigSchema{})
+	for index := 0; index < schemaT.NumField(); index++ {
+		item := schemaT.Field(index)
+		row := "| `" + item.Name + "` | `" + item.Tag.Get("env") + "` |"
+		assert.True(t, strings.Contains(string(doc), row), "docs/core/CONFIG_FILE.md is missing %s", item.Name)
+	}
+}
@@ -94,6 +94,22 @@ func TestConfig_EthereumSecondaryURLs(t *testing.T) {
 	}
 }
 
+func TestConfig_EthereumPrimaryURLs(t *testing.T) {
+	t.Parallel()
+	config := NewConfig()
+
+	node1, err := url.Parse("ws://node1.example")
+	require.NoError(t, err)
+	node2, err := url.Parse("wss://node2.example")
+	require.NoError(t, err)
+
+	config.Set("ETH_PRIMARY_URLS", "")
+	assert.Equal(t, []url.URL{}, config.EthereumPrimaryURLs())
+
+	config.Set("ETH_PRIMARY_URLS", "ws://node1.example, wss://node2.example")
+	assert.Equal(t, []url.URL{*node1, *node2}, config.EthereumPrimaryURLs())
+}
+
 func TestConfig_ChainSpecificConfig(t *testing.T) {
 	t.Parallel()
 
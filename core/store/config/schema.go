@@ -19,6 +19,7 @@ import (
 type ConfigSchema struct {
 	AdminCredentialsFile                       string                        `env:"ADMIN_CREDENTIALS_FILE" default:"$ROOT/apicredentials"`
 	AllowOrigins                               string                        `env:"ALLOW_ORIGINS" default:"http://localhost:3000,http://localhost:6688"`
+	AuditLogFile                               string                        `env:"AUDIT_LOG_FILE"`
 	AuthenticatedRateLimit                     int64                         `env:"AUTHENTICATED_RATE_LIMIT" default:"1000"`
 	AuthenticatedRateLimitPeriod               time.Duration                 `env:"AUTHENTICATED_RATE_LIMIT_PERIOD" default:"1m"`
 	BalanceMonitorEnabled                      bool                          `env:"BALANCE_MONITOR_ENABLED" default:"true"`
@@ -34,6 +35,9 @@ type ConfigSchema struct {
 	DatabaseBackupDir                          string                        `env:"DATABASE_BACKUP_DIR" default:""`
 	DatabaseBackupFrequency                    time.Duration                 `env:"DATABASE_BACKUP_FREQUENCY" default:"1h"`
 	DatabaseBackupMode                         string                        `env:"DATABASE_BACKUP_MODE" default:"none"`
+	DatabaseBackupRetentionCount               uint32                        `env:"DATABASE_BACKUP_RETENTION_COUNT" default:"3"`
+	DatabaseBackupRetentionPeriod              time.Duration                 `env:"DATABASE_BACKUP_RETENTION_PERIOD" default:"0s"`
+	DatabaseBackupS3URL                        *url.URL                      `env:"DATABASE_BACKUP_S3_URL" default:""`
 	DatabaseBackupURL                          *url.URL                      `env:"DATABASE_BACKUP_URL" default:""`
 	DatabaseListenerMaxReconnectDuration       time.Duration                 `env:"DATABASE_LISTENER_MAX_RECONNECT_DURATION" default:"10m"`
 	DatabaseListenerMinReconnectInterval       time.Duration                 `env:"DATABASE_LISTENER_MIN_RECONNECT_INTERVAL" default:"1m"`
@@ -46,8 +50,9 @@ type ConfigSchema struct {
 	DefaultMaxHTTPAttempts                     uint                          `env:"MAX_HTTP_ATTEMPTS" default:"5"`
 	Dev                                        bool                          `env:"CHAINLINK_DEV" default:"false"`
 	EthBalanceMonitorBlockDelay                uint16                        `env:"ETH_BALANCE_MONITOR_BLOCK_DELAY"`
+	EthEIP1559DynamicFees                      bool                          `env:"ETH_EIP1559_DYNAMIC_FEES"`
 	EthFinalityDepth                           uint                          `env:"ETH_FINALITY_DEPTH"`
-	EthGasBumpPercent                          uint16                        `env:"ETH_GAS_BUMP_PERCENT" default:"20"`
+	EthGasBumpPercent                          uint16                        `env:"ETH_GAS_BUMP_PERCENT"`
 	EthGasBumpThreshold                        uint64                        `env:"ETH_GAS_BUMP_THRESHOLD"`
 	EthGasBumpTxDepth                          uint16                        `env:"ETH_GAS_BUMP_TX_DEPTH" default:"10"`
 	EthGasBumpWei                              big.Int                       `env:"ETH_GAS_BUMP_WEI"`
@@ -55,6 +60,8 @@ type ConfigSchema struct {
 	EthGasLimitMultiplier                      float32                       `env:"ETH_GAS_LIMIT_MULTIPLIER" default:"1.0"`
 	EthGasLimitTransfer                        uint64                        `env:"ETH_GAS_LIMIT_TRANSFER"`
 	EthGasPriceDefault                         big.Int                       `env:"ETH_GAS_PRICE_DEFAULT"`
+	EthGasTipCapDefault                        big.Int                       `env:"ETH_GAS_TIP_CAP_DEFAULT"`
+	EthGasTipCapMinimum                        big.Int                       `env:"ETH_GAS_TIP_CAP_MINIMUM"`
 	EthHeadTrackerHistoryDepth                 uint                          `env:"ETH_HEAD_TRACKER_HISTORY_DEPTH"`
 	EthHeadTrackerMaxBufferSize                uint                          `env:"ETH_HEAD_TRACKER_MAX_BUFFER_SIZE" default:"3"`
 	EthHeadTrackerSamplingInterval             time.Duration                 `env:"ETH_HEAD_TRACKER_SAMPLING_INTERVAL" default:"1s"`
@@ -65,11 +72,20 @@ type ConfigSchema struct {
 	EthMinGasPriceWei                          big.Int                       `env:"ETH_MIN_GAS_PRICE_WEI"`
 	EthNonceAutoSync                           bool                          `env:"ETH_NONCE_AUTO_SYNC" default:"true"`
 	EthRPCDefaultBatchSize                     uint32                        `env:"ETH_RPC_DEFAULT_BATCH_SIZE" default:"100"`
+	EthRemoteSignerURL                         *url.URL                      `env:"ETH_REMOTE_SIGNER_URL"`
+	EthTxLimitMaxCostPerTxWei                  big.Int                       `env:"ETH_TX_LIMIT_MAX_COST_PER_TX_WEI" default:"0"`
+	EthTxLimitMaxDailySpendPerJobWei           big.Int                       `env:"ETH_TX_LIMIT_MAX_DAILY_SPEND_PER_JOB_WEI" default:"0"`
+	EthTxLimitMaxDailySpendPerKeyWei           big.Int                       `env:"ETH_TX_LIMIT_MAX_DAILY_SPEND_PER_KEY_WEI" default:"0"`
+	EthTxLimitMaxTxsPerJob                     uint32                        `env:"ETH_TX_LIMIT_MAX_TXS_PER_JOB" default:"0"`
+	EthTxLimitMaxTxsPerKey                     uint32                        `env:"ETH_TX_LIMIT_MAX_TXS_PER_KEY" default:"0"`
+	EthTxLimitMaxValuePerTxWei                 big.Int                       `env:"ETH_TX_LIMIT_MAX_VALUE_PER_TX_WEI" default:"0"`
+	EthTxLimitWindow                           time.Duration                 `env:"ETH_TX_LIMIT_WINDOW" default:"1h"`
 	EthTxReaperInterval                        time.Duration                 `env:"ETH_TX_REAPER_INTERVAL" default:"1h"`
 	EthTxReaperThreshold                       time.Duration                 `env:"ETH_TX_REAPER_THRESHOLD" default:"168h"`
 	EthTxResendAfterThreshold                  time.Duration                 `env:"ETH_TX_RESEND_AFTER_THRESHOLD"`
 	EthereumDisabled                           bool                          `env:"ETH_DISABLED" default:"false"`
 	EthereumHTTPURL                            string                        `env:"ETH_HTTP_URL"`
+	EthereumPrimaryURLs                        string                        `env:"ETH_PRIMARY_URLS" default:""`
 	EthereumSecondaryURL                       string                        `env:"ETH_SECONDARY_URL" default:""`
 	EthereumSecondaryURLs                      string                        `env:"ETH_SECONDARY_URLS" default:""`
 	EthereumURL                                string                        `env:"ETH_URL" default:"ws://localhost:8546"`
@@ -77,6 +93,7 @@ type ConfigSchema struct {
 	ExplorerSecret                             string                        `env:"EXPLORER_SECRET"`
 	ExplorerURL                                *url.URL                      `env:"EXPLORER_URL"`
 	FMDefaultTransactionQueueDepth             uint32                        `env:"FM_DEFAULT_TRANSACTION_QUEUE_DEPTH" default:"1"`
+	FMSimulateTransactions                     bool                          `env:"FM_SIMULATE_TRANSACTIONS" default:"false"`
 	FeatureCronV2                              bool                          `env:"FEATURE_CRON_V2" default:"true"`
 	FeatureExternalInitiators                  bool                          `env:"FEATURE_EXTERNAL_INITIATORS" default:"false"`
 	FeatureFluxMonitorV2                       bool                          `env:"FEATURE_FLUX_MONITOR_V2" default:"true"`
@@ -84,6 +101,10 @@ type ConfigSchema struct {
 	FeatureWebhookV2                           bool                          `env:"FEATURE_WEBHOOK_V2" default:"false"`
 	FlagsContractAddress                       string                        `env:"FLAGS_CONTRACT_ADDRESS"`
 	GasEstimatorMode                           string                        `env:"GAS_ESTIMATOR_MODE"`
+	GasOracleAggregation                       string                        `env:"GAS_ORACLE_AGGREGATION" default:"median"`
+	GasOraclePollPeriod                        time.Duration                 `env:"GAS_ORACLE_POLL_PERIOD" default:"15s"`
+	GasOraclePriceUnit                         string                        `env:"GAS_ORACLE_PRICE_UNIT" default:"gwei"`
+	GasOracleURLs                              string                        `env:"GAS_ORACLE_URLS" default:""`
 	GasUpdaterBatchSize                        uint32                        `env:"GAS_UPDATER_BATCH_SIZE"`
 	GasUpdaterBlockDelay                       uint16                        `env:"GAS_UPDATER_BLOCK_DELAY"`
 	GasUpdaterBlockHistorySize                 uint16                        `env:"GAS_UPDATER_BLOCK_HISTORY_SIZE"`
@@ -97,10 +118,14 @@ type ConfigSchema struct {
 	JobPipelineMaxRunDuration                  time.Duration                 `env:"JOB_PIPELINE_MAX_RUN_DURATION" default:"10m"`
 	JobPipelineReaperInterval                  time.Duration                 `env:"JOB_PIPELINE_REAPER_INTERVAL" default:"1h"`
 	JobPipelineReaperThreshold                 time.Duration                 `env:"JOB_PIPELINE_REAPER_THRESHOLD" default:"24h"`
+	JobPipelineResponseCacheMaxStaleness       time.Duration                 `env:"JOB_PIPELINE_RESPONSE_CACHE_MAX_STALENESS" default:"1h"`
+	JobPipelineResponseCacheSize               uint64                        `env:"JOB_PIPELINE_RESPONSE_CACHE_SIZE" default:"10485760"`
 	JobPipelineResultWriteQueueDepth           uint64                        `env:"JOB_PIPELINE_RESULT_WRITE_QUEUE_DEPTH" default:"100"`
+	KeeperCheckUpkeepBatchSize                 uint32                        `env:"KEEPER_CHECK_UPKEEP_BATCH_SIZE" default:"10"`
 	KeeperDefaultTransactionQueueDepth         uint32                        `env:"KEEPER_DEFAULT_TRANSACTION_QUEUE_DEPTH" default:"1"`
 	KeeperMaximumGracePeriod                   int64                         `env:"KEEPER_MAXIMUM_GRACE_PERIOD" default:"100"`
 	KeeperMinimumRequiredConfirmations         uint64                        `env:"KEEPER_MINIMUM_REQUIRED_CONFIRMATIONS" default:"12"`
+	KeeperMulticallAddress                     string                        `env:"KEEPER_MULTICALL_ADDRESS"`
 	KeeperRegistryCheckGasOverhead             uint64                        `env:"KEEPER_REGISTRY_CHECK_GAS_OVERHEAD" default:"200000"`
 	KeeperRegistryPerformGasOverhead           uint64                        `env:"KEEPER_REGISTRY_PERFORM_GAS_OVERHEAD" default:"150000"`
 	KeeperRegistrySyncInterval                 time.Duration                 `env:"KEEPER_REGISTRY_SYNC_INTERVAL" default:"30m"`
@@ -132,6 +157,7 @@ type ConfigSchema struct {
 	OCRObservationGracePeriod                  time.Duration                 `env:"OCR_OBSERVATION_GRACE_PERIOD" default:"1s"`
 	OCRObservationTimeout                      time.Duration                 `env:"OCR_OBSERVATION_TIMEOUT" default:"12s"`
 	OCROutgoingMessageBufferSize               int                           `env:"OCR_OUTGOING_MESSAGE_BUFFER_SIZE" default:"10"`
+	OCRSimulateTransactions                    bool                          `env:"OCR_SIMULATE_TRANSACTIONS" default:"false"`
 	OCRTraceLogging                            bool                          `env:"OCR_TRACE_LOGGING" default:"false"`
 	OCRTransmitterAddress                      string                        `env:"OCR_TRANSMITTER_ADDRESS"`
 	ORMMaxIdleConns                            int                           `env:"ORM_MAX_IDLE_CONNS" default:"10"`
@@ -166,6 +192,8 @@ type ConfigSchema struct {
 	TriggerFallbackDBPollInterval              time.Duration                 `env:"TRIGGER_FALLBACK_DB_POLL_INTERVAL" default:"30s"`
 	UnAuthenticatedRateLimit                   int64                         `env:"UNAUTHENTICATED_RATE_LIMIT" default:"5"`
 	UnAuthenticatedRateLimitPeriod             time.Duration                 `env:"UNAUTHENTICATED_RATE_LIMIT_PERIOD" default:"20s"`
+	VaultToken                                 string                        `env:"VAULT_TOKEN"`
+	VaultURL                                   *url.URL                      `env:"VAULT_ADDR"`
 }
 
 // EnvVarName gets the environment variable name for a config schema field
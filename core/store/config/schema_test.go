@@ -12,6 +12,7 @@ func TestConfigSchema(t *testing.T) {
 	items := map[string]string{
 		"AdminCredentialsFile":                       "ADMIN_CREDENTIALS_FILE",
 		"AllowOrigins":                               "ALLOW_ORIGINS",
+		"AuditLogFile":                               "AUDIT_LOG_FILE",
 		"AuthenticatedRateLimit":                     "AUTHENTICATED_RATE_LIMIT",
 		"AuthenticatedRateLimitPeriod":               "AUTHENTICATED_RATE_LIMIT_PERIOD",
 		"BalanceMonitorEnabled":                      "BALANCE_MONITOR_ENABLED",
@@ -27,6 +28,9 @@ func TestConfigSchema(t *testing.T) {
 		"DatabaseBackupDir":                          "DATABASE_BACKUP_DIR",
 		"DatabaseBackupFrequency":                    "DATABASE_BACKUP_FREQUENCY",
 		"DatabaseBackupMode":                         "DATABASE_BACKUP_MODE",
+		"DatabaseBackupRetentionCount":               "DATABASE_BACKUP_RETENTION_COUNT",
+		"DatabaseBackupRetentionPeriod":              "DATABASE_BACKUP_RETENTION_PERIOD",
+		"DatabaseBackupS3URL":                        "DATABASE_BACKUP_S3_URL",
 		"DatabaseBackupURL":                          "DATABASE_BACKUP_URL",
 		"DatabaseListenerMaxReconnectDuration":       "DATABASE_LISTENER_MAX_RECONNECT_DURATION",
 		"DatabaseListenerMinReconnectInterval":       "DATABASE_LISTENER_MIN_RECONNECT_INTERVAL",
@@ -39,6 +43,7 @@ func TestConfigSchema(t *testing.T) {
 		"DefaultMaxHTTPAttempts":                     "MAX_HTTP_ATTEMPTS",
 		"Dev":                                        "CHAINLINK_DEV",
 		"EthBalanceMonitorBlockDelay":                "ETH_BALANCE_MONITOR_BLOCK_DELAY",
+		"EthEIP1559DynamicFees":                      "ETH_EIP1559_DYNAMIC_FEES",
 		"EthFinalityDepth":                           "ETH_FINALITY_DEPTH",
 		"EthGasBumpPercent":                          "ETH_GAS_BUMP_PERCENT",
 		"EthGasBumpThreshold":                        "ETH_GAS_BUMP_THRESHOLD",
@@ -48,6 +53,8 @@ func TestConfigSchema(t *testing.T) {
 		"EthGasLimitMultiplier":                      "ETH_GAS_LIMIT_MULTIPLIER",
 		"EthGasLimitTransfer":                        "ETH_GAS_LIMIT_TRANSFER",
 		"EthGasPriceDefault":                         "ETH_GAS_PRICE_DEFAULT",
+		"EthGasTipCapDefault":                        "ETH_GAS_TIP_CAP_DEFAULT",
+		"EthGasTipCapMinimum":                        "ETH_GAS_TIP_CAP_MINIMUM",
 		"EthHeadTrackerHistoryDepth":                 "ETH_HEAD_TRACKER_HISTORY_DEPTH",
 		"EthHeadTrackerMaxBufferSize":                "ETH_HEAD_TRACKER_MAX_BUFFER_SIZE",
 		"EthHeadTrackerSamplingInterval":             "ETH_HEAD_TRACKER_SAMPLING_INTERVAL",
@@ -58,11 +65,20 @@ func TestConfigSchema(t *testing.T) {
 		"EthMinGasPriceWei":                          "ETH_MIN_GAS_PRICE_WEI",
 		"EthNonceAutoSync":                           "ETH_NONCE_AUTO_SYNC",
 		"EthRPCDefaultBatchSize":                     "ETH_RPC_DEFAULT_BATCH_SIZE",
+		"EthRemoteSignerURL":                         "ETH_REMOTE_SIGNER_URL",
+		"EthTxLimitMaxCostPerTxWei":                  "ETH_TX_LIMIT_MAX_COST_PER_TX_WEI",
+		"EthTxLimitMaxDailySpendPerJobWei":           "ETH_TX_LIMIT_MAX_DAILY_SPEND_PER_JOB_WEI",
+		"EthTxLimitMaxDailySpendPerKeyWei":           "ETH_TX_LIMIT_MAX_DAILY_SPEND_PER_KEY_WEI",
+		"EthTxLimitMaxTxsPerJob":                     "ETH_TX_LIMIT_MAX_TXS_PER_JOB",
+		"EthTxLimitMaxTxsPerKey":                     "ETH_TX_LIMIT_MAX_TXS_PER_KEY",
+		"EthTxLimitMaxValuePerTxWei":                 "ETH_TX_LIMIT_MAX_VALUE_PER_TX_WEI",
+		"EthTxLimitWindow":                           "ETH_TX_LIMIT_WINDOW",
 		"EthTxReaperInterval":                        "ETH_TX_REAPER_INTERVAL",
 		"EthTxReaperThreshold":                       "ETH_TX_REAPER_THRESHOLD",
 		"EthTxResendAfterThreshold":                  "ETH_TX_RESEND_AFTER_THRESHOLD",
 		"EthereumDisabled":                           "ETH_DISABLED",
 		"EthereumHTTPURL":                            "ETH_HTTP_URL",
+		"EthereumPrimaryURLs":                        "ETH_PRIMARY_URLS",
 		"EthereumSecondaryURL":                       "ETH_SECONDARY_URL",
 		"EthereumSecondaryURLs":                      "ETH_SECONDARY_URLS",
 		"EthereumURL":                                "ETH_URL",
@@ -70,6 +86,7 @@ func TestConfigSchema(t *testing.T) {
 		"ExplorerSecret":                             "EXPLORER_SECRET",
 		"ExplorerURL":                                "EXPLORER_URL",
 		"FMDefaultTransactionQueueDepth":             "FM_DEFAULT_TRANSACTION_QUEUE_DEPTH",
+		"FMSimulateTransactions":                     "FM_SIMULATE_TRANSACTIONS",
 		"FeatureCronV2":                              "FEATURE_CRON_V2",
 		"FeatureExternalInitiators":                  "FEATURE_EXTERNAL_INITIATORS",
 		"FeatureFluxMonitorV2":                       "FEATURE_FLUX_MONITOR_V2",
@@ -77,6 +94,10 @@ func TestConfigSchema(t *testing.T) {
 		"FeatureWebhookV2":                           "FEATURE_WEBHOOK_V2",
 		"FlagsContractAddress":                       "FLAGS_CONTRACT_ADDRESS",
 		"GasEstimatorMode":                           "GAS_ESTIMATOR_MODE",
+		"GasOracleAggregation":                       "GAS_ORACLE_AGGREGATION",
+		"GasOraclePollPeriod":                        "GAS_ORACLE_POLL_PERIOD",
+		"GasOraclePriceUnit":                         "GAS_ORACLE_PRICE_UNIT",
+		"GasOracleURLs":                              "GAS_ORACLE_URLS",
 		"GasUpdaterBatchSize":                        "GAS_UPDATER_BATCH_SIZE",
 		"GasUpdaterBlockDelay":                       "GAS_UPDATER_BLOCK_DELAY",
 		"GasUpdaterBlockHistorySize":                 "GAS_UPDATER_BLOCK_HISTORY_SIZE",
@@ -90,10 +111,14 @@ func TestConfigSchema(t *testing.T) {
 		"JobPipelineMaxRunDuration":                  "JOB_PIPELINE_MAX_RUN_DURATION",
 		"JobPipelineReaperInterval":                  "JOB_PIPELINE_REAPER_INTERVAL",
 		"JobPipelineReaperThreshold":                 "JOB_PIPELINE_REAPER_THRESHOLD",
+		"JobPipelineResponseCacheMaxStaleness":       "JOB_PIPELINE_RESPONSE_CACHE_MAX_STALENESS",
+		"JobPipelineResponseCacheSize":               "JOB_PIPELINE_RESPONSE_CACHE_SIZE",
 		"JobPipelineResultWriteQueueDepth":           "JOB_PIPELINE_RESULT_WRITE_QUEUE_DEPTH",
+		"KeeperCheckUpkeepBatchSize":                 "KEEPER_CHECK_UPKEEP_BATCH_SIZE",
 		"KeeperDefaultTransactionQueueDepth":         "KEEPER_DEFAULT_TRANSACTION_QUEUE_DEPTH",
 		"KeeperMaximumGracePeriod":                   "KEEPER_MAXIMUM_GRACE_PERIOD",
 		"KeeperMinimumRequiredConfirmations":         "KEEPER_MINIMUM_REQUIRED_CONFIRMATIONS",
+		"KeeperMulticallAddress":                     "KEEPER_MULTICALL_ADDRESS",
 		"KeeperRegistryCheckGasOverhead":             "KEEPER_REGISTRY_CHECK_GAS_OVERHEAD",
 		"KeeperRegistryPerformGasOverhead":           "KEEPER_REGISTRY_PERFORM_GAS_OVERHEAD",
 		"KeeperRegistrySyncInterval":                 "KEEPER_REGISTRY_SYNC_INTERVAL",
@@ -125,6 +150,7 @@ func TestConfigSchema(t *testing.T) {
 		"OCRObservationGracePeriod":                  "OCR_OBSERVATION_GRACE_PERIOD",
 		"OCRObservationTimeout":                      "OCR_OBSERVATION_TIMEOUT",
 		"OCROutgoingMessageBufferSize":               "OCR_OUTGOING_MESSAGE_BUFFER_SIZE",
+		"OCRSimulateTransactions":                    "OCR_SIMULATE_TRANSACTIONS",
 		"OCRTraceLogging":                            "OCR_TRACE_LOGGING",
 		"OCRTransmitterAddress":                      "OCR_TRANSMITTER_ADDRESS",
 		"ORMMaxIdleConns":                            "ORM_MAX_IDLE_CONNS",
@@ -161,6 +187,8 @@ func TestConfigSchema(t *testing.T) {
 		"TriggerFallbackDBPollInterval":              "TRIGGER_FALLBACK_DB_POLL_INTERVAL",
 		"UnAuthenticatedRateLimit":                   "UNAUTHENTICATED_RATE_LIMIT",
 		"UnAuthenticatedRateLimitPeriod":             "UNAUTHENTICATED_RATE_LIMIT_PERIOD",
+		"VaultToken":                                 "VAULT_TOKEN",
+		"VaultURL":                                   "VAULT_ADDR",
 	}
 
 	schemaT := reflect.TypeOf(ConfigSchema{})
@@ -0,0 +1,157 @@
+package config
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/services/secrets"
+)
+
+// RedactedSecret replaces the values of resolved secrets in the output of
+// Redact
+const RedactedSecret = "xxxxx"
+
+// minRedactedSecretLength is the length below which Redact leaves secrets in
+// text alone, since replacing every "1" or "true" would mangle it. Fields that
+// hold a secret are redacted whole by RedactField instead.
+const minRedactedSecretLength = 6
+
+// secretPathFields hold the path of a file of secrets rather than a secret,
+// so references in them are resolved where the file is read
+var secretPathFields = []string{"AdminCredentialsFile"}
+
+// resolvedSecrets are the secret values resolved by ResolveSecrets and
+// ResolveSecret, so that they can be redacted
+type resolvedSecrets struct {
+	mu     sync.RWMutex
+	values map[string]struct{}
+	// fields are the ConfigSchema fields resolved from secret references
+	fields map[string]struct{}
+}
+
+func (r *resolvedSecrets) add(secret string) {
+	if secret == "" {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.values[secret] = struct{}{}
+}
+
+func (r *resolvedSecrets) addField(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.fields[name] = struct{}{}
+}
+
+// ResolveSecrets replaces the values of all fields that are secret
+// references, e.g. DATABASE_URL=vault://secret/data/chainlink#database_url,
+// by the secrets they reference. See package secrets for the supported
+// references. VaultToken may itself only be a file:// or env:// reference.
+// All references that cannot be resolved are reported in a single error.
+func (c Config) ResolveSecrets(ctx context.Context) (err error) {
+	resolver, err := c.secretResolver(ctx)
+	if err != nil {
+		return err
+	}
+
+	schemaT := reflect.TypeOf(ConfigSchema{})
+	for index := 0; index < schemaT.NumField(); index++ {
+		item := schemaT.Field(index)
+		if item.Type == reflect.TypeOf([]string{}) || isSecretPathField(item.Name) {
+			continue
+		}
+		env := item.Tag.Get("env")
+		value := c.viper.GetString(env)
+		if !secrets.IsReference(value) {
+			continue
+		}
+		secret, rerr := resolver.Resolve(ctx, value)
+		if rerr != nil {
+			err = multierr.Append(err, errors.Wrapf(rerr, "invalid value for %s (%s)", item.Name, env))
+			continue
+		}
+		c.resolvedSecrets.add(secret)
+		c.resolvedSecrets.addField(item.Name)
+		c.viper.Set(env, secret)
+	}
+	return err
+}
+
+func isSecretPathField(name string) bool {
+	for _, f := range secretPathFields {
+		if f == name {
+			return true
+		}
+	}
+	return false
+}
+
+// ResolveSecret returns the secret referenced by value, or value itself if
+// it is not a secret reference. The secret is redacted by Redact.
+func (c Config) ResolveSecret(ctx context.Context, value string) (string, error) {
+	if !secrets.IsReference(value) {
+		return value, nil
+	}
+	resolver, err := c.secretResolver(ctx)
+	if err != nil {
+		return "", err
+	}
+	secret, err := resolver.Resolve(ctx, value)
+	if err != nil {
+		return "", err
+	}
+	c.resolvedSecrets.add(secret)
+	return secret, nil
+}
+
+// secretResolver returns a resolver for file and env references, and for
+// vault references if VaultURL is set
+func (c Config) secretResolver(ctx context.Context) (*secrets.Resolver, error) {
+	vaultURL := c.VaultURL()
+	if vaultURL == nil {
+		return secrets.NewResolver(nil), nil
+	}
+	token := c.VaultToken()
+	if strings.HasPrefix(token, secrets.SchemeVault+"://") {
+		return nil, errors.New("invalid value for VaultToken (VAULT_TOKEN): the Vault token cannot be a vault:// reference")
+	}
+	token, err := secrets.NewResolver(nil).Resolve(ctx, token)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid value for VaultToken (VAULT_TOKEN)")
+	}
+	c.resolvedSecrets.add(token)
+	return secrets.NewResolver(secrets.NewVaultProvider(vaultURL, token)), nil
+}
+
+// Redact replaces all secrets resolved by ResolveSecrets and ResolveSecret
+// in s with RedactedSecret. Secrets shorter than minRedactedSecretLength are
+// not replaced.
+func (c Config) Redact(s string) string {
+	c.resolvedSecrets.mu.RLock()
+	defer c.resolvedSecrets.mu.RUnlock()
+	for secret := range c.resolvedSecrets.values {
+		if len(secret) < minRedactedSecretLength {
+			continue
+		}
+		s = strings.ReplaceAll(s, secret, RedactedSecret)
+	}
+	return s
+}
+
+// RedactField returns RedactedSecret if the ConfigSchema field name was
+// resolved from a secret reference, and the redacted value otherwise
+func (c Config) RedactField(name string, value string) string {
+	c.resolvedSecrets.mu.RLock()
+	_, isSecret := c.resolvedSecrets.fields[name]
+	c.resolvedSecrets.mu.RUnlock()
+	if isSecret {
+		return RedactedSecret
+	}
+	return c.Redact(value)
+}
@@ -0,0 +1,56 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+const up55 = `
+ALTER TABLE eth_tx_attempts
+	ADD COLUMN tx_type smallint NOT NULL DEFAULT 0,
+	ADD COLUMN gas_tip_cap numeric(78,0),
+	ADD COLUMN gas_fee_cap numeric(78,0),
+	ALTER COLUMN gas_price DROP NOT NULL;
+
+ALTER TABLE eth_tx_attempts ADD CONSTRAINT chk_tx_type_is_byte CHECK (
+	tx_type >= 0 AND tx_type <= 255
+);
+
+ALTER TABLE eth_tx_attempts ADD CONSTRAINT chk_legacy_or_dynamic CHECK (
+	(tx_type = 0 AND gas_price IS NOT NULL AND gas_tip_cap IS NULL AND gas_fee_cap IS NULL)
+	OR
+	(tx_type = 2 AND gas_price IS NULL AND gas_tip_cap IS NOT NULL AND gas_fee_cap IS NOT NULL)
+);
+
+ALTER TABLE eth_tx_attempts ADD CONSTRAINT chk_sane_tip_cap CHECK (
+	gas_tip_cap IS NULL OR gas_fee_cap IS NULL OR gas_tip_cap <= gas_fee_cap
+);
+
+CREATE UNIQUE INDEX idx_eth_tx_attempts_unique_gas_fees ON eth_tx_attempts (eth_tx_id, gas_tip_cap, gas_fee_cap) WHERE tx_type = 2;
+`
+
+const down55 = `
+DELETE FROM eth_tx_attempts WHERE tx_type = 2;
+
+DROP INDEX idx_eth_tx_attempts_unique_gas_fees;
+
+ALTER TABLE eth_tx_attempts
+	DROP CONSTRAINT chk_tx_type_is_byte,
+	DROP CONSTRAINT chk_legacy_or_dynamic,
+	DROP CONSTRAINT chk_sane_tip_cap,
+	DROP COLUMN tx_type,
+	DROP COLUMN gas_tip_cap,
+	DROP COLUMN gas_fee_cap,
+	ALTER COLUMN gas_price SET NOT NULL;
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0055_eip1559_eth_tx_attempts",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up55).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down55).Error
+		},
+	})
+}
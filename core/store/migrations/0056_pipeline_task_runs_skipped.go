@@ -0,0 +1,29 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+const up56 = `
+ALTER TABLE pipeline_task_runs ADD COLUMN skipped boolean NOT NULL DEFAULT false;
+
+ALTER TABLE pipeline_task_runs ADD CONSTRAINT chk_pipeline_task_run_skipped CHECK (
+	skipped = false OR (finished_at IS NOT NULL AND output IS NULL AND error IS NULL)
+);
+`
+
+const down56 = `
+ALTER TABLE pipeline_task_runs DROP COLUMN skipped;
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0056_pipeline_task_runs_skipped",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up56).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down56).Error
+		},
+	})
+}
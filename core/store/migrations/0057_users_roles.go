@@ -0,0 +1,40 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+const up57 = `
+CREATE TYPE user_roles AS ENUM ('view_only', 'job_editor', 'key_admin', 'owner');
+
+-- Before roles existed the sole API user had full control of the node
+ALTER TABLE users ADD COLUMN role user_roles NOT NULL DEFAULT 'owner';
+ALTER TABLE users ALTER COLUMN role DROP DEFAULT;
+
+CREATE UNIQUE INDEX idx_unique_users_token_key ON users (token_key) WHERE token_key IS NOT NULL AND token_key != '';
+
+ALTER TABLE sessions ADD COLUMN user_email text REFERENCES users (email) ON DELETE CASCADE;
+UPDATE sessions SET user_email = (SELECT email FROM users ORDER BY created_at DESC LIMIT 1);
+DELETE FROM sessions WHERE user_email IS NULL;
+ALTER TABLE sessions ALTER COLUMN user_email SET NOT NULL;
+CREATE INDEX idx_sessions_user_email ON sessions (user_email);
+`
+
+const down57 = `
+ALTER TABLE sessions DROP COLUMN user_email;
+DROP INDEX idx_unique_users_token_key;
+ALTER TABLE users DROP COLUMN role;
+DROP TYPE user_roles;
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0057_users_roles",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up57).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down57).Error
+		},
+	})
+}
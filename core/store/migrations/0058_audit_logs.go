@@ -0,0 +1,35 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+const up58 = `
+CREATE TABLE audit_logs (
+	id BIGSERIAL PRIMARY KEY,
+	type text NOT NULL,
+	user_email text,
+	remote_ip text NOT NULL DEFAULT '',
+	data jsonb NOT NULL DEFAULT '{}',
+	created_at timestamptz NOT NULL
+);
+
+CREATE INDEX idx_audit_logs_created_at ON audit_logs (created_at);
+CREATE INDEX idx_audit_logs_user_email ON audit_logs (user_email);
+`
+
+const down58 = `
+DROP TABLE audit_logs;
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0058_audit_logs",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up58).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down58).Error
+		},
+	})
+}
@@ -0,0 +1,94 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// Records with a NULL evm_chain_id belong to the node's default chain, set by
+// ETH_CHAIN_ID
+const up59 = `
+CREATE TABLE evm_chains (
+	id numeric(78,0) PRIMARY KEY,
+	enabled bool NOT NULL DEFAULT true,
+	created_at timestamptz NOT NULL,
+	updated_at timestamptz NOT NULL
+);
+
+CREATE TABLE evm_nodes (
+	id serial PRIMARY KEY,
+	name varchar(255) NOT NULL CHECK (name != ''),
+	evm_chain_id numeric(78,0) NOT NULL REFERENCES evm_chains (id) ON DELETE CASCADE,
+	ws_url text CHECK (ws_url != ''),
+	http_url text CHECK (http_url != ''),
+	send_only bool NOT NULL,
+	created_at timestamptz NOT NULL,
+	updated_at timestamptz NOT NULL,
+	CONSTRAINT primary_or_sendonly CHECK (
+		(send_only AND ws_url IS NULL AND http_url IS NOT NULL)
+		OR
+		(NOT send_only AND ws_url IS NOT NULL)
+	)
+);
+
+CREATE INDEX idx_evm_nodes_evm_chain_id ON evm_nodes (evm_chain_id);
+CREATE UNIQUE INDEX idx_evm_nodes_unique_name ON evm_nodes (lower(name));
+
+CREATE TABLE evm_key_states (
+	id serial PRIMARY KEY,
+	address bytea NOT NULL REFERENCES keys (address) ON DELETE CASCADE,
+	evm_chain_id numeric(78,0) NOT NULL REFERENCES evm_chains (id) ON DELETE CASCADE,
+	next_nonce bigint NOT NULL DEFAULT 0,
+	created_at timestamptz NOT NULL,
+	updated_at timestamptz NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_evm_key_states_address_evm_chain_id ON evm_key_states (address, evm_chain_id);
+
+ALTER TABLE heads ADD COLUMN evm_chain_id numeric(78,0) REFERENCES evm_chains (id) ON DELETE CASCADE;
+CREATE INDEX idx_heads_evm_chain_id_number ON heads (evm_chain_id, number);
+
+ALTER TABLE eth_txes ADD COLUMN evm_chain_id numeric(78,0) REFERENCES evm_chains (id);
+CREATE INDEX idx_eth_txes_evm_chain_id ON eth_txes (evm_chain_id) WHERE evm_chain_id IS NOT NULL;
+
+-- A key has separate nonces on every chain. The default chain is -1 so that
+-- its NULL evm_chain_ids are not distinct from each other.
+DROP INDEX idx_eth_txes_nonce_from_address;
+CREATE UNIQUE INDEX idx_eth_txes_nonce_from_address ON eth_txes (nonce, from_address, COALESCE(evm_chain_id, -1));
+DROP INDEX idx_only_one_in_progress_tx_per_account;
+CREATE UNIQUE INDEX idx_only_one_in_progress_tx_per_account ON eth_txes (from_address, COALESCE(evm_chain_id, -1)) WHERE state = 'in_progress';
+
+ALTER TABLE direct_request_specs ADD COLUMN evm_chain_id numeric(78,0) REFERENCES evm_chains (id);
+ALTER TABLE flux_monitor_specs ADD COLUMN evm_chain_id numeric(78,0) REFERENCES evm_chains (id);
+ALTER TABLE offchainreporting_oracle_specs ADD COLUMN evm_chain_id numeric(78,0) REFERENCES evm_chains (id);
+ALTER TABLE keeper_specs ADD COLUMN evm_chain_id numeric(78,0) REFERENCES evm_chains (id);
+ALTER TABLE vrf_specs ADD COLUMN evm_chain_id numeric(78,0) REFERENCES evm_chains (id);
+`
+
+const down59 = `
+ALTER TABLE vrf_specs DROP COLUMN evm_chain_id;
+ALTER TABLE keeper_specs DROP COLUMN evm_chain_id;
+ALTER TABLE offchainreporting_oracle_specs DROP COLUMN evm_chain_id;
+ALTER TABLE flux_monitor_specs DROP COLUMN evm_chain_id;
+ALTER TABLE direct_request_specs DROP COLUMN evm_chain_id;
+DROP INDEX idx_only_one_in_progress_tx_per_account;
+DROP INDEX idx_eth_txes_nonce_from_address;
+ALTER TABLE eth_txes DROP COLUMN evm_chain_id;
+CREATE UNIQUE INDEX idx_eth_txes_nonce_from_address ON eth_txes (nonce, from_address);
+CREATE UNIQUE INDEX idx_only_one_in_progress_tx_per_account ON eth_txes (from_address) WHERE state = 'in_progress';
+ALTER TABLE heads DROP COLUMN evm_chain_id;
+DROP TABLE evm_key_states;
+DROP TABLE evm_nodes;
+DROP TABLE evm_chains;
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0059_evm_chains",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up59).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down59).Error
+		},
+	})
+}
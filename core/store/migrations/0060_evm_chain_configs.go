@@ -0,0 +1,27 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// cfg holds the settings of the chain that override its built-in defaults,
+// see types.ChainCfg
+const up60 = `
+ALTER TABLE evm_chains ADD COLUMN cfg jsonb NOT NULL DEFAULT '{}';
+`
+
+const down60 = `
+ALTER TABLE evm_chains DROP COLUMN cfg;
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0060_evm_chain_configs",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up60).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down60).Error
+		},
+	})
+}
@@ -0,0 +1,29 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// These indexes support counting the recent transactions of each key and job
+// when checking the ETH_TX_LIMIT_* limits
+const up61 = `
+CREATE INDEX idx_eth_txes_from_address_created_at ON eth_txes (from_address, created_at);
+CREATE INDEX idx_eth_txes_meta_job_id_created_at ON eth_txes ((meta->>'JobID'), created_at) WHERE meta IS NOT NULL;
+`
+
+const down61 = `
+DROP INDEX idx_eth_txes_meta_job_id_created_at;
+DROP INDEX idx_eth_txes_from_address_created_at;
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0061_eth_tx_limits",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up61).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down61).Error
+		},
+	})
+}
@@ -0,0 +1,27 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// simulate is set from the TxStrategy of the eth_tx and tells the
+// EthBroadcaster to eth_call the transaction before it is broadcast
+const up62 = `
+ALTER TABLE eth_txes ADD COLUMN simulate bool NOT NULL DEFAULT FALSE;
+`
+
+const down62 = `
+ALTER TABLE eth_txes DROP COLUMN simulate;
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0062_eth_tx_simulate",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up62).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down62).Error
+		},
+	})
+}
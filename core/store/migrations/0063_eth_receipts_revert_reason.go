@@ -0,0 +1,28 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// revert_reason is NULL until the EthConfirmer has replayed the reverted
+// transaction, receipts that reverted before this migration are not replayed
+const up63 = `
+ALTER TABLE eth_receipts ADD COLUMN revert_reason text;
+UPDATE eth_receipts SET revert_reason = '' WHERE receipt->>'status' = '0x0';
+`
+
+const down63 = `
+ALTER TABLE eth_receipts DROP COLUMN revert_reason;
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0063_eth_receipts_revert_reason",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up63).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down63).Error
+		},
+	})
+}
@@ -0,0 +1,40 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// eth_tx_id has no foreign key since unstarted transactions can be pruned by
+// the tx strategy, the perform is still part of the history of the upkeep
+const up64 = `
+ALTER TABLE keeper_registries ADD COLUMN version text NOT NULL DEFAULT '1.0', ADD COLUMN paused bool NOT NULL DEFAULT FALSE;
+
+CREATE TABLE upkeep_performs (
+	id BIGSERIAL PRIMARY KEY,
+	upkeep_registration_id bigint NOT NULL REFERENCES upkeep_registrations(id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE,
+	eth_tx_id bigint NOT NULL,
+	block_number bigint NOT NULL,
+	success bool,
+	created_at timestamp with time zone NOT NULL
+);
+
+CREATE INDEX idx_upkeep_performs_upkeep_registration_id ON upkeep_performs(upkeep_registration_id, id);
+CREATE INDEX idx_upkeep_performs_eth_tx_id ON upkeep_performs(eth_tx_id);
+`
+
+const down64 = `
+DROP TABLE upkeep_performs;
+ALTER TABLE keeper_registries DROP COLUMN version, DROP COLUMN paused;
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0064_keeper_registry_versions_and_upkeep_performs",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up64).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down64).Error
+		},
+	})
+}
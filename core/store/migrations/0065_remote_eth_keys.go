@@ -0,0 +1,28 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// Keys held by a remote signer have no private key in the database, their row
+// only exists for the nonce and the foreign keys
+const up65 = `
+ALTER TABLE keys ADD COLUMN remote bool NOT NULL DEFAULT FALSE;
+`
+
+const down65 = `
+DELETE FROM keys WHERE remote;
+ALTER TABLE keys DROP COLUMN remote;
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0065_remote_eth_keys",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up65).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down65).Error
+		},
+	})
+}
@@ -0,0 +1,35 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// VRF jobs can target the subscription based coordinator, optionally
+// batching their fulfillments through a batch coordinator
+const up66 = `
+ALTER TABLE vrf_specs
+	ADD COLUMN coordinator_version text NOT NULL DEFAULT 'v1' CHECK (coordinator_version IN ('v1', 'v2')),
+	ADD COLUMN from_address bytea CHECK (octet_length(from_address) = 20),
+	ADD COLUMN batch_coordinator_address bytea CHECK (octet_length(batch_coordinator_address) = 20),
+	ADD COLUMN batch_fulfillment_gas_limit bigint NOT NULL DEFAULT 0 CHECK (batch_fulfillment_gas_limit >= 0);
+`
+
+const down66 = `
+ALTER TABLE vrf_specs
+	DROP COLUMN coordinator_version,
+	DROP COLUMN from_address,
+	DROP COLUMN batch_coordinator_address,
+	DROP COLUMN batch_fulfillment_gas_limit;
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0066_vrf_v2_coordinator",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up66).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down66).Error
+		},
+	})
+}
@@ -0,0 +1,27 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// On L2 chains, the part of the fee of a transaction that paid for posting it
+// to L1 is recorded with its receipt
+const up67 = `
+ALTER TABLE eth_receipts ADD COLUMN l1_fee numeric(78,0) CHECK (l1_fee >= 0);
+`
+
+const down67 = `
+ALTER TABLE eth_receipts DROP COLUMN l1_fee;
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0067_eth_receipts_l1_fee",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up67).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down67).Error
+		},
+	})
+}
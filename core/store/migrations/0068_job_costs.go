@@ -0,0 +1,42 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// Transactions are linked to the job that created them, and receipts record
+// what the transaction cost and earned, so that gas spend can be reported per
+// job. job_id deliberately has no foreign key so the spend of deleted jobs
+// remains in the reports.
+const up68 = `
+ALTER TABLE eth_txes ADD COLUMN job_id integer;
+UPDATE eth_txes SET job_id = (meta->>'JobID')::integer WHERE meta->>'JobID' IS NOT NULL AND meta->>'JobID' <> '0';
+CREATE INDEX idx_eth_txes_job_id ON eth_txes (job_id) WHERE job_id IS NOT NULL;
+
+ALTER TABLE eth_receipts
+	ADD COLUMN gas_used bigint CHECK (gas_used >= 0),
+	ADD COLUMN effective_gas_price numeric(78,0) CHECK (effective_gas_price >= 0),
+	ADD COLUMN link_earned numeric(78,0) CHECK (link_earned >= 0);
+UPDATE eth_receipts SET gas_used = ('x' || lpad(substr(receipt->>'gasUsed', 3), 16, '0'))::bit(64)::bigint WHERE receipt->>'gasUsed' LIKE '0x%';
+`
+
+const down68 = `
+ALTER TABLE eth_receipts
+	DROP COLUMN gas_used,
+	DROP COLUMN effective_gas_price,
+	DROP COLUMN link_earned;
+DROP INDEX idx_eth_txes_job_id;
+ALTER TABLE eth_txes DROP COLUMN job_id;
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0068_job_costs",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up68).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down68).Error
+		},
+	})
+}
@@ -0,0 +1,46 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// Every update of a job stores a new immutable version of its spec. A version
+// keeps the TOML it was created from and its pipeline spec, so that runs of
+// earlier versions stay linked to the job. Jobs created before versioning get
+// a first version without TOML.
+const up69 = `
+ALTER TABLE jobs ADD COLUMN version integer NOT NULL DEFAULT 1 CHECK (version > 0);
+
+CREATE TABLE job_spec_versions (
+	id BIGSERIAL PRIMARY KEY,
+	job_id integer NOT NULL REFERENCES jobs (id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE,
+	version integer NOT NULL CHECK (version > 0),
+	toml text,
+	pipeline_spec_id integer NOT NULL REFERENCES pipeline_specs (id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE,
+	created_at timestamptz NOT NULL,
+	UNIQUE (job_id, version)
+);
+CREATE INDEX idx_job_spec_versions_pipeline_spec_id ON job_spec_versions (pipeline_spec_id);
+
+INSERT INTO job_spec_versions (job_id, version, pipeline_spec_id, created_at)
+SELECT jobs.id, 1, jobs.pipeline_spec_id, pipeline_specs.created_at
+FROM jobs
+JOIN pipeline_specs ON pipeline_specs.id = jobs.pipeline_spec_id;
+`
+
+const down69 = `
+DROP TABLE job_spec_versions;
+ALTER TABLE jobs DROP COLUMN version;
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0069_job_spec_versions",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up69).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down69).Error
+		},
+	})
+}
@@ -0,0 +1,25 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+const up70 = `
+ALTER TABLE pipeline_task_runs ADD COLUMN attempts jsonb;
+`
+
+const down70 = `
+ALTER TABLE pipeline_task_runs DROP COLUMN attempts;
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0070_pipeline_task_runs_attempts",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up70).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down70).Error
+		},
+	})
+}
@@ -0,0 +1,27 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// The EthConfirmer looks up the reverted receipts without a revert reason on
+// every head
+const up71 = `
+CREATE INDEX idx_eth_receipts_revert_reason_pending ON eth_receipts (block_number) WHERE revert_reason IS NULL AND receipt->>'status' = '0x0';
+`
+
+const down71 = `
+DROP INDEX idx_eth_receipts_revert_reason_pending;
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0071_eth_receipts_revert_reason_index",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up71).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down71).Error
+		},
+	})
+}
@@ -0,0 +1,41 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// Jobs and keys can override the ETH_TX_LIMIT_* limits of the node, NULL
+// falls back to the config
+const up72 = `
+ALTER TABLE jobs
+	ADD COLUMN eth_tx_limit_max_txs bigint CHECK (eth_tx_limit_max_txs >= 0),
+	ADD COLUMN eth_tx_limit_max_daily_spend_wei numeric(78,0) CHECK (eth_tx_limit_max_daily_spend_wei >= 0),
+	ADD COLUMN eth_tx_limit_max_value_per_tx_wei numeric(78,0) CHECK (eth_tx_limit_max_value_per_tx_wei >= 0);
+ALTER TABLE keys
+	ADD COLUMN eth_tx_limit_max_txs bigint CHECK (eth_tx_limit_max_txs >= 0),
+	ADD COLUMN eth_tx_limit_max_daily_spend_wei numeric(78,0) CHECK (eth_tx_limit_max_daily_spend_wei >= 0),
+	ADD COLUMN eth_tx_limit_max_value_per_tx_wei numeric(78,0) CHECK (eth_tx_limit_max_value_per_tx_wei >= 0);
+`
+
+const down72 = `
+ALTER TABLE keys
+	DROP COLUMN eth_tx_limit_max_value_per_tx_wei,
+	DROP COLUMN eth_tx_limit_max_daily_spend_wei,
+	DROP COLUMN eth_tx_limit_max_txs;
+ALTER TABLE jobs
+	DROP COLUMN eth_tx_limit_max_value_per_tx_wei,
+	DROP COLUMN eth_tx_limit_max_daily_spend_wei,
+	DROP COLUMN eth_tx_limit_max_txs;
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0072_eth_tx_limit_overrides",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up72).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down72).Error
+		},
+	})
+}
@@ -26,6 +26,20 @@ type EthTxMetaV2 struct {
 	JobID         int32
 	RequestID     common.Hash
 	RequestTxHash common.Hash
+	// SubID and MaxLink are set on the fulfillment of a VRF v2 request, MaxLink
+	// is the most juels the fulfillment can charge the subscription
+	SubID   *uint64 `json:",omitempty"`
+	MaxLink *string `json:",omitempty"`
+	// Fulfillments lists the requests a batch of VRF v2 fulfillments fulfills
+	Fulfillments []VRFFulfillmentMeta `json:",omitempty"`
+}
+
+// VRFFulfillmentMeta is the metadata of one fulfillment in a batch of VRF v2
+// fulfillments
+type VRFFulfillmentMeta struct {
+	RequestID common.Hash
+	SubID     uint64
+	MaxLink   string
 }
 
 // Head represents a BlockNumber, BlockHash.
@@ -38,6 +52,8 @@ type Head struct {
 	Parent        *Head `gorm:"-"`
 	Timestamp     time.Time
 	CreatedAt     time.Time
+	// EVMChainID is nil for heads of the node's default chain
+	EVMChainID *utils.Big `gorm:"column:evm_chain_id"`
 }
 
 // NewHead returns a Head instance.
@@ -4,6 +4,7 @@ import (
 	"crypto/subtle"
 	"fmt"
 	"regexp"
+	"strings"
 	"time"
 
 	"github.com/smartcontractkit/chainlink/core/auth"
@@ -16,6 +17,7 @@ import (
 type User struct {
 	Email             string `gorm:"primary_key"`
 	HashedPassword    string
+	Role              UserRole
 	CreatedAt         time.Time `gorm:"index"`
 	TokenKey          string
 	TokenSalt         string
@@ -23,6 +25,60 @@ type User struct {
 	UpdatedAt         time.Time
 }
 
+// UserRole determines which operator API routes a user may access. Roles are
+// ordered, and each role is granted everything the roles below it are.
+type UserRole string
+
+const (
+	// UserRoleViewOnly may only read from the API
+	UserRoleViewOnly UserRole = "view_only"
+	// UserRoleJobEditor may additionally manage jobs, job proposals, bridges,
+	// external initiators and trigger runs
+	UserRoleJobEditor UserRole = "job_editor"
+	// UserRoleKeyAdmin may additionally create, import, export and delete
+	// keys and transfer funds
+	UserRoleKeyAdmin UserRole = "key_admin"
+	// UserRoleOwner has full control of the node, including managing other
+	// users and changing the node's configuration
+	UserRoleOwner UserRole = "owner"
+)
+
+var userRoleRanks = map[UserRole]int{
+	UserRoleViewOnly:  0,
+	UserRoleJobEditor: 1,
+	UserRoleKeyAdmin:  2,
+	UserRoleOwner:     3,
+}
+
+// UserRoles returns all roles, from least to most privileged
+func UserRoles() []UserRole {
+	return []UserRole{UserRoleViewOnly, UserRoleJobEditor, UserRoleKeyAdmin, UserRoleOwner}
+}
+
+// ParseUserRole returns the role with the given name. Hyphens are accepted in
+// place of underscores, e.g. "view-only".
+func ParseUserRole(s string) (UserRole, error) {
+	role := UserRole(strings.ReplaceAll(strings.ToLower(strings.TrimSpace(s)), "-", "_"))
+	if _, ok := userRoleRanks[role]; !ok {
+		return "", fmt.Errorf("invalid role %q, must be one of: %v", s, UserRoles())
+	}
+	return role, nil
+}
+
+// Includes returns true if a user with this role is granted everything a user
+// with the other role is
+func (r UserRole) Includes(other UserRole) bool {
+	rank, ok := userRoleRanks[r]
+	if !ok {
+		return false
+	}
+	otherRank, ok := userRoleRanks[other]
+	if !ok {
+		return false
+	}
+	return rank >= otherRank
+}
+
 // https://davidcel.is/posts/stop-validating-email-addresses-with-regex/
 var emailRegexp = regexp.MustCompile("^[a-zA-Z0-9.!#$%&'*+/=?^_`{|}~-]+@[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?(?:\\.[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)*$")
 
@@ -31,8 +87,9 @@ const (
 	MaxBcryptPasswordLength = 50
 )
 
-// NewUser creates a new user by hashing the passed plainPwd with bcrypt.
-func NewUser(email, plainPwd string) (User, error) {
+// NewUser creates a new user with the given role by hashing the passed
+// plainPwd with bcrypt.
+func NewUser(email, plainPwd string, role UserRole) (User, error) {
 	if len(email) == 0 {
 		return User{}, errors.New("Must enter an email")
 	}
@@ -45,6 +102,10 @@ func NewUser(email, plainPwd string) (User, error) {
 		return User{}, fmt.Errorf("must enter a password with 8 - %v characters", MaxBcryptPasswordLength)
 	}
 
+	if _, err := ParseUserRole(string(role)); err != nil {
+		return User{}, err
+	}
+
 	pwd, err := utils.HashPassword(plainPwd)
 	if err != nil {
 		return User{}, err
@@ -53,6 +114,7 @@ func NewUser(email, plainPwd string) (User, error) {
 	return User{
 		Email:          email,
 		HashedPassword: pwd,
+		Role:           role,
 	}, nil
 }
 
@@ -66,16 +128,18 @@ type SessionRequest struct {
 // Session holds the unique id for the authenticated session.
 type Session struct {
 	ID        string    `json:"id" gorm:"primary_key"`
+	UserEmail string    `json:"userEmail"`
 	LastUsed  time.Time `json:"lastUsed" gorm:"index"`
 	CreatedAt time.Time `json:"createdAt" gorm:"index"`
 }
 
-// NewSession returns a session instance with ID set to a random ID and
-// LastUsed to to now.
-func NewSession() Session {
+// NewSession returns a session instance for the given user with ID set to a
+// random ID and LastUsed to to now.
+func NewSession(userEmail string) Session {
 	return Session{
-		ID:       utils.NewBytes32ID(),
-		LastUsed: time.Now(),
+		ID:        utils.NewBytes32ID(),
+		UserEmail: userEmail,
+		LastUsed:  time.Now(),
 	}
 }
 
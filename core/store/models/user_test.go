@@ -29,12 +29,13 @@ func TestNewUser(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.email, func(t *testing.T) {
-			user, err := models.NewUser(test.email, test.pwd)
+			user, err := models.NewUser(test.email, test.pwd, models.UserRoleJobEditor)
 			if test.wantError {
 				assert.Error(t, err)
 			} else {
 				assert.NoError(t, err)
 				assert.Equal(t, test.email, user.Email)
+				assert.Equal(t, models.UserRoleJobEditor, user.Role)
 				assert.NotEmpty(t, user.HashedPassword)
 				newHash, _ := utils.HashPassword(test.pwd)
 				assert.NotEqual(t, newHash, user.HashedPassword, "Salt should prevent equality")
@@ -43,6 +44,56 @@ func TestNewUser(t *testing.T) {
 	}
 }
 
+func TestNewUser_InvalidRole(t *testing.T) {
+	t.Parallel()
+
+	_, err := models.NewUser("good@email.com", "goodpassword", models.UserRole("superuser"))
+	assert.Error(t, err)
+}
+
+func TestParseUserRole(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input     string
+		want      models.UserRole
+		wantError bool
+	}{
+		{"view_only", models.UserRoleViewOnly, false},
+		{"view-only", models.UserRoleViewOnly, false},
+		{"Job-Editor", models.UserRoleJobEditor, false},
+		{"key_admin", models.UserRoleKeyAdmin, false},
+		{" owner ", models.UserRoleOwner, false},
+		{"admin", "", true},
+		{"", "", true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			role, err := models.ParseUserRole(test.input)
+			if test.wantError {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, test.want, role)
+			}
+		})
+	}
+}
+
+func TestUserRole_Includes(t *testing.T) {
+	t.Parallel()
+
+	roles := models.UserRoles()
+	for i, role := range roles {
+		for j, other := range roles {
+			assert.Equal(t, i >= j, role.Includes(other), "%s includes %s", role, other)
+		}
+		assert.False(t, role.Includes(models.UserRole("superuser")))
+		assert.False(t, models.UserRole("superuser").Includes(role))
+	}
+}
+
 func TestUserGenerateAuthToken(t *testing.T) {
 	var user models.User
 	token, err := user.GenerateAuthToken()
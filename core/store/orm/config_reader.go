@@ -17,6 +17,7 @@ import (
 // ConfigReader represents just the read side of the config
 type ConfigReader interface {
 	AllowOrigins() string
+	AuditLogFile() string
 	BlockBackfillDepth() uint64
 	BridgeResponseURL() *url.URL
 	CertFile() string
@@ -32,6 +33,7 @@ type ConfigReader interface {
 	DefaultMaxHTTPAttempts() uint
 	Dev() bool
 	EthBalanceMonitorBlockDelay() uint16
+	EthEIP1559DynamicFees() bool
 	EthFinalityDepth() uint
 	EthGasBumpPercent() uint16
 	EthGasBumpThreshold() uint64
@@ -40,33 +42,51 @@ type ConfigReader interface {
 	EthGasLimitDefault() uint64
 	EthGasLimitMultiplier() float32
 	EthGasPriceDefault() *big.Int
+	EthGasTipCapDefault() *big.Int
+	EthGasTipCapMinimum() *big.Int
 	EthHeadTrackerHistoryDepth() uint
 	EthHeadTrackerMaxBufferSize() uint
 	EthLogBackfillBatchSize() uint32
 	EthMaxGasPriceWei() *big.Int
 	EthNonceAutoSync() bool
 	EthRPCDefaultBatchSize() uint32
+	EthRemoteSignerURL() *url.URL
+	EthTxLimitMaxCostPerTxWei() *big.Int
+	EthTxLimitMaxDailySpendPerJobWei() *big.Int
+	EthTxLimitMaxDailySpendPerKeyWei() *big.Int
+	EthTxLimitMaxTxsPerJob() uint32
+	EthTxLimitMaxTxsPerKey() uint32
+	EthTxLimitMaxValuePerTxWei() *big.Int
+	EthTxLimitWindow() time.Duration
 	EthTxReaperInterval() time.Duration
 	EthTxReaperThreshold() time.Duration
 	EthTxResendAfterThreshold() time.Duration
+	EthereumPrimaryURLs() []url.URL
 	EthereumSecondaryURLs() []url.URL
 	EthereumURL() string
 	ExplorerAccessKey() string
 	ExplorerSecret() string
 	ExplorerURL() *url.URL
+	FMSimulateTransactions() bool
 	FeatureExternalInitiators() bool
 	FeatureOffchainReporting() bool
 	BlockHistoryEstimatorBlockDelay() uint16
 	BlockHistoryEstimatorBlockHistorySize() uint16
 	BlockHistoryEstimatorTransactionPercentile() uint16
+	GasOracleAggregation() string
+	GasOraclePollPeriod() time.Duration
+	GasOraclePriceUnit() string
+	GasOracleURLs() []url.URL
 	InsecureSkipVerify() bool
 	JSONConsole() bool
+	KeeperCheckUpkeepBatchSize() uint32
 	KeeperDefaultTransactionQueueDepth() uint32
 	KeeperRegistryCheckGasOverhead() uint64
 	KeeperRegistryPerformGasOverhead() uint64
 	KeeperRegistrySyncInterval() time.Duration
 	KeeperMinimumRequiredConfirmations() uint64
 	KeeperMaximumGracePeriod() int64
+	KeeperMulticallAddress() string
 	KeyFile() string
 	LinkContractAddress() string
 	LogLevel() config.LogLevel
@@ -79,6 +99,7 @@ type ConfigReader interface {
 	MinimumContractPayment() *assets.Link
 	MinimumRequestExpiration() uint64
 	MinimumServiceDuration() models.Duration
+	OCRSimulateTransactions() bool
 	OCRTraceLogging() bool
 	OperatorContractAddress() common.Address
 	Port() uint16
@@ -96,4 +117,6 @@ type ConfigReader interface {
 	TLSPort() uint16
 	TLSRedirect() bool
 	TriggerFallbackDBPollInterval() time.Duration
+	VaultToken() string
+	VaultURL() *url.URL
 }
@@ -302,13 +302,14 @@ func (orm *ORM) FindEthTxAttempt(hash common.Hash) (*bulletprooftxmanager.EthTxA
 		return nil, err
 	}
 	ethTxAttempt := &bulletprooftxmanager.EthTxAttempt{}
-	if err := orm.DB.Preload("EthTx").First(ethTxAttempt, "hash = ?", hash).Error; err != nil {
+	if err := orm.DB.Preload("EthTx").Preload("EthReceipts").First(ethTxAttempt, "hash = ?", hash).Error; err != nil {
 		return nil, errors.Wrap(err, "FindEthTxAttempt First(ethTxAttempt) failed")
 	}
 	return ethTxAttempt, nil
 }
 
-// FindUser will return the one API user, or an error.
+// FindUser will return the most recently created API user, or an error if
+// there are none.
 func (orm *ORM) FindUser() (models.User, error) {
 	return findUser(orm.DB)
 }
@@ -317,8 +318,100 @@ func findUser(db *gorm.DB) (user models.User, err error) {
 	return user, db.Preload(clause.Associations).Order("created_at desc").First(&user).Error
 }
 
-// AuthorizedUserWithSession will return the one API user if the Session ID exists
-// and hasn't expired, and update session's LastUsed field.
+// FindUserByEmail returns the API user with the given email.
+func (orm *ORM) FindUserByEmail(email string) (user models.User, err error) {
+	return user, orm.DB.First(&user, "email = ?", email).Error
+}
+
+// FindUserByAPIToken returns the API user whose API token has the given
+// access key.
+func (orm *ORM) FindUserByAPIToken(accessKey string) (user models.User, err error) {
+	if accessKey == "" {
+		return user, ErrorNotFound
+	}
+	return user, orm.DB.First(&user, "token_key = ?", accessKey).Error
+}
+
+// ListUsers returns all API users, ordered by email.
+func (orm *ORM) ListUsers() (users []models.User, err error) {
+	return users, orm.DB.Order("email asc").Find(&users).Error
+}
+
+// CreateUser inserts a new API user, failing if one with the same email
+// already exists.
+func (orm *ORM) CreateUser(user *models.User) error {
+	if err := orm.MustEnsureAdvisoryLock(); err != nil {
+		return err
+	}
+	return orm.DB.Create(user).Error
+}
+
+// ErrLastOwner is returned when a change would leave the node without a user
+// with the owner role.
+var ErrLastOwner = errors.New("cannot remove the last user with the owner role")
+
+// UpdateUserRole changes the role of the API user with the given email.
+func (orm *ORM) UpdateUserRole(email string, role models.UserRole) (user models.User, err error) {
+	if err = orm.MustEnsureAdvisoryLock(); err != nil {
+		return user, err
+	}
+	err = postgres.GormTransactionWithDefaultContext(orm.DB, func(dbtx *gorm.DB) error {
+		if err = dbtx.First(&user, "email = ?", email).Error; err != nil {
+			return err
+		}
+		if user.Role == models.UserRoleOwner && role != models.UserRoleOwner {
+			if err = ensureAnotherOwner(dbtx, email); err != nil {
+				return err
+			}
+		}
+		user.Role = role
+		return dbtx.Model(&user).Update("role", role).Error
+	})
+	return user, err
+}
+
+// DeleteUserByEmail deletes the API user with the given email, along with all
+// of their sessions.
+func (orm *ORM) DeleteUserByEmail(email string) error {
+	if err := orm.MustEnsureAdvisoryLock(); err != nil {
+		return err
+	}
+	return postgres.GormTransactionWithDefaultContext(orm.DB, func(dbtx *gorm.DB) error {
+		var user models.User
+		if err := dbtx.First(&user, "email = ?", email).Error; err != nil {
+			return err
+		}
+		if user.Role == models.UserRoleOwner {
+			if err := ensureAnotherOwner(dbtx, email); err != nil {
+				return err
+			}
+		}
+		return dbtx.Delete(&user).Error
+	})
+}
+
+// ensureAnotherOwner locks all owners, so that concurrent changes cannot
+// together remove every one of them
+func ensureAnotherOwner(db *gorm.DB, email string) error {
+	var owners []string
+	err := db.Model(&models.User{}).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("role = ?", models.UserRoleOwner).
+		Pluck("email", &owners).Error
+	if err != nil {
+		return err
+	}
+	for _, owner := range owners {
+		if owner != email {
+			return nil
+		}
+	}
+	return ErrLastOwner
+}
+
+// AuthorizedUserWithSession will return the API user the session belongs to
+// if the Session ID exists and hasn't expired, and update session's LastUsed
+// field.
 func (orm *ORM) AuthorizedUserWithSession(sessionID string, sessionDuration time.Duration) (models.User, error) {
 	if len(sessionID) == 0 {
 		return models.User{}, errors.New("Session ID cannot be empty")
@@ -337,10 +430,11 @@ func (orm *ORM) AuthorizedUserWithSession(sessionID string, sessionDuration time
 	if err := orm.DB.Save(&session).Error; err != nil {
 		return models.User{}, err
 	}
-	return orm.FindUser()
+	return orm.FindUserByEmail(session.UserEmail)
 }
 
-// DeleteUser will delete the API User in the db.
+// DeleteUser will delete the most recently created API User in the db, along
+// with all of their sessions.
 func (orm *ORM) DeleteUser() error {
 	return postgres.GormTransactionWithDefaultContext(orm.DB, func(dbtx *gorm.DB) error {
 		user, err := findUser(dbtx)
@@ -348,15 +442,11 @@ func (orm *ORM) DeleteUser() error {
 			return err
 		}
 
-		if err = dbtx.Delete(&user).Error; err != nil {
-			return err
-		}
-
-		return dbtx.Exec("DELETE FROM sessions").Error
+		return dbtx.Delete(&user).Error
 	})
 }
 
-// DeleteUserSession will erase the session ID for the sole API User.
+// DeleteUserSession will erase the given session ID.
 func (orm *ORM) DeleteUserSession(sessionID string) error {
 	return orm.DB.Delete(models.Session{ID: sessionID}).Error
 }
@@ -370,10 +460,12 @@ func (orm *ORM) DeleteBridgeType(bt *models.BridgeType) error {
 }
 
 // CreateSession will check the password in the SessionRequest against
-// the hashed API User password in the db.
+// the hashed password of the API User with the given email in the db.
 func (orm *ORM) CreateSession(sr models.SessionRequest) (string, error) {
-	user, err := orm.FindUser()
-	if err != nil {
+	user, err := orm.FindUserByEmail(sr.Email)
+	if errors.Is(err, ErrorNotFound) {
+		return "", errors.New("Invalid email")
+	} else if err != nil {
 		return "", err
 	}
 
@@ -382,7 +474,7 @@ func (orm *ORM) CreateSession(sr models.SessionRequest) (string, error) {
 	}
 
 	if utils.CheckPasswordHash(sr.Password, user.HashedPassword) {
-		session := models.NewSession()
+		session := models.NewSession(user.Email)
 		return session.ID, orm.DB.Save(&session).Error
 	}
 	return "", errors.New("Invalid password")
@@ -399,9 +491,10 @@ func constantTimeEmailCompare(left, right string) bool {
 	return subtle.ConstantTimeCompare(leftBytes, rightBytes) == 1
 }
 
-// ClearNonCurrentSessions removes all sessions but the id passed in.
-func (orm *ORM) ClearNonCurrentSessions(sessionID string) error {
-	return orm.DB.Delete(&models.Session{}, "id != ?", sessionID).Error
+// ClearNonCurrentSessions removes all of the user's sessions but the id
+// passed in.
+func (orm *ORM) ClearNonCurrentSessions(email, sessionID string) error {
+	return orm.DB.Delete(&models.Session{}, "user_email = ? AND id != ?", email, sessionID).Error
 }
 
 // BridgeTypes returns bridge types ordered by name filtered limited by the
@@ -10,8 +10,10 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
 	"github.com/smartcontractkit/chainlink/core/services/postgres"
 	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/store/orm"
 	"github.com/smartcontractkit/chainlink/core/utils"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"gorm.io/gorm"
@@ -164,6 +166,7 @@ func TestORM_AuthorizedUserWithSession(t *testing.T) {
 			require.NoError(t, store.SaveUser(&user))
 
 			prevSession := cltest.NewSession("correctID")
+			prevSession.UserEmail = user.Email
 			prevSession.LastUsed = time.Now().Add(-cltest.MustParseDuration(t, "2m"))
 			require.NoError(t, store.DB.Save(&prevSession).Error)
 
@@ -201,13 +204,74 @@ func TestORM_DeleteUser(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestORM_UserRoles(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	// The fixture user is the only owner
+	_, err := store.UpdateUserRole(cltest.APIEmail, models.UserRoleViewOnly)
+	require.Equal(t, orm.ErrLastOwner, errors.Cause(err))
+	require.Equal(t, orm.ErrLastOwner, errors.Cause(store.DeleteUserByEmail(cltest.APIEmail)))
+
+	user := cltest.MustNewUserWithRole(t, "editor@chainlink.test", "password", models.UserRoleJobEditor)
+	require.NoError(t, store.CreateUser(&user))
+	_, err = store.UpdateUserRole(cltest.APIEmail, models.UserRoleViewOnly)
+	require.Equal(t, orm.ErrLastOwner, errors.Cause(err))
+
+	updated, err := store.UpdateUserRole(user.Email, models.UserRoleOwner)
+	require.NoError(t, err)
+	assert.Equal(t, models.UserRoleOwner, updated.Role)
+
+	// Now that there is another owner, the fixture user can be demoted
+	updated, err = store.UpdateUserRole(cltest.APIEmail, models.UserRoleViewOnly)
+	require.NoError(t, err)
+	assert.Equal(t, models.UserRoleViewOnly, updated.Role)
+
+	session := models.NewSession(cltest.APIEmail)
+	require.NoError(t, store.DB.Save(&session).Error)
+	require.NoError(t, store.DeleteUserByEmail(cltest.APIEmail))
+	_, err = store.FindUserByEmail(cltest.APIEmail)
+	require.Equal(t, orm.ErrorNotFound, errors.Cause(err))
+	_, err = store.AuthorizedUserWithSession(session.ID)
+	require.Error(t, err)
+
+	users, err := store.ListUsers()
+	require.NoError(t, err)
+	require.Len(t, users, 1)
+	assert.Equal(t, user.Email, users[0].Email)
+}
+
+func TestORM_FindUserByAPIToken(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	user, err := store.FindUserByEmail(cltest.APIEmail)
+	require.NoError(t, err)
+	token, err := user.GenerateAuthToken()
+	require.NoError(t, err)
+	require.NoError(t, store.SaveUser(&user))
+
+	found, err := store.FindUserByAPIToken(token.AccessKey)
+	require.NoError(t, err)
+	assert.Equal(t, user.Email, found.Email)
+
+	_, err = store.FindUserByAPIToken("")
+	assert.Equal(t, orm.ErrorNotFound, errors.Cause(err))
+	_, err = store.FindUserByAPIToken("unknown")
+	assert.Equal(t, orm.ErrorNotFound, errors.Cause(err))
+}
+
 func TestORM_DeleteUserSession(t *testing.T) {
 	t.Parallel()
 
 	store, cleanup := cltest.NewStore(t)
 	defer cleanup()
 
-	session := models.NewSession()
+	session := models.NewSession(cltest.APIEmail)
 	require.NoError(t, store.DB.Save(&session).Error)
 
 	err := store.DeleteUserSession(session.ID)
@@ -276,7 +340,7 @@ func TestORM_EthTransactionsWithAttempts(t *testing.T) {
 	blockNum := int64(3)
 	attempt := cltest.NewEthTxAttempt(t, tx2.ID)
 	attempt.State = bulletprooftxmanager.EthTxAttemptBroadcast
-	attempt.GasPrice = *utils.NewBig(big.NewInt(3))
+	attempt.GasPrice = utils.NewBig(big.NewInt(3))
 	attempt.BroadcastBeforeBlockNum = &blockNum
 	require.NoError(t, store.DB.Create(&attempt).Error)
 
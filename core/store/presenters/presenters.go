@@ -32,6 +32,7 @@ type ConfigPrinter struct {
 // EnvPrinter contains the supported environment variables
 type EnvPrinter struct {
 	AllowOrigins                               string          `json:"ALLOW_ORIGINS"`
+	AuditLogFile                               string          `json:"AUDIT_LOG_FILE"`
 	BalanceMonitorEnabled                      bool            `json:"BALANCE_MONITOR_ENABLED"`
 	BlockBackfillDepth                         uint64          `json:"BLOCK_BACKFILL_DEPTH"`
 	BlockBackfillSkip                          bool            `json:"BLOCK_BACKFILL_SKIP"`
@@ -43,12 +44,15 @@ type EnvPrinter struct {
 	ClientNodeURL                              string          `json:"CLIENT_NODE_URL"`
 	DatabaseBackupFrequency                    time.Duration   `json:"DATABASE_BACKUP_FREQUENCY"`
 	DatabaseBackupMode                         string          `json:"DATABASE_BACKUP_MODE"`
+	DatabaseBackupRetentionCount               uint32          `json:"DATABASE_BACKUP_RETENTION_COUNT"`
+	DatabaseBackupRetentionPeriod              time.Duration   `json:"DATABASE_BACKUP_RETENTION_PERIOD"`
 	DatabaseMaximumTxDuration                  time.Duration   `json:"DATABASE_MAXIMUM_TX_DURATION"`
 	DatabaseTimeout                            models.Duration `json:"DATABASE_TIMEOUT"`
 	DefaultHTTPLimit                           int64           `json:"DEFAULT_HTTP_LIMIT"`
 	DefaultHTTPTimeout                         models.Duration `json:"DEFAULT_HTTP_TIMEOUT"`
 	Dev                                        bool            `json:"CHAINLINK_DEV"`
 	EthBalanceMonitorBlockDelay                uint16          `json:"ETH_BALANCE_MONITOR_BLOCK_DELAY"`
+	EthEIP1559DynamicFees                      bool            `json:"ETH_EIP1559_DYNAMIC_FEES"`
 	EthFinalityDepth                           uint            `json:"ETH_FINALITY_DEPTH"`
 	EthGasBumpThreshold                        uint64          `json:"ETH_GAS_BUMP_THRESHOLD"`
 	EthGasBumpTxDepth                          uint16          `json:"ETH_GAS_BUMP_TX_DEPTH"`
@@ -56,24 +60,43 @@ type EnvPrinter struct {
 	EthGasLimitDefault                         uint64          `json:"ETH_GAS_LIMIT_DEFAULT"`
 	EthGasLimitTransfer                        uint64          `json:"ETH_GAS_LIMIT_TRANSFER"`
 	EthGasPriceDefault                         *big.Int        `json:"ETH_GAS_PRICE_DEFAULT"`
+	EthGasTipCapDefault                        *big.Int        `json:"ETH_GAS_TIP_CAP_DEFAULT"`
+	EthGasTipCapMinimum                        *big.Int        `json:"ETH_GAS_TIP_CAP_MINIMUM"`
 	EthHeadTrackerHistoryDepth                 uint            `json:"ETH_HEAD_TRACKER_HISTORY_DEPTH"`
 	EthHeadTrackerMaxBufferSize                uint            `json:"ETH_HEAD_TRACKER_MAX_BUFFER_SIZE"`
 	EthMaxGasPriceWei                          *big.Int        `json:"ETH_MAX_GAS_PRICE_WEI"`
+	EthRemoteSignerURL                         string          `json:"ETH_REMOTE_SIGNER_URL"`
+	EthTxLimitMaxCostPerTxWei                  *big.Int        `json:"ETH_TX_LIMIT_MAX_COST_PER_TX_WEI"`
+	EthTxLimitMaxDailySpendPerJobWei           *big.Int        `json:"ETH_TX_LIMIT_MAX_DAILY_SPEND_PER_JOB_WEI"`
+	EthTxLimitMaxDailySpendPerKeyWei           *big.Int        `json:"ETH_TX_LIMIT_MAX_DAILY_SPEND_PER_KEY_WEI"`
+	EthTxLimitMaxTxsPerJob                     uint32          `json:"ETH_TX_LIMIT_MAX_TXS_PER_JOB"`
+	EthTxLimitMaxTxsPerKey                     uint32          `json:"ETH_TX_LIMIT_MAX_TXS_PER_KEY"`
+	EthTxLimitMaxValuePerTxWei                 *big.Int        `json:"ETH_TX_LIMIT_MAX_VALUE_PER_TX_WEI"`
+	EthTxLimitWindow                           time.Duration   `json:"ETH_TX_LIMIT_WINDOW"`
 	EthereumDisabled                           bool            `json:"ETH_DISABLED"`
 	EthereumHTTPURL                            string          `json:"ETH_HTTP_URL"`
+	EthereumPrimaryURLs                        []string        `json:"ETH_PRIMARY_URLS"`
 	EthereumSecondaryURLs                      []string        `json:"ETH_SECONDARY_URLS"`
 	EthereumURL                                string          `json:"ETH_URL"`
 	ExplorerURL                                string          `json:"EXPLORER_URL"`
 	FMDefaultTransactionQueueDepth             uint32          `json:"FM_DEFAULT_TRANSACTION_QUEUE_DEPTH"`
+	FMSimulateTransactions                     bool            `json:"FM_SIMULATE_TRANSACTIONS"`
 	FeatureExternalInitiators                  bool            `json:"FEATURE_EXTERNAL_INITIATORS"`
 	FeatureOffchainReporting                   bool            `json:"FEATURE_OFFCHAIN_REPORTING"`
 	FlagsContractAddress                       string          `json:"FLAGS_CONTRACT_ADDRESS"`
 	GasEstimatorMode                           string          `json:"GAS_ESTIMATOR_MODE"`
+	GasOracleAggregation                       string          `json:"GAS_ORACLE_AGGREGATION"`
+	GasOraclePollPeriod                        time.Duration   `json:"GAS_ORACLE_POLL_PERIOD"`
+	GasOraclePriceUnit                         string          `json:"GAS_ORACLE_PRICE_UNIT"`
 	InsecureFastScrypt                         bool            `json:"INSECURE_FAST_SCRYPT"`
 	JSONConsole                                bool            `json:"JSON_CONSOLE"`
 	JobPipelineReaperInterval                  time.Duration   `json:"JOB_PIPELINE_REAPER_INTERVAL"`
 	JobPipelineReaperThreshold                 time.Duration   `json:"JOB_PIPELINE_REAPER_THRESHOLD"`
+	JobPipelineResponseCacheMaxStaleness       time.Duration   `json:"JOB_PIPELINE_RESPONSE_CACHE_MAX_STALENESS"`
+	JobPipelineResponseCacheSize               uint64          `json:"JOB_PIPELINE_RESPONSE_CACHE_SIZE"`
+	KeeperCheckUpkeepBatchSize                 uint32          `json:"KEEPER_CHECK_UPKEEP_BATCH_SIZE"`
 	KeeperDefaultTransactionQueueDepth         uint32          `json:"KEEPER_DEFAULT_TRANSACTION_QUEUE_DEPTH"`
+	KeeperMulticallAddress                     string          `json:"KEEPER_MULTICALL_ADDRESS"`
 	LinkContractAddress                        string          `json:"LINK_CONTRACT_ADDRESS"`
 	LogLevel                                   config.LogLevel `json:"LOG_LEVEL"`
 	LogSQLMigrations                           bool            `json:"LOG_SQL_MIGRATIONS"`
@@ -104,6 +127,7 @@ type EnvPrinter struct {
 	OCROutgoingMessageBufferSize               int             `json:"OCR_OUTGOING_MESSAGE_BUFFER_SIZE"`
 	OCRNewStreamTimeout                        time.Duration   `json:"OCR_NEW_STREAM_TIMEOUT"`
 	OCRDHTLookupInterval                       int             `json:"OCR_DHT_LOOKUP_INTERVAL"`
+	OCRSimulateTransactions                    bool            `json:"OCR_SIMULATE_TRANSACTIONS"`
 	OCRTraceLogging                            bool            `json:"OCR_TRACE_LOGGING"`
 	OperatorContractAddress                    common.Address  `json:"OPERATOR_CONTRACT_ADDRESS"`
 	Port                                       uint16          `json:"CHAINLINK_PORT"`
@@ -115,6 +139,7 @@ type EnvPrinter struct {
 	TLSHost                                    string          `json:"CHAINLINK_TLS_HOST"`
 	TLSPort                                    uint16          `json:"CHAINLINK_TLS_PORT"`
 	TLSRedirect                                bool            `json:"CHAINLINK_TLS_REDIRECT"`
+	VaultURL                                   string          `json:"VAULT_ADDR"`
 }
 
 // NewConfigPrinter creates an instance of ConfigPrinter
@@ -130,9 +155,18 @@ func NewConfigPrinter(store *store.Store) (ConfigPrinter, error) {
 	if config.EthereumHTTPURL() != nil {
 		ethereumHTTPURL = config.EthereumHTTPURL().String()
 	}
-	return ConfigPrinter{
+	ethRemoteSignerURL := ""
+	if config.EthRemoteSignerURL() != nil {
+		ethRemoteSignerURL = config.EthRemoteSignerURL().Redacted()
+	}
+	vaultURL := ""
+	if config.VaultURL() != nil {
+		vaultURL = config.VaultURL().Redacted()
+	}
+	printer := ConfigPrinter{
 		EnvPrinter: EnvPrinter{
 			AllowOrigins:                               config.AllowOrigins(),
+			AuditLogFile:                               config.AuditLogFile(),
 			BalanceMonitorEnabled:                      config.BalanceMonitorEnabled(),
 			BlockBackfillDepth:                         config.BlockBackfillDepth(),
 			BlockBackfillSkip:                          config.BlockBackfillSkip(),
@@ -144,12 +178,15 @@ func NewConfigPrinter(store *store.Store) (ConfigPrinter, error) {
 			ClientNodeURL:                              config.ClientNodeURL(),
 			DatabaseBackupFrequency:                    config.DatabaseBackupFrequency(),
 			DatabaseBackupMode:                         string(config.DatabaseBackupMode()),
+			DatabaseBackupRetentionCount:               config.DatabaseBackupRetentionCount(),
+			DatabaseBackupRetentionPeriod:              config.DatabaseBackupRetentionPeriod(),
 			DatabaseMaximumTxDuration:                  config.DatabaseMaximumTxDuration(),
 			DatabaseTimeout:                            config.DatabaseTimeout(),
 			DefaultHTTPLimit:                           config.DefaultHTTPLimit(),
 			DefaultHTTPTimeout:                         config.DefaultHTTPTimeout(),
 			Dev:                                        config.Dev(),
 			EthBalanceMonitorBlockDelay:                config.EthBalanceMonitorBlockDelay(),
+			EthEIP1559DynamicFees:                      config.EthEIP1559DynamicFees(),
 			EthFinalityDepth:                           config.EthFinalityDepth(),
 			EthGasBumpThreshold:                        config.EthGasBumpThreshold(),
 			EthGasBumpTxDepth:                          config.EthGasBumpTxDepth(),
@@ -157,24 +194,43 @@ func NewConfigPrinter(store *store.Store) (ConfigPrinter, error) {
 			EthGasLimitDefault:                         config.EthGasLimitDefault(),
 			EthGasLimitTransfer:                        config.EthGasLimitTransfer(),
 			EthGasPriceDefault:                         config.EthGasPriceDefault(),
+			EthGasTipCapDefault:                        config.EthGasTipCapDefault(),
+			EthGasTipCapMinimum:                        config.EthGasTipCapMinimum(),
 			EthHeadTrackerHistoryDepth:                 config.EthHeadTrackerHistoryDepth(),
 			EthHeadTrackerMaxBufferSize:                config.EthHeadTrackerMaxBufferSize(),
 			EthMaxGasPriceWei:                          config.EthMaxGasPriceWei(),
+			EthRemoteSignerURL:                         ethRemoteSignerURL,
+			EthTxLimitMaxCostPerTxWei:                  config.EthTxLimitMaxCostPerTxWei(),
+			EthTxLimitMaxDailySpendPerJobWei:           config.EthTxLimitMaxDailySpendPerJobWei(),
+			EthTxLimitMaxDailySpendPerKeyWei:           config.EthTxLimitMaxDailySpendPerKeyWei(),
+			EthTxLimitMaxTxsPerJob:                     config.EthTxLimitMaxTxsPerJob(),
+			EthTxLimitMaxTxsPerKey:                     config.EthTxLimitMaxTxsPerKey(),
+			EthTxLimitMaxValuePerTxWei:                 config.EthTxLimitMaxValuePerTxWei(),
+			EthTxLimitWindow:                           config.EthTxLimitWindow(),
 			EthereumDisabled:                           config.EthereumDisabled(),
 			EthereumHTTPURL:                            ethereumHTTPURL,
+			EthereumPrimaryURLs:                        mapToStringA(config.EthereumPrimaryURLs()),
 			EthereumSecondaryURLs:                      mapToStringA(config.EthereumSecondaryURLs()),
 			EthereumURL:                                config.EthereumURL(),
 			ExplorerURL:                                explorerURL,
 			FMDefaultTransactionQueueDepth:             config.FMDefaultTransactionQueueDepth(),
+			FMSimulateTransactions:                     config.FMSimulateTransactions(),
 			FeatureExternalInitiators:                  config.FeatureExternalInitiators(),
 			FeatureOffchainReporting:                   config.FeatureOffchainReporting(),
 			FlagsContractAddress:                       config.FlagsContractAddress(),
 			GasEstimatorMode:                           config.GasEstimatorMode(),
+			GasOracleAggregation:                       config.GasOracleAggregation(),
+			GasOraclePollPeriod:                        config.GasOraclePollPeriod(),
+			GasOraclePriceUnit:                         config.GasOraclePriceUnit(),
 			InsecureFastScrypt:                         config.InsecureFastScrypt(),
 			JSONConsole:                                config.JSONConsole(),
 			JobPipelineReaperInterval:                  config.JobPipelineReaperInterval(),
 			JobPipelineReaperThreshold:                 config.JobPipelineReaperThreshold(),
+			JobPipelineResponseCacheMaxStaleness:       config.JobPipelineResponseCacheMaxStaleness(),
+			JobPipelineResponseCacheSize:               config.JobPipelineResponseCacheSize(),
+			KeeperCheckUpkeepBatchSize:                 config.KeeperCheckUpkeepBatchSize(),
 			KeeperDefaultTransactionQueueDepth:         config.KeeperDefaultTransactionQueueDepth(),
+			KeeperMulticallAddress:                     config.KeeperMulticallAddress(),
 			LinkContractAddress:                        config.LinkContractAddress(),
 			LogLevel:                                   config.LogLevel(),
 			LogSQLMigrations:                           config.LogSQLMigrations(),
@@ -194,6 +250,7 @@ func NewConfigPrinter(store *store.Store) (ConfigPrinter, error) {
 			OCRIncomingMessageBufferSize:               config.OCRIncomingMessageBufferSize(),
 			OCRNewStreamTimeout:                        config.OCRNewStreamTimeout(),
 			OCROutgoingMessageBufferSize:               config.OCROutgoingMessageBufferSize(),
+			OCRSimulateTransactions:                    config.OCRSimulateTransactions(),
 			OCRTraceLogging:                            config.OCRTraceLogging(),
 			P2PBootstrapPeers:                          p2pBootstrapPeers,
 			P2PListenIP:                                config.P2PListenIP().String(),
@@ -216,8 +273,32 @@ func NewConfigPrinter(store *store.Store) (ConfigPrinter, error) {
 			TLSHost:                                    config.TLSHost(),
 			TLSPort:                                    config.TLSPort(),
 			TLSRedirect:                                config.TLSRedirect(),
+			VaultURL:                                   vaultURL,
 		},
-	}, nil
+	}
+	redactSecrets(&printer.EnvPrinter, config.RedactField)
+	return printer, nil
+}
+
+// redactSecrets applies redact to all string values of the printer, so that
+// secrets resolved from secret references are never shown
+func redactSecrets(p *EnvPrinter, redact func(name string, value string) string) {
+	v := reflect.ValueOf(p).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Field(i)
+		name := v.Type().Field(i).Name
+		switch field.Kind() {
+		case reflect.String:
+			field.SetString(redact(name, field.String()))
+		case reflect.Slice:
+			if field.Type().Elem().Kind() != reflect.String {
+				continue
+			}
+			for j := 0; j < field.Len(); j++ {
+				field.Index(j).SetString(redact(name, field.Index(j).String()))
+			}
+		}
+	}
 }
 
 // String returns the values as a newline delimited string
@@ -167,7 +167,10 @@ func initializeORM(cfg *config.Config, shutdownSignal gracefulpanic.Signal) (*or
 		if version != nil {
 			versionString = version.Version
 		}
-		databaseBackup := periodicbackup.NewDatabaseBackup(cfg, logger.Default)
+		databaseBackup, err2 := periodicbackup.NewDatabaseBackup(cfg, logger.Default)
+		if err2 != nil {
+			return nil, errors.Wrap(err2, "initializeORM#NewDatabaseBackup")
+		}
 		databaseBackup.RunBackupGracefully(versionString)
 	}
 	if err = CheckSquashUpgrade(dbOrm.DB); err != nil {
@@ -80,6 +80,8 @@ type VRFSpecParams struct {
 	Confirmations      int
 	PublicKey          string
 	ObservationSource  string
+	// V2 generates a job for a VRFCoordinatorV2
+	V2 bool
 }
 
 type VRFSpec struct {
@@ -131,6 +133,22 @@ submit_tx  [type=ethtx to="%s"
             txMeta="{\\"requestTxHash\\": $(jobRun.logTxHash),\\"requestID\\": $(decode_log.requestID),\\"jobID\\": $(jobSpec.databaseID)}"]
 decode_log->vrf->encode_tx->submit_tx
 `, coordinatorAddress)
+	coordinatorVersion := "v1"
+	if params.V2 {
+		coordinatorVersion = "v2"
+		observationSource = `
+decode_log   [type=ethabidecodelog
+              abi="RandomWordsRequested(bytes32 indexed keyHash,uint256 requestId,uint256 preSeed,uint64 indexed subId,uint16 minimumRequestConfirmations,uint32 callbackGasLimit,uint32 numWords,address indexed sender)"
+              data="$(jobRun.logData)"
+              topics="$(jobRun.logTopics)"]
+vrf          [type=vrfv2
+              publicKey="$(jobSpec.publicKey)"
+              requestBlockHash="$(jobRun.logBlockHash)"
+              requestBlockNumber="$(jobRun.logBlockNumber)"
+              topics="$(jobRun.logTopics)"]
+decode_log->vrf
+`
+	}
 	if params.ObservationSource != "" {
 		publicKey = params.ObservationSource
 	}
@@ -140,6 +158,7 @@ type = "vrf"
 schemaVersion = 1
 name = "%s"
 coordinatorAddress = "%s"
+coordinatorVersion = "%s"
 confirmations = %d 
 publicKey = "%s"
 observationSource = """
@@ -153,7 +172,8 @@ observationSource = """
 		Confirmations:      confirmations,
 		PublicKey:          publicKey,
 		ObservationSource:  observationSource,
-	}, toml: fmt.Sprintf(template, jobID, name, coordinatorAddress, confirmations, publicKey, observationSource)}
+		V2:                 params.V2,
+	}, toml: fmt.Sprintf(template, jobID, name, coordinatorAddress, coordinatorVersion, confirmations, publicKey, observationSource)}
 }
 
 type OCRSpecParams struct {
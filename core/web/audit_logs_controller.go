@@ -0,0 +1,48 @@
+package web
+
+import (
+	"github.com/smartcontractkit/chainlink/core/services/audit"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AuditLogsController lists the audit log of sensitive operator actions
+type AuditLogsController struct {
+	App chainlink.Application
+}
+
+// Index lists audit log events, most recent first
+// Example:
+// "GET <application>/audit_logs"
+func (alc *AuditLogsController) Index(c *gin.Context, size, page, offset int) {
+	events, count, err := alc.App.GetAuditLogger().Events(offset, size)
+
+	paginatedResponse(c, "auditLogs", size, page, presenters.NewAuditLogResources(events), count, err)
+}
+
+// recordAuditEvent records an action taken by the authenticated user of the
+// request
+func recordAuditEvent(c *gin.Context, app chainlink.Application, eventType audit.EventType, data interface{}) {
+	var email null.String
+	if user, ok := authenticatedUser(c); ok {
+		email = null.StringFrom(user.Email)
+	}
+	recordAuditEventForUser(c, app, eventType, email, data)
+}
+
+// recordAuditEventForUser records an action for a user that is not
+// authenticated on the request, e.g. on login
+func recordAuditEventForUser(c *gin.Context, app chainlink.Application, eventType audit.EventType, email null.String, data interface{}) {
+	event := audit.Event{
+		Type:      eventType,
+		UserEmail: email,
+		RemoteIP:  c.ClientIP(),
+	}
+	if data != nil {
+		event.Data = audit.NewData(data)
+	}
+	app.GetAuditLogger().Record(event)
+}
@@ -0,0 +1,84 @@
+package web_test
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/services/audit"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/web"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditLogsController_Index(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication(t)
+	t.Cleanup(cleanup)
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	body := `{"name": "auditedbridge", "url": "http://localhost:3000/audited"}`
+	resp, cleanup := client.Post("/v2/bridge_types", bytes.NewBufferString(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	resp, cleanup = client.Delete("/v2/bridge_types/auditedbridge")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	resp, cleanup = client.Get("/v2/audit_logs?size=2")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var links jsonapi.Links
+	resources := []presenters.AuditLogResource{}
+	require.NoError(t, web.ParsePaginatedResponse(cltest.ParseResponseBody(t, resp), &resources, &links))
+	require.Len(t, resources, 2)
+
+	// Most recent first
+	assert.Equal(t, audit.EventBridgeDeleted, resources[0].Type)
+	assert.Equal(t, cltest.APIEmail, resources[0].UserEmail)
+	assert.JSONEq(t, `{"name": "auditedbridge"}`, string(resources[0].Data))
+	assert.Equal(t, audit.EventBridgeCreated, resources[1].Type)
+	assert.Equal(t, cltest.APIEmail, resources[1].UserEmail)
+}
+
+func TestAuditLogsController_SessionEvents(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication(t)
+	t.Cleanup(cleanup)
+	require.NoError(t, app.Start())
+
+	body := `{"email": "` + cltest.APIEmail + `", "password": "wrongpassword"}`
+	resp, err := http.Post(app.Config.ClientNodeURL()+"/sessions", "application/json", bytes.NewBufferString(body))
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	events, _, err := app.GetAuditLogger().Events(0, 1)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, audit.EventSessionLoginFailed, events[0].Type)
+	assert.Equal(t, cltest.APIEmail, events[0].UserEmail.ValueOrZero())
+}
+
+func TestAuditLogsController_RequiresOwner(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication(t)
+	t.Cleanup(cleanup)
+	require.NoError(t, app.Start())
+
+	keyAdmin := app.NewHTTPClientForUser(cltest.MustNewUserWithRole(t, "keys@chainlink.test", cltest.Password, models.UserRoleKeyAdmin))
+
+	resp, cleanup := keyAdmin.Get("/v2/audit_logs")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusForbidden)
+}
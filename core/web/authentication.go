@@ -1,6 +1,7 @@
 package web
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/smartcontractkit/chainlink/core/auth"
@@ -23,7 +24,7 @@ const (
 type AuthStorer interface {
 	AuthorizedUserWithSession(sessionID string) (models.User, error)
 	FindExternalInitiator(eia *auth.Token) (*models.ExternalInitiator, error)
-	FindUser() (models.User, error)
+	FindUserByAPIToken(accessKey string) (models.User, error)
 }
 
 type authType func(store AuthStorer, ctx *gin.Context) error
@@ -79,7 +80,7 @@ func AuthenticateByToken(store AuthStorer, c *gin.Context) error {
 		Secret:    c.GetHeader(APISecret),
 	}
 
-	user, err := store.FindUser()
+	user, err := store.FindUserByAPIToken(token.AccessKey)
 	if errors.Cause(err) == orm.ErrorNotFound {
 		return auth.ErrorAuthFailed
 	} else if err != nil {
@@ -132,3 +133,23 @@ func RequireAuth(store AuthStorer, methods ...authType) gin.HandlerFunc {
 		}
 	}
 }
+
+// RequireRole rejects requests from users whose role does not include the
+// given role, and so must come after RequireAuth. Requests authenticated as an
+// external initiator are let through, as they are authorized per job.
+func RequireRole(role models.UserRole) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if user, ok := authenticatedUser(c); ok {
+			if !user.Role.Includes(role) {
+				c.Abort()
+				jsonAPIError(c, http.StatusForbidden, fmt.Errorf("%s role is required, user %s has role %s", role, user.Email, user.Role))
+				return
+			}
+		} else if _, ok := authenticatedEI(c); !ok {
+			c.Abort()
+			jsonAPIError(c, http.StatusUnauthorized, auth.ErrorAuthFailed)
+			return
+		}
+		c.Next()
+	}
+}
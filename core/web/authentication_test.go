@@ -9,6 +9,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/store"
 	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/store/orm"
 	"github.com/smartcontractkit/chainlink/core/web"
 
 	"github.com/gin-gonic/gin"
@@ -34,7 +35,7 @@ type userFindFailer struct {
 	err error
 }
 
-func (u userFindFailer) FindUser() (models.User, error) {
+func (u userFindFailer) FindUserByAPIToken(string) (models.User, error) {
 	return models.User{}, u.err
 }
 
@@ -43,7 +44,10 @@ type userFindSuccesser struct {
 	user models.User
 }
 
-func (u userFindSuccesser) FindUser() (models.User, error) {
+func (u userFindSuccesser) FindUserByAPIToken(accessKey string) (models.User, error) {
+	if accessKey != u.user.TokenKey {
+		return models.User{}, orm.ErrorNotFound
+	}
 	return u.user, nil
 }
 
@@ -164,3 +168,53 @@ func TestRequireAuth_Error(t *testing.T) {
 	assert.False(t, called)
 	assert.Equal(t, http.StatusText(http.StatusUnauthorized), http.StatusText(w.Code))
 }
+
+func TestRequireRole(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		set      func(c *gin.Context)
+		role     models.UserRole
+		wantCode int
+	}{
+		{"user with the role", func(c *gin.Context) {
+			c.Set(web.SessionUserKey, &models.User{Role: models.UserRoleJobEditor})
+		}, models.UserRoleJobEditor, http.StatusOK},
+		{"user with a higher role", func(c *gin.Context) {
+			c.Set(web.SessionUserKey, &models.User{Role: models.UserRoleOwner})
+		}, models.UserRoleKeyAdmin, http.StatusOK},
+		{"user with a lower role", func(c *gin.Context) {
+			c.Set(web.SessionUserKey, &models.User{Role: models.UserRoleViewOnly})
+		}, models.UserRoleJobEditor, http.StatusForbidden},
+		{"user without a role", func(c *gin.Context) {
+			c.Set(web.SessionUserKey, &models.User{})
+		}, models.UserRoleViewOnly, http.StatusForbidden},
+		{"external initiator", func(c *gin.Context) {
+			c.Set(web.SessionExternalInitiatorKey, &models.ExternalInitiator{})
+		}, models.UserRoleJobEditor, http.StatusOK},
+		{"unauthenticated", func(c *gin.Context) {}, models.UserRoleViewOnly, http.StatusUnauthorized},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			called := false
+			router := gin.New()
+			router.Use(func(c *gin.Context) { test.set(c) }, web.RequireRole(test.role))
+			router.GET("/", func(c *gin.Context) {
+				called = true
+				c.String(http.StatusOK, "")
+			})
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", "/", nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, test.wantCode == http.StatusOK, called)
+			assert.Equal(t, http.StatusText(test.wantCode), http.StatusText(w.Code))
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/jackc/pgconn"
 
 	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/services/audit"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/store/orm"
@@ -59,6 +60,7 @@ func (btc *BridgeTypesController) Create(c *gin.Context) {
 	default:
 		resource := presenters.NewBridgeResource(*bt)
 		resource.IncomingToken = bta.IncomingToken
+		recordAuditEvent(c, btc.App, audit.EventBridgeCreated, map[string]interface{}{"name": bt.Name, "url": bt.URL.String()})
 
 		jsonAPIResponse(c, resource, "bridge")
 	}
@@ -132,6 +134,7 @@ func (btc *BridgeTypesController) Update(c *gin.Context) {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
+	recordAuditEvent(c, btc.App, audit.EventBridgeUpdated, map[string]interface{}{"name": bt.Name, "url": bt.URL.String()})
 
 	jsonAPIResponse(c, presenters.NewBridgeResource(bt), "bridge")
 }
@@ -168,6 +171,7 @@ func (btc *BridgeTypesController) Destroy(c *gin.Context) {
 		jsonAPIError(c, StatusCodeForError(err), fmt.Errorf("failed to delete bridge: %+v", err))
 		return
 	}
+	recordAuditEvent(c, btc.App, audit.EventBridgeDeleted, map[string]interface{}{"name": bt.Name})
 
 	jsonAPIResponse(c, presenters.NewBridgeResource(bt), "bridge")
 }
@@ -0,0 +1,194 @@
+package web
+
+import (
+	"fmt"
+	"math/big"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/services/audit"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// ChainsController manages the EVM chains known to the node, along with their
+// configs
+type ChainsController struct {
+	App chainlink.Application
+}
+
+// CreateChainRequest is the request body for creating a chain
+type CreateChainRequest struct {
+	ChainID utils.Big       `json:"chainID"`
+	Config  types.ChainCfg  `json:"config"`
+	Nodes   []types.NewNode `json:"nodes"`
+}
+
+// UpdateChainRequest is the request body for updating a chain. Only the
+// fields that are given are changed.
+type UpdateChainRequest struct {
+	Enabled *bool           `json:"enabled"`
+	Config  *types.ChainCfg `json:"config"`
+	Nodes   []types.NewNode `json:"nodes"`
+}
+
+// Index lists chains, one page at a time.
+// Example:
+// "GET <application>/chains"
+func (cc *ChainsController) Index(c *gin.Context, size, page, offset int) {
+	chains, count, err := cc.App.GetChainSet().ORM().Chains(offset, size)
+
+	paginatedResponse(c, "chains", size, page, presenters.NewChainResources(chains), count, err)
+}
+
+// Create adds a new chain and starts it.
+// Example:
+// "POST <application>/chains"
+func (cc *ChainsController) Create(c *gin.Context) {
+	request := &CreateChainRequest{}
+	if err := c.ShouldBindJSON(request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	_, err := cc.App.GetChainSet().ORM().Chain(request.ChainID)
+	if err == nil {
+		jsonAPIError(c, http.StatusConflict, errors.Errorf("chain %s already exists, update it instead", request.ChainID.String()))
+		return
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	chain, err := cc.App.GetChainSet().Add(request.ChainID.ToInt(), request.Config, request.Nodes)
+	if err != nil {
+		jsonAPIError(c, StatusCodeForError(err), err)
+		return
+	}
+	recordAuditEvent(c, cc.App, audit.EventChainCreated, map[string]interface{}{"evmChainID": chain.ID.String()})
+
+	jsonAPIResponseWithStatus(c, presenters.NewChainResource(chain), "chain", http.StatusCreated)
+}
+
+// Show returns the details of a chain.
+// Example:
+// "GET <application>/chains/:ID"
+func (cc *ChainsController) Show(c *gin.Context) {
+	id, ok := chainIDParam(c)
+	if !ok {
+		return
+	}
+
+	chain, err := cc.App.GetChainSet().ORM().Chain(*utils.NewBig(id))
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("chain not found"))
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewChainResource(chain), "chain")
+}
+
+// Update changes the config of a chain, which takes effect immediately, and
+// enables or disables it and replaces its nodes, which restarts the chain.
+// Example:
+// "PATCH <application>/chains/:ID"
+func (cc *ChainsController) Update(c *gin.Context) {
+	id, ok := chainIDParam(c)
+	if !ok {
+		return
+	}
+
+	request := &UpdateChainRequest{}
+	if err := c.ShouldBindJSON(request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if request.Enabled == nil && request.Config == nil && request.Nodes == nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("must give at least one of enabled, config or nodes"))
+		return
+	}
+	restart := request.Enabled != nil || request.Nodes != nil
+	if restart && !cc.ensureNoJobs(c, id, "update") {
+		return
+	}
+
+	chainSet := cc.App.GetChainSet()
+	chain, err := chainSet.ORM().Chain(*utils.NewBig(id))
+	if err == nil && request.Config != nil {
+		chain, err = chainSet.Configure(id, *request.Config)
+	}
+	if err == nil && restart {
+		enabled := chain.Enabled
+		if request.Enabled != nil {
+			enabled = *request.Enabled
+		}
+		chain, err = chainSet.Update(id, enabled, request.Nodes)
+	}
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("chain not found"))
+		return
+	} else if err != nil {
+		jsonAPIError(c, StatusCodeForError(err), err)
+		return
+	}
+	recordAuditEvent(c, cc.App, audit.EventChainUpdated, map[string]interface{}{"evmChainID": chain.ID.String(), "enabled": chain.Enabled, "config": chain.Cfg})
+
+	jsonAPIResponse(c, presenters.NewChainResource(chain), "chain")
+}
+
+// Delete stops a chain and removes it along with its nodes.
+// Example:
+// "DELETE <application>/chains/:ID"
+func (cc *ChainsController) Delete(c *gin.Context) {
+	id, ok := chainIDParam(c)
+	if !ok {
+		return
+	}
+	if !cc.ensureNoJobs(c, id, "remove") {
+		return
+	}
+
+	err := cc.App.GetChainSet().Remove(id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("chain not found"))
+		return
+	} else if err != nil {
+		jsonAPIError(c, StatusCodeForError(err), err)
+		return
+	}
+	recordAuditEvent(c, cc.App, audit.EventChainDeleted, map[string]interface{}{"evmChainID": id.String()})
+
+	jsonAPIResponseWithStatus(c, nil, "chain", http.StatusNoContent)
+}
+
+// ensureNoJobs responds with a conflict if any jobs run on the chain, as
+// they would keep using the services of the stopped chain
+func (cc *ChainsController) ensureNoJobs(c *gin.Context, id *big.Int, action string) bool {
+	jobIDs, err := cc.App.JobORM().FindJobIDsWithEVMChainID(*utils.NewBig(id))
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, fmt.Errorf("error searching for associated v2 jobs: %+v", err))
+		return false
+	}
+	if len(jobIDs) > 0 {
+		jsonAPIError(c, http.StatusConflict, fmt.Errorf("can't %s the chain because jobs %v run on it", action, jobIDs))
+		return false
+	}
+	return true
+}
+
+func chainIDParam(c *gin.Context) (*big.Int, bool) {
+	id, ok := new(big.Int).SetString(c.Param("ID"), 10)
+	if !ok {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid chain ID: %s", c.Param("ID")))
+		return nil, false
+	}
+	return id, true
+}
@@ -0,0 +1,175 @@
+package web_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"testing"
+
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/chainlink/core/web"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+)
+
+func TestChainsController_Index(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationEthereumDisabled(t)
+	defer cleanup()
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	orm := evm.NewORM(app.GetStore().DB)
+	_, err := orm.CreateChain(*utils.NewBigI(42), types.ChainCfg{}, true, []types.NewNode{
+		{Name: "primary-42", WSURL: null.StringFrom("ws://primary.test")},
+	})
+	require.NoError(t, err)
+	_, err = orm.CreateChain(*utils.NewBigI(43), types.ChainCfg{}, false, nil)
+	require.NoError(t, err)
+
+	resp, cleanup := client.Get("/v2/chains?size=1")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	body := cltest.ParseResponseBody(t, resp)
+
+	metaCount, err := cltest.ParseJSONAPIResponseMetaCount(body)
+	require.NoError(t, err)
+	require.Equal(t, 2, metaCount)
+
+	var links jsonapi.Links
+	chains := []presenters.ChainResource{}
+	err = web.ParsePaginatedResponse(body, &chains, &links)
+	require.NoError(t, err)
+	assert.NotEmpty(t, links["next"].Href)
+
+	require.Len(t, chains, 1)
+	assert.Equal(t, "42", chains[0].ID)
+	assert.True(t, chains[0].Enabled)
+	require.Len(t, chains[0].Nodes, 1)
+	assert.Equal(t, "primary-42", chains[0].Nodes[0].Name)
+}
+
+func TestChainsController_Show(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationEthereumDisabled(t)
+	defer cleanup()
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	_, err := evm.NewORM(app.GetStore().DB).CreateChain(*utils.NewBigI(42), types.ChainCfg{}, false, nil)
+	require.NoError(t, err)
+
+	resp, cleanup := client.Get("/v2/chains/42")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var chain presenters.ChainResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &chain))
+	assert.Equal(t, "42", chain.ID)
+	assert.False(t, chain.Enabled)
+
+	resp, cleanup = client.Get("/v2/chains/1337")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+}
+
+func TestChainsController_Create(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationEthereumDisabled(t)
+	defer cleanup()
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	_, err := evm.NewORM(app.GetStore().DB).CreateChain(*utils.NewBigI(42), types.ChainCfg{}, false, nil)
+	require.NoError(t, err)
+
+	request := web.CreateChainRequest{
+		ChainID: *utils.NewBigI(42),
+		Nodes:   []types.NewNode{{Name: "primary", WSURL: null.StringFrom("ws://primary.test")}},
+	}
+	body, err := json.Marshal(request)
+	require.NoError(t, err)
+	resp, cleanup := client.Post("/v2/chains", bytes.NewReader(body))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusConflict)
+
+	// Chains can't be started while Ethereum is disabled
+	request.ChainID = *utils.NewBigI(43)
+	body, err = json.Marshal(request)
+	require.NoError(t, err)
+	resp, cleanup = client.Post("/v2/chains", bytes.NewReader(body))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusBadRequest)
+}
+
+func TestChainsController_Update(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationEthereumDisabled(t)
+	defer cleanup()
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	_, err := evm.NewORM(app.GetStore().DB).CreateChain(*utils.NewBigI(1338), types.ChainCfg{}, false, nil)
+	require.NoError(t, err)
+
+	resp, cleanup := client.Patch("/v2/chains/1338", bytes.NewBufferString(`{"config": {"ethFinalityDepth": 5, "chainType": "optimism"}}`))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var chain presenters.ChainResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &chain))
+	assert.Equal(t, null.IntFrom(5), chain.Config.EthFinalityDepth)
+	assert.False(t, chain.Enabled)
+
+	// The config takes effect without restarting the node
+	cfg := app.GetStore().Config.ForEVMChain(big.NewInt(1338))
+	assert.Equal(t, uint(5), cfg.EthFinalityDepth())
+	assert.True(t, cfg.Chain().IsOptimism())
+
+	resp, cleanup = client.Patch("/v2/chains/1338", bytes.NewBufferString(`{"config": {"gasEstimatorMode": "Magic"}}`))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusBadRequest)
+
+	resp, cleanup = client.Patch("/v2/chains/1338", bytes.NewBufferString(`{}`))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+
+	resp, cleanup = client.Patch("/v2/chains/1339", bytes.NewBufferString(`{"config": {}}`))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+}
+
+func TestChainsController_Delete(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationEthereumDisabled(t)
+	defer cleanup()
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	orm := evm.NewORM(app.GetStore().DB)
+	_, err := orm.CreateChain(*utils.NewBigI(42), types.ChainCfg{}, false, nil)
+	require.NoError(t, err)
+
+	resp, cleanup := client.Delete("/v2/chains/42")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusNoContent)
+
+	_, err = orm.Chain(*utils.NewBigI(42))
+	require.Error(t, err)
+
+	resp, cleanup = client.Delete("/v2/chains/42")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+}
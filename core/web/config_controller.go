@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/smartcontractkit/chainlink/core/services/audit"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/store/presenters"
 	"github.com/smartcontractkit/chainlink/core/utils"
@@ -76,5 +77,6 @@ func (cc *ConfigController) Patch(c *gin.Context) {
 			To:   request.EthGasPriceDefault.String(),
 		},
 	}
+	recordAuditEvent(c, cc.App, audit.EventConfigUpdated, map[string]interface{}{"ethGasPriceDefault": response.EthGasPriceDefault})
 	jsonAPIResponse(c, response, "config")
 }
@@ -1,8 +1,10 @@
 package web_test
 
 import (
+	"context"
 	"math/big"
 	"net/http"
+	"os"
 	"testing"
 	"time"
 
@@ -54,3 +56,28 @@ func TestConfigController_Show(t *testing.T) {
 	assert.Equal(t, common.Address{}, cp.OperatorContractAddress)
 	assert.Equal(t, time.Second*5, cp.DatabaseTimeout.Duration())
 }
+
+func TestConfigController_Show_RedactsSecrets(t *testing.T) {
+	ethClient, _, assertMocksCalled := cltest.NewEthMocksWithStartupAssertions(t)
+	defer assertMocksCalled()
+	app, cleanup := cltest.NewApplicationWithKey(t,
+		ethClient,
+	)
+	defer cleanup()
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	require.NoError(t, os.Setenv("CONFIG_CONTROLLER_TEST_ETH_URL", "ws://localhost:8546/s3cr3t-api-key"))
+	defer os.Unsetenv("CONFIG_CONTROLLER_TEST_ETH_URL")
+	app.Config.Set("ETH_URL", "env://CONFIG_CONTROLLER_TEST_ETH_URL")
+	require.NoError(t, app.Config.ResolveSecrets(context.Background()))
+	assert.Equal(t, "ws://localhost:8546/s3cr3t-api-key", app.Config.EthereumURL())
+
+	resp, cleanup := client.Get("/v2/config")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	cp := presenters.ConfigPrinter{}
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &cp))
+	assert.Equal(t, config.RedactedSecret, cp.EthereumURL)
+}
@@ -5,6 +5,7 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/smartcontractkit/chainlink/core/services/audit"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
@@ -41,5 +42,6 @@ func (ctrl *CSAKeysController) Create(c *gin.Context) {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
+	recordAuditEvent(c, ctrl.App, audit.EventKeyCreated, map[string]interface{}{"type": "csa", "id": key.PublicKey.String()})
 	jsonAPIResponse(c, presenters.NewCSAKeyResource(*key), "csaKeys")
 }
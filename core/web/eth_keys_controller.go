@@ -8,7 +8,11 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/audit"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -80,9 +84,66 @@ func (ekc *ETHKeysController) Create(c *gin.Context) {
 		return
 	}
 
+	recordAuditEvent(c, ekc.App, audit.EventKeyCreated, map[string]interface{}{"type": "eth", "id": key.Address.Hex()})
+
 	jsonAPIResponseWithStatus(c, r, "account", http.StatusCreated)
 }
 
+// UpdateETHKeyRequest is the request body for updating an ETH key
+type UpdateETHKeyRequest struct {
+	// TxLimits replace the transaction limits of the key, unset limits fall
+	// back to the node's config
+	TxLimits *ethkey.TxLimits `json:"txLimits"`
+}
+
+// Update replaces the transaction limits of an ETH key
+// Example:
+// "PATCH <application>/keys/eth/:keyID"
+func (ekc *ETHKeysController) Update(c *gin.Context) {
+	if !common.IsHexAddress(c.Param("keyID")) {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("invalid address"))
+		return
+	}
+	address := common.HexToAddress(c.Param("keyID"))
+
+	request := &UpdateETHKeyRequest{}
+	if err := c.ShouldBindJSON(request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if request.TxLimits == nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("must give txLimits"))
+		return
+	}
+	for name, limit := range map[string]*utils.Big{
+		"maxDailySpendWei": request.TxLimits.MaxDailySpendWei,
+		"maxValuePerTxWei": request.TxLimits.MaxValuePerTxWei,
+	} {
+		if limit != nil && limit.ToInt().Sign() < 0 {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("%s must not be negative", name))
+			return
+		}
+	}
+
+	key, err := ekc.App.GetKeyStore().Eth().SetTxLimits(address, *request.TxLimits)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	recordAuditEvent(c, ekc.App, audit.EventKeyUpdated, map[string]interface{}{"type": "eth", "id": address.Hex(), "txLimits": key.TxLimits})
+
+	r, err := presenters.NewETHKeyResource(key,
+		ekc.setEthBalance(c.Request.Context(), key.Address.Address()),
+		ekc.setLinkBalance(key.Address.Address()),
+	)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, r, "account")
+}
+
 // Delete an ETH key bundle
 // Example:
 // "DELETE <application>/keys/eth/:keyID"
@@ -105,10 +166,15 @@ func (ekc *ETHKeysController) Delete(c *gin.Context) {
 	address := common.HexToAddress(c.Param("keyID"))
 
 	key, err := ekc.App.GetKeyStore().Eth().RemoveKey(address, hardDelete)
+	if errors.Cause(err) == keystore.ErrRemoteKey {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
 	if err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
+	recordAuditEvent(c, ekc.App, audit.EventKeyDeleted, map[string]interface{}{"type": "eth", "id": address.Hex(), "hard": hardDelete})
 
 	r, err := presenters.NewETHKeyResource(key,
 		ekc.setEthBalance(c.Request.Context(), key.Address.Address()),
@@ -138,6 +204,7 @@ func (ekc *ETHKeysController) Import(c *gin.Context) {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
+	recordAuditEvent(c, ekc.App, audit.EventKeyImported, map[string]interface{}{"type": "eth", "id": key.Address.Hex()})
 
 	r, err := presenters.NewETHKeyResource(key,
 		ekc.setEthBalance(c.Request.Context(), key.Address.Address()),
@@ -159,10 +226,15 @@ func (ekc *ETHKeysController) Export(c *gin.Context) {
 	newPassword := c.Query("newpassword")
 
 	bytes, err := ekc.App.GetKeyStore().Eth().ExportKey(address, newPassword)
+	if errors.Cause(err) == keystore.ErrRemoteKey {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
 	if err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
+	recordAuditEvent(c, ekc.App, audit.EventKeyExported, map[string]interface{}{"type": "eth", "id": address.Hex()})
 	c.Data(http.StatusOK, MediaType, bytes)
 }
 
@@ -1,12 +1,14 @@
 package web_test
 
 import (
+	"bytes"
 	"math/big"
 	"net/http"
 	"testing"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/null"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	webpresenters "github.com/smartcontractkit/chainlink/core/web/presenters"
 	"github.com/stretchr/testify/assert"
@@ -144,3 +146,48 @@ func TestETHKeysController_CreateSuccess(t *testing.T) {
 
 	ethClient.AssertExpectations(t)
 }
+
+func TestETHKeysController_Update(t *testing.T) {
+	t.Parallel()
+
+	config, _ := cltest.NewConfig(t)
+	ethClient := cltest.NewEthClientMock(t)
+	app, cleanup := cltest.NewApplicationWithConfigAndKey(t, config, ethClient)
+	t.Cleanup(cleanup)
+
+	verify := cltest.MockApplicationEthCalls(t, app, ethClient)
+	defer verify()
+
+	ethClient.On("BalanceAt", mock.Anything, mock.Anything, mock.Anything).Return(big.NewInt(100), nil)
+	ethClient.On("GetLINKBalance", mock.Anything, mock.Anything, mock.Anything).Return(assets.NewLink(42), nil)
+
+	client := app.NewHTTPClient()
+	require.NoError(t, app.Start())
+	address := app.Key.Address.Hex()
+
+	resp, cleanup := client.Patch("/v2/keys/eth/"+address, bytes.NewBufferString(`{"txLimits": {"maxTxs": 10, "maxDailySpendWei": "1000000000000000000000"}}`))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var r webpresenters.ETHKeyResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &r))
+	assert.Equal(t, null.Uint32From(10), r.TxLimits.MaxTxs)
+	assert.Equal(t, "1000000000000000000000", r.TxLimits.MaxDailySpendWei.String())
+	assert.Nil(t, r.TxLimits.MaxValuePerTxWei)
+
+	var limits ethkey.TxLimits
+	require.NoError(t, app.Store.DB.Raw(`SELECT eth_tx_limit_max_txs AS max_txs, eth_tx_limit_max_daily_spend_wei AS max_daily_spend_wei, eth_tx_limit_max_value_per_tx_wei AS max_value_per_tx_wei FROM keys WHERE address = ?`, app.Key.Address).Scan(&limits).Error)
+	assert.Equal(t, r.TxLimits, limits)
+
+	t.Run("negative limits", func(t *testing.T) {
+		resp, cleanup := client.Patch("/v2/keys/eth/"+address, bytes.NewBufferString(`{"txLimits": {"maxValuePerTxWei": "-1"}}`))
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+	})
+
+	t.Run("without limits", func(t *testing.T) {
+		resp, cleanup := client.Patch("/v2/keys/eth/"+address, bytes.NewBufferString(`{}`))
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+	})
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/auth"
 	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/services/audit"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/store/orm"
@@ -58,6 +59,7 @@ func (eic *ExternalInitiatorsController) Create(c *gin.Context) {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
+	recordAuditEvent(c, eic.App, audit.EventExternalInitiatorCreated, map[string]interface{}{"name": ei.Name})
 
 	resp := presenters.NewExternalInitiatorAuthentication(*ei, *eia)
 	jsonAPIResponseWithStatus(c, resp, "external initiator authentication", http.StatusCreated)
@@ -75,6 +77,7 @@ func (eic *ExternalInitiatorsController) Destroy(c *gin.Context) {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
+	recordAuditEvent(c, eic.App, audit.EventExternalInitiatorDeleted, map[string]interface{}{"name": exi.Name})
 
 	jsonAPIResponseWithStatus(c, nil, "external initiator", http.StatusNoContent)
 }
@@ -0,0 +1,77 @@
+package web
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// defaultJobCostsPeriod is the period reported if no start is given
+const defaultJobCostsPeriod = 7 * 24 * time.Hour
+
+// JobCostsController reports the gas spent by jobs
+type JobCostsController struct {
+	App chainlink.Application
+}
+
+// Index reports the gas spent by confirmed transactions per job, key, chain
+// and day. The report can be limited to one job with the jobID query
+// parameter, and to a period with since and until, given as RFC3339
+// timestamps or YYYY-MM-DD dates. It defaults to the last 7 days.
+// Example:
+// "GET <application>/job_costs?jobID=1&since=2021-10-01&until=2021-11-01"
+func (jcc *JobCostsController) Index(c *gin.Context) {
+	var params bulletprooftxmanager.JobCostsParams
+	var err error
+
+	if s := c.Query("jobID"); s != "" {
+		var id int64
+		id, err = strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "invalid jobID"))
+			return
+		}
+		params.JobID = int32(id)
+	}
+
+	params.Until = time.Now()
+	if s := c.Query("until"); s != "" {
+		if params.Until, err = parseJobCostsTime(s); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "invalid until"))
+			return
+		}
+	}
+	params.Since = params.Until.Add(-defaultJobCostsPeriod)
+	if s := c.Query("since"); s != "" {
+		if params.Since, err = parseJobCostsTime(s); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "invalid since"))
+			return
+		}
+	}
+	if !params.Since.Before(params.Until) {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("since must be before until"))
+		return
+	}
+
+	costs, err := bulletprooftxmanager.JobCosts(jcc.App.GetStore().DB.WithContext(c.Request.Context()), params)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	defaultChainID := jcc.App.GetChainSet().Default().ID()
+	jsonAPIResponse(c, presenters.NewJobCostResources(costs, defaultChainID), "jobCosts")
+}
+
+func parseJobCostsTime(s string) (time.Time, error) {
+	if t, err := time.Parse("2006-01-02", s); err == nil {
+		return t, nil
+	}
+	return time.Parse(time.RFC3339, s)
+}
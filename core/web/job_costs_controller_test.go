@@ -0,0 +1,71 @@
+package web_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobCostsController_Index(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKey(t)
+	t.Cleanup(cleanup)
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	_, fromAddress := cltest.MustAddRandomKeyToKeystore(t, app.KeyStore.Eth())
+	db := app.Store.DB
+
+	// Two transactions of job 42, one paid at the attempt gas price of 1 wei
+	// and one at an effective gas price of 2 wei, and one without a job
+	etx1 := cltest.MustInsertConfirmedEthTxWithReceipt(t, db, fromAddress, 0, 42)
+	etx2 := cltest.MustInsertConfirmedEthTxWithReceipt(t, db, fromAddress, 1, 43)
+	cltest.MustInsertConfirmedEthTxWithReceipt(t, db, fromAddress, 2, 44)
+	require.NoError(t, db.Exec(`UPDATE eth_txes SET job_id = 42 WHERE id IN (?, ?)`, etx1.ID, etx2.ID).Error)
+	require.NoError(t, db.Exec(`UPDATE eth_receipts SET gas_used = 21000`).Error)
+	require.NoError(t, db.Exec(`UPDATE eth_receipts SET effective_gas_price = 2, link_earned = 100 WHERE tx_hash = ?`, etx2.EthTxAttempts[0].Hash).Error)
+
+	resp, cleanup := client.Get("/v2/job_costs")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var costs []presenters.JobCostResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &costs))
+	require.Len(t, costs, 2)
+
+	require.NotNil(t, costs[0].JobID)
+	assert.Equal(t, int32(42), *costs[0].JobID)
+	assert.Equal(t, fromAddress.Hex(), costs[0].FromAddress)
+	assert.Equal(t, int64(2), costs[0].Transactions)
+	assert.Equal(t, int64(42000), costs[0].GasUsed)
+	assert.Equal(t, "63000", costs[0].Fee.ToInt().String())
+	assert.Equal(t, "100", costs[0].LinkEarned.ToInt().String())
+
+	assert.Nil(t, costs[1].JobID)
+	assert.Equal(t, int64(1), costs[1].Transactions)
+	assert.Equal(t, "21000", costs[1].Fee.ToInt().String())
+
+	resp, cleanup = client.Get("/v2/job_costs?jobID=42")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &costs))
+	require.Len(t, costs, 1)
+
+	resp, cleanup = client.Get("/v2/job_costs?since=2000-01-01&until=2000-01-02")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &costs))
+	assert.Len(t, costs, 0)
+
+	for _, query := range []string{"jobID=foo", "since=yesterday", "since=2000-01-02&until=2000-01-01"} {
+		resp, cleanup = client.Get(fmt.Sprintf("/v2/job_costs?%s", query))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+	}
+}
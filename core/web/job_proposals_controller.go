@@ -7,6 +7,7 @@ import (
 	"strconv"
 
 	"github.com/gin-gonic/gin"
+	"github.com/smartcontractkit/chainlink/core/services/audit"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 )
@@ -75,6 +76,7 @@ func (jpc *JobProposalsController) Approve(c *gin.Context) {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
+	recordAuditEvent(c, jpc.App, audit.EventJobProposalApproved, map[string]interface{}{"id": id})
 
 	jp, err := feedsSvc.GetJobProposal(id)
 	if err != nil {
@@ -116,6 +118,7 @@ func (jpc *JobProposalsController) Reject(c *gin.Context) {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
+	recordAuditEvent(c, jpc.App, audit.EventJobProposalRejected, map[string]interface{}{"id": id})
 
 	jp, err := feedsSvc.GetJobProposal(id)
 	if err != nil {
@@ -167,6 +170,7 @@ func (jpc *JobProposalsController) UpdateSpec(c *gin.Context) {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
+	recordAuditEvent(c, jpc.App, audit.EventJobProposalSpecUpdated, map[string]interface{}{"id": id})
 
 	jp, err := feedsSvc.GetJobProposal(id)
 	if err != nil {
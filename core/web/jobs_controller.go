@@ -1,8 +1,12 @@
 package web
 
 import (
+	"math/big"
 	"net/http"
+	"strconv"
 
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	"github.com/smartcontractkit/chainlink/core/services/audit"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/services/cron"
 	"github.com/smartcontractkit/chainlink/core/services/directrequest"
@@ -10,6 +14,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/keeper"
 	"github.com/smartcontractkit/chainlink/core/services/offchainreporting"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/core/services/vrf"
 	"github.com/smartcontractkit/chainlink/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/core/store/orm"
@@ -17,6 +22,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
 )
 
 // JobsController manages jobs
@@ -81,51 +87,245 @@ func (jc *JobsController) Create(c *gin.Context) {
 		return
 	}
 
-	jobType, err := job.ValidateSpec(request.TOML)
+	jb, status, err := jc.validateJobSpec(request.TOML)
 	if err != nil {
-		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "failed to parse TOML"))
+		jsonAPIError(c, status, err)
+		return
+	}
+
+	jb, err = jc.App.AddJobV2(c.Request.Context(), jb, jb.Name)
+	if err != nil {
+		jsonAPIError(c, jobSaveErrorStatus(err), err)
+		return
+	}
+	recordAuditEvent(c, jc.App, audit.EventJobCreated, map[string]interface{}{"id": jb.ID, "name": jb.Name.ValueOrZero(), "type": jb.Type})
+
+	jsonAPIResponse(c, presenters.NewJobResource(jb), jb.Type.String())
+}
+
+// SimulateJobRequest represents a request to simulate the pipeline of a job
+// spec with the given vars, e.g. {"jobRun": {"logData": "0x..."}}
+type SimulateJobRequest struct {
+	TOML string                 `json:"toml"`
+	Vars map[string]interface{} `json:"vars"`
+}
+
+// Simulate validates a job spec and runs its pipeline in-memory with the
+// given vars, without saving the job or the run. Tasks with side effects,
+// i.e. ethtx and async bridge tasks, record what they would have done
+// instead of doing it.
+// Example:
+// "POST <application>/jobs/simulate"
+func (jc *JobsController) Simulate(c *gin.Context) {
+	request := SimulateJobRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	jb, status, err := jc.validateJobSpec(request.TOML)
+	if err != nil {
+		jsonAPIError(c, status, err)
+		return
+	}
+	if request.Vars == nil {
+		request.Vars = map[string]interface{}{}
+	}
+
+	run, sim, err := jc.App.SimulateJobV2(c.Request.Context(), jb, request.Vars)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewJobSimulationResource(uuid.NewV4().String(), run, sim), "jobSimulations")
+}
+
+// UpdateJobRequest represents a request to update a job with a new version of
+// its spec
+type UpdateJobRequest struct {
+	TOML string `json:"toml"`
+}
+
+// Update validates and saves a new version of the spec of a job, and restarts
+// the job with it. The job keeps its ID and external job ID.
+// Example:
+// "PATCH <application>/jobs/:ID"
+func (jc *JobsController) Update(c *gin.Context) {
+	jobSpec := job.Job{}
+	err := jobSpec.SetID(c.Param("ID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	request := UpdateJobRequest{}
+	if err = c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	jobSpec, err = jc.App.JobORM().FindJobTx(jobSpec.ID)
+	if errors.Cause(err) == orm.ErrorNotFound {
+		jsonAPIError(c, http.StatusNotFound, errors.New("job not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jc.update(c, jobSpec, request.TOML, map[string]interface{}{"id": jobSpec.ID})
+}
+
+// Versions lists the versions of the spec of a job, most recent first
+// Example:
+// "GET <application>/jobs/:ID/versions"
+func (jc *JobsController) Versions(c *gin.Context) {
+	jobSpec := job.Job{}
+	err := jobSpec.SetID(c.Param("ID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	jobSpec, err = jc.App.JobORM().FindJobTx(jobSpec.ID)
+	if errors.Cause(err) == orm.ErrorNotFound {
+		jsonAPIError(c, http.StatusNotFound, errors.New("job not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	versions, err := jc.App.JobORM().JobSpecVersions(jobSpec.ID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewJobSpecVersionResources(versions, jobSpec.Version), "jobSpecVersions")
+}
+
+// Rollback restores an earlier version of the spec of a job by saving it as
+// a new version, and restarts the job with it.
+// Example:
+// "POST <application>/jobs/:ID/versions/:version/rollback"
+func (jc *JobsController) Rollback(c *gin.Context) {
+	jobSpec := job.Job{}
+	err := jobSpec.SetID(c.Param("ID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	version, err := strconv.ParseInt(c.Param("version"), 10, 32)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "invalid version"))
+		return
+	}
+
+	jobSpec, err = jc.App.JobORM().FindJobTx(jobSpec.ID)
+	if errors.Cause(err) == orm.ErrorNotFound {
+		jsonAPIError(c, http.StatusNotFound, errors.New("job not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	v, err := jc.App.JobORM().FindJobSpecVersion(jobSpec.ID, int32(version))
+	if errors.Cause(err) == orm.ErrorNotFound {
+		jsonAPIError(c, http.StatusNotFound, errors.New("job spec version not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	if !v.TOML.Valid {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("version %d of job %d was created before job specs were versioned and cannot be restored", v.Version, v.JobID))
+		return
+	}
+
+	jc.update(c, jobSpec, v.TOML.String, map[string]interface{}{"id": jobSpec.ID, "rollbackTo": v.Version})
+}
+
+// update saves toml as a new version of the spec of current
+func (jc *JobsController) update(c *gin.Context, current job.Job, toml string, auditData map[string]interface{}) {
+	jb, status, err := jc.validateJobSpec(toml)
+	if err != nil {
+		jsonAPIError(c, status, err)
+		return
+	}
+	if jb.Type != current.Type {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("cannot change the type of job %d from %s to %s", current.ID, current.Type, jb.Type))
+		return
+	}
+	if jb.ExternalJobID != (uuid.UUID{}) && jb.ExternalJobID != current.ExternalJobID {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("cannot change the externalJobID of job %d from %s to %s", current.ID, current.ExternalJobID, jb.ExternalJobID))
+		return
+	}
+
+	jb, err = jc.App.UpdateJob(c.Request.Context(), current.ID, jb)
+	if err != nil {
+		jsonAPIError(c, jobSaveErrorStatus(err), err)
+		return
+	}
+	auditData["version"] = jb.Version
+	recordAuditEvent(c, jc.App, audit.EventJobUpdated, auditData)
+
+	jsonAPIResponse(c, presenters.NewJobResource(jb), jb.Type.String())
+}
+
+// validateJobSpec parses and validates a job spec, returning the HTTP status
+// to respond with if it is invalid
+func (jc *JobsController) validateJobSpec(toml string) (jb job.Job, status int, err error) {
+	jobType, err := job.ValidateSpec(toml)
+	if err != nil {
+		return jb, http.StatusUnprocessableEntity, errors.Wrap(err, "failed to parse TOML")
 	}
 
-	var jb job.Job
 	config := jc.App.GetStore().Config
 	switch jobType {
 	case job.OffchainReporting:
-		jb, err = offchainreporting.ValidatedOracleSpecToml(jc.App.GetStore().Config, request.TOML)
+		jb, err = offchainreporting.ValidatedOracleSpecToml(jc.App.GetStore().Config, toml)
 		if !config.Dev() && !config.FeatureOffchainReporting() {
-			jsonAPIError(c, http.StatusNotImplemented, errors.New("The Offchain Reporting feature is disabled by configuration"))
-			return
+			return jb, http.StatusNotImplemented, errors.New("The Offchain Reporting feature is disabled by configuration")
 		}
 	case job.DirectRequest:
-		jb, err = directrequest.ValidatedDirectRequestSpec(request.TOML)
+		jb, err = directrequest.ValidatedDirectRequestSpec(toml)
 	case job.FluxMonitor:
-		jb, err = fluxmonitorv2.ValidatedFluxMonitorSpec(jc.App.GetStore().Config, request.TOML)
+		jb, err = fluxmonitorv2.ValidatedFluxMonitorSpec(jc.App.GetStore().Config, toml)
 	case job.Keeper:
-		jb, err = keeper.ValidatedKeeperSpec(request.TOML)
+		jb, err = keeper.ValidatedKeeperSpec(toml)
 	case job.Cron:
-		jb, err = cron.ValidatedCronSpec(request.TOML)
+		jb, err = cron.ValidatedCronSpec(toml)
 	case job.VRF:
-		jb, err = vrf.ValidatedVRFSpec(request.TOML)
+		jb, err = vrf.ValidatedVRFSpec(toml)
 	case job.Webhook:
-		jb, err = webhook.ValidatedWebhookSpec(request.TOML, jc.App.GetExternalInitiatorManager())
+		jb, err = webhook.ValidatedWebhookSpec(toml, jc.App.GetExternalInitiatorManager())
 	default:
-		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("unknown job type: %s", jobType))
+		return jb, http.StatusUnprocessableEntity, errors.Errorf("unknown job type: %s", jobType)
 	}
 	if err != nil {
-		jsonAPIError(c, http.StatusBadRequest, err)
-		return
+		return jb, http.StatusBadRequest, err
 	}
-
-	jb, err = jc.App.AddJobV2(c.Request.Context(), jb, jb.Name)
-	if err != nil {
-		if errors.Cause(err) == job.ErrNoSuchKeyBundle || errors.Cause(err) == job.ErrNoSuchPeerID || errors.Cause(err) == job.ErrNoSuchTransmitterAddress {
-			jsonAPIError(c, http.StatusBadRequest, err)
-			return
-		}
-		jsonAPIError(c, http.StatusInternalServerError, err)
-		return
+	if err = validateEVMChains(jc.App.GetChainSet(), jb); err != nil {
+		return jb, http.StatusBadRequest, err
 	}
+	jb.TOML = toml
+	return jb, http.StatusOK, nil
+}
 
-	jsonAPIResponse(c, presenters.NewJobResource(jb), jb.Type.String())
+// jobSaveErrorStatus returns the HTTP status for an error saving a job
+func jobSaveErrorStatus(err error) int {
+	switch errors.Cause(err) {
+	case job.ErrNoSuchKeyBundle, job.ErrNoSuchPeerID, job.ErrNoSuchTransmitterAddress:
+		return http.StatusBadRequest
+	}
+	return http.StatusInternalServerError
 }
 
 // Delete hard deletes a job spec.
@@ -148,6 +348,35 @@ func (jc *JobsController) Delete(c *gin.Context) {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
+	recordAuditEvent(c, jc.App, audit.EventJobDeleted, map[string]interface{}{"id": jobSpec.ID})
 
 	jsonAPIResponseWithStatus(c, nil, "job", http.StatusNoContent)
 }
+
+// validateEVMChains ensures that the node runs the chains used by the job
+// spec and by its ethtx and ethcall tasks
+func validateEVMChains(chainSet evm.ChainSet, jb job.Job) error {
+	if _, err := chainSet.Get(jb.EVMChainID()); err != nil {
+		return err
+	}
+	for _, task := range jb.Pipeline.Tasks {
+		var evmChainID string
+		switch t := task.(type) {
+		case *pipeline.ETHTxTask:
+			evmChainID = t.EVMChainID
+		case *pipeline.ETHCallTask:
+			evmChainID = t.EVMChainID
+		}
+		if evmChainID == "" {
+			continue
+		}
+		id, ok := new(big.Int).SetString(evmChainID, 10)
+		if !ok {
+			return errors.Errorf("task %s has an invalid evmChainID: %s", task.DotID(), evmChainID)
+		}
+		if _, err := chainSet.Get(id); err != nil {
+			return errors.Wrapf(err, "task %s", task.DotID())
+		}
+	}
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
@@ -268,6 +269,174 @@ func TestJobsController_Create_WebhookSpec(t *testing.T) {
 	assert.NotNil(t, resource.PipelineSpec.DotDAGSource)
 }
 
+func TestJobsController_Update_WebhookSpec(t *testing.T) {
+	ethClient, _, assertMocksCalled := cltest.NewEthMocksWithStartupAssertions(t)
+	t.Cleanup(assertMocksCalled)
+	app, cleanup := cltest.NewApplicationWithKey(t,
+		ethClient,
+	)
+	t.Cleanup(cleanup)
+	require.NoError(t, app.Start())
+
+	_, bridge := cltest.NewBridgeType(t, "fetch_bridge", "http://foo.bar")
+	require.NoError(t, app.Store.DB.Create(bridge).Error)
+	_, bridge = cltest.NewBridgeType(t, "submit_bridge", "http://foo.bar")
+	require.NoError(t, app.Store.DB.Create(bridge).Error)
+
+	client := app.NewHTTPClient()
+
+	tomlString := string(cltest.MustReadFile(t, "../testdata/tomlspecs/webhook-job-spec-no-body.toml"))
+	body, _ := json.Marshal(web.CreateJobRequest{
+		TOML: tomlString,
+	})
+	response, cleanup := client.Post("/v2/jobs", bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	require.Equal(t, http.StatusOK, response.StatusCode)
+
+	created := presenters.JobResource{}
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &created))
+	assert.Equal(t, int32(1), created.Version)
+
+	t.Run("saves a new version", func(t *testing.T) {
+		body, _ = json.Marshal(web.UpdateJobRequest{
+			TOML: strings.Replace(tomlString, `times="100"`, `times="1000"`, 1),
+		})
+		response, cleanup = client.Patch("/v2/jobs/"+created.ID, bytes.NewReader(body))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+
+		updated := presenters.JobResource{}
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &updated))
+		assert.Equal(t, created.ID, updated.ID)
+		assert.Equal(t, int32(2), updated.Version)
+		assert.Contains(t, updated.PipelineSpec.DotDAGSource, `times="1000"`)
+	})
+
+	t.Run("lists the versions", func(t *testing.T) {
+		response, cleanup = client.Get("/v2/jobs/" + created.ID + "/versions")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+
+		versions := []presenters.JobSpecVersionResource{}
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &versions))
+		require.Len(t, versions, 2)
+		assert.Equal(t, int32(1), versions[0].Version)
+		assert.False(t, versions[0].Current)
+		assert.Equal(t, tomlString, versions[0].TOML.String)
+		assert.Equal(t, int32(2), versions[1].Version)
+		assert.True(t, versions[1].Current)
+	})
+
+	t.Run("rolls back to an earlier version", func(t *testing.T) {
+		response, cleanup = client.Post("/v2/jobs/"+created.ID+"/versions/1/rollback", nil)
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+
+		rolledBack := presenters.JobResource{}
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &rolledBack))
+		assert.Equal(t, int32(3), rolledBack.Version)
+		assert.Contains(t, rolledBack.PipelineSpec.DotDAGSource, `times="100"`)
+
+		response, cleanup = client.Post("/v2/jobs/"+created.ID+"/versions/42/rollback", nil)
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusNotFound)
+	})
+
+	t.Run("rejects a change of job type", func(t *testing.T) {
+		body, _ = json.Marshal(web.UpdateJobRequest{
+			TOML: string(cltest.MustReadFile(t, "../testdata/tomlspecs/direct-request-spec.toml")),
+		})
+		response, cleanup = client.Patch("/v2/jobs/"+created.ID, bytes.NewReader(body))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+	})
+
+	t.Run("returns 404 for a missing job", func(t *testing.T) {
+		body, _ = json.Marshal(web.UpdateJobRequest{
+			TOML: tomlString,
+		})
+		response, cleanup = client.Patch("/v2/jobs/999999999", bytes.NewReader(body))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusNotFound)
+	})
+}
+
+func TestJobsController_Simulate_WebhookSpec(t *testing.T) {
+	ethClient, _, assertMocksCalled := cltest.NewEthMocksWithStartupAssertions(t)
+	t.Cleanup(assertMocksCalled)
+	app, cleanup := cltest.NewApplicationWithKey(t,
+		ethClient,
+	)
+	t.Cleanup(cleanup)
+	require.NoError(t, app.Start())
+
+	_, bridge := cltest.NewBridgeType(t, "async_bridge", "http://foo.bar")
+	require.NoError(t, app.Store.DB.Create(bridge).Error)
+
+	client := app.NewHTTPClient()
+
+	tomlString := `
+type            = "webhook"
+schemaVersion   = 1
+observationSource   = """
+    parse_request [type=jsonparse path="data,result" data="$(jobRun.requestBody)"];
+    multiply      [type=multiply times="100"];
+    submit        [type=bridge async=true name="async_bridge" requestData=<{"data": {"result": $(multiply)}}>];
+
+    parse_request -> multiply -> submit;
+"""
+`
+
+	t.Run("runs the pipeline without side effects", func(t *testing.T) {
+		body, _ := json.Marshal(web.SimulateJobRequest{
+			TOML: tomlString,
+			Vars: map[string]interface{}{
+				"jobRun": map[string]interface{}{"requestBody": `{"data":{"result":"1.5"}}`},
+			},
+		})
+		response, cleanup := client.Post("/v2/jobs/simulate", bytes.NewReader(body))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+
+		resource := presenters.JobSimulationResource{}
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource))
+		require.Len(t, resource.TaskRuns, 3)
+		for _, tr := range resource.TaskRuns {
+			assert.Nil(t, tr.Error, tr.DotID)
+			switch tr.DotID {
+			case "multiply":
+				require.NotNil(t, tr.Output)
+				assert.Equal(t, `"150"`, *tr.Output)
+				assert.Nil(t, tr.SimulatedAction)
+			case "submit":
+				require.NotNil(t, tr.SimulatedAction)
+				assert.Equal(t, "async_bridge", tr.SimulatedAction["name"])
+				assert.Equal(t, "http://foo.bar", tr.SimulatedAction["url"])
+			}
+		}
+
+		requireJobsCount(t, app.JobORM(), 0)
+		var runs int64
+		require.NoError(t, app.Store.DB.Table("pipeline_runs").Count(&runs).Error)
+		assert.Equal(t, int64(0), runs)
+	})
+
+	t.Run("rejects an invalid spec", func(t *testing.T) {
+		body, _ := json.Marshal(web.SimulateJobRequest{
+			TOML: `type = "webhook`,
+		})
+		response, cleanup := client.Post("/v2/jobs/simulate", bytes.NewReader(body))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+	})
+}
+
+func requireJobsCount(t *testing.T, orm job.ORM, expected int) {
+	jobs, _, err := orm.JobsV2(0, 1000)
+	require.NoError(t, err)
+	require.Len(t, jobs, expected)
+}
+
 func TestJobsController_Index_HappyPath(t *testing.T) {
 	_, client, ocrJobSpecFromFile, _, ereJobSpecFromFile, _ := setupJobSpecsControllerTestsWithJobs(t)
 
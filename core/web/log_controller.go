@@ -7,6 +7,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/audit"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 	"go.uber.org/zap/zapcore"
@@ -126,6 +127,7 @@ func (cc *LogController) Patch(c *gin.Context) {
 	// Set default logger with new configurations
 	logger.SetLogger(cc.App.GetStore().Config.CreateProductionLogger())
 	cc.App.GetLogger().SetDB(cc.App.GetStore().DB)
+	recordAuditEvent(c, cc.App, audit.EventLogConfigUpdated, request)
 
 	response := &presenters.ServiceLogConfigResource{
 		JAID: presenters.JAID{
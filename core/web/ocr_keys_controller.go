@@ -8,6 +8,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/pkg/errors"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/audit"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
@@ -39,6 +40,7 @@ func (ocrkc *OCRKeysController) Create(c *gin.Context) {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
+	recordAuditEvent(c, ocrkc.App, audit.EventKeyCreated, map[string]interface{}{"type": "ocr", "id": ekb.ID.String()})
 	jsonAPIResponse(c, presenters.NewOCRKeysBundleResource(ekb), "offChainReportingKeyBundle")
 }
 
@@ -76,6 +78,7 @@ func (ocrkc *OCRKeysController) Delete(c *gin.Context) {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
+	recordAuditEvent(c, ocrkc.App, audit.EventKeyDeleted, map[string]interface{}{"type": "ocr", "id": id.String(), "hard": hardDelete})
 	jsonAPIResponse(c, presenters.NewOCRKeysBundleResource(ekb), "offChainReportingKeyBundle")
 }
 
@@ -96,6 +99,7 @@ func (ocrkc *OCRKeysController) Import(c *gin.Context) {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
+	recordAuditEvent(c, ocrkc.App, audit.EventKeyImported, map[string]interface{}{"type": "ocr", "id": encryptedOCRKeyBundle.ID.String()})
 
 	jsonAPIResponse(c, encryptedOCRKeyBundle, "offChainReportingKeyBundle")
 }
@@ -118,6 +122,7 @@ func (ocrkc *OCRKeysController) Export(c *gin.Context) {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
+	recordAuditEvent(c, ocrkc.App, audit.EventKeyExported, map[string]interface{}{"type": "ocr", "id": id.String()})
 
 	c.Data(http.StatusOK, MediaType, bytes)
 }
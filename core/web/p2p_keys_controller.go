@@ -8,6 +8,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/audit"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/p2pkey"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
@@ -39,6 +40,7 @@ func (p2pkc *P2PKeysController) Create(c *gin.Context) {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
+	recordAuditEvent(c, p2pkc.App, audit.EventKeyCreated, map[string]interface{}{"type": "p2p", "id": key.PeerID.String()})
 	jsonAPIResponse(c, presenters.NewP2PKeyResource(key), "p2pKey")
 }
 
@@ -77,6 +79,7 @@ func (p2pkc *P2PKeysController) Delete(c *gin.Context) {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
+	recordAuditEvent(c, p2pkc.App, audit.EventKeyDeleted, map[string]interface{}{"type": "p2p", "id": key.PeerID.String(), "hard": hardDelete})
 	jsonAPIResponse(c, presenters.NewP2PKeyResource(*key), "p2pKey")
 }
 
@@ -97,6 +100,7 @@ func (p2pkc *P2PKeysController) Import(c *gin.Context) {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
+	recordAuditEvent(c, p2pkc.App, audit.EventKeyImported, map[string]interface{}{"type": "p2p", "id": key.PeerID.String()})
 
 	jsonAPIResponse(c, presenters.NewP2PKeyResource(*key), "p2pKey")
 }
@@ -120,6 +124,7 @@ func (p2pkc *P2PKeysController) Export(c *gin.Context) {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
+	recordAuditEvent(c, p2pkc.App, audit.EventKeyExported, map[string]interface{}{"type": "p2p", "id": stringID})
 
 	c.Data(http.StatusOK, MediaType, bytes)
 }
@@ -0,0 +1,50 @@
+package presenters
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/services/audit"
+)
+
+// AuditLogResource represents an audit log event JSONAPI resource.
+type AuditLogResource struct {
+	JAID
+	Type      audit.EventType `json:"type"`
+	UserEmail string          `json:"userEmail"`
+	RemoteIP  string          `json:"remoteIP"`
+	Data      json.RawMessage `json:"data"`
+	CreatedAt time.Time       `json:"createdAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r AuditLogResource) GetName() string {
+	return "auditLogs"
+}
+
+// NewAuditLogResource constructs a new AuditLogResource.
+func NewAuditLogResource(e audit.Event) *AuditLogResource {
+	data := json.RawMessage(e.Data)
+	if len(data) == 0 {
+		data = json.RawMessage("{}")
+	}
+	return &AuditLogResource{
+		JAID:      NewJAID(strconv.FormatInt(e.ID, 10)),
+		Type:      e.Type,
+		UserEmail: e.UserEmail.ValueOrZero(),
+		RemoteIP:  e.RemoteIP,
+		Data:      data,
+		CreatedAt: e.CreatedAt,
+	}
+}
+
+// NewAuditLogResources initializes a slice of JSONAPI audit log resources
+func NewAuditLogResources(events []audit.Event) []AuditLogResource {
+	rs := []AuditLogResource{}
+	for _, e := range events {
+		rs = append(rs, *NewAuditLogResource(e))
+	}
+
+	return rs
+}
@@ -0,0 +1,51 @@
+package presenters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/smartcontractkit/chainlink/core/services/audit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+	"gorm.io/datatypes"
+)
+
+func TestAuditLogResource(t *testing.T) {
+	var (
+		ts = time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	)
+
+	event := audit.Event{
+		ID:        7,
+		Type:      audit.EventBridgeDeleted,
+		UserEmail: null.StringFrom("notreal@fakeemail.ch"),
+		RemoteIP:  "127.0.0.1",
+		Data:      datatypes.JSON(`{"name":"adapter"}`),
+		CreatedAt: ts,
+	}
+
+	r := NewAuditLogResource(event)
+
+	b, err := jsonapi.Marshal(r)
+	require.NoError(t, err)
+
+	expected := `
+	{
+		"data": {
+		   "type": "auditLogs",
+		   "id": "7",
+		   "attributes": {
+			  "type": "bridge_deleted",
+			  "userEmail": "notreal@fakeemail.ch",
+			  "remoteIP": "127.0.0.1",
+			  "data": {"name": "adapter"},
+			  "createdAt": "2000-01-01T00:00:00Z"
+		   }
+		}
+	 }
+	`
+
+	assert.JSONEq(t, expected, string(b))
+}
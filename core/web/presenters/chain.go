@@ -0,0 +1,63 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"gopkg.in/guregu/null.v4"
+)
+
+// ChainResource represents an EVM chain JSONAPI resource.
+type ChainResource struct {
+	JAID
+	Enabled   bool           `json:"enabled"`
+	Config    types.ChainCfg `json:"config"`
+	Nodes     []NodeResource `json:"nodes"`
+	CreatedAt time.Time      `json:"createdAt"`
+	UpdatedAt time.Time      `json:"updatedAt"`
+}
+
+// NodeResource represents an RPC node of a chain.
+type NodeResource struct {
+	ID       int32       `json:"id"`
+	Name     string      `json:"name"`
+	WSURL    null.String `json:"wsURL"`
+	HTTPURL  null.String `json:"httpURL"`
+	SendOnly bool        `json:"sendOnly"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r ChainResource) GetName() string {
+	return "chains"
+}
+
+// NewChainResource constructs a new ChainResource
+func NewChainResource(c types.Chain) ChainResource {
+	nodes := make([]NodeResource, len(c.Nodes))
+	for i, n := range c.Nodes {
+		nodes[i] = NodeResource{
+			ID:       n.ID,
+			Name:     n.Name,
+			WSURL:    n.WSURL,
+			HTTPURL:  n.HTTPURL,
+			SendOnly: n.SendOnly,
+		}
+	}
+	return ChainResource{
+		JAID:      NewJAID(c.ID.String()),
+		Enabled:   c.Enabled,
+		Config:    c.Cfg,
+		Nodes:     nodes,
+		CreatedAt: c.CreatedAt,
+		UpdatedAt: c.UpdatedAt,
+	}
+}
+
+// NewChainResources initializes a slice of JSONAPI chain resources
+func NewChainResources(chains []types.Chain) []ChainResource {
+	rs := []ChainResource{}
+	for _, c := range chains {
+		rs = append(rs, NewChainResource(c))
+	}
+	return rs
+}
@@ -0,0 +1,83 @@
+package presenters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+)
+
+func TestChainResource(t *testing.T) {
+	var (
+		ts = time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	)
+
+	chain := types.Chain{
+		ID:      *utils.NewBigI(42),
+		Enabled: true,
+		Cfg: types.ChainCfg{
+			ChainType:        null.StringFrom("optimism"),
+			EthFinalityDepth: null.IntFrom(1),
+			EthGasBumpWei:    utils.NewBigI(5000000000),
+		},
+		Nodes: []types.Node{
+			{
+				ID:         1,
+				Name:       "primary",
+				EVMChainID: *utils.NewBigI(42),
+				WSURL:      null.StringFrom("ws://primary.test"),
+				HTTPURL:    null.StringFrom("http://primary.test"),
+			},
+			{
+				ID:         2,
+				Name:       "sendonly",
+				EVMChainID: *utils.NewBigI(42),
+				HTTPURL:    null.StringFrom("http://sendonly.test"),
+				SendOnly:   true,
+			},
+		},
+		CreatedAt: ts,
+		UpdatedAt: ts,
+	}
+
+	r := NewChainResource(chain)
+
+	b, err := jsonapi.Marshal(r)
+	require.NoError(t, err)
+
+	expected := `
+	{
+		"data": {
+		   "type": "chains",
+		   "id": "42",
+		   "attributes": {
+			  "enabled": true,
+			  "config": {
+				"chainType": "optimism",
+				"ethFinalityDepth": 1,
+				"ethGasBumpPercent": null,
+				"ethGasBumpThreshold": null,
+				"ethGasBumpWei": "5000000000",
+				"ethGasLimitDefault": null,
+				"ethGasLimitTransfer": null,
+				"gasEstimatorMode": null,
+				"keeperMulticallAddress": null
+			  },
+			  "nodes": [
+				{"id": 1, "name": "primary", "wsURL": "ws://primary.test", "httpURL": "http://primary.test", "sendOnly": false},
+				{"id": 2, "name": "sendonly", "wsURL": null, "httpURL": "http://sendonly.test", "sendOnly": true}
+			  ],
+			  "createdAt": "2000-01-01T00:00:00Z",
+			  "updatedAt": "2000-01-01T00:00:00Z"
+		   }
+		}
+	 }
+	`
+
+	assert.JSONEq(t, expected, string(b))
+}
@@ -16,9 +16,13 @@ type ETHKeyResource struct {
 	LinkBalance *assets.Link `json:"linkBalance"`
 	NextNonce   int64        `json:"nextNonce"`
 	IsFunding   bool         `json:"isFunding"`
-	CreatedAt   time.Time    `json:"createdAt"`
-	UpdatedAt   time.Time    `json:"updatedAt"`
-	DeletedAt   *time.Time   `json:"deletedAt"`
+	IsRemote    bool         `json:"isRemote"`
+	// TxLimits are the limits that override the node's config for the
+	// transactions of the key
+	TxLimits  ethkey.TxLimits `json:"txLimits"`
+	CreatedAt time.Time       `json:"createdAt"`
+	UpdatedAt time.Time       `json:"updatedAt"`
+	DeletedAt *time.Time      `json:"deletedAt"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -44,6 +48,8 @@ func NewETHKeyResource(k ethkey.Key, opts ...NewETHKeyOption) (*ETHKeyResource,
 		LinkBalance: nil,
 		NextNonce:   k.NextNonce,
 		IsFunding:   k.IsFunding,
+		IsRemote:    k.Remote,
+		TxLimits:    k.TxLimits,
 		CreatedAt:   k.CreatedAt,
 		UpdatedAt:   k.UpdatedAt,
 	}
@@ -8,7 +8,9 @@ import (
 
 	"github.com/manyminds/api2go/jsonapi"
 	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/null"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"gorm.io/gorm"
@@ -55,6 +57,8 @@ func TestETHKeyResource(t *testing.T) {
 			  "linkBalance":"1",
 			  "nextNonce":1,
 			  "isFunding":true,
+			  "isRemote":false,
+			  "txLimits":{"maxTxs":null,"maxDailySpendWei":null,"maxValuePerTxWei":null},
 			  "createdAt":"2000-01-01T00:00:00Z",
 			  "updatedAt":"2000-01-01T00:00:00Z",
 			  "deletedAt":null
@@ -65,8 +69,9 @@ func TestETHKeyResource(t *testing.T) {
 
 	assert.JSONEq(t, expected, string(b))
 
-	// With a deleted field
+	// With a deleted field and transaction limits
 	key.DeletedAt = gorm.DeletedAt(sql.NullTime{Time: now, Valid: true})
+	key.TxLimits = ethkey.TxLimits{MaxTxs: null.Uint32From(5), MaxDailySpendWei: utils.NewBigI(1000)}
 
 	r, err = NewETHKeyResource(key,
 		SetETHKeyEthBalance(assets.NewEth(1)),
@@ -87,6 +92,8 @@ func TestETHKeyResource(t *testing.T) {
 				"linkBalance":"1",
 				"nextNonce":1,
 				"isFunding":true,
+				"isRemote":false,
+				"txLimits":{"maxTxs":5,"maxDailySpendWei":"1000","maxValuePerTxWei":null},
 				"createdAt":"2000-01-01T00:00:00Z",
 				"updatedAt":"2000-01-01T00:00:00Z",
 				"deletedAt":"2000-01-01T00:00:00Z"
@@ -11,17 +11,20 @@ import (
 // EthTxResource represents a Ethereum Transaction JSONAPI resource.
 type EthTxResource struct {
 	JAID
-	State    string          `json:"state"`
-	Data     hexutil.Bytes   `json:"data"`
-	From     *common.Address `json:"from"`
-	GasLimit string          `json:"gasLimit"`
-	GasPrice string          `json:"gasPrice"`
-	Hash     common.Hash     `json:"hash"`
-	Hex      string          `json:"rawHex"`
-	Nonce    string          `json:"nonce"`
-	SentAt   string          `json:"sentAt"`
-	To       *common.Address `json:"to"`
-	Value    string          `json:"value"`
+	State        string          `json:"state"`
+	Data         hexutil.Bytes   `json:"data"`
+	From         *common.Address `json:"from"`
+	GasLimit     string          `json:"gasLimit"`
+	GasPrice     string          `json:"gasPrice"`
+	GasTipCap    string          `json:"gasTipCap,omitempty"`
+	GasFeeCap    string          `json:"gasFeeCap,omitempty"`
+	Hash         common.Hash     `json:"hash"`
+	Hex          string          `json:"rawHex"`
+	Nonce        string          `json:"nonce"`
+	SentAt       string          `json:"sentAt"`
+	To           *common.Address `json:"to"`
+	Value        string          `json:"value"`
+	RevertReason string          `json:"revertReason,omitempty"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -50,7 +53,13 @@ func NewEthTxResourceFromAttempt(txa bulletprooftxmanager.EthTxAttempt) EthTxRes
 
 	r := NewEthTxResource(tx)
 	r.JAID = NewJAID(txa.Hash.Hex())
-	r.GasPrice = txa.GasPrice.String()
+	if txa.GasPrice != nil {
+		r.GasPrice = txa.GasPrice.String()
+	}
+	if txa.IsDynamicFee() {
+		r.GasTipCap = txa.GasTipCap.String()
+		r.GasFeeCap = txa.GasFeeCap.String()
+	}
 	r.Hash = txa.Hash
 	r.Hex = hexutil.Encode(txa.SignedRawTx)
 
@@ -60,5 +69,10 @@ func NewEthTxResourceFromAttempt(txa bulletprooftxmanager.EthTxAttempt) EthTxRes
 	if txa.BroadcastBeforeBlockNum != nil {
 		r.SentAt = strconv.FormatUint(uint64(*txa.BroadcastBeforeBlockNum), 10)
 	}
+	for _, receipt := range txa.EthReceipts {
+		if receipt.RevertReason.Valid {
+			r.RevertReason = receipt.RevertReason.String
+		}
+	}
 	return r
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
 )
 
 func TestEthTxResource(t *testing.T) {
@@ -68,7 +69,7 @@ func TestEthTxResource(t *testing.T) {
 	txa := bulletprooftxmanager.EthTxAttempt{
 		EthTx:                   tx,
 		Hash:                    hash,
-		GasPrice:                *gasPrice,
+		GasPrice:                gasPrice,
 		SignedRawTx:             hexutil.MustDecode("0xcafe"),
 		BroadcastBeforeBlockNum: &broadcastBefore,
 	}
@@ -101,4 +102,13 @@ func TestEthTxResource(t *testing.T) {
 	`
 
 	assert.JSONEq(t, expected, string(b))
+
+	txa.EthReceipts = []bulletprooftxmanager.EthReceipt{{TxHash: hash, RevertReason: null.StringFrom("round not accepting submissions")}}
+
+	r = NewEthTxResourceFromAttempt(txa)
+	assert.Equal(t, "round not accepting submissions", r.RevertReason)
+
+	b, err = jsonapi.Marshal(r)
+	require.NoError(t, err)
+	assert.Contains(t, string(b), `"revertReason":"round not accepting submissions"`)
 }
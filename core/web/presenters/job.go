@@ -13,6 +13,8 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/core/services/signatures/secp256k1"
 	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"gopkg.in/guregu/null.v4"
 )
 
 // JobSpecType defines the the the spec type of the job
@@ -37,6 +39,7 @@ type DirectRequestSpec struct {
 	ContractAddress          ethkey.EIP55Address `json:"contractAddress"`
 	MinIncomingConfirmations clnull.Uint32       `json:"minIncomingConfirmations"`
 	Initiator                string              `json:"initiator"`
+	EVMChainID               *utils.Big          `json:"evmChainID"`
 	CreatedAt                time.Time           `json:"createdAt"`
 	UpdatedAt                time.Time           `json:"updatedAt"`
 }
@@ -49,9 +52,10 @@ func NewDirectRequestSpec(spec *job.DirectRequestSpec) *DirectRequestSpec {
 		MinIncomingConfirmations: spec.MinIncomingConfirmations,
 		// This is hardcoded to runlog. When we support other intiators, we need
 		// to change this
-		Initiator: "runlog",
-		CreatedAt: spec.CreatedAt,
-		UpdatedAt: spec.UpdatedAt,
+		Initiator:  "runlog",
+		EVMChainID: spec.EVMChainID,
+		CreatedAt:  spec.CreatedAt,
+		UpdatedAt:  spec.UpdatedAt,
 	}
 }
 
@@ -65,6 +69,7 @@ type FluxMonitorSpec struct {
 	IdleTimerPeriod   string              `json:"idleTimerPeriod"`
 	IdleTimerDisabled bool                `json:"idleTimerDisabled"`
 	MinPayment        *assets.Link        `json:"minPayment"`
+	EVMChainID        *utils.Big          `json:"evmChainID"`
 	CreatedAt         time.Time           `json:"createdAt"`
 	UpdatedAt         time.Time           `json:"updatedAt"`
 }
@@ -81,6 +86,7 @@ func NewFluxMonitorSpec(spec *job.FluxMonitorSpec) *FluxMonitorSpec {
 		IdleTimerPeriod:   spec.IdleTimerPeriod.String(),
 		IdleTimerDisabled: spec.IdleTimerDisabled,
 		MinPayment:        spec.MinPayment,
+		EVMChainID:        spec.EVMChainID,
 		CreatedAt:         spec.CreatedAt,
 		UpdatedAt:         spec.UpdatedAt,
 	}
@@ -99,6 +105,7 @@ type OffChainReportingSpec struct {
 	ContractConfigTrackerSubscribeInterval models.Interval      `json:"contractConfigTrackerSubscribeInterval"`
 	ContractConfigTrackerPollInterval      models.Interval      `json:"contractConfigTrackerPollInterval"`
 	ContractConfigConfirmations            uint16               `json:"contractConfigConfirmations"`
+	EVMChainID                             *utils.Big           `json:"evmChainID"`
 	CreatedAt                              time.Time            `json:"createdAt"`
 	UpdatedAt                              time.Time            `json:"updatedAt"`
 }
@@ -118,6 +125,7 @@ func NewOffChainReportingSpec(spec *job.OffchainReportingOracleSpec) *OffChainRe
 		ContractConfigTrackerSubscribeInterval: spec.ContractConfigTrackerSubscribeInterval,
 		ContractConfigTrackerPollInterval:      spec.ContractConfigTrackerPollInterval,
 		ContractConfigConfirmations:            spec.ContractConfigConfirmations,
+		EVMChainID:                             spec.EVMChainID,
 		CreatedAt:                              spec.CreatedAt,
 		UpdatedAt:                              spec.UpdatedAt,
 	}
@@ -141,6 +149,7 @@ func NewPipelineSpec(spec *pipeline.Spec) PipelineSpec {
 type KeeperSpec struct {
 	ContractAddress ethkey.EIP55Address `json:"contractAddress"`
 	FromAddress     ethkey.EIP55Address `json:"fromAddress"`
+	EVMChainID      *utils.Big          `json:"evmChainID"`
 	CreatedAt       time.Time           `json:"createdAt"`
 	UpdatedAt       time.Time           `json:"updatedAt"`
 }
@@ -150,6 +159,7 @@ func NewKeeperSpec(spec *job.KeeperSpec) *KeeperSpec {
 	return &KeeperSpec{
 		ContractAddress: spec.ContractAddress,
 		FromAddress:     spec.FromAddress,
+		EVMChainID:      spec.EVMChainID,
 		CreatedAt:       spec.CreatedAt,
 		UpdatedAt:       spec.UpdatedAt,
 	}
@@ -186,20 +196,30 @@ func NewCronSpec(spec *job.CronSpec) *CronSpec {
 }
 
 type VRFSpec struct {
-	CoordinatorAddress ethkey.EIP55Address `json:"coordinatorAddress"`
-	PublicKey          secp256k1.PublicKey `json:"publicKey"`
-	Confirmations      uint32              `json:"confirmations"`
-	CreatedAt          time.Time           `json:"createdAt"`
-	UpdatedAt          time.Time           `json:"updatedAt"`
+	CoordinatorAddress       ethkey.EIP55Address       `json:"coordinatorAddress"`
+	CoordinatorVersion       job.VRFCoordinatorVersion `json:"coordinatorVersion"`
+	PublicKey                secp256k1.PublicKey       `json:"publicKey"`
+	Confirmations            uint32                    `json:"confirmations"`
+	FromAddress              *ethkey.EIP55Address      `json:"fromAddress"`
+	BatchCoordinatorAddress  *ethkey.EIP55Address      `json:"batchCoordinatorAddress"`
+	BatchFulfillmentGasLimit uint64                    `json:"batchFulfillmentGasLimit"`
+	EVMChainID               *utils.Big                `json:"evmChainID"`
+	CreatedAt                time.Time                 `json:"createdAt"`
+	UpdatedAt                time.Time                 `json:"updatedAt"`
 }
 
 func NewVRFSpec(spec *job.VRFSpec) *VRFSpec {
 	return &VRFSpec{
-		CoordinatorAddress: spec.CoordinatorAddress,
-		PublicKey:          spec.PublicKey,
-		Confirmations:      spec.Confirmations,
-		CreatedAt:          spec.CreatedAt,
-		UpdatedAt:          spec.UpdatedAt,
+		CoordinatorAddress:       spec.CoordinatorAddress,
+		CoordinatorVersion:       spec.CoordinatorVersion,
+		PublicKey:                spec.PublicKey,
+		Confirmations:            spec.Confirmations,
+		FromAddress:              spec.FromAddress,
+		BatchCoordinatorAddress:  spec.BatchCoordinatorAddress,
+		BatchFulfillmentGasLimit: spec.BatchFulfillmentGasLimit,
+		EVMChainID:               spec.EVMChainID,
+		CreatedAt:                spec.CreatedAt,
+		UpdatedAt:                spec.UpdatedAt,
 	}
 }
 
@@ -228,6 +248,7 @@ type JobResource struct {
 	Name                  string                 `json:"name"`
 	Type                  JobSpecType            `json:"type"`
 	SchemaVersion         uint32                 `json:"schemaVersion"`
+	Version               int32                  `json:"version"`
 	MaxTaskDuration       models.Interval        `json:"maxTaskDuration"`
 	ExternalJobID         uuid.UUID              `json:"externalJobID"`
 	DirectRequestSpec     *DirectRequestSpec     `json:"directRequestSpec"`
@@ -248,6 +269,7 @@ func NewJobResource(j job.Job) *JobResource {
 		Name:            j.Name.ValueOrZero(),
 		Type:            JobSpecType(j.Type),
 		SchemaVersion:   j.SchemaVersion,
+		Version:         j.Version,
 		MaxTaskDuration: j.MaxTaskDuration,
 		PipelineSpec:    NewPipelineSpec(j.PipelineSpec),
 		ExternalJobID:   j.ExternalJobID,
@@ -294,3 +316,50 @@ func NewJobResources(js []job.Job) []JobResource {
 func (r JobResource) GetName() string {
 	return "jobs"
 }
+
+// JobSpecVersionResource represents a version of the spec of a job
+type JobSpecVersionResource struct {
+	JAID
+	JobID   int32 `json:"jobID"`
+	Version int32 `json:"version"`
+	// Current is true for the version the job is running
+	Current bool `json:"current"`
+	// TOML is null for the first version of jobs created before specs were
+	// versioned
+	TOML         null.String  `json:"toml"`
+	PipelineSpec PipelineSpec `json:"pipelineSpec"`
+	CreatedAt    time.Time    `json:"createdAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r JobSpecVersionResource) GetName() string {
+	return "jobSpecVersions"
+}
+
+// NewJobSpecVersionResource initializes a new JSONAPI job spec version
+// resource. currentVersion is the version the job is running.
+func NewJobSpecVersionResource(v job.SpecVersion, currentVersion int32) *JobSpecVersionResource {
+	r := &JobSpecVersionResource{
+		JAID:      NewJAIDInt64(v.ID),
+		JobID:     v.JobID,
+		Version:   v.Version,
+		Current:   v.Version == currentVersion,
+		TOML:      v.TOML,
+		CreatedAt: v.CreatedAt,
+	}
+	if v.PipelineSpec != nil {
+		r.PipelineSpec = NewPipelineSpec(v.PipelineSpec)
+	}
+	return r
+}
+
+// NewJobSpecVersionResources initializes a slice of JSONAPI job spec version
+// resources
+func NewJobSpecVersionResources(versions []job.SpecVersion, currentVersion int32) []JobSpecVersionResource {
+	rs := []JobSpecVersionResource{}
+	for _, v := range versions {
+		rs = append(rs, *NewJobSpecVersionResource(v, currentVersion))
+	}
+
+	return rs
+}
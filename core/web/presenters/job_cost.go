@@ -0,0 +1,69 @@
+package presenters
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// JobCostResource represents the gas spent by a job from one key on one chain
+// on one day as a JSONAPI resource. Fee includes L1Fee on Arbitrum but not on
+// Optimism.
+type JobCostResource struct {
+	JAID
+	// JobID is nil for transactions not sent by a job
+	JobID        *int32       `json:"jobID"`
+	EVMChainID   *utils.Big   `json:"evmChainID"`
+	FromAddress  string       `json:"fromAddress"`
+	Day          time.Time    `json:"day"`
+	Transactions int64        `json:"transactions"`
+	GasUsed      int64        `json:"gasUsed"`
+	Fee          *assets.Eth  `json:"fee"`
+	L1Fee        *assets.Eth  `json:"l1Fee"`
+	LinkEarned   *assets.Link `json:"linkEarned"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r JobCostResource) GetName() string {
+	return "jobCosts"
+}
+
+// NewJobCostResource constructs a new JobCostResource. Costs on the node's
+// default chain are reported with defaultChainID.
+func NewJobCostResource(c bulletprooftxmanager.JobCost, defaultChainID *big.Int) *JobCostResource {
+	chainID := defaultChainID
+	if c.EVMChainID != nil {
+		chainID = c.EVMChainID.ToInt()
+	}
+	job := "none"
+	if c.JobID != nil {
+		job = fmt.Sprint(*c.JobID)
+	}
+	day := c.Day.UTC()
+	return &JobCostResource{
+		JAID:         NewJAID(fmt.Sprintf("%s-%s-%s-%s", day.Format("2006-01-02"), job, chainID, c.FromAddress.Hex())),
+		JobID:        c.JobID,
+		EVMChainID:   utils.NewBig(chainID),
+		FromAddress:  c.FromAddress.Hex(),
+		Day:          day,
+		Transactions: c.Transactions,
+		GasUsed:      c.GasUsed,
+		Fee:          (*assets.Eth)(c.Fee.ToInt()),
+		L1Fee:        (*assets.Eth)(c.L1Fee.ToInt()),
+		LinkEarned:   (*assets.Link)(c.LinkEarned.ToInt()),
+	}
+}
+
+// NewJobCostResources initializes a slice of JSONAPI job cost resources
+func NewJobCostResources(costs []bulletprooftxmanager.JobCost, defaultChainID *big.Int) []JobCostResource {
+	rs := []JobCostResource{}
+	for _, c := range costs {
+		rs = append(rs, *NewJobCostResource(c, defaultChainID))
+	}
+
+	return rs
+}
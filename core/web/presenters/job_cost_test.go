@@ -0,0 +1,87 @@
+package presenters
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobCostResource(t *testing.T) {
+	var (
+		day         = time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+		fromAddress = common.HexToAddress("0x9D26769a2fF5B5DC4A7e9A1A79F3d2df8ee8f1a8")
+		jobID       = int32(7)
+	)
+
+	costs := []bulletprooftxmanager.JobCost{
+		{
+			JobID:        &jobID,
+			FromAddress:  fromAddress,
+			Day:          day,
+			Transactions: 2,
+			GasUsed:      42000,
+			Fee:          *utils.NewBigI(42000000000000),
+			LinkEarned:   *utils.NewBigI(1000000000000000000),
+		},
+		{
+			EVMChainID:   utils.NewBigI(42161),
+			FromAddress:  fromAddress,
+			Day:          day,
+			Transactions: 1,
+			GasUsed:      700000,
+			Fee:          *utils.NewBigI(700000000000000),
+			L1Fee:        *utils.NewBigI(500000000000000),
+		},
+	}
+
+	r := NewJobCostResources(costs, big.NewInt(1))
+
+	b, err := jsonapi.Marshal(r)
+	require.NoError(t, err)
+
+	expected := `
+	{
+		"data": [
+			{
+				"type": "jobCosts",
+				"id": "2000-01-01-7-1-0x9D26769a2fF5B5DC4A7e9A1A79F3d2df8ee8f1a8",
+				"attributes": {
+					"jobID": 7,
+					"evmChainID": "1",
+					"fromAddress": "0x9D26769a2fF5B5DC4A7e9A1A79F3d2df8ee8f1a8",
+					"day": "2000-01-01T00:00:00Z",
+					"transactions": 2,
+					"gasUsed": 42000,
+					"fee": "42000000000000",
+					"l1Fee": "0",
+					"linkEarned": "1000000000000000000"
+				}
+			},
+			{
+				"type": "jobCosts",
+				"id": "2000-01-01-none-42161-0x9D26769a2fF5B5DC4A7e9A1A79F3d2df8ee8f1a8",
+				"attributes": {
+					"jobID": null,
+					"evmChainID": "42161",
+					"fromAddress": "0x9D26769a2fF5B5DC4A7e9A1A79F3d2df8ee8f1a8",
+					"day": "2000-01-01T00:00:00Z",
+					"transactions": 1,
+					"gasUsed": 700000,
+					"fee": "700000000000000",
+					"l1Fee": "500000000000000",
+					"linkEarned": "0"
+				}
+			}
+		]
+	}
+	`
+
+	assert.JSONEq(t, expected, string(b))
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/p2pkey"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 )
 
@@ -58,6 +59,7 @@ func TestJob(t *testing.T) {
 				ID: 1,
 				DirectRequestSpec: &job.DirectRequestSpec{
 					ContractAddress: contractAddress,
+					EVMChainID:      utils.NewBigI(42),
 					CreatedAt:       timestamp,
 					UpdatedAt:       timestamp,
 				},
@@ -68,6 +70,7 @@ func TestJob(t *testing.T) {
 				},
 				Type:            job.Type("directrequest"),
 				SchemaVersion:   1,
+				Version:         1,
 				Name:            null.StringFrom("test"),
 				MaxTaskDuration: models.Interval(1 * time.Minute),
 			},
@@ -79,6 +82,7 @@ func TestJob(t *testing.T) {
 					"attributes":{
 						"name": "test",
 						"schemaVersion": 1,
+						"version": 1,
 						"type": "directrequest",
 						"maxTaskDuration": "1m0s",
 					    "externalJobID":"0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
@@ -90,6 +94,7 @@ func TestJob(t *testing.T) {
 							"contractAddress": "%s",
 							"minIncomingConfirmations": null,
 							"initiator": "runlog",
+							"evmChainID": "42",
 							"createdAt":"2000-01-01T00:00:00Z",
 							"updatedAt":"2000-01-01T00:00:00Z"
 						},
@@ -126,6 +131,7 @@ func TestJob(t *testing.T) {
 				},
 				Type:            job.Type("fluxmonitor"),
 				SchemaVersion:   1,
+				Version:         1,
 				Name:            null.StringFrom("test"),
 				MaxTaskDuration: models.Interval(1 * time.Minute),
 			},
@@ -137,6 +143,7 @@ func TestJob(t *testing.T) {
 					"attributes":{
 						"name": "test",
 						"schemaVersion": 1,
+						"version": 1,
 						"type": "fluxmonitor",
 						"maxTaskDuration": "1m0s",
 					    "externalJobID":"0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
@@ -153,6 +160,7 @@ func TestJob(t *testing.T) {
 							"pollTimerPeriod": "1s",
 							"pollTimerDisabled": false,
 							"minPayment": "1",
+							"evmChainID": null,
 							"createdAt":"2000-01-01T00:00:00Z",
 							"updatedAt":"2000-01-01T00:00:00Z"
 						},
@@ -193,6 +201,7 @@ func TestJob(t *testing.T) {
 				},
 				Type:            job.Type("offchainreporting"),
 				SchemaVersion:   1,
+				Version:         1,
 				Name:            null.StringFrom("test"),
 				MaxTaskDuration: models.Interval(1 * time.Minute),
 			},
@@ -204,6 +213,7 @@ func TestJob(t *testing.T) {
 					"attributes":{
 						"name": "test",
 						"schemaVersion": 1,
+						"version": 1,
 						"type": "offchainreporting",
 						"maxTaskDuration": "1m0s",
 					    "externalJobID":"0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
@@ -223,6 +233,7 @@ func TestJob(t *testing.T) {
 							"contractConfigTrackerSubscribeInterval": "1m0s",
 							"contractConfigTrackerPollInterval": "1m0s",
 							"contractConfigConfirmations": 1,
+							"evmChainID": null,
 							"createdAt":"2000-01-01T00:00:00Z",
 							"updatedAt":"2000-01-01T00:00:00Z"
 						},
@@ -254,6 +265,7 @@ func TestJob(t *testing.T) {
 				},
 				Type:            job.Type("keeper"),
 				SchemaVersion:   1,
+				Version:         1,
 				Name:            null.StringFrom("test"),
 				MaxTaskDuration: models.Interval(1 * time.Minute),
 			},
@@ -265,6 +277,7 @@ func TestJob(t *testing.T) {
 					"attributes":{
 						"name": "test",
 						"schemaVersion": 1,
+						"version": 1,
 						"type": "keeper",
 						"maxTaskDuration": "1m0s",
 					    "externalJobID":"0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
@@ -275,6 +288,7 @@ func TestJob(t *testing.T) {
 						"keeperSpec": {
 							"contractAddress": "%s",
 							"fromAddress": "%s",
+							"evmChainID": null,
 							"createdAt":"2000-01-01T00:00:00Z",
 							"updatedAt":"2000-01-01T00:00:00Z"
 						},
@@ -306,6 +320,7 @@ func TestJob(t *testing.T) {
 				},
 				Type:            job.Type("cron"),
 				SchemaVersion:   1,
+				Version:         1,
 				Name:            null.StringFrom("test"),
 				MaxTaskDuration: models.Interval(1 * time.Minute),
 			},
@@ -317,6 +332,7 @@ func TestJob(t *testing.T) {
                     "attributes":{
                         "name": "test",
                         "schemaVersion": 1,
+                        "version": 1,
                         "type": "cron",
                         "maxTaskDuration": "1m0s",
 					    "externalJobID":"0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
@@ -355,6 +371,7 @@ func TestJob(t *testing.T) {
 				},
 				Type:            job.Type("webhook"),
 				SchemaVersion:   1,
+				Version:         1,
 				Name:            null.StringFrom("test"),
 				MaxTaskDuration: models.Interval(1 * time.Minute),
 			},
@@ -366,6 +383,7 @@ func TestJob(t *testing.T) {
 					"attributes":{
 						"name": "test",
 						"schemaVersion": 1,
+						"version": 1,
 						"type": "webhook",
 						"maxTaskDuration": "1m0s",
 					    "externalJobID":"0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
@@ -405,6 +423,7 @@ func TestJob(t *testing.T) {
 				},
 				Type:            job.Type("keeper"),
 				SchemaVersion:   1,
+				Version:         1,
 				Name:            null.StringFrom("test"),
 				MaxTaskDuration: models.Interval(1 * time.Minute),
 				JobSpecErrors: []job.SpecError{
@@ -426,6 +445,7 @@ func TestJob(t *testing.T) {
 					"attributes":{
 						"name": "test",
 						"schemaVersion": 1,
+						"version": 1,
 						"type": "keeper",
 						"maxTaskDuration": "1m0s",
 					    "externalJobID":"0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
@@ -436,6 +456,7 @@ func TestJob(t *testing.T) {
 						"keeperSpec": {
 							"contractAddress": "%s",
 							"fromAddress": "%s",
+							"evmChainID": null,
 							"createdAt":"2000-01-01T00:00:00Z",
 							"updatedAt":"2000-01-01T00:00:00Z"
 						},
@@ -471,3 +492,50 @@ func TestJob(t *testing.T) {
 		})
 	}
 }
+
+func TestJobSpecVersionResource(t *testing.T) {
+	timestamp := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	v := job.SpecVersion{
+		ID:             10,
+		JobID:          1,
+		Version:        2,
+		TOML:           null.StringFrom(`type = "cron"`),
+		PipelineSpecID: 3,
+		PipelineSpec: &pipeline.Spec{
+			ID:           3,
+			DotDagSource: "ds1 [type=http];",
+		},
+		CreatedAt: timestamp,
+	}
+
+	r := presenters.NewJobSpecVersionResource(v, 2)
+	b, err := jsonapi.Marshal(r)
+	require.NoError(t, err)
+
+	expected := `
+	{
+		"data": {
+			"type": "jobSpecVersions",
+			"id": "10",
+			"attributes": {
+				"jobID": 1,
+				"version": 2,
+				"current": true,
+				"toml": "type = \"cron\"",
+				"pipelineSpec": {
+					"id": 3,
+					"dotDagSource": "ds1 [type=http];"
+				},
+				"createdAt": "2000-01-01T00:00:00Z"
+			}
+		}
+	}`
+	assert.JSONEq(t, expected, string(b))
+
+	v.TOML = null.String{}
+	rs := presenters.NewJobSpecVersionResources([]job.SpecVersion{v}, 3)
+	require.Len(t, rs, 1)
+	assert.False(t, rs[0].Current)
+	assert.False(t, rs[0].TOML.Valid)
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/shopspring/decimal"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/store/models"
 )
 
 // Corresponds with models.d.ts PipelineRun
@@ -89,12 +90,15 @@ func NewPipelineRunResource(pr pipeline.Run) PipelineRunResource {
 
 // Corresponds with models.d.ts PipelineTaskRun
 type PipelineTaskRunResource struct {
-	Type       pipeline.TaskType `json:"type"`
-	CreatedAt  time.Time         `json:"createdAt"`
-	FinishedAt time.Time         `json:"finishedAt"`
-	Output     *string           `json:"output"`
-	Error      *string           `json:"error"`
-	DotID      string            `json:"dotId"`
+	Type       pipeline.TaskType      `json:"type"`
+	CreatedAt  time.Time              `json:"createdAt"`
+	FinishedAt time.Time              `json:"finishedAt"`
+	Output     *string                `json:"output"`
+	Error      *string                `json:"error"`
+	DotID      string                 `json:"dotId"`
+	State      pipeline.TaskRunStatus `json:"state"`
+	// Attempts are all executions of a task with retries
+	Attempts pipeline.TaskRunAttempts `json:"attempts"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -120,6 +124,8 @@ func NewPipelineTaskRunResource(tr pipeline.TaskRun) PipelineTaskRunResource {
 		Output:     output,
 		Error:      error,
 		DotID:      tr.GetDotID(),
+		State:      tr.Status(),
+		Attempts:   tr.Attempts,
 	}
 }
 
@@ -132,3 +138,62 @@ func NewPipelineRunResources(prs []pipeline.Run) []PipelineRunResource {
 
 	return out
 }
+
+// JobSimulationResource represents a run of the pipeline of a job spec that
+// was simulated without saving the job; see pipeline.Runner.SimulateRun
+type JobSimulationResource struct {
+	JAID
+	State      pipeline.RunStatus         `json:"state"`
+	Outputs    pipeline.JSONSerializable  `json:"outputs"`
+	Errors     []*string                  `json:"errors"`
+	Inputs     pipeline.JSONSerializable  `json:"inputs"`
+	TaskRuns   []SimulatedTaskRunResource `json:"taskRuns"`
+	CreatedAt  time.Time                  `json:"createdAt"`
+	FinishedAt time.Time                  `json:"finishedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r JobSimulationResource) GetName() string {
+	return "jobSimulations"
+}
+
+// SimulatedTaskRunResource represents a task run of a simulated pipeline run
+type SimulatedTaskRunResource struct {
+	PipelineTaskRunResource
+	Elapsed models.Duration `json:"elapsed"`
+	// SimulatedAction is what the task would have done if it has side
+	// effects, e.g. the transaction an ethtx task would have sent
+	SimulatedAction map[string]interface{} `json:"simulatedAction"`
+}
+
+// NewJobSimulationResource initializes a new JSONAPI job simulation resource
+func NewJobSimulationResource(id string, run pipeline.Run, sim *pipeline.Simulation) *JobSimulationResource {
+	r := &JobSimulationResource{
+		JAID:       NewJAID(id),
+		State:      run.State,
+		Outputs:    run.Outputs,
+		Inputs:     run.Inputs,
+		TaskRuns:   []SimulatedTaskRunResource{},
+		CreatedAt:  run.CreatedAt,
+		FinishedAt: run.FinishedAt.ValueOrZero(),
+	}
+	for _, err := range run.Errors {
+		if err.Valid {
+			s := err.String
+			r.Errors = append(r.Errors, &s)
+		} else {
+			r.Errors = append(r.Errors, nil)
+		}
+	}
+	for _, tr := range run.PipelineTaskRuns {
+		str := SimulatedTaskRunResource{PipelineTaskRunResource: NewPipelineTaskRunResource(tr)}
+		if tr.FinishedAt.Valid {
+			str.Elapsed = models.MustMakeDuration(tr.FinishedAt.Time.Sub(tr.CreatedAt))
+		}
+		if sim != nil {
+			str.SimulatedAction = sim.Action(tr.GetDotID())
+		}
+		r.TaskRuns = append(r.TaskRuns, str)
+	}
+	return r
+}
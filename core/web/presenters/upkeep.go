@@ -0,0 +1,83 @@
+package presenters
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/smartcontractkit/chainlink/core/services/keeper"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"gopkg.in/guregu/null.v4"
+)
+
+// UpkeepResource represents an upkeep of a keeper job JSONAPI resource.
+type UpkeepResource struct {
+	JAID
+	UpkeepID           string                  `json:"upkeepID"`
+	RegistryAddress    ethkey.EIP55Address     `json:"registryAddress"`
+	RegistryVersion    string                  `json:"registryVersion"`
+	RegistryPaused     bool                    `json:"registryPaused"`
+	ExecuteGas         uint64                  `json:"executeGas"`
+	LastRunBlockHeight int64                   `json:"lastRunBlockHeight"`
+	Performs           []UpkeepPerformResource `json:"performs"`
+}
+
+// UpkeepPerformResource represents an attempt by this node to perform an upkeep.
+type UpkeepPerformResource struct {
+	BlockNumber          int64        `json:"blockNumber"`
+	EthTxID              int64        `json:"ethTxID"`
+	TxState              null.String  `json:"txState"`
+	TxHash               *common.Hash `json:"txHash"`
+	ConfirmedBlockNumber null.Int     `json:"confirmedBlockNumber"`
+	GasUsed              null.Int     `json:"gasUsed"`
+	Success              null.Bool    `json:"success"`
+	CreatedAt            time.Time    `json:"createdAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r UpkeepResource) GetName() string {
+	return "upkeeps"
+}
+
+// NewUpkeepResource constructs a new UpkeepResource from an upkeep and its
+// perform history
+func NewUpkeepResource(upkeep keeper.UpkeepRegistration, performs []keeper.UpkeepPerformAttempt) UpkeepResource {
+	r := UpkeepResource{
+		JAID:               NewJAIDInt32(upkeep.ID),
+		UpkeepID:           strconv.FormatInt(upkeep.UpkeepID, 10),
+		RegistryAddress:    upkeep.Registry.ContractAddress,
+		RegistryVersion:    string(upkeep.Registry.Version),
+		RegistryPaused:     upkeep.Registry.Paused,
+		ExecuteGas:         upkeep.ExecuteGas,
+		LastRunBlockHeight: upkeep.LastRunBlockHeight,
+		Performs:           []UpkeepPerformResource{},
+	}
+	for _, p := range performs {
+		r.Performs = append(r.Performs, NewUpkeepPerformResource(p))
+	}
+	return r
+}
+
+// NewUpkeepPerformResource constructs a new UpkeepPerformResource
+func NewUpkeepPerformResource(p keeper.UpkeepPerformAttempt) UpkeepPerformResource {
+	r := UpkeepPerformResource{
+		BlockNumber:          p.BlockNumber,
+		EthTxID:              p.EthTxID,
+		TxState:              p.TxState,
+		TxHash:               p.TxHash,
+		ConfirmedBlockNumber: p.ConfirmedBlockNumber,
+		Success:              p.Success,
+		CreatedAt:            p.CreatedAt,
+	}
+	if p.GasUsed.Valid {
+		if gasUsed, err := hexutil.DecodeUint64(p.GasUsed.String); err == nil {
+			r.GasUsed = null.IntFrom(int64(gasUsed))
+		}
+	}
+	// A reverted transaction emits no UpkeepPerformed log
+	if !r.Success.Valid && p.ReceiptStatus.Valid && p.ReceiptStatus.String == "0x0" {
+		r.Success = null.BoolFrom(false)
+	}
+	return r
+}
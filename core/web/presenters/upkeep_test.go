@@ -0,0 +1,104 @@
+package presenters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/smartcontractkit/chainlink/core/services/keeper"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+)
+
+func TestUpkeepResource(t *testing.T) {
+	var (
+		ts       = time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+		registry = ethkey.EIP55AddressFromAddress(common.HexToAddress("0x9D26769a2fF5B5DC4A7e9A1A79F3d2df8ee8f1a8"))
+		hash1    = common.HexToHash("0x1")
+		hash2    = common.HexToHash("0x2")
+	)
+
+	upkeep := keeper.UpkeepRegistration{
+		ID:                 1,
+		UpkeepID:           7,
+		ExecuteGas:         10000,
+		LastRunBlockHeight: 42,
+		Registry: keeper.Registry{
+			ContractAddress: registry,
+			Version:         keeper.RegistryVersion_1_1,
+		},
+	}
+	performs := []keeper.UpkeepPerformAttempt{
+		{
+			UpkeepPerform: keeper.UpkeepPerform{
+				EthTxID:     2,
+				BlockNumber: 41,
+				CreatedAt:   ts,
+			},
+			TxState:              null.StringFrom("confirmed"),
+			TxHash:               &hash2,
+			ConfirmedBlockNumber: null.IntFrom(43),
+			GasUsed:              null.StringFrom("0x5208"),
+			ReceiptStatus:        null.StringFrom("0x0"),
+		},
+		{
+			UpkeepPerform: keeper.UpkeepPerform{
+				EthTxID:     1,
+				BlockNumber: 40,
+				Success:     null.BoolFrom(true),
+				CreatedAt:   ts,
+			},
+			TxState: null.StringFrom("unconfirmed"),
+			TxHash:  &hash1,
+		},
+	}
+
+	r := NewUpkeepResource(upkeep, performs)
+
+	b, err := jsonapi.Marshal(r)
+	require.NoError(t, err)
+
+	expected := `
+	{
+		"data": {
+			"type": "upkeeps",
+			"id": "1",
+			"attributes": {
+				"upkeepID": "7",
+				"registryAddress": "0x9D26769a2fF5B5DC4A7e9A1A79F3d2df8ee8f1a8",
+				"registryVersion": "1.1",
+				"registryPaused": false,
+				"executeGas": 10000,
+				"lastRunBlockHeight": 42,
+				"performs": [
+					{
+						"blockNumber": 41,
+						"ethTxID": 2,
+						"txState": "confirmed",
+						"txHash": "0x0000000000000000000000000000000000000000000000000000000000000002",
+						"confirmedBlockNumber": 43,
+						"gasUsed": 21000,
+						"success": false,
+						"createdAt": "2000-01-01T00:00:00Z"
+					},
+					{
+						"blockNumber": 40,
+						"ethTxID": 1,
+						"txState": "unconfirmed",
+						"txHash": "0x0000000000000000000000000000000000000000000000000000000000000001",
+						"confirmedBlockNumber": null,
+						"gasUsed": null,
+						"success": true,
+						"createdAt": "2000-01-01T00:00:00Z"
+					}
+				]
+			}
+		}
+	}
+	`
+
+	assert.JSONEq(t, expected, string(b))
+}
@@ -9,8 +9,9 @@ import (
 // UserResource represents a User JSONAPI resource.
 type UserResource struct {
 	JAID
-	Email     string    `json:"email"`
-	CreatedAt time.Time `json:"createdAt"`
+	Email     string          `json:"email"`
+	Role      models.UserRole `json:"role"`
+	CreatedAt time.Time       `json:"createdAt"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -25,6 +26,17 @@ func NewUserResource(u models.User) *UserResource {
 	return &UserResource{
 		JAID:      NewJAID(u.Email),
 		Email:     u.Email,
+		Role:      u.Role,
 		CreatedAt: u.CreatedAt,
 	}
 }
+
+// NewUserResources initializes a slice of JSONAPI user resources
+func NewUserResources(users []models.User) []UserResource {
+	rs := []UserResource{}
+	for _, u := range users {
+		rs = append(rs, *NewUserResource(u))
+	}
+
+	return rs
+}
@@ -17,6 +17,7 @@ func TestUserResource(t *testing.T) {
 
 	user := models.User{
 		Email:     "notreal@fakeemail.ch",
+		Role:      models.UserRoleJobEditor,
 		CreatedAt: ts,
 	}
 
@@ -32,6 +33,7 @@ func TestUserResource(t *testing.T) {
 		   "id": "notreal@fakeemail.ch",
 		   "attributes": {
 			  "email": "notreal@fakeemail.ch",
+			  "role": "job_editor",
 			  "createdAt": "2000-01-01T00:00:00Z"
 		   }
 		}
@@ -27,6 +27,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/store/config"
+	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/store/orm"
 	"github.com/ulule/limiter"
 	mgin "github.com/ulule/limiter/drivers/middleware/gin"
@@ -200,34 +201,55 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 	unauthedv2.PATCH("/resume/:runID", prc.Resume)
 
 	authv2 := r.Group("/v2", RequireAuth(app.GetStore(), AuthenticateByToken, AuthenticateBySession))
+	// Each group requires the given role or any role above it, see
+	// models.UserRole
+	jobEditor := authv2.Group("", RequireRole(models.UserRoleJobEditor))
+	keyAdmin := authv2.Group("", RequireRole(models.UserRoleKeyAdmin))
+	owner := authv2.Group("", RequireRole(models.UserRoleOwner))
 	{
 		uc := UserController{app}
 		authv2.PATCH("/user/password", uc.UpdatePassword)
 		authv2.POST("/user/token", uc.NewAPIToken)
 		authv2.POST("/user/token/delete", uc.DeleteAPIToken)
 
+		usc := UsersController{app}
+		owner.GET("/users", usc.Index)
+		owner.POST("/users", usc.Create)
+		owner.PATCH("/users/:email", usc.Update)
+		owner.DELETE("/users/:email", usc.Delete)
+
+		alc := AuditLogsController{app}
+		owner.GET("/audit_logs", paginatedRequest(alc.Index))
+
 		eia := ExternalInitiatorsController{app}
 		authv2.GET("/external_initiators", paginatedRequest(eia.Index))
-		authv2.POST("/external_initiators", eia.Create)
-		authv2.DELETE("/external_initiators/:Name", eia.Destroy)
+		jobEditor.POST("/external_initiators", eia.Create)
+		jobEditor.DELETE("/external_initiators/:Name", eia.Destroy)
 
 		bt := BridgeTypesController{app}
 		authv2.GET("/bridge_types", paginatedRequest(bt.Index))
-		authv2.POST("/bridge_types", bt.Create)
+		jobEditor.POST("/bridge_types", bt.Create)
 		authv2.GET("/bridge_types/:BridgeName", bt.Show)
-		authv2.PATCH("/bridge_types/:BridgeName", bt.Update)
-		authv2.DELETE("/bridge_types/:BridgeName", bt.Destroy)
+		jobEditor.PATCH("/bridge_types/:BridgeName", bt.Update)
+		jobEditor.DELETE("/bridge_types/:BridgeName", bt.Destroy)
+
+		chc := ChainsController{app}
+		authv2.GET("/chains", paginatedRequest(chc.Index))
+		owner.POST("/chains", chc.Create)
+		authv2.GET("/chains/:ID", chc.Show)
+		owner.PATCH("/chains/:ID", chc.Update)
+		owner.DELETE("/chains/:ID", chc.Delete)
 
 		ts := TransfersController{app}
-		authv2.POST("/transfers", ts.Create)
+		keyAdmin.POST("/transfers", ts.Create)
 
 		cc := ConfigController{app}
 		authv2.GET("/config", cc.Show)
-		authv2.PATCH("/config", cc.Patch)
+		owner.PATCH("/config", cc.Patch)
 
 		feedsMgrCtlr := FeedsManagerController{app}
 		authv2.GET("/feeds_managers", feedsMgrCtlr.List)
-		authv2.POST("/feeds_managers", feedsMgrCtlr.Create)
+		owner.POST("/feeds_managers", feedsMgrCtlr.Create)
 		authv2.GET("/feeds_managers/:id", feedsMgrCtlr.Show)
 
 		tas := TxAttemptsController{app}
@@ -238,52 +260,63 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.GET("/transactions/:TxHash", txs.Show)
 
 		rc := ReplayController{app}
-		authv2.POST("/replay_from_block/:number", rc.ReplayFromBlock)
+		owner.POST("/replay_from_block/:number", rc.ReplayFromBlock)
 
 		ekc := ETHKeysController{app}
 		authv2.GET("/keys/eth", ekc.Index)
-		authv2.POST("/keys/eth", ekc.Create)
-		authv2.DELETE("/keys/eth/:keyID", ekc.Delete)
-		authv2.POST("/keys/eth/import", ekc.Import)
-		authv2.POST("/keys/eth/export/:address", ekc.Export)
+		keyAdmin.POST("/keys/eth", ekc.Create)
+		keyAdmin.PATCH("/keys/eth/:keyID", ekc.Update)
+		keyAdmin.DELETE("/keys/eth/:keyID", ekc.Delete)
+		keyAdmin.POST("/keys/eth/import", ekc.Import)
+		keyAdmin.POST("/keys/eth/export/:address", ekc.Export)
 
 		ocrkc := OCRKeysController{app}
 		authv2.GET("/keys/ocr", ocrkc.Index)
-		authv2.POST("/keys/ocr", ocrkc.Create)
-		authv2.DELETE("/keys/ocr/:keyID", ocrkc.Delete)
-		authv2.POST("/keys/ocr/import", ocrkc.Import)
-		authv2.POST("/keys/ocr/export/:ID", ocrkc.Export)
+		keyAdmin.POST("/keys/ocr", ocrkc.Create)
+		keyAdmin.DELETE("/keys/ocr/:keyID", ocrkc.Delete)
+		keyAdmin.POST("/keys/ocr/import", ocrkc.Import)
+		keyAdmin.POST("/keys/ocr/export/:ID", ocrkc.Export)
 
 		p2pkc := P2PKeysController{app}
 		authv2.GET("/keys/p2p", p2pkc.Index)
-		authv2.POST("/keys/p2p", p2pkc.Create)
-		authv2.DELETE("/keys/p2p/:keyID", p2pkc.Delete)
-		authv2.POST("/keys/p2p/import", p2pkc.Import)
-		authv2.POST("/keys/p2p/export/:ID", p2pkc.Export)
+		keyAdmin.POST("/keys/p2p", p2pkc.Create)
+		keyAdmin.DELETE("/keys/p2p/:keyID", p2pkc.Delete)
+		keyAdmin.POST("/keys/p2p/import", p2pkc.Import)
+		keyAdmin.POST("/keys/p2p/export/:ID", p2pkc.Export)
 
 		csakc := CSAKeysController{app}
 		authv2.GET("/keys/csa", csakc.Index)
-		authv2.POST("/keys/csa", csakc.Create)
+		keyAdmin.POST("/keys/csa", csakc.Create)
 
 		vrfkc := VRFKeysController{app}
 		authv2.GET("/keys/vrf", vrfkc.Index)
-		authv2.POST("/keys/vrf", vrfkc.Create)
-		authv2.DELETE("/keys/vrf/:keyID", vrfkc.Delete)
-		authv2.POST("/keys/vrf/import", vrfkc.Import)
-		authv2.POST("/keys/vrf/export/:keyID", vrfkc.Export)
+		keyAdmin.POST("/keys/vrf", vrfkc.Create)
+		keyAdmin.DELETE("/keys/vrf/:keyID", vrfkc.Delete)
+		keyAdmin.POST("/keys/vrf/import", vrfkc.Import)
+		keyAdmin.POST("/keys/vrf/export/:keyID", vrfkc.Export)
 
 		jc := JobsController{app}
 		authv2.GET("/jobs", paginatedRequest(jc.Index))
 		authv2.GET("/jobs/:ID", jc.Show)
-		authv2.POST("/jobs", jc.Create)
-		authv2.DELETE("/jobs/:ID", jc.Delete)
+		jobEditor.POST("/jobs", jc.Create)
+		jobEditor.POST("/jobs/simulate", jc.Simulate)
+		jobEditor.PATCH("/jobs/:ID", jc.Update)
+		jobEditor.DELETE("/jobs/:ID", jc.Delete)
+		authv2.GET("/jobs/:ID/versions", jc.Versions)
+		jobEditor.POST("/jobs/:ID/versions/:version/rollback", jc.Rollback)
+
+		ukc := UpkeepsController{app}
+		authv2.GET("/jobs/:ID/upkeeps", ukc.Index)
+
+		jcc := JobCostsController{app}
+		authv2.GET("/job_costs", jcc.Index)
 
 		jpc := JobProposalsController{app}
 		authv2.GET("/job_proposals", jpc.Index)
 		authv2.GET("/job_proposals/:id", jpc.Show)
-		authv2.POST("/job_proposals/:id/approve", jpc.Approve)
-		authv2.POST("/job_proposals/:id/reject", jpc.Reject)
-		authv2.PATCH("/job_proposals/:id/spec", jpc.UpdateSpec)
+		jobEditor.POST("/job_proposals/:id/approve", jpc.Approve)
+		jobEditor.POST("/job_proposals/:id/reject", jpc.Reject)
+		jobEditor.PATCH("/job_proposals/:id/spec", jpc.UpdateSpec)
 
 		// PipelineRunsController
 		authv2.GET("/pipeline/runs", paginatedRequest(prc.Index))
@@ -291,11 +324,11 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.GET("/jobs/:ID/runs/:runID", prc.Show)
 
 		// PipelineJobSpecErrorsController
-		authv2.DELETE("/pipeline/job_spec_errors/:ID", psec.Destroy)
+		jobEditor.DELETE("/pipeline/job_spec_errors/:ID", psec.Destroy)
 
 		lgc := LogController{app}
 		authv2.GET("/log", lgc.Get)
-		authv2.PATCH("/log", lgc.Patch)
+		owner.PATCH("/log", lgc.Patch)
 	}
 
 	ping := PingController{app}
@@ -305,7 +338,7 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		AuthenticateBySession,
 	))
 	userOrEI.GET("/ping", ping.Show)
-	userOrEI.POST("/jobs/:ID/runs", prc.Create)
+	userOrEI.POST("/jobs/:ID/runs", RequireRole(models.UserRoleJobEditor), prc.Create)
 }
 
 // This is higher because it serves main.js and any static images. There are
@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/smartcontractkit/chainlink/core/services/audit"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/store/models"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/gin-gonic/contrib/sessions"
 	"github.com/gin-gonic/gin"
@@ -32,6 +34,7 @@ func (sc *SessionsController) Create(c *gin.Context) {
 
 	sid, err := sc.App.GetStore().CreateSession(sr)
 	if err != nil {
+		recordAuditEventForUser(c, sc.App, audit.EventSessionLoginFailed, null.StringFrom(sr.Email), nil)
 		jsonAPIError(c, http.StatusUnauthorized, err)
 		return
 	}
@@ -39,6 +42,7 @@ func (sc *SessionsController) Create(c *gin.Context) {
 		jsonAPIError(c, http.StatusInternalServerError, multierr.Append(errors.New("unable to save session id"), err))
 		return
 	}
+	recordAuditEventForUser(c, sc.App, audit.EventSessionLogin, null.StringFrom(sr.Email), nil)
 
 	jsonAPIResponse(c, Session{Authenticated: true}, "session")
 }
@@ -58,6 +62,7 @@ func (sc *SessionsController) Destroy(c *gin.Context) {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
+	recordAuditEvent(c, sc.App, audit.EventSessionLogout, nil)
 
 	jsonAPIResponse(c, Session{Authenticated: false}, "session")
 }
@@ -122,7 +122,7 @@ func TestSessionsController_Destroy(t *testing.T) {
 	)
 	require.NoError(t, app.Start())
 
-	correctSession := models.NewSession()
+	correctSession := models.NewSession(cltest.APIEmail)
 	require.NoError(t, app.Store.DB.Save(&correctSession).Error)
 	defer cleanup()
 
@@ -169,7 +169,7 @@ func TestSessionsController_Destroy_ReapSessions(t *testing.T) {
 	defer cleanup()
 	require.NoError(t, app.Start())
 
-	correctSession := models.NewSession()
+	correctSession := models.NewSession(cltest.APIEmail)
 	require.NoError(t, app.Store.DB.Save(&correctSession).Error)
 	cookie := cltest.MustGenerateSessionCookie(correctSession.ID)
 
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/smartcontractkit/chainlink/core/services/audit"
 	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/store/models"
@@ -34,6 +35,12 @@ func (tc *TransfersController) Create(c *gin.Context) {
 		jsonAPIError(c, http.StatusBadRequest, fmt.Errorf("transaction failed: %v", err))
 		return
 	}
+	recordAuditEvent(c, tc.App, audit.EventFundsTransferred, map[string]interface{}{
+		"from":    tr.FromAddress.Hex(),
+		"to":      tr.DestinationAddress.Hex(),
+		"amount":  tr.Amount.String(),
+		"ethTxID": etx.ID,
+	})
 
 	jsonAPIResponse(c, presenters.NewEthTxResource(etx), "eth_tx")
 }
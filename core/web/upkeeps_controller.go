@@ -0,0 +1,80 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/keeper"
+	"github.com/smartcontractkit/chainlink/core/store/orm"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// upkeepPerformHistoryLimit is the number of most recent performs returned
+// for each upkeep
+const upkeepPerformHistoryLimit = 20
+
+// UpkeepsController manages the upkeeps of keeper jobs
+type UpkeepsController struct {
+	App chainlink.Application
+}
+
+// Index lists the upkeeps of a keeper job, along with their recent perform
+// history
+// Example:
+// "GET <application>/jobs/:ID/upkeeps"
+func (uc *UpkeepsController) Index(c *gin.Context) {
+	jobSpec := job.Job{}
+	err := jobSpec.SetID(c.Param("ID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	jobSpec, err = uc.App.JobORM().FindJobTx(jobSpec.ID)
+	if errors.Cause(err) == orm.ErrorNotFound {
+		jsonAPIError(c, http.StatusNotFound, errors.New("job not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	if jobSpec.Type != job.Keeper {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("job %d is not a keeper job", jobSpec.ID))
+		return
+	}
+
+	store := uc.App.GetStore()
+	korm := keeper.NewORM(store.DB, nil, store.Config, nil)
+	upkeeps, err := korm.UpkeepsForJob(c.Request.Context(), jobSpec.ID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	ids := make([]int32, len(upkeeps))
+	for i, upkeep := range upkeeps {
+		ids[i] = upkeep.ID
+	}
+	performs := make(map[int32][]keeper.UpkeepPerformAttempt)
+	if len(ids) > 0 {
+		history, err := korm.UpkeepPerformHistory(c.Request.Context(), ids, upkeepPerformHistoryLimit)
+		if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+		for _, p := range history {
+			performs[p.UpkeepRegistrationID] = append(performs[p.UpkeepRegistrationID], p)
+		}
+	}
+
+	resources := []presenters.UpkeepResource{}
+	for _, upkeep := range upkeeps {
+		resources = append(resources, presenters.NewUpkeepResource(upkeep, performs[upkeep.ID]))
+	}
+
+	jsonAPIResponse(c, resources, "upkeeps")
+}
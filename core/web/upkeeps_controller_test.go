@@ -0,0 +1,52 @@
+package web_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/services/keeper"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpkeepsController_Index(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKey(t)
+	t.Cleanup(cleanup)
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	registry, j := cltest.MustInsertKeeperRegistry(t, app.Store, app.KeyStore.Eth())
+	upkeep := cltest.MustInsertUpkeepForRegistry(t, app.Store, registry)
+
+	etx := cltest.MustInsertConfirmedEthTxWithReceipt(t, app.Store.DB, registry.FromAddress.Address(), 0, 42)
+	korm := keeper.NewORM(app.Store.DB, nil, app.Store.Config, nil)
+	require.NoError(t, korm.InsertUpkeepPerform(app.Store.DB, &keeper.UpkeepPerform{
+		UpkeepRegistrationID: upkeep.ID,
+		EthTxID:              etx.ID,
+		BlockNumber:          41,
+	}))
+
+	resp, cleanup := client.Get(fmt.Sprintf("/v2/jobs/%d/upkeeps", j.ID))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var upkeeps []presenters.UpkeepResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &upkeeps))
+	require.Len(t, upkeeps, 1)
+	assert.Equal(t, fmt.Sprintf("%d", upkeep.UpkeepID), upkeeps[0].UpkeepID)
+	assert.Equal(t, registry.ContractAddress, upkeeps[0].RegistryAddress)
+	assert.Equal(t, string(keeper.RegistryVersion_1_0), upkeeps[0].RegistryVersion)
+	require.Len(t, upkeeps[0].Performs, 1)
+	assert.Equal(t, etx.ID, upkeeps[0].Performs[0].EthTxID)
+	assert.Equal(t, etx.EthTxAttempts[0].Hash, *upkeeps[0].Performs[0].TxHash)
+	assert.Equal(t, int64(42), upkeeps[0].Performs[0].ConfirmedBlockNumber.Int64)
+
+	resp, cleanup = client.Get("/v2/jobs/999999/upkeeps")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/smartcontractkit/chainlink/core/services/audit"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils"
@@ -14,7 +15,7 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-// UserController manages the current Session's User User.
+// UserController manages the current Session's User.
 type UserController struct {
 	App chainlink.Application
 }
@@ -34,7 +35,7 @@ func (c *UserController) UpdatePassword(ctx *gin.Context) {
 		return
 	}
 
-	user, err := c.App.GetStore().FindUser()
+	user, err := c.currentUser(ctx)
 	if err != nil {
 		jsonAPIError(ctx, http.StatusInternalServerError, fmt.Errorf("failed to obtain current user record: %+v", err))
 		return
@@ -47,6 +48,7 @@ func (c *UserController) UpdatePassword(ctx *gin.Context) {
 		jsonAPIError(ctx, http.StatusInternalServerError, err)
 		return
 	}
+	recordAuditEvent(ctx, c.App, audit.EventPasswordUpdated, nil)
 
 	jsonAPIResponse(ctx, presenters.NewUserResource(user), "user")
 }
@@ -59,7 +61,7 @@ func (c *UserController) NewAPIToken(ctx *gin.Context) {
 		return
 	}
 
-	user, err := c.App.GetStore().FindUser()
+	user, err := c.currentUser(ctx)
 	if err != nil {
 		jsonAPIError(ctx, http.StatusInternalServerError, fmt.Errorf("failed to obtain current user record: %+v", err))
 		return
@@ -77,6 +79,7 @@ func (c *UserController) NewAPIToken(ctx *gin.Context) {
 		jsonAPIError(ctx, http.StatusInternalServerError, err)
 		return
 	}
+	recordAuditEvent(ctx, c.App, audit.EventAPITokenCreated, nil)
 
 	jsonAPIResponseWithStatus(ctx, newToken, "auth_token", http.StatusCreated)
 }
@@ -89,7 +92,7 @@ func (c *UserController) DeleteAPIToken(ctx *gin.Context) {
 		return
 	}
 
-	user, err := c.App.GetStore().FindUser()
+	user, err := c.currentUser(ctx)
 	if err != nil {
 		jsonAPIError(ctx, http.StatusInternalServerError, fmt.Errorf("failed to obtain current user record: %+v", err))
 		return
@@ -106,11 +109,22 @@ func (c *UserController) DeleteAPIToken(ctx *gin.Context) {
 		jsonAPIError(ctx, http.StatusInternalServerError, err)
 		return
 	}
+	recordAuditEvent(ctx, c.App, audit.EventAPITokenDeleted, nil)
 	{
 		jsonAPIResponseWithStatus(ctx, nil, "auth_token", http.StatusNoContent)
 	}
 }
 
+// currentUser reloads the authenticated user, so that changes are made to
+// the latest version of their record
+func (c *UserController) currentUser(ctx *gin.Context) (models.User, error) {
+	sessionUser, ok := authenticatedUser(ctx)
+	if !ok {
+		return models.User{}, errors.New("no user is authenticated")
+	}
+	return c.App.GetStore().FindUserByEmail(sessionUser.Email)
+}
+
 func (c *UserController) getCurrentSessionID(ctx *gin.Context) (string, error) {
 	session := sessions.Default(ctx)
 	sessionID, ok := session.Get(SessionIDKey).(string)
@@ -134,7 +148,7 @@ func (c *UserController) updateUserPassword(ctx *gin.Context, user *models.User,
 	if err != nil {
 		return err
 	}
-	if err := c.App.GetStore().ClearNonCurrentSessions(sessionID); err != nil {
+	if err := c.App.GetStore().ClearNonCurrentSessions(user.Email, sessionID); err != nil {
 		return fmt.Errorf("failed to clear non current user sessions: %+v", err)
 	}
 	if err := c.saveNewPassword(user, newPassword); err != nil {
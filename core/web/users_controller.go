@@ -0,0 +1,140 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/smartcontractkit/chainlink/core/services/audit"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/store/orm"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+// UsersController manages the node's API users
+type UsersController struct {
+	App chainlink.Application
+}
+
+// CreateUserRequest represents a JSONAPI request for adding an API user
+type CreateUserRequest struct {
+	Email    string          `json:"email"`
+	Password string          `json:"password"`
+	Role     models.UserRole `json:"role"`
+}
+
+// UpdateUserRequest represents a JSONAPI request for changing an API user's
+// role
+type UpdateUserRequest struct {
+	Role models.UserRole `json:"role"`
+}
+
+// Index lists all API users
+// Example:
+// "GET <application>/users"
+func (uc *UsersController) Index(c *gin.Context) {
+	users, err := uc.App.GetStore().ListUsers()
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewUserResources(users), "users")
+}
+
+// Create adds a new API user
+// Example:
+// "POST <application>/users"
+func (uc *UsersController) Create(c *gin.Context) {
+	var request CreateUserRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	role, err := models.ParseUserRole(string(request.Role))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	user, err := models.NewUser(request.Email, request.Password, role)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	if _, err = uc.App.GetStore().FindUserByEmail(user.Email); err == nil {
+		jsonAPIError(c, http.StatusConflict, errors.Errorf("user %s already exists", user.Email))
+		return
+	} else if errors.Cause(err) != orm.ErrorNotFound {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	if err = uc.App.GetStore().CreateUser(&user); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	recordAuditEvent(c, uc.App, audit.EventUserCreated, map[string]interface{}{"email": user.Email, "role": user.Role})
+
+	jsonAPIResponseWithStatus(c, presenters.NewUserResource(user), "users", http.StatusCreated)
+}
+
+// Update changes the role of an API user
+// Example:
+// "PATCH <application>/users/:email"
+func (uc *UsersController) Update(c *gin.Context) {
+	var request UpdateUserRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	role, err := models.ParseUserRole(string(request.Role))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	user, err := uc.App.GetStore().UpdateUserRole(c.Param("email"), role)
+	if errors.Cause(err) == orm.ErrorNotFound {
+		jsonAPIError(c, http.StatusNotFound, errors.New("user not found"))
+		return
+	} else if errors.Cause(err) == orm.ErrLastOwner {
+		jsonAPIError(c, http.StatusConflict, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	recordAuditEvent(c, uc.App, audit.EventUserRoleUpdated, map[string]interface{}{"email": user.Email, "role": user.Role})
+
+	jsonAPIResponse(c, presenters.NewUserResource(user), "users")
+}
+
+// Delete removes an API user and all of their sessions
+// Example:
+// "DELETE <application>/users/:email"
+func (uc *UsersController) Delete(c *gin.Context) {
+	email := c.Param("email")
+	if current, ok := authenticatedUser(c); ok && current.Email == email {
+		jsonAPIError(c, http.StatusConflict, errors.New("cannot delete the currently authenticated user"))
+		return
+	}
+
+	err := uc.App.GetStore().DeleteUserByEmail(email)
+	if errors.Cause(err) == orm.ErrorNotFound {
+		jsonAPIError(c, http.StatusNotFound, errors.New("user not found"))
+		return
+	} else if errors.Cause(err) == orm.ErrLastOwner {
+		jsonAPIError(c, http.StatusConflict, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	recordAuditEvent(c, uc.App, audit.EventUserDeleted, map[string]interface{}{"email": email})
+
+	jsonAPIResponseWithStatus(c, nil, "users", http.StatusNoContent)
+}
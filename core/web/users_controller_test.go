@@ -0,0 +1,133 @@
+package web_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/store/orm"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUsersController_Lifecycle(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication(t)
+	t.Cleanup(cleanup)
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	body := fmt.Sprintf(`{"email": "editor@chainlink.test", "password": "%s", "role": "job_editor"}`, cltest.Password)
+	resp, cleanup := client.Post("/v2/users", bytes.NewBufferString(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusCreated)
+	var created presenters.UserResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &created))
+	assert.Equal(t, "editor@chainlink.test", created.Email)
+	assert.Equal(t, models.UserRoleJobEditor, created.Role)
+
+	resp, cleanup = client.Post("/v2/users", bytes.NewBufferString(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusConflict)
+
+	resp, cleanup = client.Get("/v2/users")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var users []presenters.UserResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &users))
+	require.Len(t, users, 2)
+	assert.Equal(t, cltest.APIEmail, users[0].Email)
+	assert.Equal(t, models.UserRoleOwner, users[0].Role)
+	assert.Equal(t, "editor@chainlink.test", users[1].Email)
+
+	resp, cleanup = client.Patch("/v2/users/editor@chainlink.test", bytes.NewBufferString(`{"role": "view-only"}`))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	user, err := app.Store.FindUserByEmail("editor@chainlink.test")
+	require.NoError(t, err)
+	assert.Equal(t, models.UserRoleViewOnly, user.Role)
+
+	resp, cleanup = client.Delete("/v2/users/editor@chainlink.test")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNoContent)
+	_, err = app.Store.FindUserByEmail("editor@chainlink.test")
+	assert.Equal(t, orm.ErrorNotFound, err)
+}
+
+func TestUsersController_Errors(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication(t)
+	t.Cleanup(cleanup)
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		body       string
+		wantStatus int
+	}{
+		{"create with invalid role", "POST", "/v2/users", fmt.Sprintf(`{"email": "a@chainlink.test", "password": "%s", "role": "admin"}`, cltest.Password), http.StatusUnprocessableEntity},
+		{"create with invalid email", "POST", "/v2/users", fmt.Sprintf(`{"email": "a", "password": "%s", "role": "owner"}`, cltest.Password), http.StatusUnprocessableEntity},
+		{"update missing user", "PATCH", "/v2/users/missing@chainlink.test", `{"role": "owner"}`, http.StatusNotFound},
+		{"demote the last owner", "PATCH", "/v2/users/" + cltest.APIEmail, `{"role": "key_admin"}`, http.StatusConflict},
+		{"delete missing user", "DELETE", "/v2/users/missing@chainlink.test", "", http.StatusNotFound},
+		{"delete the current user", "DELETE", "/v2/users/" + cltest.APIEmail, "", http.StatusConflict},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var resp *http.Response
+			var cleanup func()
+			switch test.method {
+			case "POST":
+				resp, cleanup = client.Post(test.path, bytes.NewBufferString(test.body))
+			case "PATCH":
+				resp, cleanup = client.Patch(test.path, bytes.NewBufferString(test.body))
+			case "DELETE":
+				resp, cleanup = client.Delete(test.path)
+			}
+			t.Cleanup(cleanup)
+			cltest.AssertServerResponse(t, resp, test.wantStatus)
+		})
+	}
+}
+
+func TestUsersController_Roles(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication(t)
+	t.Cleanup(cleanup)
+	require.NoError(t, app.Start())
+
+	viewer := app.NewHTTPClientForUser(cltest.MustNewUserWithRole(t, "viewer@chainlink.test", cltest.Password, models.UserRoleViewOnly))
+	editor := app.NewHTTPClientForUser(cltest.MustNewUserWithRole(t, "editor@chainlink.test", cltest.Password, models.UserRoleJobEditor))
+
+	resp, cleanup := viewer.Get("/v2/jobs")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	resp, cleanup = viewer.Post("/v2/jobs", bytes.NewBufferString(`{}`))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusForbidden)
+
+	resp, cleanup = editor.Post("/v2/keys/eth", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusForbidden)
+
+	resp, cleanup = editor.Get("/v2/users")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusForbidden)
+
+	resp, cleanup = editor.Delete("/v2/users/viewer@chainlink.test")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusForbidden)
+}
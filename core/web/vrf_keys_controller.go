@@ -9,6 +9,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/audit"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 )
@@ -44,6 +45,7 @@ func (vrfkc *VRFKeysController) Create(c *gin.Context) {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
+	recordAuditEvent(c, vrfkc.App, audit.EventKeyCreated, map[string]interface{}{"type": "vrf", "id": pk.String()})
 	jsonAPIResponse(c, presenters.NewVRFKeyResource(*encKey), "vrfKey")
 }
 
@@ -80,6 +82,7 @@ func (vrfkc *VRFKeysController) Delete(c *gin.Context) {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
+	recordAuditEvent(c, vrfkc.App, audit.EventKeyDeleted, map[string]interface{}{"type": "vrf", "id": pk.String(), "hard": hardDelete})
 	jsonAPIResponse(c, presenters.NewVRFKeyResource(*key), "vrfKey")
 }
 
@@ -100,6 +103,7 @@ func (vrfkc *VRFKeysController) Import(c *gin.Context) {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
+	recordAuditEvent(c, vrfkc.App, audit.EventKeyImported, map[string]interface{}{"type": "vrf", "id": key.PublicKey.String()})
 
 	jsonAPIResponse(c, presenters.NewVRFKeyResource(key), "vrfKey")
 }
@@ -122,6 +126,7 @@ func (vrfkc *VRFKeysController) Export(c *gin.Context) {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
+	recordAuditEvent(c, vrfkc.App, audit.EventKeyExported, map[string]interface{}{"type": "vrf", "id": pk.String()})
 
 	c.Data(http.StatusOK, MediaType, bytes)
 }
@@ -459,5 +459,13 @@ export interface PipelineTaskRun {
   output: PipelineTaskOutput
   dotId: string
   type: string
+  state?: 'pending' | 'errored' | 'completed' | 'skipped'
+  attempts?: PipelineTaskRunAttempt[] | null
+}
+
+export interface PipelineTaskRunAttempt {
+  createdAt: time.Time
+  finishedAt: time.Time
+  error: PipelineTaskError
 }
 
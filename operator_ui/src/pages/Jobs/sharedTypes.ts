@@ -37,6 +37,7 @@ export type PipelineTaskRunStatus =
   | 'errored'
   | 'completed'
   | 'not_run'
+  | 'skipped'
 
 export type PipelineTaskRun = JobRunV2['taskRuns'][0] & {
   status: PipelineTaskRunStatus
@@ -5,7 +5,7 @@ import { PipelineJobRun, PipelineTaskRun } from './sharedTypes'
 import { getOcrJobStatus } from './utils'
 
 function getTaskStatus({
-  taskRun: { dotId, finishedAt, error },
+  taskRun: { dotId, finishedAt, error, state },
   stratify,
   taskRuns,
 }: {
@@ -13,6 +13,9 @@ function getTaskStatus({
   stratify: Stratify[]
   taskRuns: JobRunV2['taskRuns']
 }) {
+  if (state === 'skipped') {
+    return 'skipped'
+  }
   if (finishedAt === null) {
     return 'in_progress'
   }